@@ -3,8 +3,11 @@ package archive
 import (
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
+	argotime "github.com/argoproj/pkg/time"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,9 +20,14 @@ import (
 
 func NewListCommand() *cobra.Command {
 	var (
-		selector  string
-		output    string
-		chunkSize int64
+		selector         string
+		output           string
+		chunkSize        int64
+		prefix           string
+		status           []string
+		workflowTemplate string
+		since            string
+		older            string
 	)
 	var command = &cobra.Command{
 		Use: "list",
@@ -28,15 +36,31 @@ func NewListCommand() *cobra.Command {
 			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
 			errors.CheckError(err)
 			namespace := client.Namespace()
+			fieldSelector := []string{"metadata.namespace=" + namespace}
+			if since != "" {
+				t, err := argotime.ParseSince(since)
+				errors.CheckError(err)
+				fieldSelector = append(fieldSelector, "spec.startedAt>"+t.Format(time.RFC3339))
+			}
+			if older != "" {
+				t, err := argotime.ParseSince(older)
+				errors.CheckError(err)
+				fieldSelector = append(fieldSelector, "spec.finishedAt<"+t.Format(time.RFC3339))
+			}
 			listOpts := &metav1.ListOptions{
-				FieldSelector: "metadata.namespace=" + namespace,
+				FieldSelector: strings.Join(fieldSelector, ","),
 				LabelSelector: selector,
 				Limit:         chunkSize,
 			}
 			var workflows wfv1.Workflows
 			for {
 				log.WithField("listOpts", listOpts).Debug()
-				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts})
+				resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{
+					ListOptions:      listOpts,
+					NamePrefix:       prefix,
+					Phases:           status,
+					WorkflowTemplate: workflowTemplate,
+				})
 				errors.CheckError(err)
 				workflows = append(workflows, resp.Items...)
 				if resp.Continue == "" {
@@ -52,5 +76,10 @@ func NewListCommand() *cobra.Command {
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
+	command.Flags().StringVar(&prefix, "prefix", "", "Filter workflows by prefix")
+	command.Flags().StringSliceVar(&status, "status", []string{}, "Filter by status (comma separated)")
+	command.Flags().StringVar(&workflowTemplate, "workflow-template", "", "Filter by the workflow template or cluster workflow template the workflows were created from")
+	command.Flags().StringVar(&since, "since", "", "Show only workflows started after a relative duration (e.g. 10m, 3h, 7d)")
+	command.Flags().StringVar(&older, "older", "", "Show only workflows finished before a relative duration (e.g. 10m, 3h, 7d)")
 	return command
 }
@@ -0,0 +1,37 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	client "github.com/argoproj/argo/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
+)
+
+func NewResubmitCommand() *cobra.Command {
+	var (
+		memoized bool
+	)
+	var command = &cobra.Command{
+		Use:   "resubmit UID...",
+		Short: "resubmit one or more archived workflows",
+		Example: `# Resubmit an archived workflow:
+
+  argo archive resubmit 7d8f6b5c-4a3e-2d1c-0b9a-8f7e6d5c4b3a
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			for _, uid := range args {
+				created, err := serviceClient.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: uid, Memoized: memoized})
+				errors.CheckError(err)
+				fmt.Printf("Archived workflow '%s' resubmitted as '%s'\n", uid, created.Name)
+			}
+		},
+	}
+	command.Flags().BoolVar(&memoized, "memoized", false, "re-use successful steps & outputs from the previous run (experimental)")
+	return command
+}
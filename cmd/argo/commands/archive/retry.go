@@ -0,0 +1,49 @@
+package archive
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	client "github.com/argoproj/argo/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
+)
+
+func NewRetryCommand() *cobra.Command {
+	var (
+		nodeFieldSelector string
+		restartSuccessful bool
+	)
+	var command = &cobra.Command{
+		Use:   "retry UID...",
+		Short: "retry one or more archived workflows, re-creating them with their previous names",
+		Example: `# Retry an archived workflow:
+
+  argo archive retry 7d8f6b5c-4a3e-2d1c-0b9a-8f7e6d5c4b3a
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			selector, err := fields.ParseSelector(nodeFieldSelector)
+			if err != nil {
+				log.Fatalf("Unable to parse node field selector '%s': %s", nodeFieldSelector, err)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			for _, uid := range args {
+				wf, err := serviceClient.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{
+					Uid:               uid,
+					RestartSuccessful: restartSuccessful,
+					NodeFieldSelector: selector.String(),
+				})
+				errors.CheckError(err)
+				fmt.Printf("Archived workflow '%s' retried as '%s'\n", uid, wf.Name)
+			}
+		},
+	}
+	command.Flags().BoolVar(&restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
+	command.Flags().StringVar(&nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	return command
+}
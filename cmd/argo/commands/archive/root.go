@@ -14,5 +14,7 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewResubmitCommand())
+	command.AddCommand(NewRetryCommand())
 	return command
 }
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var (
+		cacheType string
+		all       bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "delete CACHE [KEY...]",
+		Short: "delete entries of a memoization cache",
+		Example: `# Delete an entry of a ConfigMap cache:
+  argo cache delete my-cache my-key
+
+# Delete all entries of a SQL cache:
+  argo cache delete my-cache --type sql --all`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 || (len(args) == 1 && !all) {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewCacheServiceClient()
+			namespace := client.Namespace()
+			name := args[0]
+			keys := args[1:]
+			if all {
+				resp, err := serviceClient.ListCacheEntries(ctx, &cachepkg.CacheListRequest{
+					Namespace: namespace,
+					Type:      cacheType,
+					Name:      name,
+				})
+				if err != nil {
+					log.Fatal(err)
+				}
+				keys = nil
+				for _, entry := range resp.Entries {
+					keys = append(keys, entry.Key)
+				}
+			}
+			for _, key := range keys {
+				_, err := serviceClient.DeleteCacheEntry(ctx, &cachepkg.CacheDeleteRequest{
+					Namespace: namespace,
+					Type:      cacheType,
+					Name:      name,
+					Key:       key,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Cache entry '%s' deleted\n", key)
+			}
+		},
+	}
+
+	command.Flags().StringVar(&cacheType, "type", "configmap", "The type of the cache. One of: configmap|sql|artifact")
+	command.Flags().BoolVar(&all, "all", false, "Delete all entries of the cache")
+	return command
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheType string
+		output    string
+	)
+	var command = &cobra.Command{
+		Use:   "list CACHE",
+		Short: "list the entries of a memoization cache",
+		Example: `# List the entries of a ConfigMap cache:
+  argo cache list my-cache
+
+# List the entries of a SQL cache:
+  argo cache list my-cache --type sql`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewCacheServiceClient()
+			resp, err := serviceClient.ListCacheEntries(ctx, &cachepkg.CacheListRequest{
+				Namespace: client.Namespace(),
+				Type:      cacheType,
+				Name:      args[0],
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+			switch output {
+			case "":
+				printTable(resp.Entries)
+			case "name":
+				for _, entry := range resp.Entries {
+					fmt.Println(entry.Key)
+				}
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "configmap", "The type of the cache. One of: configmap|sql|artifact")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name")
+	return command
+}
+
+func printTable(entries []*cachepkg.CacheEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "KEY\tNODE ID\tAGE\n")
+	for _, entry := range entries {
+		age := "-"
+		if entry.CreationTimestamp != nil && !entry.CreationTimestamp.IsZero() {
+			age = humanize.RelativeDurationShort(entry.CreationTimestamp.Time, time.Now())
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", entry.Key, entry.NodeID, age)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,20 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewCacheCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "cache",
+		Short: "manipulate memoization caches",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewDeleteCommand())
+
+	return command
+}
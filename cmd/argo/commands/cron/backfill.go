@@ -0,0 +1,110 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	cronworkflowpkg "github.com/argoproj/argo/pkg/apiclient/cronworkflow"
+	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+func NewBackfillCommand() *cobra.Command {
+	var (
+		from string
+		to   string
+		wait bool
+	)
+	var command = &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW",
+		Short: "create the workflows of the scheduled times a cron workflow missed",
+		Example: `# Backfill the scheduled times of a day:
+
+  argo cron backfill my-cron --from 2020-01-01T00:00:00Z --to 2020-01-01T23:59:59Z
+
+# Backfill, waiting for the workflows to complete, which is needed to backfill more than one scheduled time
+# of a cron workflow with the Forbid or Replace concurrency policy:
+
+  argo cron backfill my-cron --from 2020-01-01T00:00:00Z --to 2020-01-01T23:59:59Z --wait
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			fromTime, err := time.Parse(time.RFC3339, from)
+			if err != nil {
+				log.Fatalf("Unable to parse --from '%s', it must be in RFC3339 format: %s", from, err)
+			}
+			toTime := time.Now()
+			if to != "" {
+				toTime, err = time.Parse(time.RFC3339, to)
+				if err != nil {
+					log.Fatalf("Unable to parse --to '%s', it must be in RFC3339 format: %s", to, err)
+				}
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewCronWorkflowServiceClient()
+			wfServiceClient := apiClient.NewWorkflowServiceClient()
+			namespace := client.Namespace()
+			req := &cronworkflowpkg.BackfillCronWorkflowRequest{
+				Name:      args[0],
+				Namespace: namespace,
+				From:      &metav1.Time{Time: fromTime},
+				To:        &metav1.Time{Time: toTime},
+			}
+			total := 0
+			for {
+				wfs, err := serviceClient.BackfillCronWorkflow(ctx, req)
+				errors.CheckError(err)
+				for _, wf := range wfs.Items {
+					fmt.Printf("Workflow '%s' created for scheduled time %s\n", wf.Name, wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+				}
+				total += len(wfs.Items)
+				if !wait || len(wfs.Items) == 0 {
+					break
+				}
+				waitForWorkflows(ctx, wfServiceClient, namespace, wfs.Items)
+			}
+			if total == 0 {
+				fmt.Printf("No workflows created, scheduled times between %s and %s have already been backfilled or workflows of CronWorkflow '%s' are running\n", fromTime.Format(time.RFC3339), toTime.Format(time.RFC3339), args[0])
+			}
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "the start of the window of scheduled times to backfill, inclusive, in RFC3339 format")
+	command.Flags().StringVar(&to, "to", "", "the end of the window of scheduled times to backfill, inclusive, in RFC3339 format, defaults to now")
+	command.Flags().BoolVar(&wait, "wait", false, "wait for the workflows to complete, and keep backfilling until every scheduled time has a workflow")
+	_ = command.MarkFlagRequired("from")
+	return command
+}
+
+// waitForWorkflows waits for each of the workflows to complete. A workflow which no longer exists, because it was
+// garbage collected or archived and deleted, has completed.
+func waitForWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace string, wfs []wfv1.Workflow) {
+	for _, wf := range wfs {
+		for {
+			latest, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: wf.Name, Namespace: namespace})
+			if status.Code(err) == codes.NotFound {
+				fmt.Printf("%s no longer exists, it has completed\n", wf.Name)
+				break
+			}
+			errors.CheckError(err)
+			if latest.Status.Fulfilled() {
+				fmt.Printf("%s %s at %v\n", latest.Name, latest.Status.Phase, latest.Status.FinishedAt)
+				break
+			}
+			time.Sleep(10 * time.Second)
+		}
+	}
+}
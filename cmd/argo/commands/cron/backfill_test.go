@@ -0,0 +1,28 @@
+package cron
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
+	workflowmocks "github.com/argoproj/argo/pkg/apiclient/workflow/mocks"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_waitForWorkflows(t *testing.T) {
+	c := &workflowmocks.WorkflowServiceClient{}
+	c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "completed", Namespace: "my-ns"}).
+		Return(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "completed"}, Status: wfv1.WorkflowStatus{Phase: wfv1.NodeSucceeded}}, nil)
+	c.On("GetWorkflow", mock.Anything, &workflowpkg.WorkflowGetRequest{Name: "deleted", Namespace: "my-ns"}).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+	waitForWorkflows(context.Background(), c, "my-ns", []wfv1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "completed"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "deleted"}},
+	})
+	c.AssertExpectations(t)
+}
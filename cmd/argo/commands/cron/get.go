@@ -67,6 +67,9 @@ func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string) {
 	}
 }
 
+// numNextScheduledTimes is the number of the next scheduled times printed
+const numNextScheduledTimes = 5
+
 func getCronWorkflowGet(wf *wfv1.CronWorkflow) string {
 	const fmtStr = "%-30s %v\n"
 
@@ -74,7 +77,10 @@ func getCronWorkflowGet(wf *wfv1.CronWorkflow) string {
 	out += fmt.Sprintf(fmtStr, "Name:", wf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", wf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(wf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", wf.Spec.Schedule)
+	out += fmt.Sprintf(fmtStr, "Schedule:", strings.Join(wf.GetSchedules(), ", "))
+	if len(wf.Spec.Exclusions) > 0 {
+		out += fmt.Sprintf(fmtStr, "Exclusions:", strings.Join(wf.Spec.Exclusions, ", "))
+	}
 	out += fmt.Sprintf(fmtStr, "Suspended:", wf.Spec.Suspend)
 	if wf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", wf.Spec.Timezone)
@@ -89,9 +95,15 @@ func getCronWorkflowGet(wf *wfv1.CronWorkflow) string {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(wf.Status.LastScheduledTime.Time))
 	}
 
-	next, err := wf.GetNextRuntime()
+	nextRuntimes, err := wf.GetNextRuntimes(numNextScheduledTimes)
 	if err == nil {
-		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next))
+		for i, next := range nextRuntimes {
+			label := ""
+			if i == 0 {
+				label = "NextScheduledTimes:"
+			}
+			out += fmt.Sprintf(fmtStr, label, humanize.Timestamp(next))
+		}
 	}
 
 	if len(wf.Status.Active) > 0 {
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
+)
+
+func NewDiffCommand() *cobra.Command {
+	var (
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "diff WORKFLOW OTHER_WORKFLOW",
+		Short: "show the differences between two workflows",
+		Example: `# Compare tonight's run with last night's:
+
+  argo diff nightly-fghij nightly-abcde
+
+# Compare with an archived workflow, using its UID:
+
+  argo diff nightly-fghij 6522aff1-1e01-11ea-b443-42010aa80075
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			comparison, err := serviceClient.CompareWorkflows(ctx, &workflowpkg.WorkflowCompareRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				OtherName: args[1],
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(comparison, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+			case "yaml":
+				data, err := yaml.Marshal(comparison)
+				errors.CheckError(err)
+				fmt.Print(string(data))
+			case "":
+				printWorkflowComparison(os.Stdout, comparison, args[0], args[1])
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+func printWorkflowComparison(out io.Writer, comparison *workflowpkg.WorkflowCompareResponse, name, otherName string) {
+	if len(comparison.Differences) == 0 && len(comparison.Nodes) == 0 {
+		_, _ = fmt.Fprintln(out, "No differences")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if len(comparison.Differences) > 0 {
+		_, _ = fmt.Fprintf(w, "FIELD\t%s\t%s\n", name, otherName)
+		for _, d := range comparison.Differences {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", d.Field, orNone(d.Value), orNone(d.OtherValue))
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	if len(comparison.Nodes) > 0 {
+		_, _ = fmt.Fprintf(w, "NODE\tTEMPLATE\tFIELD\t%s\t%s\n", name, otherName)
+		for _, n := range comparison.Nodes {
+			for i, d := range n.Differences {
+				displayName, templateName := n.DisplayName, n.TemplateName
+				if i > 0 {
+					displayName, templateName = "", ""
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", displayName, templateName, d.Field, orNone(d.Value), orNone(d.OtherValue))
+			}
+		}
+	}
+	_ = w.Flush()
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package binding
+
+import (
+	"log"
+	"os"
+
+	"github.com/argoproj/pkg/json"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+type cliCreateOpts struct {
+	output string // --output
+	strict bool   // --strict
+}
+
+func NewCreateCommand() *cobra.Command {
+	var (
+		cliCreateOpts cliCreateOpts
+	)
+	var command = &cobra.Command{
+		Use:   "create FILE1 FILE2...",
+		Short: "create a workflow event binding",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+
+			createWorkflowEventBindings(args, &cliCreateOpts)
+		},
+	}
+	command.Flags().StringVarP(&cliCreateOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	command.Flags().BoolVar(&cliCreateOpts.strict, "strict", true, "perform strict validation")
+	return command
+}
+
+func createWorkflowEventBindings(filePaths []string, cliOpts *cliCreateOpts) {
+	ctx, apiClient := client.NewAPIClient()
+	serviceClient := apiClient.NewWorkflowEventBindingServiceClient()
+
+	fileContents, err := util.ReadManifest(filePaths...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var bindings []wfv1.WorkflowEventBinding
+	for _, body := range fileContents {
+		bindings = append(bindings, unmarshalWorkflowEventBindings(body, cliOpts.strict)...)
+	}
+
+	if len(bindings) == 0 {
+		log.Println("No workflow event binding found in given files")
+		os.Exit(1)
+	}
+
+	for _, wfeb := range bindings {
+		if wfeb.Namespace == "" {
+			wfeb.Namespace = client.Namespace()
+		}
+		created, err := serviceClient.CreateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingCreateRequest{
+			Namespace: wfeb.Namespace,
+			Binding:   &wfeb,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create workflow event binding: %v", err)
+		}
+		printWorkflowEventBinding(created, cliOpts.output)
+	}
+}
+
+// unmarshalWorkflowEventBindings unmarshals the input bytes as either json or yaml
+func unmarshalWorkflowEventBindings(body []byte, strict bool) []wfv1.WorkflowEventBinding {
+	var wfeb wfv1.WorkflowEventBinding
+	var jsonOpts []json.JSONOpt
+	if strict {
+		jsonOpts = append(jsonOpts, json.DisallowUnknownFields)
+	}
+	err := json.Unmarshal(body, &wfeb, jsonOpts...)
+	if err == nil {
+		return []wfv1.WorkflowEventBinding{wfeb}
+	}
+	yamlBindings, err := common.SplitWorkflowEventBindingYAMLFile(body, strict)
+	if err == nil {
+		return yamlBindings
+	}
+	log.Fatalf("Failed to parse workflow event binding: %v", err)
+	return nil
+}
@@ -0,0 +1,52 @@
+package binding
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var (
+		all bool
+	)
+
+	var command = &cobra.Command{
+		Use:   "delete WORKFLOW_EVENT_BINDING...",
+		Short: "delete a workflow event binding",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowEventBindingServiceClient()
+			namespace := client.Namespace()
+			names := args
+			if all {
+				list, err := serviceClient.ListWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingListRequest{
+					Namespace: namespace,
+				})
+				if err != nil {
+					log.Fatal(err)
+				}
+				names = nil
+				for _, wfeb := range list.Items {
+					names = append(names, wfeb.Name)
+				}
+			}
+			for _, name := range names {
+				_, err := serviceClient.DeleteWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingDeleteRequest{
+					Name:      name,
+					Namespace: namespace,
+				})
+				errors.CheckError(err)
+				fmt.Printf("WorkflowEventBinding '%s' deleted\n", name)
+			}
+		},
+	}
+
+	command.Flags().BoolVar(&all, "all", false, "Delete all workflow event bindings")
+	return command
+}
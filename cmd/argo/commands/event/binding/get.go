@@ -0,0 +1,96 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:   "get WORKFLOW_EVENT_BINDING...",
+		Short: "display details about a workflow event binding",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowEventBindingServiceClient()
+			namespace := client.Namespace()
+			for _, name := range args {
+				wfeb, err := serviceClient.GetWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingGetRequest{
+					Name:      name,
+					Namespace: namespace,
+				})
+				errors.CheckError(err)
+				printWorkflowEventBinding(wfeb, output)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printWorkflowEventBinding(wfeb *wfv1.WorkflowEventBinding, outFmt string) {
+	switch outFmt {
+	case "name":
+		fmt.Println(wfeb.ObjectMeta.Name)
+	case "json":
+		outBytes, _ := json.MarshalIndent(wfeb, "", "    ")
+		fmt.Println(string(outBytes))
+	case "yaml":
+		outBytes, _ := yaml.Marshal(wfeb)
+		fmt.Print(string(outBytes))
+	case "wide", "":
+		fmt.Print(getWorkflowEventBindingGet(wfeb))
+	default:
+		log.Fatalf("Unknown output format: %s", outFmt)
+	}
+}
+
+func getWorkflowEventBindingGet(wfeb *wfv1.WorkflowEventBinding) string {
+	const fmtStr = "%-20s %v\n"
+
+	out := ""
+	out += fmt.Sprintf(fmtStr, "Name:", wfeb.ObjectMeta.Name)
+	out += fmt.Sprintf(fmtStr, "Namespace:", wfeb.ObjectMeta.Namespace)
+	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(wfeb.ObjectMeta.CreationTimestamp.Time))
+	out += fmt.Sprintf(fmtStr, "Selector:", wfeb.Spec.Event.Selector)
+	if submit := wfeb.Spec.Submit; submit != nil {
+		out += fmt.Sprintf(fmtStr, "Workflow Template:", workflowTemplateRefString(submit.WorkflowTemplateRef))
+		if submit.Arguments != nil && len(submit.Arguments.Parameters) > 0 {
+			out += fmt.Sprintf(fmtStr, "Parameters:", "")
+			for _, param := range submit.Arguments.Parameters {
+				if param.ValueFrom == nil {
+					continue
+				}
+				out += fmt.Sprintf(fmtStr, "  "+param.Name+":", param.ValueFrom.Event)
+			}
+		}
+	}
+	return out
+}
+
+func workflowTemplateRefString(ref wfv1.WorkflowTemplateRef) string {
+	if ref.ClusterScope {
+		return "clusterworkflowtemplate/" + ref.Name
+	}
+	return "workflowtemplate/" + ref.Name
+}
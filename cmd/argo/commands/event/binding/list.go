@@ -0,0 +1,76 @@
+package binding
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+type listFlags struct {
+	allNamespaces bool   // --all-namespaces
+	output        string // --output
+}
+
+func NewListCommand() *cobra.Command {
+	var (
+		listArgs listFlags
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "list workflow event bindings",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowEventBindingServiceClient()
+			namespace := client.Namespace()
+			if listArgs.allNamespaces {
+				namespace = apiv1.NamespaceAll
+			}
+			list, err := serviceClient.ListWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingListRequest{
+				Namespace: namespace,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+			switch listArgs.output {
+			case "", "wide":
+				printTable(list.Items, &listArgs)
+			case "name":
+				for _, wfeb := range list.Items {
+					fmt.Println(wfeb.ObjectMeta.Name)
+				}
+			default:
+				log.Fatalf("Unknown output mode: %s", listArgs.output)
+			}
+		},
+	}
+	command.Flags().BoolVar(&listArgs.allNamespaces, "all-namespaces", false, "Show workflow event bindings from all namespaces")
+	command.Flags().StringVarP(&listArgs.output, "output", "o", "", "Output format. One of: wide|name")
+	return command
+}
+
+func printTable(bindings []wfv1.WorkflowEventBinding, listArgs *listFlags) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if listArgs.allNamespaces {
+		_, _ = fmt.Fprint(w, "NAMESPACE\t")
+	}
+	_, _ = fmt.Fprint(w, "NAME\tWORKFLOW TEMPLATE\tSELECTOR\n")
+	for _, wfeb := range bindings {
+		if listArgs.allNamespaces {
+			_, _ = fmt.Fprintf(w, "%s\t", wfeb.ObjectMeta.Namespace)
+		}
+		tmpl := ""
+		if wfeb.Spec.Submit != nil {
+			tmpl = workflowTemplateRefString(wfeb.Spec.Submit.WorkflowTemplateRef)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", wfeb.ObjectMeta.Name, tmpl, wfeb.Spec.Event.Selector)
+	}
+	_ = w.Flush()
+}
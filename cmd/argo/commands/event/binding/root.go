@@ -0,0 +1,23 @@
+package binding
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewBindingCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "binding",
+		Short: "manipulate workflow event bindings",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewCreateCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewTestCommand())
+
+	return command
+}
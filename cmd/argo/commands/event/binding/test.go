@@ -0,0 +1,99 @@
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+func NewTestCommand() *cobra.Command {
+	var (
+		discriminator string
+		output        string
+	)
+
+	var command = &cobra.Command{
+		Use:   "test FILE",
+		Short: "report which workflow event bindings an event would fire, without submitting any workflows",
+		Example: `# Test the event in payload.json against the bindings in the current namespace:
+
+  argo event binding test payload.json
+
+# Test an event read from stdin:
+
+  echo '{"message": "hello"}' | argo event binding test -
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+
+			fileContents, err := util.ReadManifest(args[0])
+			errors.CheckError(err)
+			var payload wfv1.Item
+			err = yaml.Unmarshal(fileContents[0], &payload)
+			if err != nil {
+				log.Fatalf("Failed to parse event payload: %v", err)
+			}
+
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowEventBindingServiceClient()
+			rsp, err := serviceClient.TestWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingTestRequest{
+				Namespace:     client.Namespace(),
+				Discriminator: discriminator,
+				Payload:       &payload,
+			})
+			errors.CheckError(err)
+			printTestResults(rsp.Results, output)
+		},
+	}
+
+	command.Flags().StringVar(&discriminator, "discriminator", "", "Discriminator of the event")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printTestResults(results []*workfloweventbindingpkg.WorkflowEventBindingTestResult, outFmt string) {
+	switch outFmt {
+	case "json":
+		outBytes, _ := json.MarshalIndent(results, "", "    ")
+		fmt.Println(string(outBytes))
+	case "yaml":
+		outBytes, _ := yaml.Marshal(results)
+		fmt.Print(string(outBytes))
+	case "wide", "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprint(w, "NAME\tMATCHED\tWORKFLOW TEMPLATE\tARGUMENTS\tERROR\n")
+		for _, result := range results {
+			tmpl := ""
+			if result.WorkflowTemplateRef != nil {
+				tmpl = workflowTemplateRefString(*result.WorkflowTemplateRef)
+			}
+			arguments := ""
+			if result.Arguments != nil {
+				for i, param := range result.Arguments.Parameters {
+					if i > 0 {
+						arguments += ","
+					}
+					arguments += param.Name + "=" + param.Value.String()
+				}
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%v\t%s\t%s\t%s\n", result.Name, result.Matched, tmpl, arguments, result.Error)
+		}
+		_ = w.Flush()
+	default:
+		log.Fatalf("Unknown output format: %s", outFmt)
+	}
+}
@@ -0,0 +1,21 @@
+package event
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/event/binding"
+)
+
+func NewEventCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "event",
+		Short: "manipulate events",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(binding.NewBindingCommand())
+
+	return command
+}
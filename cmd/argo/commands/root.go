@@ -11,7 +11,9 @@ import (
 	"github.com/argoproj/argo/cmd/argo/commands/clustertemplate"
 
 	"github.com/argoproj/argo/cmd/argo/commands/auth"
+	"github.com/argoproj/argo/cmd/argo/commands/cache"
 	"github.com/argoproj/argo/cmd/argo/commands/cron"
+	"github.com/argoproj/argo/cmd/argo/commands/event"
 	"github.com/argoproj/argo/util/help"
 
 	"github.com/argoproj/argo/cmd/argo/commands/archive"
@@ -37,12 +39,14 @@ func NewCommand() *cobra.Command {
 
 	command.AddCommand(NewCompletionCommand())
 	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewDiffCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewLogsCommand())
 	command.AddCommand(NewResubmitCommand())
 	command.AddCommand(NewResumeCommand())
+	command.AddCommand(NewRunCommand())
 	command.AddCommand(NewRetryCommand())
 	command.AddCommand(NewServerCommand())
 	command.AddCommand(NewSubmitCommand())
@@ -58,6 +62,8 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
 	command.AddCommand(clustertemplate.NewClusterTemplateCommand())
+	command.AddCommand(event.NewEventCommand())
+	command.AddCommand(cache.NewCacheCommand())
 
 	client.AddKubectlFlagsToCmd(command)
 	client.AddAPIClientFlagsToCmd(command)
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/argoproj/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/cmd/argo/commands/client"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/local"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+func NewRunCommand() *cobra.Command {
+	var (
+		submitOpts    wfv1.SubmitOpts
+		cliSubmitOpts cliSubmitOpts
+		runLocal      bool
+		artifactDir   string
+	)
+	var command = &cobra.Command{
+		Use:   "run FILE",
+		Short: "run a workflow to completion",
+		Example: `# Run a workflow on the cluster, waiting for it to complete:
+
+  argo run my-wf.yaml
+
+# Run a workflow locally, using Docker to run its containers:
+
+  argo run --local my-wf.yaml
+
+# Run a workflow locally, keeping its artifacts in a directory:
+
+  argo run --local --artifact-dir /tmp/artifacts my-wf.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			if !runLocal {
+				cliSubmitOpts.wait = true
+				ctx, apiClient := client.NewAPIClient()
+				submitWorkflowsFromFile(ctx, apiClient.NewWorkflowServiceClient(), client.Namespace(), args, &submitOpts, &cliSubmitOpts)
+				return
+			}
+
+			fileContents, err := util.ReadManifest(args...)
+			errors.CheckError(err)
+			var workflows []wfv1.Workflow
+			for _, body := range fileContents {
+				workflows = append(workflows, unmarshalWorkflows(body, cliSubmitOpts.strict)...)
+			}
+			if len(workflows) != 1 {
+				log.Fatalf("Expected exactly one workflow, found %d", len(workflows))
+			}
+			wf := &workflows[0]
+			err = util.ApplySubmitOpts(wf, &submitOpts)
+			errors.CheckError(err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-signals
+				cancel()
+			}()
+
+			runner := local.NewRunner(local.Opts{ArtifactDir: artifactDir})
+			wf, err = runner.Run(ctx, wf)
+			errors.CheckError(err)
+			printWorkflow(wf, getFlags{output: cliSubmitOpts.output})
+			if wf.Status.Phase != wfv1.NodeSucceeded {
+				os.Exit(1)
+			}
+		},
+	}
+	util.PopulateSubmitOpts(command, &submitOpts, false)
+	command.Flags().StringVarP(&cliSubmitOpts.output, "output", "o", "", "Output format. One of: name|json|yaml|wide")
+	command.Flags().BoolVar(&cliSubmitOpts.strict, "strict", true, "perform strict workflow validation")
+	command.Flags().BoolVar(&runLocal, "local", false, "run the workflow locally, without a Kubernetes cluster, using Docker to run its containers")
+	command.Flags().StringVar(&artifactDir, "artifact-dir", ".argo/artifacts", "directory to keep artifacts in when running locally")
+	return command
+}
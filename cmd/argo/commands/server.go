@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo/server/apiserver"
 	"github.com/argoproj/argo/server/auth"
 	"github.com/argoproj/argo/util/help"
+	"github.com/argoproj/argo/util/tracing"
 )
 
 func NewServerCommand() *cobra.Command {
@@ -47,6 +48,10 @@ See %s`, help.ArgoSever),
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
 
+			shutdownTracing, err := tracing.Init("argo-server")
+			errors.CheckError(err)
+			defer shutdownTracing()
+
 			config, err := client.GetConfig().ClientConfig()
 			errors.CheckError(err)
 			config.Burst = 30
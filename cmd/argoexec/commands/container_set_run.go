@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/workflow/executor/containerset"
+)
+
+func NewContainerSetRunCommand() *cobra.Command {
+	var (
+		name         string
+		dependencies []string
+	)
+	var command = cobra.Command{
+		Use:    "container-set-run --name NAME [--dependencies NAME,...] -- COMMAND [ARG...]",
+		Short:  "run a container of a container set once its dependencies have succeeded",
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			exitCode, err := containerset.NewRunner(name, dependencies).Run(context.Background(), args)
+			if err != nil {
+				log.Errorf("%+v", err)
+			}
+			os.Exit(exitCode)
+		},
+	}
+	command.Flags().StringVar(&name, "name", "", "Name of the container")
+	command.Flags().StringSliceVar(&dependencies, "dependencies", nil, "Names of the containers which must succeed first")
+	_ = command.MarkFlagRequired("name")
+	return &command
+}
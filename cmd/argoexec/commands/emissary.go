@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/executor/emissary"
+)
+
+func NewEmissaryCommand() *cobra.Command {
+	var command = cobra.Command{
+		Use:    "emissary -- COMMAND [ARG...]",
+		Short:  "run the command of a container, recording its outputs for the wait container",
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			name, ok := os.LookupEnv(common.EnvVarContainerName)
+			if !ok {
+				log.Fatalf("Unable to determine container name from environment variable %s", common.EnvVarContainerName)
+			}
+			exitCode, err := emissary.NewRunner(name).Run(args)
+			if err != nil {
+				log.Errorf("%+v", err)
+			}
+			os.Exit(exitCode)
+		},
+	}
+	return &command
+}
@@ -1,9 +1,14 @@
 package commands
 
 import (
+	"os"
+
 	"github.com/argoproj/pkg/stats"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/util/tracing"
+	"github.com/argoproj/argo/workflow/common"
 )
 
 func NewInitCommand() *cobra.Command {
@@ -20,18 +25,36 @@ func NewInitCommand() *cobra.Command {
 	return &command
 }
 
-func loadArtifacts() error {
+func loadArtifacts() (err error) {
+	ctx, shutdownTracing := initTracing()
+	defer shutdownTracing()
+	ctx, span := tracing.Tracer().Start(ctx, "init")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	wfExecutor := initExecutor()
 	defer wfExecutor.HandleError()
 	defer stats.LogStats()
 
 	// Download input artifacts
-	err := wfExecutor.StageFiles()
+	err = wfExecutor.StageFiles()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
 	}
+	if os.Getenv(common.EnvVarContainerRuntimeExecutor) == common.ContainerRuntimeExecutorEmissary {
+		err = wfExecutor.StageExecutor()
+		if err != nil {
+			wfExecutor.AddError(err)
+			return err
+		}
+	}
+	_, loadSpan := tracing.Tracer().Start(ctx, "loadArtifacts")
 	err = wfExecutor.LoadArtifacts()
+	tracing.RecordError(loadSpan, err)
+	loadSpan.End()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/argoproj/argo"
 	"github.com/argoproj/argo/util"
 	"github.com/argoproj/argo/util/cmd"
+	"github.com/argoproj/argo/util/tracing"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/executor"
 	"github.com/argoproj/argo/workflow/executor/docker"
+	"github.com/argoproj/argo/workflow/executor/emissary"
 	"github.com/argoproj/argo/workflow/executor/k8sapi"
 	"github.com/argoproj/argo/workflow/executor/kubelet"
 	"github.com/argoproj/argo/workflow/executor/pns"
@@ -57,6 +60,8 @@ func NewRootCommand() *cobra.Command {
 		},
 	}
 
+	command.AddCommand(NewContainerSetRunCommand())
+	command.AddCommand(NewEmissaryCommand())
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
 	command.AddCommand(NewWaitCommand())
@@ -97,6 +102,8 @@ func initExecutor() *executor.WorkflowExecutor {
 		cre, err = kubelet.NewKubeletExecutor()
 	case common.ContainerRuntimeExecutorPNS:
 		cre, err = pns.NewPNSExecutor(clientset, podName, namespace, tmpl.Outputs.HasOutputs())
+	case common.ContainerRuntimeExecutorEmissary:
+		cre, err = emissary.NewEmissaryExecutor(clientset, podName, namespace)
 	default:
 		cre, err = docker.NewDockerExecutor()
 	}
@@ -109,6 +116,17 @@ func initExecutor() *executor.WorkflowExecutor {
 	return &wfExecutor
 }
 
+// initTracing starts exporting the traces of the executor, and returns the context of the trace of the workflow, and
+// the function to flush the traces with before exiting
+func initTracing() (context.Context, func()) {
+	shutdown, err := tracing.Init(CLIName)
+	if err != nil {
+		log.WithError(err).Warn("Failed to initialize tracing")
+		shutdown = func() {}
+	}
+	return tracing.Extract(context.Background(), executor.LoadTraceParent(podAnnotationsPath)), shutdown
+}
+
 // checkErr is a convenience function to panic upon error
 func checkErr(err error) {
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"github.com/argoproj/pkg/stats"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo/util/tracing"
 )
 
 func NewWaitCommand() *cobra.Command {
@@ -22,7 +24,15 @@ func NewWaitCommand() *cobra.Command {
 	return &command
 }
 
-func waitContainer() error {
+func waitContainer() (err error) {
+	ctx, shutdownTracing := initTracing()
+	defer shutdownTracing()
+	ctx, span := tracing.Tracer().Start(ctx, "wait")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	wfExecutor := initExecutor()
 	defer wfExecutor.HandleError()
 	defer stats.LogStats()
@@ -37,14 +47,17 @@ func waitContainer() error {
 	}()
 
 	// Wait for main container to complete
+	_, waitSpan := tracing.Tracer().Start(ctx, "waitMainContainer")
 	waitErr := wfExecutor.Wait()
+	tracing.RecordError(waitSpan, waitErr)
+	waitSpan.End()
 	if waitErr != nil {
 		wfExecutor.AddError(waitErr)
 		// do not return here so we can still try to kill sidecars & save outputs
 	}
 
 	// Capture output script result
-	err := wfExecutor.CaptureScriptResult()
+	err = wfExecutor.CaptureScriptResult()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
@@ -68,7 +81,16 @@ func waitContainer() error {
 		return err
 	}
 	// Saving output artifacts
+	_, saveSpan := tracing.Tracer().Start(ctx, "saveArtifacts")
 	err = wfExecutor.SaveArtifacts()
+	tracing.RecordError(saveSpan, err)
+	saveSpan.End()
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
+	// Saving the outputs of the containers of a container set
+	err = wfExecutor.SaveContainerOutputs()
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
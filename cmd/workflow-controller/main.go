@@ -20,6 +20,7 @@ import (
 
 	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
 	cmdutil "github.com/argoproj/argo/util/cmd"
+	"github.com/argoproj/argo/util/tracing"
 	"github.com/argoproj/argo/workflow/controller"
 )
 
@@ -55,6 +56,12 @@ func NewRootCommand() *cobra.Command {
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(5 * time.Minute)
 
+			shutdownTracing, err := tracing.Init(CLIName)
+			if err != nil {
+				return err
+			}
+			defer shutdownTracing()
+
 			config, err := clientConfig.ClientConfig()
 			if err != nil {
 				return err
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"math"
+	"time"
+
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -73,6 +76,17 @@ type Config struct {
 	// Parallelism limits the max total parallel workflows that can execute at the same time
 	Parallelism int `json:"parallelism,omitempty"`
 
+	// NamespaceParallelism limits the max parallel workflows of each namespace, unless the namespace has its own limit
+	// in its workflows.argoproj.io/parallelism-limit label or annotation
+	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
+
+	// NamespacePodParallelism limits the max pending or running workflow pods of each namespace, unless the namespace
+	// has its own limit in its workflows.argoproj.io/pod-parallelism-limit label or annotation
+	NamespacePodParallelism int `json:"namespacePodParallelism,omitempty"`
+
+	// PodCreation throttles the creation of workflow pods
+	PodCreation *PodCreationConfig `json:"podCreation,omitempty"`
+
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
@@ -92,6 +106,25 @@ type Config struct {
 	WorkflowRestrictions *WorkflowRestrictions `json:"workflowRestrictions,omitempty"`
 }
 
+// PodCreationConfig throttles the creation of workflow pods, so that large fan-outs do not overload the Kubernetes API
+// server and the cluster autoscaler
+type PodCreationConfig struct {
+	// MaxInFlight is the max number of workflow pods pending or running at the same time, default no limit
+	MaxInFlight int `json:"maxInFlight,omitempty"`
+	// QPS is the average number of pods created per second, default no limit
+	QPS float32 `json:"qps,omitempty"`
+	// Burst is the max number of pods created at once, default QPS rounded up
+	Burst int `json:"burst,omitempty"`
+}
+
+// GetBurst returns the max number of pods created at once
+func (c *PodCreationConfig) GetBurst() int {
+	if c.Burst > 0 {
+		return c.Burst
+	}
+	return int(math.Ceil(float64(c.QPS)))
+}
+
 // PodSpecLogStrategy contains the configuration for logging the pod spec in controller log for debugging purpose
 type PodSpecLogStrategy struct {
 	FailedPod bool `json:"failedPod,omitempty"`
@@ -127,6 +160,8 @@ type ArtifactRepository struct {
 	OSS *OSSArtifactRepository `json:"oss,omitempty"`
 	// GCS stores artifact in a GCS object store
 	GCS *GCSArtifactRepository `json:"gcs,omitempty"`
+	// Azure stores artifact in an Azure Blob Storage container
+	Azure *AzureArtifactRepository `json:"azure,omitempty"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
@@ -145,6 +180,8 @@ type PersistConfig struct {
 	ConnectionPool *ConnectionPool   `json:"connectionPool,omitempty"`
 	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
+	// Synchronization enables database semaphores and mutexes, which are shared by the controllers of the database
+	Synchronization *SyncConfig `json:"synchronization,omitempty"`
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -161,6 +198,31 @@ func (c PersistConfig) GetClusterName() string {
 	return "default"
 }
 
+// SyncConfig contains the configuration of database semaphores and mutexes
+type SyncConfig struct {
+	// ControllerName identifies the controller to the other controllers of the database. It is required, and must be
+	// unique amongst the controllers and stable across restarts, as the locks are held by the controller name.
+	ControllerName string `json:"controllerName,omitempty"`
+	// LeaseSeconds is how long the locks of a controller are held for after it stops renewing its lease, default 60
+	LeaseSeconds int `json:"leaseSeconds,omitempty"`
+	// PollSeconds is how often workflows waiting for a database lock check whether it is available, default 10
+	PollSeconds int `json:"pollSeconds,omitempty"`
+}
+
+func (c SyncConfig) GetLease() time.Duration {
+	if c.LeaseSeconds > 0 {
+		return time.Duration(c.LeaseSeconds) * time.Second
+	}
+	return 60 * time.Second
+}
+
+func (c SyncConfig) GetPollInterval() time.Duration {
+	if c.PollSeconds > 0 {
+		return time.Duration(c.PollSeconds) * time.Second
+	}
+	return 10 * time.Second
+}
+
 type ConnectionPool struct {
 	MaxIdleConns    int `json:"maxIdleConns,omitempty"`
 	MaxOpenConns    int `json:"maxOpenConns,omitempty"`
@@ -216,6 +278,14 @@ type GCSArtifactRepository struct {
 	KeyFormat string `json:"keyFormat,omitempty"`
 }
 
+// AzureArtifactRepository defines the controller configuration for an Azure Blob Storage artifact repository
+type AzureArtifactRepository struct {
+	wfv1.AzureBlobContainer `json:",inline"`
+
+	// BlobNameFormat is defines the format of how to store blob names. Can reference workflow variables
+	BlobNameFormat string `json:"blobNameFormat,omitempty"`
+}
+
 // ArtifactoryArtifactRepository defines the controller configuration for an artifactory artifact repository
 type ArtifactoryArtifactRepository struct {
 	wfv1.ArtifactoryAuth `json:",inline"`
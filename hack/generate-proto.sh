@@ -17,10 +17,10 @@ for f in $(find pkg -name '*.proto'); do
     -I ./vendor \
     -I ${GOPATH}/src \
     -I ${GOPATH}/pkg/mod/github.com/gogo/protobuf@v1.3.1/gogoproto \
-    -I ${GOPATH}/pkg/mod/github.com/grpc-ecosystem/grpc-gateway@v1.12.2/third_party/googleapis \
+    -I ${GOPATH}/pkg/mod/github.com/grpc-ecosystem/grpc-gateway@v1.16.0/third_party/googleapis \
     --gogofast_out=plugins=grpc:${GOPATH}/src \
     --grpc-gateway_out=logtostderr=true:${GOPATH}/src \
-    --swagger_out=logtostderr=true,fqn_for_swagger_name=true:. \
+    --swagger_out=logtostderr=true,fqn_for_swagger_name=true,simple_operation_ids=true:. \
     $f 2>&1 |
     grep -v 'warning: Import .* is unused'
 done
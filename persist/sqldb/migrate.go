@@ -7,6 +7,9 @@ import (
 	"upper.io/db.v3/lib/sqlbuilder"
 )
 
+// MemoizationCacheTableName is the table memoization caches of the SQL type are stored in
+const MemoizationCacheTableName = "argo_memoization_cache"
+
 type Migrate interface {
 	Exec(ctx context.Context) error
 }
@@ -249,6 +252,49 @@ func (m migrate) Exec(ctx context.Context) error {
 		ansiSQLChange(`create index ` + m.tableName + `_i1 on ` + m.tableName + ` (clustername,namespace,updatedat)`),
 		// index to find records that need deleting, this omits namespaces as this might be null
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		// memoization caches, why is the key called "cachekey"? Key is an SQL reserved word.
+		ansiSQLChange(`create table if not exists ` + MemoizationCacheTableName + ` (
+    clustername varchar(64) not null,
+    namespace varchar(256) not null,
+    cachename varchar(128) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs json not null,
+    createdat timestamp not null default current_timestamp,
+    primary key (clustername, namespace, cachename, cachekey)
+)`),
+		// add workflowtemplate column to table argo_archived_workflows, back-filled from the labels
+		ansiSQLChange(`alter table argo_archived_workflows add column workflowtemplate varchar(256)`),
+		ansiSQLChange(`update argo_archived_workflows set workflowtemplate = coalesce((select max(value) from argo_archived_workflows_labels where clustername = argo_archived_workflows.clustername and uid = argo_archived_workflows.uid and name in ('workflows.argoproj.io/workflow-template', 'workflows.argoproj.io/cluster-workflow-template')), '') where workflowtemplate is null`),
+		ternary(dbType == MySQL,
+			ansiSQLChange(`alter table argo_archived_workflows modify column workflowtemplate varchar(256) not null`),
+			ansiSQLChange(`alter table argo_archived_workflows alter column workflowtemplate set not null`),
+		),
+		// indexes for the filters and the cursor, which orders by startedat then uid
+		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,namespace,startedat,uid)`),
+		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername,instanceid,namespace,phase,startedat)`),
+		ansiSQLChange(`create index argo_archived_workflows_i5 on argo_archived_workflows (clustername,instanceid,namespace,workflowtemplate,startedat)`),
+		ansiSQLChange(`create index argo_archived_workflows_i6 on argo_archived_workflows (clustername,instanceid,namespace,name)`),
+		// database semaphores and mutexes, shared by the controllers of the database
+		ansiSQLChange(`create table if not exists ` + SyncLimitTableName + ` (
+    name varchar(256) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		ansiSQLChange(`create table if not exists ` + SyncStateTableName + ` (
+    name varchar(256) not null,
+    controller varchar(64) not null,
+    holderkey varchar(384) not null,
+    held boolean not null,
+    priority int not null,
+    creationtime timestamp not null default current_timestamp,
+    primary key (name, controller, holderkey)
+)`),
+		ansiSQLChange(`create table if not exists ` + SyncControllerTableName + ` (
+    controller varchar(64) not null,
+    heartbeat timestamp not null default current_timestamp,
+    primary key (controller)
+)`),
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
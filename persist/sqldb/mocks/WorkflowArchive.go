@@ -4,7 +4,8 @@ package mocks
 
 import (
 	mock "github.com/stretchr/testify/mock"
-	labels "k8s.io/apimachinery/pkg/labels"
+
+	sqldb "github.com/argoproj/argo/persist/sqldb"
 
 	time "time"
 
@@ -81,13 +82,13 @@ func (_m *WorkflowArchive) GetWorkflow(uid string) (*v1alpha1.Workflow, error) {
 	return r0, r1
 }
 
-// ListWorkflows provides a mock function with given fields: namespace, minStartAt, maxStartAt, labelRequirements, limit, offset
-func (_m *WorkflowArchive) ListWorkflows(namespace string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (v1alpha1.Workflows, error) {
-	ret := _m.Called(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+// ListWorkflows provides a mock function with given fields: options
+func (_m *WorkflowArchive) ListWorkflows(options sqldb.ListWorkflowsOptions) (v1alpha1.Workflows, error) {
+	ret := _m.Called(options)
 
 	var r0 v1alpha1.Workflows
-	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time, labels.Requirements, int, int) v1alpha1.Workflows); ok {
-		r0 = rf(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(0).(func(sqldb.ListWorkflowsOptions) v1alpha1.Workflows); ok {
+		r0 = rf(options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(v1alpha1.Workflows)
@@ -95,8 +96,8 @@ func (_m *WorkflowArchive) ListWorkflows(namespace string, minStartAt time.Time,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time, labels.Requirements, int, int) error); ok {
-		r1 = rf(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(1).(func(sqldb.ListWorkflowsOptions) error); ok {
+		r1 = rf(options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,56 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+)
+
+var NullSyncLockRepo SyncLockRepo = &nullSyncLockRepo{}
+var SyncLockNotSupportedError = fmt.Errorf("database synchronization is not configured, configure persistence.synchronization")
+
+type nullSyncLockRepo struct {
+}
+
+func (r *nullSyncLockRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullSyncLockRepo) Heartbeat() (bool, error) {
+	return false, nil
+}
+
+func (r *nullSyncLockRepo) GetLimit(string) (int, error) {
+	return 0, SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) CreateMutex(string) error {
+	return SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) AddToQueue(string, string, int32, time.Time) error {
+	return SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) TryAcquire(string, string, int) (bool, error) {
+	return false, SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) Acquire(string, string, int) (bool, error) {
+	return false, SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) CheckAcquire(string, string, int) (bool, error) {
+	return false, SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) Release(string, string) error {
+	return SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) ListHolders(string) ([]string, error) {
+	return nil, SyncLockNotSupportedError
+}
+
+func (r *nullSyncLockRepo) NextHolder(string) (string, error) {
+	return "", SyncLockNotSupportedError
+}
@@ -0,0 +1,362 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+const (
+	// SyncLimitTableName is the table the limits of database semaphores are stored in
+	SyncLimitTableName = "argo_sync_limits"
+	// SyncStateTableName is the table the holders of database locks, and the holders waiting for them, are stored in
+	SyncStateTableName = "argo_sync_states"
+	// SyncControllerTableName is the table the leases of the controllers sharing the database locks are stored in
+	SyncControllerTableName = "argo_sync_controllers"
+)
+
+// SyncLockRepo stores the state of semaphores and mutexes shared by the controllers of the database. Each controller
+// holds a lease it renews with Heartbeat. The locks held by a controller, and its place in the queues, are released
+// once its lease expires.
+type SyncLockRepo interface {
+	// IsEnabled returns whether database locks are configured
+	IsEnabled() bool
+	// Heartbeat renews the lease of the controller. It returns whether the lease had expired, in which case the other
+	// controllers may have released the locks held by the controller.
+	Heartbeat() (bool, error)
+	// GetLimit returns the limit of the semaphore, which must be set in the limit table
+	GetLimit(name string) (int, error)
+	// CreateMutex sets the limit of the mutex to one in the limit table, unless it is set already
+	CreateMutex(name string) error
+	// AddToQueue adds the holder to the queue of the lock, unless it holds or waits for the lock already
+	AddToQueue(name, holderKey string, priority int32, creationTime time.Time) error
+	// TryAcquire acquires the lock for the holder, if it holds the lock already, or if it is first in the queue and
+	// fewer than limit holders hold the lock. The limit of a mutex is one. The queue is ordered by priority, then
+	// creation time, then holder key, so that the queues of all the locks are in the same order.
+	TryAcquire(name, holderKey string, limit int) (bool, error)
+	// Acquire acquires the lock for the holder if fewer than limit holders hold the lock, regardless of the queue
+	Acquire(name, holderKey string, limit int) (bool, error)
+	// CheckAcquire returns whether the holder holds the lock already, or TryAcquire would acquire it now
+	CheckAcquire(name, holderKey string, limit int) (bool, error)
+	// Release releases the lock, or removes the holder from the queue
+	Release(name, holderKey string) error
+	// ListHolders returns the holders of the lock
+	ListHolders(name string) ([]string, error)
+	// NextHolder returns the holder first in the queue of the lock, or "" if the queue is empty
+	NextHolder(name string) (string, error)
+}
+
+type syncLimitRecord struct {
+	Name      string `db:"name"`
+	SizeLimit int    `db:"sizelimit"`
+}
+
+type syncStateRecord struct {
+	Name         string    `db:"name"`
+	Controller   string    `db:"controller"`
+	HolderKey    string    `db:"holderkey"`
+	Held         bool      `db:"held"`
+	Priority     int32     `db:"priority"`
+	CreationTime time.Time `db:"creationtime"`
+}
+
+type syncLockRepo struct {
+	session    sqlbuilder.Database
+	controller string
+	lease      time.Duration
+}
+
+// NewSyncLockRepo returns the repository of the database locks of the controller, whose lease expires if it does not
+// renew it within the lease duration
+func NewSyncLockRepo(session sqlbuilder.Database, controller string, lease time.Duration) SyncLockRepo {
+	return &syncLockRepo{session: session, controller: controller, lease: lease}
+}
+
+func (r *syncLockRepo) log(name string) *log.Entry {
+	return log.WithFields(log.Fields{"controller": r.controller, "lock": name})
+}
+
+func (r *syncLockRepo) IsEnabled() bool {
+	return true
+}
+
+// leaseExpiry is the SQL expression of the time before which the leases have expired. Leases are written and expired
+// by the clock of the database, rather than the clocks of the controllers, which may differ.
+func (r *syncLockRepo) leaseExpiry() string {
+	return fmt.Sprintf("current_timestamp - interval '%d' second", int(r.lease.Seconds()))
+}
+
+func (r *syncLockRepo) Heartbeat() (bool, error) {
+	// the lease is looked for, rather than relying on the rows affected by the update, because MySQL does not count
+	// the rows the update does not change
+	row, err := r.session.QueryRow("select heartbeat < "+r.leaseExpiry()+" from "+SyncControllerTableName+" where controller = ?", r.controller)
+	if err != nil {
+		return false, fmt.Errorf("failed to get lease: %w", err)
+	}
+	var expired bool
+	err = row.Scan(&expired)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the heartbeat defaults to the current time
+		_, err := r.session.
+			InsertInto(SyncControllerTableName).
+			Columns("controller").
+			Values(r.controller).
+			Exec()
+		if err != nil {
+			return false, fmt.Errorf("failed to create lease: %w", err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get lease: %w", err)
+	}
+	_, err = r.session.Exec("update "+SyncControllerTableName+" set heartbeat = current_timestamp where controller = ?", r.controller)
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lease: %w", err)
+	}
+	return expired, nil
+}
+
+func (r *syncLockRepo) GetLimit(name string) (int, error) {
+	var records []syncLimitRecord
+	err := r.session.
+		Select("name", "sizelimit").
+		From(SyncLimitTableName).
+		Where(db.Cond{"name": name}).
+		All(&records)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get limit of %s: %w", name, err)
+	}
+	if len(records) == 0 {
+		return 0, fmt.Errorf("database semaphore %s has no limit, insert its limit into the %s table", name, SyncLimitTableName)
+	}
+	return records[0].SizeLimit, nil
+}
+
+func (r *syncLockRepo) holderCond(name, holderKey string) db.Cond {
+	return db.Cond{"name": name, "controller": r.controller, "holderkey": holderKey}
+}
+
+func (r *syncLockRepo) AddToQueue(name, holderKey string, priority int32, creationTime time.Time) error {
+	var records []syncStateRecord
+	err := r.session.
+		Select("holderkey").
+		From(SyncStateTableName).
+		Where(r.holderCond(name, holderKey)).
+		All(&records)
+	if err != nil {
+		return fmt.Errorf("failed to get state of %s: %w", name, err)
+	}
+	if len(records) > 0 {
+		return nil
+	}
+	_, err = r.session.Collection(SyncStateTableName).Insert(&syncStateRecord{
+		Name:         name,
+		Controller:   r.controller,
+		HolderKey:    holderKey,
+		Priority:     priority,
+		CreationTime: creationTime.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to the queue of %s: %w", holderKey, name, err)
+	}
+	r.log(name).WithField("holderKey", holderKey).Debug("Added into queue")
+	return nil
+}
+
+func (r *syncLockRepo) TryAcquire(name, holderKey string, limit int) (bool, error) {
+	return r.acquire(name, holderKey, limit, true, false)
+}
+
+func (r *syncLockRepo) Acquire(name, holderKey string, limit int) (bool, error) {
+	return r.acquire(name, holderKey, limit, false, false)
+}
+
+func (r *syncLockRepo) CheckAcquire(name, holderKey string, limit int) (bool, error) {
+	return r.acquire(name, holderKey, limit, true, true)
+}
+
+// acquire acquires the lock for the holder, if it is first in the queue when queued is true. If check is true, it
+// only returns whether it would acquire the lock.
+func (r *syncLockRepo) acquire(name, holderKey string, limit int, queued, check bool) (bool, error) {
+	acquired := false
+	err := r.session.Tx(context.Background(), func(tx sqlbuilder.Tx) error {
+		// locking the row of the lock serializes the controllers acquiring it
+		row, err := tx.QueryRow("select name from "+SyncLimitTableName+" where name = ? for update", name)
+		if err != nil {
+			return err
+		}
+		var lockName string
+		err = row.Scan(&lockName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("lock has no limit in the %s table", SyncLimitTableName)
+		}
+		if err != nil {
+			return err
+		}
+		err = r.expireInactiveControllers(tx, name)
+		if err != nil {
+			return err
+		}
+		var states []syncStateRecord
+		err = tx.
+			Select("controller", "holderkey", "held").
+			From(SyncStateTableName).
+			Where(db.Cond{"name": name}).
+			OrderBy("-priority", "creationtime", "holderkey").
+			All(&states)
+		if err != nil {
+			return err
+		}
+		held := 0
+		var next, own *syncStateRecord
+		for i, state := range states {
+			if state.Held {
+				held++
+			} else if next == nil {
+				next = &states[i]
+			}
+			if state.Controller == r.controller && state.HolderKey == holderKey {
+				own = &states[i]
+			}
+		}
+		if own != nil && own.Held {
+			acquired = true
+			return nil
+		}
+		if held >= limit || (queued && (own == nil || own != next)) {
+			return nil
+		}
+		if check {
+			acquired = true
+			return nil
+		}
+		if own == nil {
+			_, err = tx.Collection(SyncStateTableName).Insert(&syncStateRecord{
+				Name:         name,
+				Controller:   r.controller,
+				HolderKey:    holderKey,
+				Held:         true,
+				CreationTime: time.Now().UTC(),
+			})
+		} else {
+			_, err = tx.
+				Update(SyncStateTableName).
+				Set("held", true).
+				Where(r.holderCond(name, holderKey)).
+				Exec()
+		}
+		if err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire %s: %w", name, err)
+	}
+	if acquired && !check {
+		r.log(name).WithField("holderKey", holderKey).Info("Acquired database lock")
+	}
+	return acquired, nil
+}
+
+// CreateMutex creates the row of the mutex, which the controllers lock to acquire it, if there is none yet. Mutexes
+// have no row until they are first used, whereas the rows of semaphores are inserted with their limits.
+func (r *syncLockRepo) CreateMutex(name string) error {
+	var records []syncLimitRecord
+	err := r.session.
+		Select("name").
+		From(SyncLimitTableName).
+		Where(db.Cond{"name": name}).
+		All(&records)
+	if err != nil {
+		return fmt.Errorf("failed to get limit of %s: %w", name, err)
+	}
+	if len(records) > 0 {
+		return nil
+	}
+	_, err = r.session.Collection(SyncLimitTableName).Insert(&syncLimitRecord{Name: name, SizeLimit: 1})
+	if err == nil {
+		return nil
+	}
+	// another controller may have created the row at the same time, which is fine
+	err = r.session.
+		Select("name").
+		From(SyncLimitTableName).
+		Where(db.Cond{"name": name}).
+		All(&records)
+	if err == nil && len(records) == 0 {
+		err = fmt.Errorf("no row was created")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create mutex %s: %w", name, err)
+	}
+	return nil
+}
+
+// expireInactiveControllers releases the locks held by the controllers whose leases have expired
+func (r *syncLockRepo) expireInactiveControllers(tx sqlbuilder.Tx, name string) error {
+	res, err := tx.Exec("delete from "+SyncStateTableName+" where name = ? and controller <> ? and controller not in (select controller from "+SyncControllerTableName+" where heartbeat > "+r.leaseExpiry()+")",
+		name, r.controller)
+	if err != nil {
+		return err
+	}
+	if rowsAffected, err := res.RowsAffected(); err == nil && rowsAffected > 0 {
+		r.log(name).WithField("expired", rowsAffected).Info("Released the database locks of inactive controllers")
+	}
+	return nil
+}
+
+func (r *syncLockRepo) Release(name, holderKey string) error {
+	_, err := r.session.
+		DeleteFrom(SyncStateTableName).
+		Where(r.holderCond(name, holderKey)).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("failed to release %s: %w", name, err)
+	}
+	return nil
+}
+
+func (r *syncLockRepo) ListHolders(name string) ([]string, error) {
+	var states []syncStateRecord
+	err := r.session.
+		Select("holderkey").
+		From(SyncStateTableName).
+		Where(db.Cond{"name": name, "held": true}).
+		OrderBy("creationtime").
+		All(&states)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holders of %s: %w", name, err)
+	}
+	var holders []string
+	for _, state := range states {
+		holders = append(holders, state.HolderKey)
+	}
+	return holders, nil
+}
+
+func (r *syncLockRepo) NextHolder(name string) (string, error) {
+	var states []syncStateRecord
+	err := r.session.
+		Select("holderkey").
+		From(SyncStateTableName).
+		Where(db.Cond{"name": name, "held": false}).
+		OrderBy("-priority", "creationtime", "holderkey").
+		Limit(1).
+		All(&states)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the queue of %s: %w", name, err)
+	}
+	if len(states) == 0 {
+		return "", nil
+	}
+	return states[0].HolderKey, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -15,20 +16,22 @@ import (
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/util/instanceid"
+	"github.com/argoproj/argo/workflow/common"
 )
 
 const archiveTableName = "argo_archived_workflows"
 const archiveLabelsTableName = archiveTableName + "_labels"
 
 type archivedWorkflowMetadata struct {
-	ClusterName string         `db:"clustername"`
-	InstanceID  string         `db:"instanceid"`
-	UID         string         `db:"uid"`
-	Name        string         `db:"name"`
-	Namespace   string         `db:"namespace"`
-	Phase       wfv1.NodePhase `db:"phase"`
-	StartedAt   time.Time      `db:"startedat"`
-	FinishedAt  time.Time      `db:"finishedat"`
+	ClusterName      string         `db:"clustername"`
+	InstanceID       string         `db:"instanceid"`
+	UID              string         `db:"uid"`
+	Name             string         `db:"name"`
+	Namespace        string         `db:"namespace"`
+	Phase            wfv1.NodePhase `db:"phase"`
+	StartedAt        time.Time      `db:"startedat"`
+	FinishedAt       time.Time      `db:"finishedat"`
+	WorkflowTemplate string         `db:"workflowtemplate"`
 }
 
 type archivedWorkflowRecord struct {
@@ -44,9 +47,36 @@ type archivedWorkflowLabelRecord struct {
 	Value string `db:"value"`
 }
 
+// ListWorkflowsOptions filters and pages the listed archived workflows. Zero values do not filter.
+type ListWorkflowsOptions struct {
+	Namespace         string
+	Name              string
+	NamePrefix        string
+	Phases            []wfv1.NodePhase
+	WorkflowTemplate  string
+	MinStartedAt      time.Time
+	MaxStartedAt      time.Time
+	MinFinishedAt     time.Time
+	MaxFinishedAt     time.Time
+	LabelRequirements labels.Requirements
+	// Limit is the maximum number of workflows listed, zero lists them all.
+	Limit int
+	// Cursor, if not nil, lists the workflows after this one.
+	Cursor *Cursor
+	// Offset skips this many workflows. Only older clients, which page with offsets rather than cursors, use it.
+	Offset int
+}
+
+// Cursor is the position of an archived workflow in the listing, which is ordered by start time then UID, both
+// descending. Unlike an offset, a cursor is stable as workflows are archived and can use the indexes.
+type Cursor struct {
+	StartedAt time.Time
+	UID       string
+}
+
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
-	ListWorkflows(namespace string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
+	ListWorkflows(options ListWorkflowsOptions) (wfv1.Workflows, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
@@ -92,6 +122,8 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 					Phase:       wf.Status.Phase,
 					StartedAt:   wf.Status.StartedAt.Time,
 					FinishedAt:  wf.Status.FinishedAt.Time,
+					// this is always set, as the column is not nullable
+					WorkflowTemplate: workflowTemplateName(wf),
 				},
 				Workflow: string(workflow),
 			})
@@ -116,9 +148,22 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+// workflowTemplateName is the name of the workflow template or cluster workflow template the workflow was created from
+func workflowTemplateName(wf *wfv1.Workflow) string {
+	if wf.Spec.WorkflowTemplateRef != nil {
+		return wf.Spec.WorkflowTemplateRef.Name
+	}
+	for _, key := range []string{common.LabelKeyWorkflowTemplate, common.LabelKeyClusterWorkflowTemplate} {
+		if name, ok := wf.GetLabels()[key]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+func (r *workflowArchive) ListWorkflows(options ListWorkflowsOptions) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
-	clause, err := labelsClause(r.dbType, labelRequirements)
+	clause, err := labelsClause(r.dbType, options.LabelRequirements)
 	if err != nil {
 		return nil, err
 	}
@@ -126,12 +171,18 @@ func (r *workflowArchive) ListWorkflows(namespace string, minStartedAt, maxStart
 		Select("name", "namespace", "uid", "phase", "startedat", "finishedat").
 		From(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(namespaceEqual(namespace)).
-		And(startedAtClause(minStartedAt, maxStartedAt)).
+		And(namespaceEqual(options.Namespace)).
+		And(nameEqual(options.Name)).
+		And(namePrefixClause(options.NamePrefix)).
+		And(phasesClause(options.Phases)).
+		And(workflowTemplateEqual(options.WorkflowTemplate)).
+		And(startedAtClause(options.MinStartedAt, options.MaxStartedAt)).
+		And(finishedAtClause(options.MinFinishedAt, options.MaxFinishedAt)).
+		And(cursorClause(options.Cursor)).
 		And(clause).
-		OrderBy("-startedat").
-		Limit(limit).
-		Offset(offset).
+		OrderBy("-startedat", "-uid").
+		Limit(options.Limit).
+		Offset(options.Offset).
 		All(&archivedWfs)
 	if err != nil {
 		return nil, err
@@ -174,6 +225,63 @@ func startedAtClause(from, to time.Time) db.Compound {
 	return db.And(conds...)
 }
 
+func finishedAtClause(from, to time.Time) db.Compound {
+	var conds []db.Compound
+	if !from.IsZero() {
+		conds = append(conds, db.Cond{"finishedat > ": from})
+	}
+	if !to.IsZero() {
+		conds = append(conds, db.Cond{"finishedat < ": to})
+	}
+	return db.And(conds...)
+}
+
+func cursorClause(cursor *Cursor) db.Compound {
+	if cursor == nil {
+		return db.And()
+	}
+	return db.Or(
+		db.Cond{"startedat < ": cursor.StartedAt},
+		db.And(db.Cond{"startedat": cursor.StartedAt}, db.Cond{"uid < ": cursor.UID}),
+	)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func nameEqual(name string) db.Cond {
+	if name == "" {
+		return db.Cond{}
+	}
+	return db.Cond{"name": name}
+}
+
+func namePrefixClause(namePrefix string) db.Cond {
+	if namePrefix == "" {
+		return db.Cond{}
+	}
+	// the prefix is matched literally, so the LIKE wildcards in it are escaped with backslash, the default escape
+	// character of both MySQL and Postgres
+	return db.Cond{"name LIKE ": likeEscaper.Replace(namePrefix) + "%"}
+}
+
+func phasesClause(phases []wfv1.NodePhase) db.Cond {
+	if len(phases) == 0 {
+		return db.Cond{}
+	}
+	values := make([]string, len(phases))
+	for i, phase := range phases {
+		values[i] = string(phase)
+	}
+	return db.Cond{"phase IN": values}
+}
+
+func workflowTemplateEqual(workflowTemplate string) db.Cond {
+	if workflowTemplate == "" {
+		return db.Cond{}
+	}
+	return db.Cond{"workflowtemplate": workflowTemplate}
+}
+
 func namespaceEqual(namespace string) db.Cond {
 	if namespace == "" {
 		return db.Cond{}
@@ -0,0 +1,55 @@
+package sqldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+func Test_cursorClause(t *testing.T) {
+	assert.Empty(t, cursorClause(nil).Sentences())
+	startedAt := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	got := cursorClause(&Cursor{StartedAt: startedAt, UID: "my-uid"})
+	want := db.Or(
+		db.Cond{"startedat < ": startedAt},
+		db.And(db.Cond{"startedat": startedAt}, db.Cond{"uid < ": "my-uid"}),
+	)
+	assert.Equal(t, sentences(want), sentences(got))
+}
+
+// sentences returns the conditions of the compound in a form that can be compared, as compounds hold functions
+func sentences(c db.Compound) interface{} {
+	if cond, ok := c.(db.Cond); ok {
+		return cond
+	}
+	var out []interface{}
+	for _, s := range c.Sentences() {
+		out = append(out, sentences(s))
+	}
+	return []interface{}{c.Operator(), out}
+}
+
+func Test_filterClauses(t *testing.T) {
+	assert.Equal(t, db.Cond{}, nameEqual(""))
+	assert.Equal(t, db.Cond{"name": "my-wf"}, nameEqual("my-wf"))
+	assert.Equal(t, db.Cond{}, namePrefixClause(""))
+	assert.Equal(t, db.Cond{"name LIKE ": "my-wf-%"}, namePrefixClause("my-wf-"))
+	assert.Equal(t, db.Cond{"name LIKE ": `my\_wf\%\\%`}, namePrefixClause(`my_wf%\`))
+	assert.Equal(t, db.Cond{}, phasesClause(nil))
+	assert.Equal(t, db.Cond{"phase IN": []string{"Failed", "Error"}}, phasesClause([]wfv1.NodePhase{wfv1.NodeFailed, wfv1.NodeError}))
+	assert.Equal(t, db.Cond{}, workflowTemplateEqual(""))
+	assert.Equal(t, db.Cond{"workflowtemplate": "my-wftmpl"}, workflowTemplateEqual("my-wftmpl"))
+}
+
+func Test_workflowTemplateName(t *testing.T) {
+	assert.Empty(t, workflowTemplateName(&wfv1.Workflow{}))
+	assert.Equal(t, "my-wftmpl", workflowTemplateName(&wfv1.Workflow{Spec: wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-wftmpl"}}}))
+	assert.Equal(t, "my-wftmpl", workflowTemplateName(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}}}))
+	assert.Equal(t, "my-cwftmpl", workflowTemplateName(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{common.LabelKeyClusterWorkflowTemplate: "my-cwftmpl"}}}))
+}
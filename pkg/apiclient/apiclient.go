@@ -7,11 +7,13 @@ import (
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/clientcmd"
 
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/argoproj/argo/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo/pkg/apiclient/info"
 	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
 	workflowtemplatepkg "github.com/argoproj/argo/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo/util/instanceid"
 )
@@ -22,6 +24,8 @@ type Client interface {
 	NewCronWorkflowServiceClient() cronworkflowpkg.CronWorkflowServiceClient
 	NewWorkflowTemplateServiceClient() workflowtemplatepkg.WorkflowTemplateServiceClient
 	NewClusterWorkflowTemplateServiceClient() clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient
+	NewWorkflowEventBindingServiceClient() workfloweventbindingpkg.WorkflowEventBindingServiceClient
+	NewCacheServiceClient() cachepkg.CacheServiceClient
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
 }
 
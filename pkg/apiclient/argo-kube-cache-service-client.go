@@ -0,0 +1,23 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+)
+
+type argoKubeCacheServiceClient struct {
+	delegate cachepkg.CacheServiceServer
+}
+
+var _ cachepkg.CacheServiceClient = &argoKubeCacheServiceClient{}
+
+func (a *argoKubeCacheServiceClient) ListCacheEntries(ctx context.Context, req *cachepkg.CacheListRequest, _ ...grpc.CallOption) (*cachepkg.CacheListResponse, error) {
+	return a.delegate.ListCacheEntries(ctx, req)
+}
+
+func (a *argoKubeCacheServiceClient) DeleteCacheEntry(ctx context.Context, req *cachepkg.CacheDeleteRequest, _ ...grpc.CallOption) (*cachepkg.CacheDeleteResponse, error) {
+	return a.delegate.DeleteCacheEntry(ctx, req)
+}
@@ -8,17 +8,21 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo/persist/sqldb"
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
 	"github.com/argoproj/argo/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo/pkg/apiclient/info"
 	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
 	"github.com/argoproj/argo/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo/server/auth"
+	cacheserver "github.com/argoproj/argo/server/cache"
 	clusterworkflowtmplserver "github.com/argoproj/argo/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/argoproj/argo/server/cronworkflow"
 	workflowserver "github.com/argoproj/argo/server/workflow"
+	workfloweventbindingserver "github.com/argoproj/argo/server/workfloweventbinding"
 	workflowtemplateserver "github.com/argoproj/argo/server/workflowtemplate"
 	"github.com/argoproj/argo/util/help"
 	"github.com/argoproj/argo/util/instanceid"
@@ -44,7 +48,7 @@ func newArgoKubeClient(clientConfig clientcmd.ClientConfig, instanceIDService in
 	if err != nil {
 		return nil, nil, err
 	}
-	gatekeeper, err := auth.NewGatekeeper(auth.Modes{auth.Server: true}, wfClient, kubeClient, restConfig, nil)
+	gatekeeper, err := auth.NewGatekeeper(auth.Modes{auth.Server: true}, wfClient, kubeClient, restConfig, nil, "")
 	if err != nil {
 		return nil, nil, err
 	}
@@ -56,7 +60,7 @@ func newArgoKubeClient(clientConfig clientcmd.ClientConfig, instanceIDService in
 }
 
 func (a *argoKubeClient) NewWorkflowServiceClient() workflowpkg.WorkflowServiceClient {
-	return &errorTranslatingWorkflowServiceClient{&argoKubeWorkflowServiceClient{workflowserver.NewWorkflowServer(a.instanceIDService, argoKubeOffloadNodeStatusRepo)}}
+	return &errorTranslatingWorkflowServiceClient{&argoKubeWorkflowServiceClient{workflowserver.NewWorkflowServer(a.instanceIDService, argoKubeOffloadNodeStatusRepo, sqldb.NullWorkflowArchive)}}
 }
 
 func (a *argoKubeClient) NewCronWorkflowServiceClient() cronworkflow.CronWorkflowServiceClient {
@@ -78,3 +82,13 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient {
 	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService)}}
 }
+
+func (a *argoKubeClient) NewWorkflowEventBindingServiceClient() workfloweventbindingpkg.WorkflowEventBindingServiceClient {
+	return &errorTranslatingWorkflowEventBindingServiceClient{&argoKubeWorkflowEventBindingServiceClient{workfloweventbindingserver.NewWorkflowEventBindingServer(a.instanceIDService)}}
+}
+
+// NewCacheServiceClient returns a client for ConfigMap caches only, as the other caches need the configuration of the
+// Argo Server
+func (a *argoKubeClient) NewCacheServiceClient() cachepkg.CacheServiceClient {
+	return &errorTranslatingCacheServiceClient{&argoKubeCacheServiceClient{cacheserver.NewCacheServer(nil, "", nil)}}
+}
@@ -38,3 +38,7 @@ func (c *argoKubeCronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Conte
 func (c *argoKubeCronWorkflowServiceClient) DeleteCronWorkflow(ctx context.Context, req *cronworkflowpkg.DeleteCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowDeletedResponse, error) {
 	return c.delegate.DeleteCronWorkflow(ctx, req)
 }
+
+func (c *argoKubeCronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	return c.delegate.BackfillCronWorkflow(ctx, req)
+}
@@ -0,0 +1,40 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+type argoKubeWorkflowEventBindingServiceClient struct {
+	delegate workfloweventbindingpkg.WorkflowEventBindingServiceServer
+}
+
+var _ workfloweventbindingpkg.WorkflowEventBindingServiceClient = &argoKubeWorkflowEventBindingServiceClient{}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) CreateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingCreateRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	return a.delegate.CreateWorkflowEventBinding(ctx, req)
+}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) GetWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingGetRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	return a.delegate.GetWorkflowEventBinding(ctx, req)
+}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) ListWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBindingList, error) {
+	return a.delegate.ListWorkflowEventBindings(ctx, req)
+}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) UpdateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingUpdateRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	return a.delegate.UpdateWorkflowEventBinding(ctx, req)
+}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) DeleteWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingDeleteRequest, _ ...grpc.CallOption) (*workfloweventbindingpkg.WorkflowEventBindingDeleteResponse, error) {
+	return a.delegate.DeleteWorkflowEventBinding(ctx, req)
+}
+
+func (a *argoKubeWorkflowEventBindingServiceClient) TestWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingTestRequest, _ ...grpc.CallOption) (*workfloweventbindingpkg.WorkflowEventBindingTestResponse, error) {
+	return a.delegate.TestWorkflowEventBindings(ctx, req)
+}
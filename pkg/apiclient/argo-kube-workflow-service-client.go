@@ -100,3 +100,7 @@ func (c *argoKubeWorkflowServiceClient) PodLogs(ctx context.Context, req *workfl
 func (c *argoKubeWorkflowServiceClient) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SubmitWorkflow(ctx, req)
 }
+
+func (c *argoKubeWorkflowServiceClient) CompareWorkflows(ctx context.Context, req *workflowpkg.WorkflowCompareRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowCompareResponse, error) {
+	return c.delegate.CompareWorkflows(ctx, req)
+}
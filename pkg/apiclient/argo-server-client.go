@@ -8,11 +8,13 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/argoproj/argo/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo/pkg/apiclient/info"
 	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
 	workflowtemplatepkg "github.com/argoproj/argo/pkg/apiclient/workflowtemplate"
 )
 
@@ -53,6 +55,14 @@ func (a *argoServerClient) NewClusterWorkflowTemplateServiceClient() clusterwork
 	return clusterworkflowtmplpkg.NewClusterWorkflowTemplateServiceClient(a.ClientConn)
 }
 
+func (a *argoServerClient) NewWorkflowEventBindingServiceClient() workfloweventbindingpkg.WorkflowEventBindingServiceClient {
+	return workfloweventbindingpkg.NewWorkflowEventBindingServiceClient(a.ClientConn)
+}
+
+func (a *argoServerClient) NewCacheServiceClient() cachepkg.CacheServiceClient {
+	return cachepkg.NewCacheServiceClient(a.ClientConn)
+}
+
 func (a *argoServerClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error) {
 	return infopkg.NewInfoServiceClient(a.ClientConn), nil
 }
@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -30,6 +31,7 @@ var _ status.Status
 var _ = runtime.String
 var _ = utilities.NewDoubleArray
 var _ = descriptor.ForMessage
+var _ = metadata.Join
 
 func request_ClusterWorkflowTemplateService_CreateClusterWorkflowTemplate_0(ctx context.Context, marshaler runtime.Marshaler, client ClusterWorkflowTemplateServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ClusterWorkflowTemplateCreateRequest
@@ -125,7 +127,10 @@ func local_request_ClusterWorkflowTemplateService_GetClusterWorkflowTemplate_0(c
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
 	}
 
-	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_ClusterWorkflowTemplateService_GetClusterWorkflowTemplate_0); err != nil {
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_ClusterWorkflowTemplateService_GetClusterWorkflowTemplate_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
@@ -158,7 +163,10 @@ func local_request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplates_0
 	var protoReq ClusterWorkflowTemplateListRequest
 	var metadata runtime.ServerMetadata
 
-	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_ClusterWorkflowTemplateService_ListClusterWorkflowTemplates_0); err != nil {
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_ClusterWorkflowTemplateService_ListClusterWorkflowTemplates_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
@@ -297,7 +305,10 @@ func local_request_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "name", err)
 	}
 
-	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0); err != nil {
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0); err != nil {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
@@ -343,11 +354,14 @@ func local_request_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0(
 // RegisterClusterWorkflowTemplateServiceHandlerServer registers the http handlers for service ClusterWorkflowTemplateService to "mux".
 // UnaryRPC     :call ClusterWorkflowTemplateServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint instead.
 func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server ClusterWorkflowTemplateServiceServer) error {
 
 	mux.Handle("POST", pattern_ClusterWorkflowTemplateService_CreateClusterWorkflowTemplate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -355,6 +369,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_CreateClusterWorkflowTemplate_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -368,6 +383,8 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 	mux.Handle("GET", pattern_ClusterWorkflowTemplateService_GetClusterWorkflowTemplate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -375,6 +392,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_GetClusterWorkflowTemplate_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -388,6 +406,8 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 	mux.Handle("GET", pattern_ClusterWorkflowTemplateService_ListClusterWorkflowTemplates_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -395,6 +415,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_ListClusterWorkflowTemplates_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -408,6 +429,8 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 	mux.Handle("PUT", pattern_ClusterWorkflowTemplateService_UpdateClusterWorkflowTemplate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -415,6 +438,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_UpdateClusterWorkflowTemplate_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -428,6 +452,8 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 	mux.Handle("DELETE", pattern_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -435,6 +461,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_DeleteClusterWorkflowTemplate_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -448,6 +475,8 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 	mux.Handle("POST", pattern_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
 		if err != nil {
@@ -455,6 +484,7 @@ func RegisterClusterWorkflowTemplateServiceHandlerServer(ctx context.Context, mu
 			return
 		}
 		resp, md, err := local_request_ClusterWorkflowTemplateService_LintClusterWorkflowTemplate_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		ctx = runtime.NewServerMetadataContext(ctx, md)
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
@@ -432,6 +432,79 @@ func (m *CronWorkflowDeletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowDeletedResponse proto.InternalMessageInfo
 
+type BackfillCronWorkflowRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// From is the start of the window of schedule times to backfill, inclusive.
+	From *v1.Time `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// To is the end of the window of schedule times to backfill, inclusive.
+	To                   *v1.Time `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillCronWorkflowRequest) Reset()         { *m = BackfillCronWorkflowRequest{} }
+func (m *BackfillCronWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillCronWorkflowRequest) ProtoMessage()    {}
+func (*BackfillCronWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{7}
+}
+func (m *BackfillCronWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillCronWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillCronWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillCronWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillCronWorkflowRequest.Merge(m, src)
+}
+func (m *BackfillCronWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillCronWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillCronWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillCronWorkflowRequest proto.InternalMessageInfo
+
+func (m *BackfillCronWorkflowRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackfillCronWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *BackfillCronWorkflowRequest) GetFrom() *v1.Time {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *BackfillCronWorkflowRequest) GetTo() *v1.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LintCronWorkflowRequest)(nil), "cronworkflow.LintCronWorkflowRequest")
 	proto.RegisterType((*CreateCronWorkflowRequest)(nil), "cronworkflow.CreateCronWorkflowRequest")
@@ -440,6 +513,7 @@ func init() {
 	proto.RegisterType((*UpdateCronWorkflowRequest)(nil), "cronworkflow.UpdateCronWorkflowRequest")
 	proto.RegisterType((*DeleteCronWorkflowRequest)(nil), "cronworkflow.DeleteCronWorkflowRequest")
 	proto.RegisterType((*CronWorkflowDeletedResponse)(nil), "cronworkflow.CronWorkflowDeletedResponse")
+	proto.RegisterType((*BackfillCronWorkflowRequest)(nil), "cronworkflow.BackfillCronWorkflowRequest")
 }
 
 func init() {
@@ -447,49 +521,54 @@ func init() {
 }
 
 var fileDescriptor_257f310938c448f8 = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x75, 0x29, 0x42, 0xf4, 0x95, 0x0a, 0x38, 0xa4, 0x92, 0x9a, 0x52, 0x55, 0x56, 0xa1,
-	0x6d, 0xa0, 0x67, 0x92, 0x74, 0x40, 0x6c, 0x6d, 0x8a, 0xba, 0x44, 0x02, 0xb9, 0x42, 0xa8, 0x6c,
-	0x57, 0xe7, 0x70, 0x4c, 0x9c, 0x3b, 0x63, 0x5f, 0x53, 0x21, 0xd4, 0x85, 0x9d, 0x89, 0x11, 0xc4,
-	0xcc, 0xc2, 0x0f, 0x31, 0x20, 0xfe, 0x04, 0x46, 0x24, 0xfe, 0x01, 0x14, 0xf1, 0x5f, 0xb0, 0x20,
-	0x5f, 0xe2, 0xc4, 0x76, 0x62, 0xd5, 0xd0, 0x20, 0xb1, 0x9d, 0x7d, 0xf7, 0xbe, 0xef, 0x73, 0xef,
-	0x3d, 0x7d, 0x75, 0x40, 0xbc, 0x96, 0x6d, 0x50, 0xcf, 0xb1, 0x5c, 0x87, 0x71, 0x69, 0x58, 0xbe,
-	0xe0, 0x87, 0xc2, 0x6f, 0x3d, 0x72, 0xc5, 0xa1, 0xfa, 0x58, 0x8f, 0xbe, 0x88, 0xe7, 0x0b, 0x29,
-	0xf0, 0xd9, 0xf8, 0x09, 0x6d, 0xc1, 0x16, 0xc2, 0x76, 0x59, 0x28, 0x60, 0x50, 0xce, 0x85, 0xa4,
-	0xd2, 0x11, 0x3c, 0xe8, 0x9d, 0xd5, 0x36, 0x5a, 0xb7, 0x02, 0xe2, 0x88, 0x70, 0xb7, 0x4d, 0xad,
-	0xa6, 0xc3, 0x99, 0xff, 0xd4, 0xe8, 0xe7, 0x0b, 0x8c, 0x36, 0x93, 0xd4, 0xe8, 0x94, 0x0d, 0x9b,
-	0x71, 0xe6, 0x53, 0xc9, 0x1a, 0xfd, 0xa8, 0x9a, 0xed, 0xc8, 0xe6, 0xc1, 0x3e, 0xb1, 0x44, 0xdb,
-	0xa0, 0xbe, 0x2d, 0x3c, 0x5f, 0x3c, 0x56, 0x8b, 0x61, 0xe8, 0x80, 0xb0, 0x53, 0xa6, 0xae, 0xd7,
-	0xa4, 0x23, 0x22, 0xfa, 0x1b, 0x04, 0x97, 0xea, 0x0e, 0x97, 0x35, 0x5f, 0xf0, 0x07, 0xfd, 0xd3,
-	0x26, 0x7b, 0x72, 0xc0, 0x02, 0x89, 0x17, 0x60, 0x9a, 0xd3, 0x36, 0x0b, 0x3c, 0x6a, 0xb1, 0x22,
-	0x5a, 0x42, 0xab, 0xd3, 0xe6, 0xf0, 0x07, 0x66, 0xa0, 0xae, 0x18, 0x05, 0x15, 0x0b, 0x4b, 0x68,
-	0x75, 0xa6, 0xb2, 0x49, 0x86, 0x54, 0x24, 0xa2, 0x52, 0x8b, 0xb0, 0x80, 0x24, 0xa4, 0x22, 0x83,
-	0x4a, 0x45, 0x54, 0x24, 0x91, 0x3d, 0x21, 0xab, 0xff, 0x42, 0x30, 0x5f, 0xf3, 0x19, 0x95, 0xec,
-	0x7f, 0x45, 0xc4, 0x7b, 0x30, 0x6b, 0x29, 0xc2, 0xbb, 0x9e, 0xea, 0x6a, 0x71, 0x4a, 0xe5, 0xa9,
-	0x92, 0x5e, 0x5b, 0x49, 0xbc, 0xad, 0xc3, 0x14, 0x61, 0x5b, 0x49, 0x27, 0x14, 0x8e, 0x85, 0x9a,
-	0x49, 0x25, 0xfd, 0x05, 0x82, 0x62, 0xdd, 0x09, 0x12, 0xed, 0x09, 0xf2, 0x5d, 0x7e, 0x17, 0x66,
-	0x5c, 0x27, 0x90, 0x11, 0x53, 0xef, 0xee, 0xe5, 0x7c, 0x4c, 0xf5, 0x61, 0xa0, 0x19, 0x57, 0xd1,
-	0x5f, 0x23, 0x98, 0xdb, 0x61, 0x63, 0xa7, 0x05, 0xc3, 0xa9, 0x30, 0x79, 0x1f, 0x44, 0xad, 0x93,
-	0x84, 0x85, 0x34, 0xe1, 0x3d, 0x00, 0x9b, 0xc9, 0x64, 0xd1, 0x6e, 0xe6, 0x03, 0xdc, 0x19, 0xc4,
-	0x99, 0x31, 0x0d, 0xfd, 0x0b, 0x82, 0xf9, 0xfb, 0x5e, 0x23, 0x63, 0x58, 0xe6, 0xe2, 0x84, 0x5b,
-	0x85, 0x22, 0xca, 0x45, 0x99, 0x1e, 0xa2, 0xa9, 0x7f, 0x33, 0xe7, 0x6f, 0x11, 0xcc, 0x6f, 0x33,
-	0x97, 0x8d, 0x47, 0xff, 0xf3, 0xe2, 0xee, 0xc1, 0x6c, 0x43, 0xc9, 0xfd, 0xd5, 0x50, 0x6e, 0xc7,
-	0x43, 0xcd, 0xa4, 0x92, 0x7e, 0x05, 0x2e, 0xc7, 0x19, 0x7b, 0x67, 0x1b, 0x26, 0x0b, 0x3c, 0xc1,
-	0x03, 0x56, 0xf9, 0x74, 0x06, 0x2e, 0xc6, 0xf7, 0x77, 0x99, 0xdf, 0x71, 0x2c, 0x86, 0x3f, 0x22,
-	0x38, 0x9f, 0xb6, 0x1a, 0x7c, 0x95, 0xc4, 0x7d, 0x92, 0x64, 0x58, 0x91, 0x76, 0xf2, 0x72, 0xeb,
-	0x95, 0xe7, 0xdf, 0x7f, 0xbe, 0x2c, 0xdc, 0xd0, 0x57, 0x94, 0x09, 0x77, 0xca, 0x49, 0xd7, 0x0e,
-	0x8c, 0x67, 0x83, 0xda, 0x1d, 0x19, 0xae, 0xc3, 0xe5, 0x6d, 0x54, 0xc2, 0x1f, 0x10, 0xe0, 0x51,
-	0xf3, 0xc1, 0x2b, 0x49, 0xe8, 0x4c, 0x7b, 0x9a, 0x04, 0xf6, 0xba, 0xc2, 0x5e, 0xd1, 0xf5, 0xe3,
-	0xb1, 0x43, 0xe2, 0xf7, 0x08, 0x2e, 0x8c, 0x18, 0x06, 0xbe, 0x96, 0xae, 0xf2, 0x78, 0x47, 0xd1,
-	0xee, 0x9c, 0x98, 0x37, 0x94, 0xd6, 0x4b, 0x8a, 0x79, 0x19, 0xe7, 0x60, 0xc6, 0xef, 0x10, 0x9c,
-	0x4b, 0x39, 0x0a, 0x5e, 0x4e, 0xe2, 0x8e, 0x37, 0x9c, 0x49, 0x14, 0xb7, 0xac, 0x40, 0xaf, 0xe3,
-	0xb5, 0x1c, 0x33, 0xa1, 0xd6, 0x47, 0xf8, 0x33, 0x02, 0x3c, 0x6a, 0x31, 0xe9, 0x91, 0xc8, 0x34,
-	0xa1, 0x49, 0x50, 0x6f, 0x28, 0x6a, 0xa2, 0xe5, 0xa7, 0x0e, 0x27, 0xe3, 0x15, 0x02, 0x3c, 0x6a,
-	0x30, 0x69, 0xf0, 0x4c, 0x0b, 0xd2, 0xd6, 0xd2, 0x43, 0x9f, 0xe9, 0x00, 0x51, 0x59, 0x4b, 0xf9,
-	0x01, 0xb7, 0x36, 0xbf, 0x76, 0x17, 0xd1, 0xb7, 0xee, 0x22, 0xfa, 0xd1, 0x5d, 0x44, 0x0f, 0xab,
-	0xc7, 0x3d, 0x6d, 0xc6, 0xbc, 0xc2, 0xf6, 0x4f, 0xab, 0x17, 0x4d, 0xf5, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x8b, 0x9e, 0x82, 0xdc, 0xaa, 0x09, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xc7, 0x99, 0xb4, 0xbc, 0xd0, 0xa7, 0x2d, 0xef, 0xfb, 0x8e, 0x52, 0xd3, 0xb4, 0x96, 0xb2,
+	0x54, 0xdb, 0xae, 0x76, 0xd6, 0xa4, 0x05, 0x25, 0xa0, 0xd0, 0x1f, 0xd2, 0x4b, 0x41, 0xd9, 0x2a,
+	0x52, 0x6f, 0xd3, 0xcd, 0x74, 0x3b, 0x66, 0xb3, 0xb3, 0xee, 0x4e, 0x53, 0x44, 0x7a, 0xf1, 0xee,
+	0xc9, 0xa3, 0xe2, 0xd9, 0x8b, 0x3f, 0x4e, 0xe2, 0xdd, 0x8b, 0xe0, 0x45, 0xf0, 0x1f, 0x90, 0xe2,
+	0x1f, 0x21, 0x78, 0x91, 0x9d, 0x6c, 0x92, 0xdd, 0x4d, 0x62, 0xb7, 0x6d, 0x04, 0x6f, 0xb3, 0xc9,
+	0x3c, 0xcf, 0xf3, 0x79, 0x9e, 0xe7, 0xcb, 0x77, 0x17, 0x88, 0x57, 0xb5, 0x0d, 0xea, 0x71, 0xcb,
+	0xe1, 0xcc, 0x95, 0x86, 0xe5, 0x0b, 0x77, 0x5f, 0xf8, 0xd5, 0x1d, 0x47, 0xec, 0xab, 0x87, 0x85,
+	0xe6, 0x13, 0xf1, 0x7c, 0x21, 0x05, 0x1e, 0x89, 0xdf, 0x28, 0x4c, 0xda, 0x42, 0xd8, 0x0e, 0x0b,
+	0x13, 0x18, 0xd4, 0x75, 0x85, 0xa4, 0x92, 0x0b, 0x37, 0x68, 0xdc, 0x2d, 0x2c, 0x55, 0xaf, 0x05,
+	0x84, 0x8b, 0xf0, 0xdf, 0x1a, 0xb5, 0x76, 0xb9, 0xcb, 0xfc, 0x47, 0x46, 0x54, 0x2f, 0x30, 0x6a,
+	0x4c, 0x52, 0xa3, 0x5e, 0x34, 0x6c, 0xe6, 0x32, 0x9f, 0x4a, 0x56, 0x89, 0xa2, 0x56, 0x6d, 0x2e,
+	0x77, 0xf7, 0xb6, 0x89, 0x25, 0x6a, 0x06, 0xf5, 0x6d, 0xe1, 0xf9, 0xe2, 0x81, 0x3a, 0xb4, 0x43,
+	0x5b, 0x84, 0xf5, 0x22, 0x75, 0xbc, 0x5d, 0xda, 0x91, 0x44, 0x7b, 0x89, 0xe0, 0xdc, 0x06, 0x77,
+	0xe5, 0xaa, 0x2f, 0xdc, 0x7b, 0xd1, 0x6d, 0x93, 0x3d, 0xdc, 0x63, 0x81, 0xc4, 0x93, 0x30, 0xe4,
+	0xd2, 0x1a, 0x0b, 0x3c, 0x6a, 0xb1, 0x3c, 0x9a, 0x46, 0x73, 0x43, 0x66, 0xfb, 0x07, 0xcc, 0x60,
+	0xc4, 0x8a, 0x05, 0xe5, 0x73, 0xd3, 0x68, 0x6e, 0xb8, 0xb4, 0x4c, 0xda, 0x54, 0xa4, 0x49, 0xa5,
+	0x0e, 0xe1, 0x00, 0x49, 0x48, 0x45, 0x5a, 0x93, 0x6a, 0x52, 0x91, 0x44, 0xf5, 0x44, 0x5a, 0xed,
+	0x27, 0x82, 0xf1, 0x55, 0x9f, 0x51, 0xc9, 0xfe, 0x56, 0x44, 0xbc, 0x05, 0xa3, 0x96, 0x22, 0xbc,
+	0xe5, 0xa9, 0xad, 0xe6, 0x07, 0x54, 0x9d, 0x45, 0xd2, 0x58, 0x2b, 0x89, 0xaf, 0xb5, 0x5d, 0x22,
+	0x5c, 0x2b, 0xa9, 0x87, 0x89, 0x63, 0xa1, 0x66, 0x32, 0x93, 0xf6, 0x14, 0x41, 0x7e, 0x83, 0x07,
+	0x89, 0xf5, 0x04, 0xd9, 0x9a, 0xdf, 0x84, 0x61, 0x87, 0x07, 0xb2, 0xc9, 0xd4, 0xe8, 0xbd, 0x98,
+	0x8d, 0x69, 0xa3, 0x1d, 0x68, 0xc6, 0xb3, 0x68, 0x2f, 0x10, 0x8c, 0xad, 0xb3, 0xae, 0x6a, 0xc1,
+	0x30, 0x18, 0x16, 0x8f, 0x40, 0xd4, 0x39, 0x49, 0x98, 0x4b, 0x13, 0xde, 0x06, 0xb0, 0x99, 0x4c,
+	0x0e, 0xed, 0x4a, 0x36, 0xc0, 0xf5, 0x56, 0x9c, 0x19, 0xcb, 0xa1, 0x7d, 0x40, 0x30, 0x7e, 0xd7,
+	0xab, 0xf4, 0x10, 0xcb, 0x58, 0x9c, 0x70, 0x25, 0x97, 0x47, 0x99, 0x28, 0xd3, 0x22, 0x1a, 0xf8,
+	0x33, 0x3a, 0x7f, 0x85, 0x60, 0x7c, 0x8d, 0x39, 0x4c, 0xb2, 0xfe, 0x0c, 0x77, 0x0b, 0x46, 0x2b,
+	0x2a, 0xdd, 0x89, 0x44, 0xb9, 0x16, 0x0f, 0x35, 0x93, 0x99, 0xb4, 0xf3, 0x30, 0x11, 0x67, 0x6c,
+	0xdc, 0xad, 0x98, 0x2c, 0xf0, 0x84, 0x1b, 0x30, 0xed, 0x33, 0x82, 0x89, 0x15, 0x6a, 0x55, 0x77,
+	0xb8, 0xe3, 0xf4, 0xa7, 0x97, 0x1b, 0x30, 0xb8, 0xe3, 0x8b, 0x5a, 0xd4, 0x82, 0x9e, 0xad, 0x85,
+	0x3b, 0xbc, 0xc6, 0x4c, 0x15, 0x87, 0xcb, 0x90, 0x93, 0x22, 0x3f, 0x78, 0xec, 0xe8, 0x9c, 0x14,
+	0xa5, 0x1f, 0x43, 0x70, 0x26, 0xde, 0xc5, 0x26, 0xf3, 0xeb, 0xdc, 0x62, 0xf8, 0x1d, 0x82, 0xff,
+	0xd2, 0xc6, 0x89, 0x2f, 0x90, 0xb8, 0xeb, 0x93, 0x1e, 0xc6, 0x5a, 0x38, 0xbd, 0x78, 0xb4, 0xd2,
+	0x93, 0xaf, 0xdf, 0x9f, 0xe5, 0x2e, 0x6b, 0xb3, 0xea, 0x95, 0x52, 0x2f, 0x26, 0xdf, 0x41, 0x81,
+	0xf1, 0xb8, 0x35, 0xbd, 0x03, 0xc3, 0xe1, 0xae, 0x2c, 0x23, 0x1d, 0xbf, 0x45, 0x80, 0x3b, 0xad,
+	0x14, 0xcf, 0x26, 0xa1, 0x7b, 0x9a, 0x6d, 0x3f, 0xb0, 0x17, 0x14, 0xf6, 0x6c, 0x19, 0xe9, 0x9a,
+	0x76, 0x34, 0x39, 0x7e, 0x83, 0xe0, 0xff, 0x0e, 0xfb, 0xc3, 0x17, 0xd3, 0x53, 0xee, 0xee, 0x8f,
+	0x85, 0x9b, 0xa7, 0xe6, 0x0d, 0x53, 0x6b, 0xba, 0x62, 0x9e, 0xc1, 0x59, 0x80, 0x5f, 0x23, 0xf8,
+	0x37, 0xe5, 0x8f, 0x78, 0x26, 0x89, 0xdb, 0xdd, 0x3e, 0xfb, 0x31, 0xdc, 0xa2, 0x02, 0xbd, 0x84,
+	0xe7, 0x33, 0x68, 0x42, 0x9d, 0x0f, 0xf0, 0x7b, 0x04, 0xb8, 0xd3, 0x30, 0xd3, 0x92, 0xe8, 0x69,
+	0xa9, 0xfd, 0xa0, 0x5e, 0x52, 0xd4, 0xa4, 0x8c, 0xf4, 0xc2, 0x31, 0xc0, 0x9f, 0x23, 0xc0, 0x9d,
+	0x76, 0x99, 0x06, 0xef, 0x69, 0xa8, 0x85, 0xf9, 0xb4, 0xe8, 0x7b, 0xfb, 0x59, 0x34, 0x56, 0xfd,
+	0x18, 0x74, 0x1f, 0x11, 0x9c, 0xed, 0x66, 0x81, 0x38, 0x55, 0xf6, 0x37, 0x36, 0x79, 0xc2, 0xd1,
+	0x26, 0x94, 0x7b, 0x5d, 0x91, 0x5f, 0xd5, 0x4a, 0x99, 0xc9, 0x8d, 0xed, 0x88, 0xa8, 0x8c, 0xf4,
+	0x95, 0xe5, 0x4f, 0x87, 0x53, 0xe8, 0xcb, 0xe1, 0x14, 0xfa, 0x76, 0x38, 0x85, 0xee, 0x2f, 0x1e,
+	0xf5, 0xb9, 0xd9, 0xe5, 0xcb, 0x78, 0xfb, 0x1f, 0xf5, 0x95, 0xb9, 0xf8, 0x6b, 0x00, 0xca, 0xb0,
+	0x71, 0x11, 0x3e, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +589,7 @@ type CronWorkflowServiceClient interface {
 	GetCronWorkflow(ctx context.Context, in *GetCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	UpdateCronWorkflow(ctx context.Context, in *UpdateCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowDeletedResponse, error)
+	BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
 }
 
 type cronWorkflowServiceClient struct {
@@ -574,6 +654,15 @@ func (c *cronWorkflowServiceClient) DeleteCronWorkflow(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	out := new(v1alpha1.WorkflowList)
+	err := c.cc.Invoke(ctx, "/cronworkflow.CronWorkflowService/BackfillCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronWorkflowServiceServer is the server API for CronWorkflowService service.
 type CronWorkflowServiceServer interface {
 	LintCronWorkflow(context.Context, *LintCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
@@ -582,6 +671,7 @@ type CronWorkflowServiceServer interface {
 	GetCronWorkflow(context.Context, *GetCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
 	UpdateCronWorkflow(context.Context, *UpdateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*CronWorkflowDeletedResponse, error)
+	BackfillCronWorkflow(context.Context, *BackfillCronWorkflowRequest) (*v1alpha1.WorkflowList, error)
 }
 
 // UnimplementedCronWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -606,6 +696,9 @@ func (*UnimplementedCronWorkflowServiceServer) UpdateCronWorkflow(ctx context.Co
 func (*UnimplementedCronWorkflowServiceServer) DeleteCronWorkflow(ctx context.Context, req *DeleteCronWorkflowRequest) (*CronWorkflowDeletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronWorkflow not implemented")
 }
+func (*UnimplementedCronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *BackfillCronWorkflowRequest) (*v1alpha1.WorkflowList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCronWorkflow not implemented")
+}
 
 func RegisterCronWorkflowServiceServer(s *grpc.Server, srv CronWorkflowServiceServer) {
 	s.RegisterService(&_CronWorkflowService_serviceDesc, srv)
@@ -719,6 +812,24 @@ func _CronWorkflowService_DeleteCronWorkflow_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_BackfillCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCronWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronworkflow.CronWorkflowService/BackfillCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, req.(*BackfillCronWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cronworkflow.CronWorkflowService",
 	HandlerType: (*CronWorkflowServiceServer)(nil),
@@ -747,6 +858,10 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCronWorkflow",
 			Handler:    _CronWorkflowService_DeleteCronWorkflow_Handler,
 		},
+		{
+			MethodName: "BackfillCronWorkflow",
+			Handler:    _CronWorkflowService_BackfillCronWorkflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/cronworkflow/cron-workflow.proto",
@@ -1088,6 +1203,71 @@ func (m *CronWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *BackfillCronWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillCronWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillCronWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCronWorkflow(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCronWorkflow(v)
 	base := offset
@@ -1247,6 +1427,34 @@ func (m *CronWorkflowDeletedResponse) Size() (n int) {
 	return n
 }
 
+func (m *BackfillCronWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCronWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2171,6 +2379,196 @@ func (m *CronWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackfillCronWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillCronWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillCronWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &v1.Time{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &v1.Time{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCronWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,32 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+	grpcutil "github.com/argoproj/argo/util/grpc"
+)
+
+type errorTranslatingCacheServiceClient struct {
+	delegate cachepkg.CacheServiceClient
+}
+
+var _ cachepkg.CacheServiceClient = &errorTranslatingCacheServiceClient{}
+
+func (a *errorTranslatingCacheServiceClient) ListCacheEntries(ctx context.Context, req *cachepkg.CacheListRequest, _ ...grpc.CallOption) (*cachepkg.CacheListResponse, error) {
+	res, err := a.delegate.ListCacheEntries(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return res, nil
+}
+
+func (a *errorTranslatingCacheServiceClient) DeleteCacheEntry(ctx context.Context, req *cachepkg.CacheDeleteRequest, _ ...grpc.CallOption) (*cachepkg.CacheDeleteResponse, error) {
+	res, err := a.delegate.DeleteCacheEntry(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return res, nil
+}
@@ -63,3 +63,11 @@ func (c *errorTranslatingCronWorkflowServiceClient) DeleteCronWorkflow(ctx conte
 	}
 	return workflow, err
 }
+
+func (c *errorTranslatingCronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowList, error) {
+	workflows, err := c.delegate.BackfillCronWorkflow(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return workflows, nil
+}
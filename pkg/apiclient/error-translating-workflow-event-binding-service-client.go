@@ -0,0 +1,65 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	grpcutil "github.com/argoproj/argo/util/grpc"
+)
+
+type errorTranslatingWorkflowEventBindingServiceClient struct {
+	delegate workfloweventbindingpkg.WorkflowEventBindingServiceClient
+}
+
+var _ workfloweventbindingpkg.WorkflowEventBindingServiceClient = &errorTranslatingWorkflowEventBindingServiceClient{}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) CreateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingCreateRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	binding, err := a.delegate.CreateWorkflowEventBinding(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return binding, nil
+}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) GetWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingGetRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	binding, err := a.delegate.GetWorkflowEventBinding(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return binding, nil
+}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) ListWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingListRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBindingList, error) {
+	bindings, err := a.delegate.ListWorkflowEventBindings(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return bindings, nil
+}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) UpdateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingUpdateRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowEventBinding, error) {
+	binding, err := a.delegate.UpdateWorkflowEventBinding(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return binding, nil
+}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) DeleteWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingDeleteRequest, _ ...grpc.CallOption) (*workfloweventbindingpkg.WorkflowEventBindingDeleteResponse, error) {
+	res, err := a.delegate.DeleteWorkflowEventBinding(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return res, nil
+}
+
+func (a *errorTranslatingWorkflowEventBindingServiceClient) TestWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingTestRequest, _ ...grpc.CallOption) (*workfloweventbindingpkg.WorkflowEventBindingTestResponse, error) {
+	res, err := a.delegate.TestWorkflowEventBindings(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return res, nil
+}
@@ -143,3 +143,11 @@ func (c *errorTranslatingWorkflowServiceClient) SubmitWorkflow(ctx context.Conte
 	}
 	return workflow, nil
 }
+
+func (c *errorTranslatingWorkflowServiceClient) CompareWorkflows(ctx context.Context, req *workflowpkg.WorkflowCompareRequest, opts ...grpc.CallOption) (*workflowpkg.WorkflowCompareResponse, error) {
+	comparison, err := c.delegate.CompareWorkflows(ctx, req)
+	if err != nil {
+		return nil, grpcutil.TranslateError(err)
+	}
+	return comparison, nil
+}
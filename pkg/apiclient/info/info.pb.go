@@ -203,6 +203,9 @@ var xxx_messageInfo_GetUserInfoRequest proto.InternalMessageInfo
 type GetUserInfoResponse struct {
 	Issuer               string   `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
 	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Groups               []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Email                string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	ServiceAccountName   string   `protobuf:"bytes,5,opt,name=serviceAccountName,proto3" json:"serviceAccountName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -255,6 +258,27 @@ func (m *GetUserInfoResponse) GetSubject() string {
 	return ""
 }
 
+func (m *GetUserInfoResponse) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *GetUserInfoResponse) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *GetUserInfoResponse) GetServiceAccountName() string {
+	if m != nil {
+		return m.ServiceAccountName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetInfoRequest)(nil), "info.GetInfoRequest")
 	proto.RegisterType((*InfoResponse)(nil), "info.InfoResponse")
@@ -266,35 +290,37 @@ func init() {
 func init() { proto.RegisterFile("pkg/apiclient/info/info.proto", fileDescriptor_96940c93018255fa) }
 
 var fileDescriptor_96940c93018255fa = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0x66, 0x46, 0x6d, 0x31, 0x5b, 0xea, 0x36, 0x5d, 0xec, 0x38, 0xe8, 0x52, 0xe6, 0x54, 0x14,
-	0x12, 0xb6, 0x9e, 0x0a, 0x9e, 0xf4, 0x30, 0x14, 0x44, 0x61, 0x45, 0x0f, 0xe2, 0x25, 0x3b, 0x7d,
-	0x9b, 0xa6, 0x33, 0x9b, 0xc4, 0x24, 0x33, 0xbd, 0x78, 0xf2, 0xea, 0xd1, 0xbf, 0xe2, 0x8f, 0xf0,
-	0x28, 0xf8, 0x07, 0x64, 0xf1, 0x87, 0xc8, 0x64, 0x32, 0xed, 0xac, 0x0a, 0x82, 0x97, 0xf0, 0xde,
-	0xcb, 0xe3, 0xfb, 0xbe, 0xf7, 0xbd, 0x87, 0x1e, 0xe8, 0x92, 0x53, 0xa6, 0x45, 0x51, 0x09, 0x90,
-	0x8e, 0x0a, 0xb9, 0x54, 0xfe, 0x21, 0xda, 0x28, 0xa7, 0xf0, 0xcd, 0x36, 0x4e, 0xef, 0x73, 0xa5,
-	0x78, 0x05, 0x6d, 0x1f, 0x65, 0x52, 0x2a, 0xc7, 0x9c, 0x50, 0xd2, 0x76, 0x3d, 0xe9, 0x33, 0x2e,
-	0xdc, 0x79, 0xbd, 0x20, 0x85, 0x5a, 0x51, 0x66, 0xb8, 0xd2, 0x46, 0x5d, 0xf8, 0x80, 0x06, 0x6c,
-	0x4b, 0x2f, 0x95, 0x29, 0x97, 0x95, 0xba, 0xa4, 0xcd, 0x8c, 0x55, 0xfa, 0x9c, 0xcd, 0x28, 0x07,
-	0x09, 0x86, 0x39, 0x38, 0xeb, 0x40, 0xb2, 0x31, 0xda, 0xcd, 0xc1, 0x9d, 0xca, 0xa5, 0x9a, 0xc3,
-	0xfb, 0x1a, 0xac, 0xcb, 0x3e, 0x45, 0x68, 0xa7, 0xcb, 0xad, 0x56, 0xd2, 0x02, 0x7e, 0x88, 0xc6,
-	0x2b, 0x26, 0x19, 0x87, 0xb3, 0x17, 0x6c, 0x05, 0x56, 0xb3, 0x02, 0x92, 0xe8, 0x30, 0x3a, 0xba,
-	0x3d, 0xff, 0xa3, 0x8e, 0x5f, 0xa2, 0x5b, 0x95, 0x90, 0xa5, 0x4d, 0xe2, 0xc3, 0x1b, 0x47, 0xa3,
-	0xe3, 0x13, 0x72, 0xad, 0x91, 0xf4, 0x1a, 0x7d, 0x40, 0x74, 0xc9, 0x49, 0xab, 0x91, 0xf4, 0x1a,
-	0x49, 0xaf, 0x91, 0x3c, 0x17, 0xb2, 0x9c, 0x77, 0x38, 0xd9, 0x3e, 0xda, 0xcb, 0xc1, 0xbd, 0x01,
-	0x63, 0x85, 0x92, 0xbd, 0xc4, 0x09, 0xc2, 0x39, 0xb8, 0xd7, 0x16, 0xcc, 0x50, 0x78, 0x8e, 0xf6,
-	0x37, 0xaa, 0x41, 0xfe, 0x5d, 0xb4, 0x25, 0xac, 0xad, 0xc1, 0x04, 0xd1, 0x21, 0xc3, 0x09, 0xda,
-	0xb6, 0xf5, 0xe2, 0x02, 0x0a, 0x97, 0xc4, 0xfe, 0xa3, 0x4f, 0x8f, 0xbf, 0xc4, 0x68, 0xd4, 0x42,
-	0xbc, 0x02, 0xd3, 0x88, 0x02, 0xf0, 0x29, 0xda, 0x0e, 0x1e, 0xe1, 0x09, 0xf1, 0x4b, 0xda, 0xb4,
-	0x2c, 0xc5, 0x5d, 0x75, 0x48, 0x9b, 0x4d, 0x3e, 0x7e, 0xff, 0xf9, 0x39, 0xde, 0xc5, 0x3b, 0x7e,
-	0x7b, 0xcd, 0xcc, 0x6f, 0x17, 0x7f, 0x40, 0xe8, 0x7a, 0x1c, 0x7c, 0x70, 0x85, 0xb6, 0x39, 0x60,
-	0xfa, 0xe4, 0xbf, 0x7c, 0x0b, 0x20, 0xd9, 0x81, 0xa7, 0xde, 0xc3, 0x77, 0x7a, 0xea, 0x26, 0xf0,
-	0xbd, 0x43, 0xa3, 0x81, 0x43, 0x38, 0xb9, 0xa2, 0xff, 0xcd, 0xca, 0xf4, 0xde, 0x5f, 0x7e, 0xc2,
-	0x5c, 0x89, 0x07, 0xc7, 0x78, 0xdc, 0x83, 0xd7, 0x16, 0x4c, 0xdb, 0xfd, 0xf4, 0xe4, 0xeb, 0x7a,
-	0x1a, 0x7d, 0x5b, 0x4f, 0xa3, 0x1f, 0xeb, 0x69, 0xf4, 0xf6, 0xd1, 0xbf, 0xae, 0x73, 0x70, 0xf9,
-	0x8b, 0x2d, 0x7f, 0x8c, 0x8f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x27, 0xe1, 0x44, 0x16,
-	0x03, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xb5, 0x49, 0xd3, 0x0a, 0xa7, 0x2a, 0xe9, 0x34, 0xa2, 0x26, 0x82, 0x28, 0xda, 0x53,
+	0x05, 0x92, 0x57, 0x29, 0xa7, 0x4a, 0x5c, 0x80, 0x43, 0x54, 0x09, 0x81, 0x14, 0x04, 0x07, 0xc4,
+	0xc5, 0xd9, 0x4e, 0x5c, 0x77, 0x37, 0xb6, 0xb1, 0xbd, 0xe9, 0x85, 0x13, 0x57, 0x8e, 0x3c, 0x03,
+	0x6f, 0xc0, 0x43, 0x70, 0x44, 0xe2, 0x05, 0x50, 0xc4, 0x83, 0xa0, 0xf5, 0xee, 0xb6, 0x09, 0x54,
+	0x42, 0xe2, 0xb2, 0xf2, 0x7c, 0xf8, 0x3f, 0x3f, 0xcf, 0xcc, 0x92, 0xfb, 0x26, 0x13, 0x09, 0x37,
+	0x32, 0xcd, 0x25, 0x2a, 0x9f, 0x48, 0x35, 0xd7, 0xe1, 0xc3, 0x8c, 0xd5, 0x5e, 0xc3, 0x56, 0x79,
+	0x1e, 0xdc, 0x13, 0x5a, 0x8b, 0x1c, 0xcb, 0xbc, 0x84, 0x2b, 0xa5, 0x3d, 0xf7, 0x52, 0x2b, 0x57,
+	0xe5, 0x0c, 0x9e, 0x09, 0xe9, 0xcf, 0x8b, 0x19, 0x4b, 0xf5, 0x22, 0xe1, 0x56, 0x68, 0x63, 0xf5,
+	0x45, 0x38, 0x24, 0xb5, 0xb6, 0x4b, 0x2e, 0xb5, 0xcd, 0xe6, 0xb9, 0xbe, 0x4c, 0x96, 0x63, 0x9e,
+	0x9b, 0x73, 0x3e, 0x4e, 0x04, 0x2a, 0xb4, 0xdc, 0xe3, 0x59, 0x25, 0x12, 0xf7, 0xc8, 0xde, 0x04,
+	0xfd, 0xa9, 0x9a, 0xeb, 0x29, 0xbe, 0x2f, 0xd0, 0xf9, 0xf8, 0x53, 0x44, 0x76, 0x2b, 0xdb, 0x19,
+	0xad, 0x1c, 0xc2, 0x03, 0xd2, 0x5b, 0x70, 0xc5, 0x05, 0x9e, 0xbd, 0xe0, 0x0b, 0x74, 0x86, 0xa7,
+	0x48, 0xa3, 0x51, 0x74, 0x74, 0x6b, 0xfa, 0x97, 0x1f, 0x5e, 0x92, 0x4e, 0x2e, 0x55, 0xe6, 0x68,
+	0x6b, 0xd4, 0x3e, 0xea, 0x1e, 0x9f, 0xb0, 0x6b, 0x46, 0xd6, 0x30, 0x86, 0x03, 0x33, 0x99, 0x60,
+	0x25, 0x23, 0x6b, 0x18, 0x59, 0xc3, 0xc8, 0x9e, 0x4b, 0x95, 0x4d, 0x2b, 0x9d, 0xf8, 0x80, 0xec,
+	0x4f, 0xd0, 0xbf, 0x41, 0xeb, 0xa4, 0x56, 0x0d, 0x62, 0x9f, 0xc0, 0x04, 0xfd, 0x6b, 0x87, 0x76,
+	0x1d, 0xfc, 0x4b, 0x44, 0x0e, 0x36, 0xdc, 0x35, 0xff, 0x1d, 0xb2, 0x2d, 0x9d, 0x2b, 0xd0, 0xd6,
+	0xd4, 0xb5, 0x05, 0x94, 0xec, 0xb8, 0x62, 0x76, 0x81, 0xa9, 0xa7, 0xad, 0x10, 0x68, 0xcc, 0xf2,
+	0x86, 0xb0, 0xba, 0x30, 0x8e, 0xb6, 0x47, 0xed, 0xf2, 0x46, 0x65, 0x41, 0x9f, 0x74, 0x70, 0xc1,
+	0x65, 0x4e, 0xb7, 0x42, 0x7e, 0x65, 0x00, 0x23, 0xe0, 0xd0, 0x2e, 0x65, 0x8a, 0x4f, 0xd2, 0x54,
+	0x17, 0xca, 0x97, 0xed, 0xa0, 0x9d, 0x90, 0x72, 0x43, 0xe4, 0xf8, 0x6b, 0x8b, 0x74, 0x4b, 0xc0,
+	0x57, 0x55, 0x08, 0x4e, 0xc9, 0x4e, 0x3d, 0x02, 0xe8, 0xb3, 0xb0, 0x03, 0x9b, 0x13, 0x19, 0x40,
+	0xe5, 0x5d, 0x7f, 0x54, 0xdc, 0xff, 0xf8, 0xe3, 0xd7, 0xe7, 0xd6, 0x1e, 0xec, 0x86, 0xe5, 0x58,
+	0x8e, 0xc3, 0xf2, 0xc0, 0x07, 0x42, 0xae, 0xbb, 0x05, 0x87, 0x57, 0x6a, 0x9b, 0xfd, 0x1b, 0x3c,
+	0xfe, 0xaf, 0xb1, 0xd4, 0x22, 0xf1, 0x61, 0x28, 0xbd, 0x0f, 0xb7, 0x9b, 0xd2, 0xcb, 0xba, 0xde,
+	0x3b, 0xd2, 0x5d, 0xeb, 0x3f, 0xd0, 0xab, 0xf2, 0x7f, 0x4c, 0x6a, 0x70, 0xf7, 0x86, 0x48, 0xfd,
+	0x2e, 0x1a, 0xc4, 0x01, 0x7a, 0x8d, 0x78, 0xe1, 0xd0, 0x96, 0xd9, 0x4f, 0x4f, 0xbe, 0xad, 0x86,
+	0xd1, 0xf7, 0xd5, 0x30, 0xfa, 0xb9, 0x1a, 0x46, 0x6f, 0x1f, 0xfe, 0x6b, 0xf9, 0xd7, 0x7e, 0xac,
+	0xd9, 0x76, 0xd8, 0xf5, 0x47, 0xbf, 0x07, 0x00, 0x10, 0x2b, 0x48, 0xae, 0x75, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -602,6 +628,29 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ServiceAccountName) > 0 {
+		i -= len(m.ServiceAccountName)
+		copy(dAtA[i:], m.ServiceAccountName)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.ServiceAccountName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintInfo(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintInfo(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Subject) > 0 {
 		i -= len(m.Subject)
 		copy(dAtA[i:], m.Subject)
@@ -702,6 +751,20 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovInfo(uint64(l))
 	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovInfo(uint64(l))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
+	l = len(m.ServiceAccountName)
+	if l > 0 {
+		n += 1 + l + sovInfo(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1089,6 +1152,102 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInfo(dAtA[iNdEx:])
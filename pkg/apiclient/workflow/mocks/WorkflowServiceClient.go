@@ -19,6 +19,36 @@ type WorkflowServiceClient struct {
 	mock.Mock
 }
 
+// CompareWorkflows provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) CompareWorkflows(ctx context.Context, in *workflow.WorkflowCompareRequest, opts ...grpc.CallOption) (*workflow.WorkflowCompareResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowCompareResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowCompareRequest, ...grpc.CallOption) *workflow.WorkflowCompareResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowCompareResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowCompareRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) CreateWorkflow(ctx context.Context, in *workflow.WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
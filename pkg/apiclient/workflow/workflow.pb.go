@@ -1237,6 +1237,260 @@ func (m *WorkflowSubmitRequest) GetSubmitOptions() *v1alpha1.SubmitOpts {
 	return nil
 }
 
+type WorkflowCompareRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the workflow, or the UID of an archived workflow.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the workflow to compare with, or the UID of an archived workflow.
+	OtherName            string   `protobuf:"bytes,3,opt,name=otherName,proto3" json:"otherName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowCompareRequest) Reset()         { *m = WorkflowCompareRequest{} }
+func (m *WorkflowCompareRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCompareRequest) ProtoMessage()    {}
+func (*WorkflowCompareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{19}
+}
+func (m *WorkflowCompareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCompareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCompareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCompareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCompareRequest.Merge(m, src)
+}
+func (m *WorkflowCompareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCompareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCompareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCompareRequest proto.InternalMessageInfo
+
+func (m *WorkflowCompareRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowCompareRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowCompareRequest) GetOtherName() string {
+	if m != nil {
+		return m.OtherName
+	}
+	return ""
+}
+
+// WorkflowDifference is a field which is different in the two workflows, or in two of their nodes.
+type WorkflowDifference struct {
+	// The field, e.g. "phase", "duration", "resourcesDuration.cpu", "inputs.parameters.message" or "spec.entrypoint".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The value in the workflow, empty if the field is not set.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The value in the other workflow, empty if the field is not set.
+	OtherValue           string   `protobuf:"bytes,3,opt,name=otherValue,proto3" json:"otherValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowDifference) Reset()         { *m = WorkflowDifference{} }
+func (m *WorkflowDifference) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDifference) ProtoMessage()    {}
+func (*WorkflowDifference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{20}
+}
+func (m *WorkflowDifference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDifference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowDifference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowDifference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDifference.Merge(m, src)
+}
+func (m *WorkflowDifference) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDifference) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDifference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDifference proto.InternalMessageInfo
+
+func (m *WorkflowDifference) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *WorkflowDifference) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *WorkflowDifference) GetOtherValue() string {
+	if m != nil {
+		return m.OtherValue
+	}
+	return ""
+}
+
+// NodeComparison is the differences between the nodes of the two workflows with the same display name and template.
+type NodeComparison struct {
+	DisplayName          string                `protobuf:"bytes,1,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	TemplateName         string                `protobuf:"bytes,2,opt,name=templateName,proto3" json:"templateName,omitempty"`
+	Differences          []*WorkflowDifference `protobuf:"bytes,3,rep,name=differences,proto3" json:"differences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *NodeComparison) Reset()         { *m = NodeComparison{} }
+func (m *NodeComparison) String() string { return proto.CompactTextString(m) }
+func (*NodeComparison) ProtoMessage()    {}
+func (*NodeComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{21}
+}
+func (m *NodeComparison) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeComparison.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeComparison.Merge(m, src)
+}
+func (m *NodeComparison) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeComparison proto.InternalMessageInfo
+
+func (m *NodeComparison) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *NodeComparison) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
+}
+
+func (m *NodeComparison) GetDifferences() []*WorkflowDifference {
+	if m != nil {
+		return m.Differences
+	}
+	return nil
+}
+
+type WorkflowCompareResponse struct {
+	// The differences between the workflows, including the differences between their specs.
+	Differences []*WorkflowDifference `protobuf:"bytes,1,rep,name=differences,proto3" json:"differences,omitempty"`
+	// The nodes which are different, in the order they were started in. A node which only exists in one of the
+	// workflows is reported with a phase difference.
+	Nodes                []*NodeComparison `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WorkflowCompareResponse) Reset()         { *m = WorkflowCompareResponse{} }
+func (m *WorkflowCompareResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCompareResponse) ProtoMessage()    {}
+func (*WorkflowCompareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6bb75f9e833cb6, []int{22}
+}
+func (m *WorkflowCompareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCompareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCompareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowCompareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCompareResponse.Merge(m, src)
+}
+func (m *WorkflowCompareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCompareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCompareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCompareResponse proto.InternalMessageInfo
+
+func (m *WorkflowCompareResponse) GetDifferences() []*WorkflowDifference {
+	if m != nil {
+		return m.Differences
+	}
+	return nil
+}
+
+func (m *WorkflowCompareResponse) GetNodes() []*NodeComparison {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WorkflowCreateRequest)(nil), "workflow.WorkflowCreateRequest")
 	proto.RegisterType((*WorkflowGetRequest)(nil), "workflow.WorkflowGetRequest")
@@ -1257,6 +1511,10 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "workflow.LogEntry")
 	proto.RegisterType((*WorkflowLintRequest)(nil), "workflow.WorkflowLintRequest")
 	proto.RegisterType((*WorkflowSubmitRequest)(nil), "workflow.WorkflowSubmitRequest")
+	proto.RegisterType((*WorkflowCompareRequest)(nil), "workflow.WorkflowCompareRequest")
+	proto.RegisterType((*WorkflowDifference)(nil), "workflow.WorkflowDifference")
+	proto.RegisterType((*NodeComparison)(nil), "workflow.NodeComparison")
+	proto.RegisterType((*WorkflowCompareResponse)(nil), "workflow.WorkflowCompareResponse")
 }
 
 func init() {
@@ -1264,92 +1522,104 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0x5d, 0x8f, 0xd5, 0x44,
-	0x18, 0xc7, 0x33, 0xbb, 0xb0, 0xec, 0xce, 0xbe, 0x00, 0x03, 0xe2, 0xb1, 0x81, 0x65, 0x19, 0x44,
-	0x97, 0x85, 0x6d, 0xf7, 0x05, 0x15, 0x49, 0xd0, 0x00, 0x8b, 0x44, 0xdd, 0x28, 0xe9, 0xd1, 0x18,
-	0xbc, 0xeb, 0xf6, 0x3c, 0x74, 0xcb, 0x9e, 0x76, 0x6a, 0x67, 0xce, 0x21, 0x47, 0x82, 0x46, 0x6f,
-	0x4c, 0xbc, 0xd0, 0x0b, 0x6f, 0x8c, 0x5e, 0xf8, 0x9e, 0x98, 0x98, 0x18, 0x8d, 0x1f, 0x42, 0x2f,
-	0x49, 0xfc, 0x02, 0x86, 0xf0, 0x41, 0xcc, 0x4c, 0xdb, 0x69, 0xbb, 0xe7, 0x70, 0xa8, 0xdb, 0x8d,
-	0xdc, 0xf5, 0x6d, 0xe6, 0xff, 0x9b, 0xff, 0xcc, 0x3c, 0xcf, 0x33, 0xc5, 0xa7, 0xa2, 0x2d, 0xcf,
-	0x72, 0x22, 0xdf, 0x6d, 0xfb, 0x10, 0x0a, 0xeb, 0x36, 0x8b, 0xb7, 0x6e, 0xb6, 0xd9, 0x6d, 0x7d,
-	0x61, 0x46, 0x31, 0x13, 0x8c, 0x8c, 0x67, 0xf7, 0xc6, 0x51, 0x8f, 0x31, 0xaf, 0x0d, 0xb2, 0x8d,
-	0xe5, 0x84, 0x21, 0x13, 0x8e, 0xf0, 0x59, 0xc8, 0x93, 0xef, 0x8c, 0x73, 0x5b, 0xe7, 0xb9, 0xe9,
-	0x33, 0xf9, 0x36, 0x70, 0xdc, 0x4d, 0x3f, 0x84, 0xb8, 0x67, 0xa5, 0x12, 0xdc, 0x0a, 0x40, 0x38,
-	0x56, 0x77, 0xd9, 0xf2, 0x20, 0x84, 0xd8, 0x11, 0xd0, 0x4a, 0x5b, 0x5d, 0xf1, 0x7c, 0xb1, 0xd9,
-	0xd9, 0x30, 0x5d, 0x16, 0x58, 0x4e, 0xec, 0xb1, 0x28, 0x66, 0xb7, 0xd4, 0x45, 0xde, 0x54, 0x83,
-	0x75, 0x97, 0x9d, 0x76, 0xb4, 0xe9, 0xf4, 0x77, 0x42, 0x73, 0x69, 0xcb, 0x65, 0x31, 0x0c, 0x10,
-	0xa2, 0x7f, 0x8c, 0xe0, 0x27, 0xde, 0x49, 0x7b, 0xba, 0x12, 0x83, 0x23, 0xc0, 0x86, 0xf7, 0x3a,
-	0xc0, 0x05, 0x39, 0x8a, 0x27, 0x42, 0x27, 0x00, 0x1e, 0x39, 0x2e, 0x34, 0xd0, 0x1c, 0x9a, 0x9f,
-	0xb0, 0xf3, 0x07, 0xe4, 0x06, 0xd6, 0x06, 0x34, 0x46, 0xe6, 0xd0, 0xfc, 0xe4, 0xca, 0x45, 0x33,
-	0x67, 0x36, 0x33, 0x66, 0x75, 0x61, 0x46, 0x5b, 0x9e, 0x29, 0x99, 0x4d, 0xed, 0x61, 0xc6, 0x6c,
-	0x66, 0xda, 0xb6, 0xee, 0x8e, 0x50, 0x8c, 0xfd, 0x90, 0x0b, 0x27, 0x74, 0xe1, 0xd5, 0xb5, 0xc6,
-	0xa8, 0x54, 0xbe, 0x3c, 0xd2, 0x40, 0x76, 0xe1, 0x29, 0xa1, 0x78, 0x8a, 0x43, 0xdc, 0x85, 0x78,
-	0x2d, 0xee, 0xd9, 0x9d, 0xb0, 0xb1, 0x67, 0x0e, 0xcd, 0x8f, 0xdb, 0xa5, 0x67, 0xe4, 0x06, 0x9e,
-	0x76, 0xd5, 0x88, 0xde, 0x8c, 0xd4, 0x84, 0x34, 0xf6, 0x2a, 0xce, 0x55, 0x33, 0xb1, 0xc5, 0x2c,
-	0xce, 0x48, 0x8e, 0x28, 0x67, 0xc4, 0xec, 0x2e, 0x9b, 0x57, 0x8a, 0x4d, 0xed, 0x72, 0x4f, 0xf4,
-	0x37, 0x84, 0x49, 0x46, 0x7e, 0x0d, 0x44, 0x66, 0x19, 0xc1, 0x7b, 0xa4, 0x43, 0xa9, 0x5b, 0xea,
-	0xba, 0x6c, 0xe3, 0xc8, 0x76, 0x1b, 0xaf, 0x63, 0xec, 0x81, 0xc8, 0x00, 0x47, 0x15, 0xe0, 0x52,
-	0x35, 0xc0, 0x6b, 0xba, 0x9d, 0x5d, 0xe8, 0x83, 0x1c, 0xc1, 0x63, 0x37, 0x7d, 0x68, 0xb7, 0xb8,
-	0xf2, 0x64, 0xc2, 0x4e, 0xef, 0xe8, 0xb7, 0x08, 0x1f, 0xca, 0x90, 0xd7, 0x7d, 0x2e, 0xaa, 0x4d,
-	0x73, 0x13, 0x4f, 0xb6, 0x7d, 0xae, 0x01, 0x93, 0x99, 0x5e, 0xae, 0x06, 0xb8, 0x9e, 0x37, 0xb4,
-	0x8b, 0xbd, 0x14, 0x10, 0x47, 0x4b, 0x88, 0x1e, 0x7e, 0x52, 0x2f, 0x07, 0xe0, 0x9d, 0x8d, 0xc0,
-	0xaf, 0xe1, 0xac, 0x81, 0xc7, 0x03, 0x08, 0x98, 0xff, 0x3e, 0xb4, 0x94, 0xcc, 0xb8, 0xad, 0xef,
-	0xe9, 0x8f, 0x08, 0x1f, 0xce, 0x95, 0x44, 0xdc, 0xdb, 0xb9, 0xcc, 0x59, 0x7c, 0x30, 0x06, 0x2e,
-	0x9c, 0x58, 0x34, 0x3b, 0xae, 0x0b, 0x9c, 0xdf, 0xec, 0xb4, 0x53, 0xbd, 0xfe, 0x17, 0xf2, 0xeb,
-	0x90, 0xb5, 0xe0, 0x15, 0x39, 0xde, 0x26, 0xb4, 0xc1, 0x15, 0x2c, 0x4e, 0xe7, 0xa9, 0xff, 0x05,
-	0xbd, 0x9d, 0x6f, 0x4d, 0xe9, 0x47, 0x00, 0xb5, 0x30, 0xfb, 0x85, 0x47, 0x1f, 0x26, 0xbc, 0x8e,
-	0x1b, 0x99, 0xf0, 0x5b, 0x10, 0x07, 0x7e, 0x58, 0x08, 0x0b, 0xff, 0x59, 0x9b, 0x7e, 0x5e, 0x58,
-	0x79, 0x4d, 0xc1, 0xa2, 0xff, 0x69, 0x14, 0xa4, 0x81, 0xf7, 0x05, 0xc0, 0xb9, 0xe3, 0x41, 0x6a,
-	0x71, 0x76, 0x4b, 0xef, 0x15, 0xb6, 0x6f, 0xb3, 0xce, 0xf6, 0xdd, 0x25, 0x20, 0x72, 0x18, 0xef,
-	0x8d, 0x36, 0x1d, 0x0e, 0x2a, 0x44, 0x4d, 0xd8, 0xc9, 0x0d, 0x59, 0xc0, 0x07, 0x58, 0x47, 0x44,
-	0x1d, 0x71, 0xdd, 0x89, 0x9d, 0x00, 0x04, 0xc4, 0xbc, 0x31, 0xa6, 0x3e, 0xe8, 0x7b, 0x4e, 0x5f,
-	0xc3, 0x47, 0xf4, 0x88, 0x3a, 0x3c, 0x82, 0xb0, 0xb5, 0xf3, 0x09, 0xfb, 0xa1, 0x60, 0xcf, 0x3a,
-	0xf3, 0x76, 0x6e, 0x4f, 0x03, 0xef, 0x8b, 0x58, 0xeb, 0x0d, 0xd9, 0x28, 0x31, 0x25, 0xbb, 0x25,
-	0x97, 0x30, 0x6e, 0x33, 0x2f, 0x0b, 0x2b, 0x7b, 0x54, 0x58, 0x39, 0x51, 0x08, 0x2b, 0xa6, 0xcc,
-	0x57, 0x32, 0x88, 0x5c, 0x67, 0xad, 0x75, 0xfd, 0xa1, 0x5d, 0x68, 0x24, 0x37, 0xb1, 0xde, 0x1e,
-	0x6b, 0xd0, 0x86, 0x1a, 0x4b, 0x54, 0xa6, 0x8a, 0x96, 0xea, 0xa2, 0x1c, 0x89, 0x2b, 0xa6, 0x8a,
-	0xb5, 0x62, 0x53, 0xbb, 0xdc, 0x13, 0x6d, 0xe4, 0x13, 0x93, 0x51, 0xf2, 0x88, 0x85, 0x1c, 0xe8,
-	0xa7, 0x72, 0x00, 0x8e, 0x70, 0x37, 0xb3, 0xf7, 0xfc, 0xf1, 0xc5, 0x64, 0xfa, 0x61, 0x3e, 0xe5,
-	0x8a, 0xe9, 0x6a, 0x17, 0x42, 0xe5, 0xa4, 0xe8, 0x45, 0xda, 0x49, 0x79, 0x4d, 0xde, 0xc6, 0x63,
-	0x6c, 0xe3, 0x16, 0xb8, 0x62, 0x77, 0xf2, 0x7e, 0xda, 0x19, 0xfd, 0x44, 0x2e, 0x3a, 0xad, 0xfc,
-	0x38, 0xad, 0x78, 0x09, 0x8f, 0xaf, 0x33, 0xef, 0x6a, 0x28, 0xe2, 0x9e, 0x5c, 0xc1, 0x2e, 0x0b,
-	0x05, 0x84, 0x22, 0x15, 0xcf, 0x6e, 0x8b, 0x6b, 0x7b, 0xa4, 0xb4, 0xb6, 0xe9, 0x67, 0xa5, 0x4c,
-	0x1b, 0x8a, 0xc7, 0x5d, 0x50, 0xd1, 0x07, 0x85, 0x9d, 0xd2, 0x2c, 0xa5, 0xd5, 0xe1, 0x48, 0x14,
-	0x4f, 0xc5, 0xc0, 0x59, 0x27, 0x76, 0xe1, 0x75, 0x3f, 0x6c, 0xa5, 0xe3, 0x2c, 0x3d, 0x2b, 0x7e,
-	0x53, 0xd8, 0xe7, 0xa5, 0x67, 0x04, 0xf0, 0x74, 0x92, 0xcd, 0xcb, 0xfb, 0xfd, 0xe5, 0x1d, 0x8d,
-	0xaf, 0x99, 0xf5, 0xc4, 0xed, 0x72, 0xaf, 0x2b, 0x7f, 0x1e, 0xc2, 0xfb, 0xf3, 0xa8, 0x1e, 0x77,
-	0x7d, 0x17, 0xc8, 0x57, 0x08, 0xcf, 0x24, 0x95, 0x5c, 0xf6, 0x86, 0x1c, 0xcf, 0x3b, 0x1d, 0x58,
-	0xf8, 0x1a, 0xf5, 0x7c, 0xa7, 0xf3, 0x1f, 0xff, 0xfd, 0xe0, 0x8b, 0x11, 0x4a, 0x8f, 0xa9, 0xba,
-	0xbb, 0xbb, 0xac, 0x0b, 0x75, 0x6e, 0xdd, 0xd1, 0xde, 0xde, 0xbd, 0x80, 0x16, 0xc8, 0x97, 0x08,
-	0x4f, 0x5e, 0x03, 0xa1, 0xc9, 0x8e, 0xf6, 0x93, 0xe5, 0xc5, 0x65, 0x5d, 0xac, 0xb3, 0x0a, 0xeb,
-	0x19, 0xf2, 0xf4, 0x50, 0xac, 0xe4, 0xfa, 0xae, 0x44, 0x9b, 0x96, 0x1b, 0x44, 0x87, 0x26, 0x72,
-	0xac, 0x1f, 0xae, 0x50, 0x46, 0x1a, 0x97, 0x6a, 0xd1, 0xc9, 0x9e, 0xe8, 0x29, 0x45, 0x78, 0x9c,
-	0x0c, 0x37, 0x8e, 0x7c, 0x80, 0x67, 0xca, 0x51, 0xb3, 0x34, 0xa3, 0x83, 0xe2, 0xa9, 0x31, 0xc0,
-	0xd8, 0x3c, 0xd4, 0xd0, 0x33, 0x4a, 0xf7, 0x14, 0x39, 0xb9, 0x5d, 0x77, 0x11, 0x54, 0x28, 0x2a,
-	0xaa, 0x2f, 0x21, 0xc2, 0xf1, 0x64, 0x21, 0x4e, 0x95, 0x26, 0xad, 0x2f, 0x7c, 0x19, 0x4f, 0x0d,
-	0xca, 0x69, 0x89, 0xec, 0x69, 0x25, 0x7b, 0x92, 0x9c, 0xc8, 0x64, 0xb9, 0x88, 0xc1, 0x09, 0xac,
-	0x81, 0xa2, 0x1f, 0x21, 0x3c, 0x93, 0xa4, 0x8f, 0x61, 0xeb, 0xb8, 0x94, 0x06, 0x8d, 0xb9, 0x87,
-	0x7f, 0x90, 0x66, 0xa0, 0x74, 0x4d, 0x2c, 0x54, 0x5b, 0x13, 0x3f, 0x21, 0x3c, 0xad, 0xaa, 0x65,
-	0x8d, 0x30, 0xdb, 0xaf, 0x50, 0x2c, 0xa7, 0xeb, 0x2e, 0xd9, 0xe7, 0x14, 0x9e, 0x65, 0x2c, 0x54,
-	0xc1, 0xb3, 0x62, 0xa9, 0x2c, 0xb7, 0xd5, 0xaf, 0x08, 0x1f, 0xc8, 0xce, 0x0f, 0x1a, 0xf5, 0xc4,
-	0x20, 0xd4, 0xd2, 0x19, 0xa3, 0x2e, 0xed, 0x79, 0x45, 0xbb, 0x62, 0x2c, 0x56, 0xa4, 0x4d, 0xc4,
-	0x25, 0xf0, 0xcf, 0x08, 0xcf, 0x24, 0x05, 0xfe, 0xb0, 0xc9, 0x2d, 0x1d, 0x01, 0xea, 0xc2, 0x3e,
-	0xaf, 0x60, 0x97, 0x8c, 0x33, 0x95, 0x61, 0x03, 0x90, 0xa8, 0xbf, 0x20, 0xbc, 0x3f, 0xad, 0x2f,
-	0x35, 0xeb, 0x80, 0x75, 0x56, 0x2e, 0x41, 0xeb, 0xc2, 0xbe, 0xa0, 0x60, 0x97, 0x8d, 0xb3, 0x95,
-	0x60, 0x79, 0xa2, 0x2d, 0x69, 0x7f, 0x47, 0xf8, 0xa0, 0x3e, 0xc0, 0x68, 0x5e, 0xda, 0xcf, 0xbb,
-	0xfd, 0x94, 0x53, 0x97, 0xf8, 0x45, 0x45, 0xbc, 0x6a, 0x98, 0x95, 0x88, 0x45, 0xa6, 0x2e, 0x99,
-	0xbf, 0x47, 0x78, 0x4a, 0x9e, 0x92, 0x34, 0xee, 0x80, 0xc0, 0x5b, 0x38, 0x45, 0xd5, 0x25, 0x3d,
-	0xa7, 0x48, 0x4d, 0xe3, 0x74, 0x35, 0x6f, 0x05, 0x8b, 0x24, 0xe4, 0x37, 0x08, 0x4f, 0x36, 0x87,
-	0x67, 0xae, 0xe6, 0xae, 0x65, 0xae, 0x55, 0x85, 0xb8, 0x68, 0xcc, 0x57, 0x43, 0x04, 0xb5, 0xa7,
-	0xbe, 0x46, 0x78, 0x4a, 0x16, 0x5f, 0xc3, 0x6c, 0x2c, 0x14, 0x67, 0x75, 0x19, 0x17, 0x15, 0xe3,
-	0xb3, 0x94, 0x0e, 0x67, 0x6c, 0xfb, 0xa1, 0xa2, 0xeb, 0xe1, 0x7d, 0xc9, 0xc1, 0x86, 0x0f, 0xb2,
-	0x2e, 0x3f, 0x73, 0x19, 0x24, 0x7f, 0x9b, 0xd5, 0xa4, 0xf4, 0x82, 0xd2, 0x3a, 0x47, 0x56, 0x2a,
-	0xf9, 0x71, 0x27, 0x2d, 0x4b, 0xef, 0x5a, 0x6d, 0xe6, 0x2d, 0x21, 0xf2, 0x1d, 0xc2, 0x33, 0xcd,
-	0x72, 0x6c, 0x3c, 0x3e, 0x68, 0x03, 0xef, 0x62, 0x64, 0xb4, 0x14, 0xf0, 0x69, 0xfa, 0x88, 0x34,
-	0xa3, 0x03, 0xe2, 0xe5, 0x8b, 0x7f, 0xdd, 0x9f, 0x45, 0xf7, 0xee, 0xcf, 0xa2, 0x7f, 0xee, 0xcf,
-	0xa2, 0x77, 0xad, 0x47, 0xfd, 0x0b, 0xdd, 0xf6, 0xa7, 0x76, 0x63, 0x4c, 0xfd, 0xda, 0x5c, 0xfd,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x16, 0x88, 0x0a, 0xca, 0x15, 0x00, 0x00,
+	// 1551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x99, 0xdb, 0x6f, 0xdc, 0x44,
+	0x17, 0xc0, 0x35, 0x49, 0x9b, 0x26, 0x93, 0x4b, 0xdb, 0xf9, 0xda, 0x7e, 0xfb, 0x59, 0x6d, 0x9a,
+	0x4c, 0xbf, 0x42, 0x9a, 0x36, 0x76, 0x2e, 0x05, 0x4a, 0xa4, 0x16, 0xda, 0xa4, 0x54, 0x40, 0x54,
+	0x2a, 0x2f, 0x17, 0x95, 0x27, 0x1c, 0xef, 0x89, 0xe3, 0xc6, 0xf6, 0x18, 0xcf, 0xec, 0x56, 0x4b,
+	0x15, 0x10, 0xbc, 0x20, 0x78, 0x80, 0x07, 0x24, 0xc4, 0xe5, 0x81, 0xbb, 0x84, 0x84, 0x84, 0x40,
+	0xfc, 0x13, 0x3c, 0x56, 0xe2, 0x1f, 0x40, 0xa5, 0x7f, 0x08, 0x9a, 0xf1, 0x3d, 0xbb, 0xdd, 0x9a,
+	0x6c, 0x45, 0xde, 0x3c, 0x97, 0x73, 0xce, 0x6f, 0xce, 0xcc, 0x9c, 0x33, 0x67, 0x17, 0x9f, 0x0e,
+	0xb7, 0x1c, 0xc3, 0x0a, 0x5d, 0xdb, 0x73, 0x21, 0x10, 0xc6, 0x6d, 0x16, 0x6d, 0x6d, 0x78, 0xec,
+	0x76, 0xf6, 0xa1, 0x87, 0x11, 0x13, 0x8c, 0x0c, 0xa7, 0x6d, 0xed, 0xb8, 0xc3, 0x98, 0xe3, 0x81,
+	0x94, 0x31, 0xac, 0x20, 0x60, 0xc2, 0x12, 0x2e, 0x0b, 0x78, 0x3c, 0x4f, 0x3b, 0xbf, 0x75, 0x81,
+	0xeb, 0x2e, 0x93, 0xa3, 0xbe, 0x65, 0x6f, 0xba, 0x01, 0x44, 0x6d, 0x23, 0x31, 0xc1, 0x0d, 0x1f,
+	0x84, 0x65, 0xb4, 0x16, 0x0c, 0x07, 0x02, 0x88, 0x2c, 0x01, 0x8d, 0x44, 0x6a, 0xc5, 0x71, 0xc5,
+	0x66, 0x73, 0x5d, 0xb7, 0x99, 0x6f, 0x58, 0x91, 0xc3, 0xc2, 0x88, 0xdd, 0x52, 0x1f, 0xb9, 0x68,
+	0x06, 0xd6, 0x5a, 0xb0, 0xbc, 0x70, 0xd3, 0xea, 0x54, 0x42, 0x73, 0xd3, 0x86, 0xcd, 0x22, 0xe8,
+	0x62, 0x88, 0xfe, 0x36, 0x80, 0x8f, 0xbe, 0x96, 0x68, 0x5a, 0x89, 0xc0, 0x12, 0x60, 0xc2, 0x9b,
+	0x4d, 0xe0, 0x82, 0x1c, 0xc7, 0x23, 0x81, 0xe5, 0x03, 0x0f, 0x2d, 0x1b, 0x6a, 0x68, 0x0a, 0xcd,
+	0x8c, 0x98, 0x79, 0x07, 0xb9, 0x89, 0x33, 0x07, 0xd4, 0x06, 0xa6, 0xd0, 0xcc, 0xe8, 0xe2, 0x45,
+	0x3d, 0x67, 0xd6, 0x53, 0x66, 0xf5, 0xa1, 0x87, 0x5b, 0x8e, 0x2e, 0x99, 0xf5, 0xcc, 0x87, 0x29,
+	0xb3, 0x9e, 0xda, 0x36, 0x33, 0x75, 0x84, 0x62, 0xec, 0x06, 0x5c, 0x58, 0x81, 0x0d, 0xcf, 0xaf,
+	0xd6, 0x06, 0xa5, 0xe5, 0x2b, 0x03, 0x35, 0x64, 0x16, 0x7a, 0x09, 0xc5, 0x63, 0x1c, 0xa2, 0x16,
+	0x44, 0xab, 0x51, 0xdb, 0x6c, 0x06, 0xb5, 0x7d, 0x53, 0x68, 0x66, 0xd8, 0x2c, 0xf5, 0x91, 0x9b,
+	0x78, 0xdc, 0x56, 0x2b, 0x7a, 0x29, 0x54, 0x1b, 0x52, 0xdb, 0xaf, 0x38, 0x97, 0xf4, 0xd8, 0x2d,
+	0x7a, 0x71, 0x47, 0x72, 0x44, 0xb9, 0x23, 0x7a, 0x6b, 0x41, 0x5f, 0x29, 0x8a, 0x9a, 0x65, 0x4d,
+	0xf4, 0x17, 0x84, 0x49, 0x4a, 0x7e, 0x0d, 0x44, 0xea, 0x32, 0x82, 0xf7, 0x49, 0x0f, 0x25, 0xde,
+	0x52, 0xdf, 0x65, 0x37, 0x0e, 0xec, 0x74, 0xe3, 0x0d, 0x8c, 0x1d, 0x10, 0x29, 0xe0, 0xa0, 0x02,
+	0x9c, 0xaf, 0x06, 0x78, 0x2d, 0x93, 0x33, 0x0b, 0x3a, 0xc8, 0x31, 0x3c, 0xb4, 0xe1, 0x82, 0xd7,
+	0xe0, 0xca, 0x27, 0x23, 0x66, 0xd2, 0xa2, 0x5f, 0x23, 0xfc, 0x9f, 0x14, 0x79, 0xcd, 0xe5, 0xa2,
+	0xda, 0x36, 0xd7, 0xf1, 0xa8, 0xe7, 0xf2, 0x0c, 0x30, 0xde, 0xe9, 0x85, 0x6a, 0x80, 0x6b, 0xb9,
+	0xa0, 0x59, 0xd4, 0x52, 0x40, 0x1c, 0x2c, 0x21, 0x3a, 0xf8, 0xbf, 0xd9, 0x71, 0x00, 0xde, 0x5c,
+	0xf7, 0xdd, 0x3e, 0x3c, 0xab, 0xe1, 0x61, 0x1f, 0x7c, 0xe6, 0xbe, 0x05, 0x0d, 0x65, 0x66, 0xd8,
+	0xcc, 0xda, 0xf4, 0x7b, 0x84, 0x8f, 0xe4, 0x96, 0x44, 0xd4, 0xde, 0xbd, 0x99, 0x73, 0xf8, 0x70,
+	0x04, 0x5c, 0x58, 0x91, 0xa8, 0x37, 0x6d, 0x1b, 0x38, 0xdf, 0x68, 0x7a, 0x89, 0xbd, 0xce, 0x01,
+	0x39, 0x3b, 0x60, 0x0d, 0x78, 0x4e, 0xae, 0xb7, 0x0e, 0x1e, 0xd8, 0x82, 0x45, 0xc9, 0x3e, 0x75,
+	0x0e, 0xd0, 0xdb, 0xf8, 0x68, 0xd1, 0x1f, 0x3e, 0xf4, 0x85, 0xd9, 0x69, 0x78, 0xf0, 0x41, 0x86,
+	0xd7, 0x70, 0x2d, 0x35, 0xfc, 0x32, 0x44, 0xbe, 0x1b, 0x58, 0x62, 0xf7, 0xb6, 0xe9, 0xc7, 0x85,
+	0x93, 0x57, 0x17, 0x2c, 0xfc, 0x97, 0x56, 0x41, 0x6a, 0xf8, 0x80, 0x0f, 0x9c, 0x5b, 0x0e, 0x24,
+	0x2e, 0x4e, 0x9b, 0xf4, 0x6e, 0xe1, 0xfa, 0xd6, 0x41, 0xec, 0x39, 0x10, 0x39, 0x82, 0xf7, 0x87,
+	0x9b, 0x16, 0x07, 0x15, 0xa2, 0x46, 0xcc, 0xb8, 0x41, 0x66, 0xf1, 0x21, 0xd6, 0x14, 0x61, 0x53,
+	0xdc, 0xb0, 0x22, 0xcb, 0x07, 0x01, 0x11, 0xaf, 0x0d, 0xa9, 0x09, 0x1d, 0xfd, 0xf4, 0x05, 0x7c,
+	0x2c, 0x5b, 0x51, 0x93, 0x87, 0x10, 0x34, 0x76, 0xbf, 0x61, 0xdf, 0x15, 0xdc, 0xb3, 0xc6, 0x9c,
+	0xdd, 0xbb, 0xa7, 0x86, 0x0f, 0x84, 0xac, 0x71, 0x5d, 0x0a, 0xc5, 0x4e, 0x49, 0x9b, 0xe4, 0x32,
+	0xc6, 0x1e, 0x73, 0xd2, 0xb0, 0xb2, 0x4f, 0x85, 0x95, 0xe9, 0x42, 0x58, 0xd1, 0x65, 0xbe, 0x92,
+	0x41, 0xe4, 0x06, 0x6b, 0xac, 0x65, 0x13, 0xcd, 0x82, 0x90, 0xbc, 0xc4, 0xd9, 0xf5, 0x58, 0x05,
+	0x0f, 0xfa, 0x38, 0xa2, 0x32, 0x55, 0x34, 0x94, 0x8a, 0x72, 0x24, 0xae, 0x98, 0x2a, 0x56, 0x8b,
+	0xa2, 0x66, 0x59, 0x13, 0xad, 0xe5, 0x1b, 0x93, 0x52, 0xf2, 0x90, 0x05, 0x1c, 0xe8, 0x87, 0x72,
+	0x01, 0x96, 0xb0, 0x37, 0xd3, 0x71, 0xbe, 0x77, 0x31, 0x99, 0xbe, 0x93, 0x6f, 0xb9, 0x62, 0xba,
+	0xda, 0x82, 0x40, 0x79, 0x52, 0xb4, 0xc3, 0xcc, 0x93, 0xf2, 0x9b, 0xbc, 0x82, 0x87, 0xd8, 0xfa,
+	0x2d, 0xb0, 0xc5, 0xa3, 0xc9, 0xfb, 0x89, 0x32, 0xfa, 0xbe, 0x3c, 0x74, 0x99, 0xe5, 0xbd, 0x74,
+	0xc5, 0x25, 0x3c, 0xbc, 0xc6, 0x9c, 0xab, 0x81, 0x88, 0xda, 0xf2, 0x04, 0xdb, 0x2c, 0x10, 0x10,
+	0x88, 0xc4, 0x78, 0xda, 0x2c, 0x9e, 0xed, 0x81, 0xd2, 0xd9, 0xa6, 0x1f, 0x95, 0x32, 0x6d, 0x20,
+	0xf6, 0xfa, 0x41, 0x45, 0xef, 0x17, 0x6e, 0x4a, 0xbd, 0x94, 0x56, 0x7b, 0x23, 0x51, 0x3c, 0x16,
+	0x01, 0x67, 0xcd, 0xc8, 0x86, 0x17, 0xdd, 0xa0, 0x91, 0xac, 0xb3, 0xd4, 0x57, 0x9c, 0x53, 0xb8,
+	0xe7, 0xa5, 0x3e, 0x02, 0x78, 0x3c, 0xce, 0xe6, 0xe5, 0xfb, 0xfe, 0xcc, 0xae, 0xd6, 0x57, 0x4f,
+	0x35, 0x71, 0xb3, 0xac, 0x95, 0x6e, 0xe6, 0x37, 0x6d, 0x85, 0xf9, 0xa1, 0x15, 0x55, 0x7c, 0xca,
+	0xa6, 0xe1, 0x62, 0xa0, 0x1c, 0x2e, 0x98, 0xd8, 0x84, 0xa8, 0xb0, 0xa6, 0xbc, 0x83, 0xbe, 0x91,
+	0x5f, 0x96, 0x55, 0x77, 0x63, 0x03, 0x22, 0x08, 0x6c, 0x15, 0xc4, 0xd5, 0x43, 0x26, 0xb1, 0x10,
+	0x37, 0x64, 0x6f, 0xcb, 0xf2, 0x9a, 0xa9, 0xfa, 0xb8, 0x41, 0x26, 0x31, 0x56, 0xea, 0x5e, 0x55,
+	0x43, 0xb1, 0x81, 0x42, 0x0f, 0xfd, 0x14, 0xe1, 0x89, 0xeb, 0xac, 0x01, 0xf1, 0x42, 0x5c, 0xce,
+	0x02, 0x32, 0x85, 0x47, 0x1b, 0x2e, 0x0f, 0x3d, 0xab, 0x7d, 0x3d, 0x0f, 0x6e, 0xc5, 0x2e, 0xb9,
+	0x17, 0x02, 0xfc, 0xd0, 0xb3, 0x04, 0x14, 0xce, 0x65, 0xa9, 0x8f, 0x5c, 0x92, 0x5a, 0x52, 0x64,
+	0x19, 0xe7, 0x06, 0x67, 0x46, 0x17, 0x8f, 0xe7, 0x7e, 0xee, 0x5c, 0x97, 0x59, 0x14, 0xa0, 0x1f,
+	0xa0, 0xfc, 0x91, 0x96, 0x79, 0x39, 0x0e, 0x68, 0x3b, 0x75, 0xa3, 0x7f, 0xa8, 0x9b, 0xe8, 0x78,
+	0xbf, 0x4c, 0x9a, 0xf2, 0x1e, 0x4b, 0xc9, 0x5a, 0x2e, 0x59, 0x76, 0x85, 0x19, 0x4f, 0x5b, 0xfc,
+	0xeb, 0x08, 0x3e, 0x98, 0xa7, 0xf1, 0xa8, 0xe5, 0xda, 0x40, 0xbe, 0x40, 0x78, 0x22, 0x7e, 0xba,
+	0xa7, 0x23, 0xe4, 0x64, 0x27, 0x41, 0xa9, 0xd2, 0xd1, 0xfa, 0xbb, 0x68, 0x74, 0xe6, 0xbd, 0x3f,
+	0xee, 0x7f, 0x32, 0x40, 0xe9, 0x09, 0x55, 0x68, 0xb5, 0x16, 0xb2, 0xca, 0x8c, 0x1b, 0x77, 0xb2,
+	0x53, 0xb6, 0xbd, 0x8c, 0x66, 0xc9, 0x67, 0x08, 0x8f, 0x5e, 0x03, 0x91, 0x91, 0x75, 0xf1, 0x4d,
+	0x5e, 0x4d, 0xf4, 0x8b, 0x75, 0x4e, 0x61, 0x3d, 0x46, 0xfe, 0xdf, 0x13, 0x2b, 0xfe, 0xde, 0x96,
+	0x68, 0xe3, 0x32, 0x22, 0xa6, 0xe2, 0x9c, 0x9c, 0xe8, 0x84, 0x2b, 0xd4, 0x0d, 0xda, 0xe5, 0xbe,
+	0xe8, 0xa4, 0x26, 0x7a, 0x5a, 0x11, 0x9e, 0x24, 0xbd, 0x1d, 0x47, 0xde, 0xc6, 0x13, 0xe5, 0x34,
+	0x59, 0xda, 0xd1, 0x6e, 0x09, 0x54, 0xeb, 0xe2, 0xd8, 0x3c, 0xb7, 0xd0, 0xb3, 0xca, 0xee, 0x69,
+	0x72, 0x6a, 0xa7, 0xdd, 0x39, 0x90, 0xe3, 0x25, 0xeb, 0xf3, 0x88, 0x70, 0x3c, 0x9a, 0x0b, 0xf3,
+	0xd2, 0xa6, 0x75, 0xe4, 0x2b, 0xed, 0x7f, 0xdd, 0x1e, 0x31, 0xb1, 0xd9, 0x33, 0xca, 0xec, 0x29,
+	0x32, 0x9d, 0x9a, 0xe5, 0x22, 0x02, 0xcb, 0x37, 0xba, 0x1a, 0x7d, 0x17, 0xe1, 0x89, 0xf8, 0xbd,
+	0xd0, 0xeb, 0x1c, 0x97, 0xde, 0x3d, 0xda, 0xd4, 0x83, 0x27, 0x24, 0x4f, 0x8e, 0xe4, 0x4c, 0xcc,
+	0x56, 0x3b, 0x13, 0x3f, 0x20, 0x3c, 0xae, 0xca, 0xa3, 0x0c, 0x61, 0xb2, 0xd3, 0x42, 0xb1, 0x7e,
+	0xea, 0xf7, 0xc8, 0x3e, 0xa1, 0xf0, 0x0c, 0x6d, 0xb6, 0x0a, 0x9e, 0x11, 0x49, 0xcb, 0xf2, 0x5a,
+	0xfd, 0x8c, 0xf0, 0xa1, 0xb4, 0x60, 0xcc, 0x50, 0xa7, 0xbb, 0xa1, 0x96, 0x8a, 0xca, 0x7e, 0x69,
+	0x2f, 0x28, 0xda, 0x45, 0x6d, 0xae, 0x22, 0x6d, 0x6c, 0x5c, 0x02, 0xff, 0x88, 0xf0, 0x44, 0x5c,
+	0xd1, 0xf5, 0xda, 0xdc, 0x52, 0xcd, 0xd7, 0x2f, 0xec, 0x93, 0x0a, 0x76, 0x7e, 0x19, 0xcd, 0x6a,
+	0x67, 0x2b, 0xf3, 0xfa, 0x40, 0x7e, 0x42, 0xf8, 0x60, 0x52, 0x50, 0x64, 0xac, 0x5d, 0xce, 0x59,
+	0xb9, 0xe6, 0xe8, 0x17, 0xf6, 0x29, 0x05, 0xbb, 0xa0, 0x9d, 0xab, 0x44, 0xca, 0x63, 0xdb, 0xd2,
+	0xb1, 0xbf, 0x22, 0x7c, 0x38, 0xab, 0x58, 0x33, 0x5e, 0xda, 0xc9, 0xbb, 0xb3, 0xac, 0xed, 0x97,
+	0xf8, 0x69, 0x45, 0xbc, 0xa4, 0xe9, 0x95, 0x88, 0x45, 0x6a, 0x5d, 0x32, 0x7f, 0x8b, 0xf0, 0x98,
+	0x2c, 0x8b, 0x33, 0xdc, 0x2e, 0x81, 0xb7, 0x50, 0x36, 0xf7, 0x4b, 0x7a, 0x5e, 0x91, 0xea, 0xda,
+	0x99, 0x6a, 0xbe, 0x15, 0x2c, 0x94, 0x90, 0x5f, 0x21, 0x3c, 0x5a, 0xef, 0x9d, 0xb9, 0xea, 0x8f,
+	0x2c, 0x73, 0x2d, 0x29, 0xc4, 0x39, 0x6d, 0xa6, 0x1a, 0x22, 0xa8, 0x3b, 0xf5, 0x25, 0xc2, 0x63,
+	0xf2, 0xb5, 0xdd, 0xcb, 0x8d, 0x85, 0xd7, 0x78, 0xbf, 0x8c, 0x73, 0x8a, 0xf1, 0x71, 0x4a, 0x7b,
+	0x33, 0x7a, 0x6e, 0xa0, 0xe8, 0xda, 0xf8, 0x40, 0x5c, 0xc9, 0xf2, 0x6e, 0xae, 0xcb, 0x8b, 0x6c,
+	0x8d, 0xe4, 0xa3, 0x69, 0x11, 0x42, 0x97, 0x95, 0xad, 0xf3, 0x64, 0xb1, 0x92, 0x3f, 0xee, 0x24,
+	0x75, 0xc8, 0xb6, 0xe1, 0x31, 0x67, 0x1e, 0x91, 0x6f, 0x10, 0x9e, 0xa8, 0x97, 0x63, 0xe3, 0xc9,
+	0x6e, 0x17, 0xf8, 0x11, 0x46, 0x46, 0x43, 0x01, 0x9f, 0x59, 0x46, 0xb3, 0xf4, 0x21, 0x99, 0x26,
+	0x8e, 0x89, 0xe4, 0x73, 0x84, 0x0f, 0x25, 0xaf, 0xc9, 0x3c, 0xcb, 0x77, 0x09, 0x33, 0xe5, 0x77,
+	0xbd, 0x36, 0xdd, 0x63, 0x46, 0x92, 0xf1, 0x9e, 0x55, 0x28, 0xcb, 0xe4, 0x42, 0x25, 0xdf, 0xd9,
+	0xb1, 0xb4, 0x71, 0x27, 0x7b, 0xeb, 0x6f, 0x5f, 0xb9, 0xf8, 0xfb, 0xbd, 0x49, 0x74, 0xf7, 0xde,
+	0x24, 0xfa, 0xf3, 0xde, 0x24, 0x7a, 0xdd, 0x78, 0xd8, 0x0f, 0xf3, 0x3b, 0xfe, 0x36, 0x58, 0x1f,
+	0x52, 0xbf, 0xb3, 0x2f, 0xfd, 0x3d, 0x00, 0xe6, 0x00, 0xcd, 0xf8, 0x57, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1380,6 +1650,7 @@ type WorkflowServiceClient interface {
 	LintWorkflow(ctx context.Context, in *WorkflowLintRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	PodLogs(ctx context.Context, in *WorkflowLogRequest, opts ...grpc.CallOption) (WorkflowService_PodLogsClient, error)
 	SubmitWorkflow(ctx context.Context, in *WorkflowSubmitRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	CompareWorkflows(ctx context.Context, in *WorkflowCompareRequest, opts ...grpc.CallOption) (*WorkflowCompareResponse, error)
 }
 
 type workflowServiceClient struct {
@@ -1603,6 +1874,15 @@ func (c *workflowServiceClient) SubmitWorkflow(ctx context.Context, in *Workflow
 	return out, nil
 }
 
+func (c *workflowServiceClient) CompareWorkflows(ctx context.Context, in *WorkflowCompareRequest, opts ...grpc.CallOption) (*WorkflowCompareResponse, error) {
+	out := new(WorkflowCompareResponse)
+	err := c.cc.Invoke(ctx, "/workflow.WorkflowService/CompareWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 type WorkflowServiceServer interface {
 	CreateWorkflow(context.Context, *WorkflowCreateRequest) (*v1alpha1.Workflow, error)
@@ -1621,6 +1901,7 @@ type WorkflowServiceServer interface {
 	LintWorkflow(context.Context, *WorkflowLintRequest) (*v1alpha1.Workflow, error)
 	PodLogs(*WorkflowLogRequest, WorkflowService_PodLogsServer) error
 	SubmitWorkflow(context.Context, *WorkflowSubmitRequest) (*v1alpha1.Workflow, error)
+	CompareWorkflows(context.Context, *WorkflowCompareRequest) (*WorkflowCompareResponse, error)
 }
 
 // UnimplementedWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -1675,6 +1956,9 @@ func (*UnimplementedWorkflowServiceServer) PodLogs(req *WorkflowLogRequest, srv
 func (*UnimplementedWorkflowServiceServer) SubmitWorkflow(ctx context.Context, req *WorkflowSubmitRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkflow not implemented")
 }
+func (*UnimplementedWorkflowServiceServer) CompareWorkflows(ctx context.Context, req *WorkflowCompareRequest) (*WorkflowCompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareWorkflows not implemented")
+}
 
 func RegisterWorkflowServiceServer(s *grpc.Server, srv WorkflowServiceServer) {
 	s.RegisterService(&_WorkflowService_serviceDesc, srv)
@@ -1977,6 +2261,24 @@ func _WorkflowService_SubmitWorkflow_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_CompareWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowCompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CompareWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflow.WorkflowService/CompareWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CompareWorkflows(ctx, req.(*WorkflowCompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflow.WorkflowService",
 	HandlerType: (*WorkflowServiceServer)(nil),
@@ -2033,6 +2335,10 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitWorkflow",
 			Handler:    _WorkflowService_SubmitWorkflow_Handler,
 		},
+		{
+			MethodName: "CompareWorkflows",
+			Handler:    _WorkflowService_CompareWorkflows_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3030,58 +3336,264 @@ func (m *WorkflowSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflow(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WorkflowCompareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WorkflowCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WorkflowCompareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCompareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	if m.Workflow != nil {
-		l = m.Workflow.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
-	l = len(m.InstanceID)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ServerDryRun {
-		n += 2
+	if len(m.OtherName) > 0 {
+		i -= len(m.OtherName)
+		copy(dAtA[i:], m.OtherName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OtherName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CreateOptions != nil {
-		l = m.CreateOptions.Size()
-		n += 1 + l + sovWorkflow(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowDifference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDifference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDifference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflow(uint64(l))
-	}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OtherValue) > 0 {
+		i -= len(m.OtherValue)
+		copy(dAtA[i:], m.OtherValue)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.OtherValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Differences) > 0 {
+		for iNdEx := len(m.Differences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Differences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TemplateName) > 0 {
+		i -= len(m.TemplateName)
+		copy(dAtA[i:], m.TemplateName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.TemplateName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintWorkflow(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCompareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCompareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Differences) > 0 {
+		for iNdEx := len(m.Differences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Differences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.InstanceID)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.ServerDryRun {
+		n += 2
+	}
+	if m.CreateOptions != nil {
+		l = m.CreateOptions.Size()
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
 	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
@@ -3494,6 +4006,104 @@ func (m *WorkflowSubmitRequest) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowCompareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OtherName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDifference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.OtherValue)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	l = len(m.TemplateName)
+	if l > 0 {
+		n += 1 + l + sovWorkflow(uint64(l))
+	}
+	if len(m.Differences) > 0 {
+		for _, e := range m.Differences {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowCompareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Differences) > 0 {
+		for _, e := range m.Differences {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6358,6 +6968,580 @@ func (m *WorkflowSubmitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowCompareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCompareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDifference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDifference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDifference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeComparison) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeComparison: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeComparison: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Differences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Differences = append(m.Differences, &WorkflowDifference{})
+			if err := m.Differences[len(m.Differences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowCompareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowCompareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Differences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Differences = append(m.Differences, &WorkflowDifference{})
+			if err := m.Differences[len(m.Differences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeComparison{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,10 +30,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ListArchivedWorkflowsRequest struct {
-	ListOptions          *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	// Only list workflows whose name starts with this prefix.
+	NamePrefix string `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// Only list workflows in one of these phases.
+	Phases []string `protobuf:"bytes,3,rep,name=phases,proto3" json:"phases,omitempty"`
+	// Only list workflows created from this workflow template or cluster workflow template.
+	WorkflowTemplate     string   `protobuf:"bytes,4,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListArchivedWorkflowsRequest) Reset()         { *m = ListArchivedWorkflowsRequest{} }
@@ -76,6 +82,27 @@ func (m *ListArchivedWorkflowsRequest) GetListOptions() *v1.ListOptions {
 	return nil
 }
 
+func (m *ListArchivedWorkflowsRequest) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *ListArchivedWorkflowsRequest) GetPhases() []string {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+func (m *ListArchivedWorkflowsRequest) GetWorkflowTemplate() string {
+	if m != nil {
+		return m.WorkflowTemplate
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -209,11 +236,131 @@ func (m *ArchivedWorkflowDeletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArchivedWorkflowDeletedResponse proto.InternalMessageInfo
 
+type ResubmitArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Memoized             bool     `protobuf:"varint,2,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Reset()         { *m = ResubmitArchivedWorkflowRequest{} }
+func (m *ResubmitArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ResubmitArchivedWorkflowRequest) ProtoMessage()    {}
+func (*ResubmitArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{4}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *ResubmitArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetMemoized() bool {
+	if m != nil {
+		return m.Memoized
+	}
+	return false
+}
+
+type RetryArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RestartSuccessful    bool     `protobuf:"varint,2,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,3,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryArchivedWorkflowRequest) Reset()         { *m = RetryArchivedWorkflowRequest{} }
+func (m *RetryArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryArchivedWorkflowRequest) ProtoMessage()    {}
+func (*RetryArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{5}
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *RetryArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RetryArchivedWorkflowRequest) GetRestartSuccessful() bool {
+	if m != nil {
+		return m.RestartSuccessful
+	}
+	return false
+}
+
+func (m *RetryArchivedWorkflowRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
 	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
 	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
+	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
 }
 
 func init() {
@@ -221,36 +368,47 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x8a, 0xd4, 0x40,
-	0x10, 0xc6, 0x69, 0x15, 0xc1, 0xde, 0x83, 0xd2, 0xe2, 0x1f, 0xc2, 0x38, 0xae, 0x39, 0x2d, 0xca,
-	0x54, 0x9b, 0x75, 0x11, 0x2f, 0x1e, 0x56, 0x17, 0xbc, 0x2c, 0x08, 0xb3, 0x07, 0xc1, 0x5b, 0x6f,
-	0x52, 0x66, 0xda, 0x64, 0xd2, 0x6d, 0x77, 0x27, 0x83, 0x88, 0x17, 0x5f, 0xc1, 0xbb, 0x27, 0x6f,
-	0xe2, 0x7b, 0x78, 0x14, 0x7c, 0x01, 0x19, 0x7c, 0x10, 0xc9, 0xbf, 0xcd, 0x92, 0x64, 0x77, 0x04,
-	0x6f, 0x95, 0x4e, 0xd5, 0x57, 0x3f, 0xbe, 0x2f, 0x1d, 0xba, 0xa7, 0x93, 0x98, 0x0b, 0x2d, 0xc3,
-	0x54, 0x62, 0xe6, 0xf8, 0x4a, 0x99, 0xe4, 0x4d, 0xaa, 0x56, 0xc2, 0x84, 0x0b, 0x59, 0xe0, 0xc9,
-	0xf3, 0xac, 0x39, 0x00, 0x6d, 0x94, 0x53, 0xec, 0x6a, 0xaf, 0xcf, 0x9b, 0xc4, 0x4a, 0xc5, 0x29,
-	0x96, 0x4a, 0x5c, 0x64, 0x99, 0x72, 0xc2, 0x49, 0x95, 0xd9, 0xba, 0xdd, 0xdb, 0x4b, 0x9e, 0x58,
-	0x90, 0xaa, 0x7c, 0xbb, 0x14, 0xe1, 0x42, 0x66, 0x68, 0xde, 0xf3, 0x66, 0xb1, 0xe5, 0x4b, 0x74,
-	0x82, 0x17, 0x01, 0x8f, 0x31, 0x43, 0x23, 0x1c, 0x46, 0xcd, 0xd4, 0xf3, 0x58, 0xba, 0x45, 0x7e,
-	0x0c, 0xa1, 0x5a, 0x72, 0x61, 0x62, 0xa5, 0x8d, 0x7a, 0x5b, 0x15, 0xdd, 0x68, 0x8b, 0xc1, 0x8b,
-	0x40, 0xa4, 0x7a, 0x21, 0x06, 0x22, 0xbe, 0xa5, 0x93, 0x43, 0x69, 0xdd, 0x7e, 0xcd, 0x19, 0xbd,
-	0x6a, 0x06, 0xec, 0x1c, 0xdf, 0xe5, 0x68, 0x1d, 0x3b, 0xa2, 0x5b, 0xa9, 0xb4, 0xee, 0xa5, 0xae,
-	0x78, 0x6f, 0x93, 0x6d, 0xb2, 0xb3, 0xb5, 0x1b, 0x40, 0x0d, 0x0c, 0xa7, 0x81, 0x41, 0x27, 0x71,
-	0x79, 0x60, 0xa1, 0x04, 0x86, 0x22, 0x80, 0xc3, 0x6e, 0x70, 0x7e, 0x5a, 0xc5, 0x07, 0xea, 0xbd,
-	0xc0, 0xc1, 0xce, 0x76, 0xe5, 0x35, 0x7a, 0x31, 0x97, 0x51, 0xb5, 0xea, 0xca, 0xbc, 0x2c, 0xfd,
-	0x80, 0xde, 0x39, 0xc0, 0x14, 0x1d, 0xfe, 0xfb, 0xc8, 0x3d, 0x7a, 0xb7, 0xdf, 0x5c, 0x4b, 0x44,
-	0x73, 0xb4, 0x5a, 0x65, 0x16, 0x77, 0xbf, 0x5c, 0xa2, 0xb7, 0xfa, 0x3d, 0x47, 0x68, 0x0a, 0x19,
-	0x22, 0xfb, 0x4e, 0xe8, 0x8d, 0x51, 0x5f, 0xd8, 0x0c, 0x7a, 0xd9, 0xc2, 0x79, 0xfe, 0x79, 0xfb,
-	0xd0, 0xa5, 0x04, 0x6d, 0x4a, 0x55, 0xd1, 0xf9, 0xd5, 0x0a, 0x42, 0x9b, 0x12, 0xb4, 0x32, 0xa5,
-	0xb4, 0xef, 0x7f, 0xfa, 0xf5, 0xe7, 0xf3, 0x85, 0x09, 0xf3, 0xaa, 0xaf, 0xa7, 0x08, 0x78, 0xb3,
-	0x38, 0x9a, 0xad, 0x4e, 0xa8, 0xbe, 0x11, 0x7a, 0x7d, 0xc4, 0x52, 0xf6, 0x60, 0x40, 0x7b, 0xb6,
-	0xf1, 0xde, 0xd3, 0xff, 0x62, 0xf5, 0x77, 0x2a, 0x4e, 0x9f, 0x6d, 0x9f, 0xcd, 0xc9, 0x3f, 0xe4,
-	0x32, 0xfa, 0xc8, 0xbe, 0x12, 0x7a, 0x73, 0x3c, 0x50, 0x06, 0x03, 0xe0, 0x73, 0x93, 0xf7, 0x1e,
-	0x0e, 0xfa, 0x37, 0xc4, 0xde, 0x62, 0xde, 0xdf, 0x88, 0xf9, 0xec, 0xe0, 0xc7, 0x7a, 0x4a, 0x7e,
-	0xae, 0xa7, 0xe4, 0xf7, 0x7a, 0x4a, 0x5e, 0x3f, 0xde, 0x74, 0xdd, 0xc6, 0x7f, 0x11, 0xc7, 0x97,
-	0xab, 0x8b, 0xf6, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x60, 0x01, 0x7f, 0x4a, 0x04,
-	0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x75, 0x0d, 0x2a, 0xed, 0x75, 0xa0, 0x1c, 0x6a, 0x89, 0xac, 0x90, 0x06, 0x0f, 0x28,
+	0xa4, 0xf4, 0x8c, 0x4b, 0x85, 0x50, 0x25, 0x86, 0x42, 0x05, 0x4b, 0xa5, 0x22, 0x07, 0x09, 0x89,
+	0xed, 0x6a, 0xbf, 0x3a, 0x47, 0x6c, 0x9f, 0xb9, 0x3b, 0xa7, 0x14, 0xc4, 0xc2, 0xcc, 0xc6, 0xcc,
+	0xc6, 0x86, 0xd8, 0x59, 0xd9, 0x18, 0x91, 0x18, 0x58, 0x51, 0xc5, 0x1f, 0x82, 0xec, 0xd8, 0x4d,
+	0x15, 0xbb, 0x4d, 0xa4, 0x6c, 0x77, 0xef, 0xde, 0xfb, 0xee, 0xf7, 0x4e, 0xdf, 0xd3, 0xe1, 0xad,
+	0xb8, 0xef, 0x5b, 0x2c, 0xe6, 0x6e, 0xc0, 0x21, 0xd2, 0xd6, 0x91, 0x90, 0xfd, 0xc3, 0x40, 0x1c,
+	0x31, 0xe9, 0xf6, 0xf8, 0x00, 0x4e, 0xf7, 0x1b, 0x79, 0x80, 0xc6, 0x52, 0x68, 0x41, 0xae, 0x8c,
+	0xe5, 0x19, 0x0d, 0x5f, 0x08, 0x3f, 0x80, 0x54, 0xc9, 0x62, 0x51, 0x24, 0x34, 0xd3, 0x5c, 0x44,
+	0x6a, 0x98, 0x6e, 0x6c, 0xf5, 0x1f, 0x28, 0xca, 0x45, 0x7a, 0x1a, 0x32, 0xb7, 0xc7, 0x23, 0x90,
+	0xc7, 0x56, 0x7e, 0xb1, 0xb2, 0x42, 0xd0, 0xcc, 0x1a, 0xd8, 0x96, 0x0f, 0x11, 0x48, 0xa6, 0xc1,
+	0xcb, 0xab, 0x1e, 0xfb, 0x5c, 0xf7, 0x92, 0x03, 0xea, 0x8a, 0xd0, 0x62, 0xd2, 0x17, 0xb1, 0x14,
+	0xaf, 0xb2, 0xc5, 0xa8, 0xb4, 0xc0, 0xb0, 0x06, 0x36, 0x0b, 0xe2, 0x1e, 0x2b, 0x89, 0x98, 0x7f,
+	0x10, 0x6e, 0xec, 0x71, 0xa5, 0x77, 0x86, 0xa0, 0xde, 0x8b, 0xbc, 0x42, 0x39, 0xf0, 0x3a, 0x01,
+	0xa5, 0x49, 0x17, 0x2f, 0x05, 0x5c, 0xe9, 0xfd, 0x38, 0x03, 0xae, 0xa3, 0x16, 0x6a, 0x2f, 0x6d,
+	0xda, 0x74, 0x48, 0x4c, 0xcf, 0x12, 0xd3, 0xb8, 0xef, 0xa7, 0x01, 0x45, 0x53, 0x62, 0x3a, 0xb0,
+	0xe9, 0xde, 0xa8, 0xd0, 0x39, 0xab, 0x42, 0x9a, 0x18, 0x47, 0x2c, 0x84, 0x67, 0x12, 0x0e, 0xf9,
+	0x9b, 0xfa, 0x5c, 0x0b, 0xb5, 0x17, 0x9d, 0x33, 0x11, 0xb2, 0x8a, 0xe7, 0xe3, 0x1e, 0x53, 0xa0,
+	0xea, 0xb5, 0x56, 0xad, 0xbd, 0xe8, 0xe4, 0x3b, 0xd2, 0xc1, 0xcb, 0x45, 0x4b, 0xcf, 0x21, 0x8c,
+	0x03, 0xa6, 0xa1, 0x7e, 0x29, 0xab, 0x2e, 0xc5, 0x4d, 0x8a, 0x8d, 0xa7, 0x50, 0xea, 0xab, 0x68,
+	0x6b, 0x19, 0xd7, 0x12, 0xee, 0x65, 0xed, 0x2c, 0x3a, 0xe9, 0xd2, 0xb4, 0xf1, 0x8d, 0x5d, 0x08,
+	0x40, 0xc3, 0xf4, 0x25, 0x37, 0xf1, 0xda, 0x78, 0xf2, 0x50, 0xc2, 0x73, 0x40, 0xc5, 0x22, 0x52,
+	0x60, 0xee, 0xe3, 0x35, 0x07, 0x54, 0x72, 0x10, 0xf2, 0xe9, 0x51, 0x88, 0x81, 0x17, 0x42, 0x08,
+	0x05, 0x7f, 0x0b, 0x5e, 0xf6, 0x38, 0x0b, 0xce, 0xe9, 0xde, 0xfc, 0x88, 0x70, 0xc3, 0x01, 0x2d,
+	0x8f, 0xa7, 0x97, 0xbb, 0x83, 0xaf, 0x4a, 0x50, 0x9a, 0x49, 0xdd, 0x4d, 0x5c, 0x17, 0x94, 0x3a,
+	0x4c, 0x82, 0x5c, 0xb7, 0x7c, 0x90, 0x66, 0x47, 0xc2, 0x83, 0x27, 0x1c, 0x02, 0xaf, 0x0b, 0x01,
+	0xb8, 0x5a, 0xc8, 0x7a, 0x2d, 0x53, 0x2b, 0x1f, 0x6c, 0x7e, 0xbe, 0x8c, 0xaf, 0x8f, 0x93, 0x74,
+	0x41, 0x0e, 0xb8, 0x0b, 0xe4, 0x1b, 0xc2, 0x2b, 0x95, 0xde, 0x22, 0x1b, 0x74, 0x6c, 0x40, 0xe8,
+	0x45, 0x1e, 0x34, 0x76, 0xe8, 0xc8, 0xea, 0xb4, 0xb0, 0x7a, 0xb6, 0x18, 0x79, 0xae, 0x10, 0xa4,
+	0x85, 0xd5, 0x69, 0x21, 0x93, 0x4a, 0x9b, 0xe6, 0x87, 0xdf, 0xff, 0x3e, 0xcd, 0x35, 0x88, 0x91,
+	0x8d, 0xe0, 0xc0, 0xb6, 0xf2, 0x8b, 0xbd, 0x8d, 0xa3, 0x53, 0xaa, 0xaf, 0x08, 0x5f, 0xab, 0xb0,
+	0x0c, 0x59, 0x2f, 0xd1, 0x9e, 0x6f, 0x2c, 0xe3, 0xe1, 0x4c, 0xac, 0x66, 0x3b, 0xe3, 0x34, 0x49,
+	0xeb, 0x7c, 0x4e, 0xeb, 0x5d, 0xc2, 0xbd, 0xf7, 0xe4, 0x0b, 0xc2, 0xab, 0xd5, 0x86, 0x25, 0xb4,
+	0x04, 0x7c, 0xa1, 0xb3, 0x8d, 0xbb, 0xa5, 0xfc, 0x49, 0xb6, 0xce, 0x31, 0x3b, 0x93, 0x31, 0x7f,
+	0x20, 0x5c, 0x3f, 0x6f, 0x02, 0x48, 0xf9, 0xe2, 0x09, 0xc3, 0x32, 0xeb, 0xf3, 0x6e, 0x65, 0xdc,
+	0xd4, 0xb8, 0x3d, 0x89, 0xdb, 0x92, 0x39, 0xc8, 0x36, 0xea, 0x90, 0xef, 0x08, 0xaf, 0x54, 0xce,
+	0x5c, 0x85, 0x91, 0x2f, 0x9a, 0xcd, 0x59, 0xe9, 0xed, 0x8c, 0x7e, 0xdd, 0xb8, 0x35, 0x05, 0xbd,
+	0x96, 0xc7, 0xdb, 0xa8, 0xf3, 0x68, 0xf7, 0xe7, 0x49, 0x13, 0xfd, 0x3a, 0x69, 0xa2, 0xbf, 0x27,
+	0x4d, 0xf4, 0xf2, 0xfe, 0xa4, 0x2f, 0xa3, 0xfa, 0x9b, 0x3b, 0x98, 0xcf, 0x3e, 0x8b, 0x7b, 0xff,
+	0x07, 0x00, 0x9c, 0x8a, 0xd6, 0x14, 0x0e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +426,8 @@ type ArchivedWorkflowServiceClient interface {
 	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
 	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
 
 type archivedWorkflowServiceClient struct {
@@ -305,11 +465,31 @@ func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Conte
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
 type ArchivedWorkflowServiceServer interface {
 	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
 	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 }
 
 // UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -325,6 +505,12 @@ func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx conte
 func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
+}
 
 func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
 	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
@@ -384,6 +570,42 @@ func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, req.(*ResubmitArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, req.(*RetryArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowarchive.ArchivedWorkflowService",
 	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
@@ -400,6 +622,14 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
 		},
+		{
+			MethodName: "ResubmitArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "RetryArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
@@ -429,6 +659,29 @@ func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.WorkflowTemplate) > 0 {
+		i -= len(m.WorkflowTemplate)
+		copy(dAtA[i:], m.WorkflowTemplate)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.WorkflowTemplate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Phases[iNdEx])
+			copy(dAtA[i:], m.Phases[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Phases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NamePrefix) > 0 {
+		i -= len(m.NamePrefix)
+		copy(dAtA[i:], m.NamePrefix)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NamePrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ListOptions != nil {
 		{
 			size, err := m.ListOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -539,6 +792,101 @@ func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *ResubmitArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWorkflowArchive(v)
 	base := offset
@@ -560,6 +908,20 @@ func (m *ListArchivedWorkflowsRequest) Size() (n int) {
 		l = m.ListOptions.Size()
 		n += 1 + l + sovWorkflowArchive(uint64(l))
 	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if len(m.Phases) > 0 {
+		for _, s := range m.Phases {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	l = len(m.WorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -610,24 +972,66 @@ func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
 	return n
 }
 
-func sovWorkflowArchive(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWorkflowArchive(x uint64) (n int) {
-	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflowArchive
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *RetryArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWorkflowArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWorkflowArchive(x uint64) (n int) {
+	return sovWorkflowArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -681,6 +1085,102 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -932,6 +1432,250 @@ func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memoized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/robfig/cron/v3"
@@ -20,16 +21,131 @@ type CronWorkflow struct {
 	Status            CronWorkflowStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
-func (cwf *CronWorkflow) GetNextRuntime() (time.Time, error) {
-	cronScheduleString := cwf.Spec.Schedule
+// GetSchedules returns all the schedules of the CronWorkflow, in Cron format
+func (cwf *CronWorkflow) GetSchedules() []string {
+	var schedules []string
+	if cwf.Spec.Schedule != "" {
+		schedules = append(schedules, cwf.Spec.Schedule)
+	}
+	return append(schedules, cwf.Spec.Schedules...)
+}
+
+// GetSchedule returns the schedule of the CronWorkflow, which is run at the times of any of its schedules, unless the
+// time is excluded
+func (cwf *CronWorkflow) GetSchedule() (cron.Schedule, error) {
+	loc := time.Local
+	if cwf.Spec.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(cwf.Spec.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone '%s': %w", cwf.Spec.Timezone, err)
+		}
+	}
+	schedules := cwf.GetSchedules()
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("at least one schedule is required")
+	}
+	sched := &cronSchedule{loc: loc}
+	for _, s := range schedules {
+		schedule, err := cwf.parseSchedule(s)
+		if err != nil {
+			return nil, err
+		}
+		sched.schedules = append(sched.schedules, schedule)
+	}
+	for _, e := range cwf.Spec.Exclusions {
+		if date, err := time.ParseInLocation(exclusionDateLayout, e, loc); err == nil {
+			sched.excludedDates = append(sched.excludedDates, date)
+			continue
+		}
+		exclusion, err := cwf.parseSchedule(e)
+		if err != nil {
+			return nil, fmt.Errorf("exclusion '%s' is neither a date in YYYY-MM-DD format nor a valid schedule: %w", e, err)
+		}
+		sched.exclusions = append(sched.exclusions, exclusion)
+	}
+	return sched, nil
+}
+
+func (cwf *CronWorkflow) parseSchedule(s string) (cron.Schedule, error) {
 	if cwf.Spec.Timezone != "" {
-		cronScheduleString = "CRON_TZ=" + cwf.Spec.Timezone + " " + cronScheduleString
+		s = "CRON_TZ=" + cwf.Spec.Timezone + " " + s
 	}
-	cronSchedule, err := cron.ParseStandard(cronScheduleString)
+	return cron.ParseStandard(s)
+}
+
+func (cwf *CronWorkflow) GetNextRuntime() (time.Time, error) {
+	sched, err := cwf.GetSchedule()
 	if err != nil {
 		return time.Time{}, err
 	}
-	return cronSchedule.Next(time.Now()), nil
+	return sched.Next(time.Now()), nil
+}
+
+// GetNextRuntimes returns the next n times the CronWorkflow will be run at
+func (cwf *CronWorkflow) GetNextRuntimes(n int) ([]time.Time, error) {
+	sched, err := cwf.GetSchedule()
+	if err != nil {
+		return nil, err
+	}
+	var runtimes []time.Time
+	for t := sched.Next(time.Now()); !t.IsZero() && len(runtimes) < n; t = sched.Next(t) {
+		runtimes = append(runtimes, t)
+	}
+	return runtimes, nil
+}
+
+const (
+	exclusionDateLayout = "2006-01-02"
+	// maxExcludedTimes is the maximum number of consecutive excluded times skipped when looking for the next time,
+	// so that a schedule whose times are all excluded does not loop forever
+	maxExcludedTimes = 10000
+)
+
+// cronSchedule is a cron.Schedule which is the union of schedules, less the times which are excluded
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+type cronSchedule struct {
+	loc           *time.Location
+	schedules     []cron.Schedule
+	exclusions    []cron.Schedule
+	excludedDates []time.Time
+}
+
+// Next returns the next time after t at which any of the schedules is activated and which is not excluded, or the
+// zero time if there is none
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	for i := 0; i < maxExcludedTimes; i++ {
+		var next time.Time
+		for _, schedule := range s.schedules {
+			n := schedule.Next(t)
+			if !n.IsZero() && (next.IsZero() || n.Before(next)) {
+				next = n
+			}
+		}
+		if next.IsZero() || !s.isExcluded(next) {
+			return next
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) isExcluded(t time.Time) bool {
+	year, month, day := t.In(s.loc).Date()
+	for _, date := range s.excludedDates {
+		y, m, d := date.Date()
+		if year == y && month == m && day == d {
+			return true
+		}
+	}
+	for _, exclusion := range s.exclusions {
+		// schedules have a resolution of one minute, so t is excluded if the exclusion is activated at t
+		if exclusion.Next(t.Add(-time.Second)).Equal(t) {
+			return true
+		}
+	}
+	return false
 }
 
 // CronWorkflowList is list of CronWorkflow resources
@@ -53,7 +169,7 @@ type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
 	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -69,6 +185,11 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules are more schedules, in Cron format, to run the Workflow at, in addition to Schedule, e.g. "0 9 * * 1-5" and "0 17 * * 1-5"
+	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// Exclusions are the times at which the Workflow is not run even though it is scheduled, e.g. holidays. Each is either a
+	// date in YYYY-MM-DD format, or a schedule in Cron format, e.g. "* * 25 12 *". They are calculated against the Timezone.
+	Exclusions []string `json:"exclusions,omitempty" protobuf:"bytes,11,rep,name=exclusions"`
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
@@ -79,6 +200,44 @@ type CronWorkflowStatus struct {
 	LastScheduledTime *metav1.Time `json:"lastScheduledTime,omitempty" protobuf:"bytes,2,opt,name=lastScheduledTime"`
 	// Conditions is a list of conditions the CronWorkflow may have
 	Conditions Conditions `json:"conditions,omitempty" protobuf:"bytes,3,rep,name=conditions"`
+	// Backfilled are the windows of scheduled times whose workflows have been backfilled
+	Backfilled []BackfillWindow `json:"backfilled,omitempty" protobuf:"bytes,4,rep,name=backfilled"`
+}
+
+// BackfillWindow is a window of consecutive scheduled times, inclusive, whose workflows have all been backfilled
+type BackfillWindow struct {
+	// From is the first scheduled time of the window
+	From metav1.Time `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// To is the last scheduled time of the window
+	To metav1.Time `json:"to" protobuf:"bytes,2,opt,name=to"`
+}
+
+// HasBackfilled returns whether the workflow of the scheduled time has been backfilled
+func (c *CronWorkflowStatus) HasBackfilled(scheduledTime time.Time) bool {
+	for _, w := range c.Backfilled {
+		if !scheduledTime.Before(w.From.Time) && !scheduledTime.After(w.To.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBackfilled records that the workflow of the scheduled time has been backfilled. If the window of a previous
+// backfill ends at the scheduled time before it, the window is extended rather than a new one added, so that the
+// windows stay few. previous is zero if the scheduled time before it is not known.
+func (c *CronWorkflowStatus) AddBackfilled(scheduledTime, previous time.Time) {
+	if c.HasBackfilled(scheduledTime) {
+		return
+	}
+	if !previous.IsZero() {
+		for i, w := range c.Backfilled {
+			if w.To.Time.Equal(previous) {
+				c.Backfilled[i].To = metav1.NewTime(scheduledTime)
+				return
+			}
+		}
+	}
+	c.Backfilled = append(c.Backfilled, BackfillWindow{From: metav1.NewTime(scheduledTime), To: metav1.NewTime(scheduledTime)})
 }
 
 const (
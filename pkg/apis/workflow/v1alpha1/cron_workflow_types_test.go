@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronWorkflow_GetSchedule(t *testing.T) {
+	t.Run("NoSchedule", func(t *testing.T) {
+		cwf := &CronWorkflow{}
+		_, err := cwf.GetSchedule()
+		assert.EqualError(t, err, "at least one schedule is required")
+	})
+	t.Run("InvalidTimezone", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedule: "* * * * *", Timezone: "Mars/Olympus"}}
+		_, err := cwf.GetSchedule()
+		assert.Error(t, err)
+	})
+	t.Run("InvalidExclusion", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedule: "* * * * *", Exclusions: []string{"2020-13-01"}}}
+		_, err := cwf.GetSchedule()
+		assert.Error(t, err)
+	})
+	t.Run("Schedules", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{
+			Schedule:  "0 9 * * *",
+			Schedules: []string{"0 17 * * *", "0 9 * * *"},
+			Timezone:  "UTC",
+			// Christmas, and Saturday evenings
+			Exclusions: []string{"2020-12-25", "0 17 * * 6"},
+		}}
+		assert.Equal(t, []string{"0 9 * * *", "0 17 * * *", "0 9 * * *"}, cwf.GetSchedules())
+		sched, err := cwf.GetSchedule()
+		if assert.NoError(t, err) {
+			next := sched.Next(time.Date(2020, 12, 24, 10, 0, 0, 0, time.UTC))
+			assert.True(t, time.Date(2020, 12, 24, 17, 0, 0, 0, time.UTC).Equal(next), next)
+			next = sched.Next(next)
+			assert.True(t, time.Date(2020, 12, 26, 9, 0, 0, 0, time.UTC).Equal(next), next)
+			next = sched.Next(next)
+			assert.True(t, time.Date(2020, 12, 27, 9, 0, 0, 0, time.UTC).Equal(next), next)
+		}
+	})
+	t.Run("AllExcluded", func(t *testing.T) {
+		cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedule: "0 9 * * *", Exclusions: []string{"0 * * * *"}}}
+		sched, err := cwf.GetSchedule()
+		if assert.NoError(t, err) {
+			assert.True(t, sched.Next(time.Now()).IsZero())
+		}
+	})
+}
+
+func TestCronWorkflow_GetNextRuntimes(t *testing.T) {
+	cwf := &CronWorkflow{Spec: CronWorkflowSpec{Schedule: "* * * * *"}}
+	runtimes, err := cwf.GetNextRuntimes(3)
+	if assert.NoError(t, err) && assert.Len(t, runtimes, 3) {
+		assert.Equal(t, time.Minute, runtimes[1].Sub(runtimes[0]))
+		assert.Equal(t, time.Minute, runtimes[2].Sub(runtimes[1]))
+	}
+}
+
+func TestCronWorkflowStatus_AddBackfilled(t *testing.T) {
+	ten := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	eleven := ten.Add(time.Hour)
+	status := &CronWorkflowStatus{}
+	assert.False(t, status.HasBackfilled(ten))
+	status.AddBackfilled(ten, time.Time{})
+	status.AddBackfilled(eleven, ten)
+	if assert.Len(t, status.Backfilled, 1, "consecutive scheduled times share a window") {
+		assert.True(t, eleven.Equal(status.Backfilled[0].To.Time))
+	}
+	assert.True(t, status.HasBackfilled(ten))
+	assert.True(t, status.HasBackfilled(eleven))
+	assert.False(t, status.HasBackfilled(eleven.Add(time.Hour)))
+	status.AddBackfilled(ten.Add(5*time.Hour), time.Time{})
+	assert.Len(t, status.Backfilled, 2)
+}
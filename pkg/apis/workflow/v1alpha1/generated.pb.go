@@ -148,10 +148,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactCache) Reset()      { *m = ArtifactCache{} }
+func (*ArtifactCache) ProtoMessage() {}
+func (*ArtifactCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{4}
+}
+func (m *ArtifactCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactCache.Merge(m, src)
+}
+func (m *ArtifactCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactCache proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{4}
+	return fileDescriptor_c23edafa7e7ea072, []int{5}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +207,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{5}
+	return fileDescriptor_c23edafa7e7ea072, []int{6}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +235,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{6}
+	return fileDescriptor_c23edafa7e7ea072, []int{7}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +263,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{7}
+	return fileDescriptor_c23edafa7e7ea072, []int{8}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,10 +288,94 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{9}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{10}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
+func (m *BackfillWindow) Reset()      { *m = BackfillWindow{} }
+func (*BackfillWindow) ProtoMessage() {}
+func (*BackfillWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{11}
+}
+func (m *BackfillWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BackfillWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillWindow.Merge(m, src)
+}
+func (m *BackfillWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillWindow proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{8}
+	return fileDescriptor_c23edafa7e7ea072, []int{12}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +403,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{9}
+	return fileDescriptor_c23edafa7e7ea072, []int{13}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,10 +428,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChildWorkflowTemplate) Reset()      { *m = ChildWorkflowTemplate{} }
+func (*ChildWorkflowTemplate) ProtoMessage() {}
+func (*ChildWorkflowTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{14}
+}
+func (m *ChildWorkflowTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflowTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflowTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflowTemplate.Merge(m, src)
+}
+func (m *ChildWorkflowTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflowTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflowTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflowTemplate proto.InternalMessageInfo
+
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{10}
+	return fileDescriptor_c23edafa7e7ea072, []int{15}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +487,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{11}
+	return fileDescriptor_c23edafa7e7ea072, []int{16}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +515,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{12}
+	return fileDescriptor_c23edafa7e7ea072, []int{17}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,10 +540,66 @@ func (m *Condition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Condition proto.InternalMessageInfo
 
+func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
+func (*ContainerNode) ProtoMessage() {}
+func (*ContainerNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{18}
+}
+func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerNode.Merge(m, src)
+}
+func (m *ContainerNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
+
+func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
+func (*ContainerSetTemplate) ProtoMessage() {}
+func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{19}
+}
+func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerSetTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerSetTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetTemplate.Merge(m, src)
+}
+func (m *ContainerSetTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerSetTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{13}
+	return fileDescriptor_c23edafa7e7ea072, []int{20}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +627,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{14}
+	return fileDescriptor_c23edafa7e7ea072, []int{21}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +655,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{15}
+	return fileDescriptor_c23edafa7e7ea072, []int{22}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +683,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{16}
+	return fileDescriptor_c23edafa7e7ea072, []int{23}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +711,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{17}
+	return fileDescriptor_c23edafa7e7ea072, []int{24}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +739,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{18}
+	return fileDescriptor_c23edafa7e7ea072, []int{25}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +767,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{19}
+	return fileDescriptor_c23edafa7e7ea072, []int{26}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +795,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{20}
+	return fileDescriptor_c23edafa7e7ea072, []int{27}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +823,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{21}
+	return fileDescriptor_c23edafa7e7ea072, []int{28}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +851,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{22}
+	return fileDescriptor_c23edafa7e7ea072, []int{29}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +879,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{23}
+	return fileDescriptor_c23edafa7e7ea072, []int{30}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +907,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{24}
+	return fileDescriptor_c23edafa7e7ea072, []int{31}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +935,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{25}
+	return fileDescriptor_c23edafa7e7ea072, []int{32}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +963,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{26}
+	return fileDescriptor_c23edafa7e7ea072, []int{33}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +991,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{27}
+	return fileDescriptor_c23edafa7e7ea072, []int{34}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1019,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{28}
+	return fileDescriptor_c23edafa7e7ea072, []int{35}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1047,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{29}
+	return fileDescriptor_c23edafa7e7ea072, []int{36}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,10 +1072,38 @@ func (m *HDFSKrbConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 
+func (m *HTTP) Reset()      { *m = HTTP{} }
+func (*HTTP) ProtoMessage() {}
+func (*HTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{37}
+}
+func (m *HTTP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTP.Merge(m, src)
+}
+func (m *HTTP) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTP proto.InternalMessageInfo
+
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{30}
+	return fileDescriptor_c23edafa7e7ea072, []int{38}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,10 +1128,66 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
+func (*HTTPHeader) ProtoMessage() {}
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{39}
+}
+func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeader.Merge(m, src)
+}
+func (m *HTTPHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
+
+func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
+func (*HTTPHeaderSource) ProtoMessage() {}
+func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{40}
+}
+func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderSource.Merge(m, src)
+}
+func (m *HTTPHeaderSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
+
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{31}
+	return fileDescriptor_c23edafa7e7ea072, []int{41}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1215,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{32}
+	return fileDescriptor_c23edafa7e7ea072, []int{42}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1243,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{33}
+	return fileDescriptor_c23edafa7e7ea072, []int{43}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,10 +1268,38 @@ func (m *Item) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Item proto.InternalMessageInfo
 
+func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
+func (*LifecycleHook) ProtoMessage() {}
+func (*LifecycleHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{44}
+}
+func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LifecycleHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleHook.Merge(m, src)
+}
+func (m *LifecycleHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
+
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{34}
+	return fileDescriptor_c23edafa7e7ea072, []int{45}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1327,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{35}
+	return fileDescriptor_c23edafa7e7ea072, []int{46}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1355,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{36}
+	return fileDescriptor_c23edafa7e7ea072, []int{47}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1383,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{37}
+	return fileDescriptor_c23edafa7e7ea072, []int{48}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1411,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{38}
+	return fileDescriptor_c23edafa7e7ea072, []int{49}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1439,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{39}
+	return fileDescriptor_c23edafa7e7ea072, []int{50}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1467,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{40}
+	return fileDescriptor_c23edafa7e7ea072, []int{51}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1495,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{41}
+	return fileDescriptor_c23edafa7e7ea072, []int{52}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1523,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{42}
+	return fileDescriptor_c23edafa7e7ea072, []int{53}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1551,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{43}
+	return fileDescriptor_c23edafa7e7ea072, []int{54}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1579,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{44}
+	return fileDescriptor_c23edafa7e7ea072, []int{55}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1607,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{45}
+	return fileDescriptor_c23edafa7e7ea072, []int{56}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1635,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{46}
+	return fileDescriptor_c23edafa7e7ea072, []int{57}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1663,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{47}
+	return fileDescriptor_c23edafa7e7ea072, []int{58}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1691,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{48}
+	return fileDescriptor_c23edafa7e7ea072, []int{59}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1719,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{49}
+	return fileDescriptor_c23edafa7e7ea072, []int{60}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1747,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{50}
+	return fileDescriptor_c23edafa7e7ea072, []int{61}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1775,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{51}
+	return fileDescriptor_c23edafa7e7ea072, []int{62}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1803,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{52}
+	return fileDescriptor_c23edafa7e7ea072, []int{63}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1831,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{53}
+	return fileDescriptor_c23edafa7e7ea072, []int{64}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,10 +1856,66 @@ func (m *ResourceTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 
+func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
+func (*RetryAffinity) ProtoMessage() {}
+func (*RetryAffinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{65}
+}
+func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryAffinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryAffinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryAffinity.Merge(m, src)
+}
+func (m *RetryAffinity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryAffinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryAffinity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
+
+func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
+func (*RetryNodeAntiAffinity) ProtoMessage() {}
+func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{66}
+}
+func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryNodeAntiAffinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryNodeAntiAffinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryNodeAntiAffinity.Merge(m, src)
+}
+func (m *RetryNodeAntiAffinity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryNodeAntiAffinity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{54}
+	return fileDescriptor_c23edafa7e7ea072, []int{67}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1943,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{55}
+	return fileDescriptor_c23edafa7e7ea072, []int{68}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1971,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{56}
+	return fileDescriptor_c23edafa7e7ea072, []int{69}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1632,10 +1996,38 @@ func (m *S3Bucket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 
+func (m *SQLCache) Reset()      { *m = SQLCache{} }
+func (*SQLCache) ProtoMessage() {}
+func (*SQLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{70}
+}
+func (m *SQLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLCache.Merge(m, src)
+}
+func (m *SQLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLCache proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{57}
+	return fileDescriptor_c23edafa7e7ea072, []int{71}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2055,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{58}
+	return fileDescriptor_c23edafa7e7ea072, []int{72}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2083,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{59}
+	return fileDescriptor_c23edafa7e7ea072, []int{73}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2111,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{60}
+	return fileDescriptor_c23edafa7e7ea072, []int{74}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2139,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{61}
+	return fileDescriptor_c23edafa7e7ea072, []int{75}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2167,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{62}
+	return fileDescriptor_c23edafa7e7ea072, []int{76}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2195,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{63}
+	return fileDescriptor_c23edafa7e7ea072, []int{77}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2223,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{64}
+	return fileDescriptor_c23edafa7e7ea072, []int{78}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2251,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{65}
+	return fileDescriptor_c23edafa7e7ea072, []int{79}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,10 +2276,38 @@ func (m *SuspendTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 
+func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
+func (*SyncDatabaseRef) ProtoMessage() {}
+func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{80}
+}
+func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncDatabaseRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncDatabaseRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDatabaseRef.Merge(m, src)
+}
+func (m *SyncDatabaseRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncDatabaseRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDatabaseRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
+
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{66}
+	return fileDescriptor_c23edafa7e7ea072, []int{81}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2335,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{67}
+	return fileDescriptor_c23edafa7e7ea072, []int{82}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2363,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{68}
+	return fileDescriptor_c23edafa7e7ea072, []int{83}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2391,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{69}
+	return fileDescriptor_c23edafa7e7ea072, []int{84}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2419,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{70}
+	return fileDescriptor_c23edafa7e7ea072, []int{85}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2447,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{71}
+	return fileDescriptor_c23edafa7e7ea072, []int{86}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2475,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{72}
+	return fileDescriptor_c23edafa7e7ea072, []int{87}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2503,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{73}
+	return fileDescriptor_c23edafa7e7ea072, []int{88}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2531,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{74}
+	return fileDescriptor_c23edafa7e7ea072, []int{89}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2559,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{75}
+	return fileDescriptor_c23edafa7e7ea072, []int{90}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2164,10 +2584,66 @@ func (m *Workflow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Workflow proto.InternalMessageInfo
 
+func (m *WorkflowDependencies) Reset()      { *m = WorkflowDependencies{} }
+func (*WorkflowDependencies) ProtoMessage() {}
+func (*WorkflowDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{91}
+}
+func (m *WorkflowDependencies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDependencies.Merge(m, src)
+}
+func (m *WorkflowDependencies) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDependencies proto.InternalMessageInfo
+
+func (m *WorkflowDependency) Reset()      { *m = WorkflowDependency{} }
+func (*WorkflowDependency) ProtoMessage() {}
+func (*WorkflowDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{92}
+}
+func (m *WorkflowDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkflowDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDependency.Merge(m, src)
+}
+func (m *WorkflowDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDependency proto.InternalMessageInfo
+
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{76}
+	return fileDescriptor_c23edafa7e7ea072, []int{93}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2671,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{77}
+	return fileDescriptor_c23edafa7e7ea072, []int{94}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2699,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{78}
+	return fileDescriptor_c23edafa7e7ea072, []int{95}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2727,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{79}
+	return fileDescriptor_c23edafa7e7ea072, []int{96}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2755,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{80}
+	return fileDescriptor_c23edafa7e7ea072, []int{97}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2783,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{81}
+	return fileDescriptor_c23edafa7e7ea072, []int{98}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2811,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{82}
+	return fileDescriptor_c23edafa7e7ea072, []int{99}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2839,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{83}
+	return fileDescriptor_c23edafa7e7ea072, []int{100}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2867,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{84}
+	return fileDescriptor_c23edafa7e7ea072, []int{101}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2895,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{85}
+	return fileDescriptor_c23edafa7e7ea072, []int{102}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2923,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{86}
+	return fileDescriptor_c23edafa7e7ea072, []int{103}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2477,15 +2953,22 @@ func init() {
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactCache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactCache")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
+	proto.RegisterType((*BackfillWindow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.BackfillWindow")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChildWorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ChildWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Condition")
+	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflow")
@@ -2493,6 +2976,7 @@ func init() {
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTask")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ExecutorConfig")
@@ -2503,10 +2987,14 @@ func init() {
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSArtifact")
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
+	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeader")
+	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Item")
+	proto.RegisterType((*LifecycleHook)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.LifecycleHook")
 	proto.RegisterType((*Link)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Link")
 	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.MemoizationStatus")
 	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Memoize")
@@ -2530,9 +3018,12 @@ func init() {
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ResourceTemplate")
+	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryAffinity")
+	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Bucket")
+	proto.RegisterType((*SQLCache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SQLCache")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreRef")
@@ -2542,6 +3033,7 @@ func init() {
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SuspendTemplate")
+	proto.RegisterType((*SyncDatabaseRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SyncDatabaseRef")
 	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Synchronization")
 	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.TTLStrategy")
@@ -2553,17 +3045,21 @@ func init() {
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ValueFrom")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Version")
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Workflow")
+	proto.RegisterType((*WorkflowDependencies)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowDependencies")
+	proto.RegisterType((*WorkflowDependency)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowDependency")
 	proto.RegisterType((*WorkflowEventBinding)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBinding")
 	proto.RegisterType((*WorkflowEventBindingList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBindingList")
 	proto.RegisterType((*WorkflowEventBindingSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBindingSpec")
 	proto.RegisterType((*WorkflowList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowList")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
 	proto.RegisterType((*WorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus")
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStep")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStep.HooksEntry")
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
@@ -2575,450 +3071,512 @@ func init() {
 }
 
 var fileDescriptor_c23edafa7e7ea072 = []byte{
-	// 7078 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x1c, 0xd7,
-	0x75, 0xb0, 0x87, 0xe4, 0x2e, 0x77, 0xcf, 0x92, 0x22, 0x79, 0xa9, 0x9f, 0x35, 0x2d, 0x8b, 0xca,
-	0x38, 0x36, 0xe4, 0xef, 0x4b, 0xc8, 0x58, 0x8e, 0xbf, 0xcf, 0x89, 0xe3, 0x1f, 0x2e, 0x29, 0x52,
-	0xb4, 0x44, 0x91, 0x3e, 0x4b, 0x49, 0x4d, 0x64, 0xd8, 0x1d, 0xee, 0xde, 0xdd, 0x1d, 0x71, 0x77,
-	0x66, 0x3d, 0x33, 0x2b, 0x9a, 0x71, 0x8b, 0x3a, 0x41, 0x83, 0x34, 0x0d, 0x82, 0xb6, 0x08, 0x90,
-	0xba, 0x08, 0x0a, 0xf4, 0xa5, 0x28, 0x50, 0xf4, 0xb5, 0x7d, 0x29, 0x90, 0x87, 0xa2, 0x2d, 0xd2,
-	0xbc, 0x34, 0x7d, 0x4a, 0x1e, 0x02, 0x26, 0x66, 0x5f, 0x1c, 0xb4, 0x69, 0x9e, 0x82, 0x02, 0x42,
-	0x81, 0x16, 0xf7, 0x77, 0x7e, 0x76, 0x56, 0xa2, 0x66, 0x29, 0x21, 0x68, 0xf2, 0xb6, 0x73, 0xce,
-	0xb9, 0xe7, 0xdc, 0xff, 0x7b, 0xfe, 0xee, 0x5d, 0x58, 0x6e, 0xda, 0x41, 0xab, 0xb7, 0xb3, 0x50,
-	0x73, 0x3b, 0x8b, 0x96, 0xd7, 0x74, 0xbb, 0x9e, 0x7b, 0x9b, 0xff, 0x58, 0xec, 0xee, 0x36, 0x17,
-	0xad, 0xae, 0xed, 0x2f, 0xee, 0xb9, 0xde, 0x6e, 0xa3, 0xed, 0xee, 0x2d, 0xde, 0x79, 0xce, 0x6a,
-	0x77, 0x5b, 0xd6, 0x73, 0x8b, 0x4d, 0xea, 0x50, 0xcf, 0x0a, 0x68, 0x7d, 0xa1, 0xeb, 0xb9, 0x81,
-	0x4b, 0x9e, 0x0f, 0x99, 0x2c, 0x28, 0x26, 0xfc, 0xc7, 0x42, 0x77, 0xb7, 0xb9, 0xc0, 0x98, 0x2c,
-	0x28, 0x26, 0x0b, 0x8a, 0xc9, 0xdc, 0x27, 0x23, 0x92, 0x9b, 0x2e, 0x13, 0xc8, 0x78, 0xed, 0xf4,
-	0x1a, 0xfc, 0x8b, 0x7f, 0xf0, 0x5f, 0x42, 0xc6, 0x9c, 0xb9, 0xfb, 0xa2, 0xbf, 0x60, 0xbb, 0xac,
-	0x4a, 0x8b, 0x35, 0xd7, 0xa3, 0x8b, 0x77, 0xfa, 0xea, 0x31, 0xf7, 0x6c, 0x84, 0xa6, 0xeb, 0xb6,
-	0xed, 0xda, 0xfe, 0xe2, 0x9d, 0xe7, 0x76, 0x68, 0xd0, 0x5f, 0xe5, 0xb9, 0x4f, 0x87, 0xa4, 0x1d,
-	0xab, 0xd6, 0xb2, 0x1d, 0xea, 0xed, 0x87, 0x4d, 0xee, 0xd0, 0xc0, 0x4a, 0x13, 0xb0, 0x38, 0xa8,
-	0x94, 0xd7, 0x73, 0x02, 0xbb, 0x43, 0xfb, 0x0a, 0xfc, 0xbf, 0xfb, 0x15, 0xf0, 0x6b, 0x2d, 0xda,
-	0xb1, 0xfa, 0xca, 0x3d, 0x3f, 0xa8, 0x5c, 0x2f, 0xb0, 0xdb, 0x8b, 0xb6, 0x13, 0xf8, 0x81, 0x97,
-	0x2c, 0x64, 0x5e, 0x82, 0xfc, 0x52, 0xc7, 0xed, 0x39, 0x01, 0x79, 0x09, 0x72, 0x77, 0xac, 0x76,
-	0x8f, 0x96, 0x8d, 0xf3, 0xc6, 0x85, 0x62, 0xe5, 0xe9, 0xef, 0x1e, 0xcc, 0x3f, 0x76, 0x78, 0x30,
-	0x9f, 0xbb, 0xc1, 0x80, 0x77, 0x0f, 0xe6, 0x4f, 0x52, 0xa7, 0xe6, 0xd6, 0x6d, 0xa7, 0xb9, 0x78,
-	0xdb, 0x77, 0x9d, 0x85, 0x6b, 0xbd, 0xce, 0x0e, 0xf5, 0x50, 0x94, 0x31, 0xff, 0xd9, 0x80, 0xa9,
-	0x25, 0xaf, 0xd6, 0xb2, 0xef, 0xd0, 0x6a, 0xc0, 0xf8, 0x37, 0xf7, 0xc9, 0x2d, 0x18, 0x0d, 0x2c,
-	0x8f, 0xb3, 0x2b, 0x5d, 0x7c, 0x6d, 0x21, 0xc3, 0x78, 0x2f, 0x6c, 0x5b, 0x9e, 0x62, 0x57, 0x19,
-	0x3f, 0x3c, 0x98, 0x1f, 0xdd, 0xb6, 0x3c, 0x64, 0x5c, 0xc9, 0xdb, 0x30, 0xe6, 0xb8, 0x0e, 0x2d,
-	0x8f, 0x70, 0xee, 0x4b, 0x99, 0xb8, 0x5f, 0x73, 0x1d, 0x5d, 0xdb, 0x4a, 0xe1, 0xf0, 0x60, 0x7e,
-	0x8c, 0x41, 0x90, 0x33, 0x36, 0x7f, 0x6e, 0x40, 0x71, 0xc9, 0x6b, 0xf6, 0x3a, 0xd4, 0x09, 0x7c,
-	0xe2, 0x01, 0x74, 0x2d, 0xcf, 0xea, 0xd0, 0x80, 0x7a, 0x7e, 0xd9, 0x38, 0x3f, 0x7a, 0xa1, 0x74,
-	0xf1, 0x95, 0x4c, 0x42, 0xb7, 0x14, 0x9b, 0x0a, 0x91, 0x3d, 0x0c, 0x1a, 0xe4, 0x63, 0x44, 0x0a,
-	0x71, 0xa0, 0x68, 0x79, 0x81, 0xdd, 0xb0, 0x6a, 0x81, 0x5f, 0x1e, 0xe1, 0x22, 0x5f, 0xce, 0x24,
-	0x72, 0x49, 0x72, 0xa9, 0xcc, 0x48, 0x89, 0x45, 0x05, 0xf1, 0x31, 0x14, 0x61, 0x7e, 0x6b, 0x0c,
-	0x0a, 0x0a, 0x41, 0xce, 0xc3, 0x98, 0x63, 0x75, 0xd4, 0x64, 0x98, 0x90, 0x05, 0xc7, 0xae, 0x59,
-	0x1d, 0xd6, 0x41, 0x56, 0x87, 0x32, 0x8a, 0xae, 0x15, 0xb4, 0xf8, 0x08, 0x44, 0x28, 0xb6, 0xac,
-	0xa0, 0x85, 0x1c, 0x43, 0xce, 0xc2, 0x58, 0xc7, 0xad, 0xd3, 0xf2, 0xe8, 0x79, 0xe3, 0x42, 0x4e,
-	0x74, 0xf0, 0x86, 0x5b, 0xa7, 0xc8, 0xa1, 0xac, 0x7c, 0xc3, 0x73, 0x3b, 0xe5, 0xb1, 0x78, 0xf9,
-	0x55, 0xcf, 0xed, 0x20, 0xc7, 0x90, 0xaf, 0x1b, 0x30, 0xad, 0xaa, 0x77, 0xd5, 0xad, 0x59, 0x81,
-	0xed, 0x3a, 0xe5, 0x1c, 0x1f, 0xf0, 0x4b, 0x43, 0x75, 0x84, 0x62, 0x56, 0x29, 0x4b, 0xa9, 0xd3,
-	0x49, 0x0c, 0xf6, 0x09, 0x26, 0x17, 0x01, 0x9a, 0x6d, 0x77, 0xc7, 0x6a, 0xb3, 0x3e, 0x28, 0xe7,
-	0x79, 0xad, 0xf5, 0x10, 0xae, 0x69, 0x0c, 0x46, 0xa8, 0xc8, 0x2e, 0x8c, 0x5b, 0x62, 0x55, 0x94,
-	0xc7, 0x79, 0xbd, 0x57, 0x32, 0xd6, 0x3b, 0xb6, 0xb2, 0x2a, 0xa5, 0xc3, 0x83, 0xf9, 0x71, 0x09,
-	0x44, 0x25, 0x81, 0x7c, 0x02, 0x0a, 0x6e, 0x97, 0x55, 0xd5, 0x6a, 0x97, 0x0b, 0xe7, 0x8d, 0x0b,
-	0x85, 0xca, 0xb4, 0xac, 0x5e, 0x61, 0x53, 0xc2, 0x51, 0x53, 0x90, 0x67, 0x61, 0xdc, 0xef, 0xed,
-	0xb0, 0xd1, 0x2a, 0x17, 0x79, 0x5b, 0xa6, 0x24, 0xf1, 0x78, 0x55, 0x80, 0x51, 0xe1, 0xcd, 0x7f,
-	0xc9, 0x43, 0x5f, 0x07, 0x91, 0xe7, 0xa0, 0x24, 0x05, 0x5f, 0x75, 0x9b, 0x3e, 0x9f, 0x27, 0x85,
-	0xca, 0xd4, 0xe1, 0xc1, 0x7c, 0x69, 0x29, 0x04, 0x63, 0x94, 0x86, 0xdc, 0x84, 0x11, 0xff, 0x79,
-	0xb9, 0x62, 0x5f, 0xcd, 0xd4, 0x11, 0xd5, 0xe7, 0xf5, 0x5c, 0xce, 0x1f, 0x1e, 0xcc, 0x8f, 0x54,
-	0x9f, 0xc7, 0x11, 0xff, 0x79, 0xb6, 0xd3, 0x34, 0xed, 0x80, 0xcf, 0xb3, 0xac, 0x3b, 0xcd, 0x9a,
-	0x1d, 0x68, 0xd6, 0x7c, 0xa7, 0x59, 0xb3, 0x03, 0x64, 0x5c, 0xd9, 0x4e, 0xd3, 0x0a, 0x82, 0x2e,
-	0x9f, 0xa7, 0x59, 0x77, 0x9a, 0xcb, 0xdb, 0xdb, 0x5b, 0x9a, 0x3d, 0x5f, 0x08, 0x0c, 0x82, 0x9c,
-	0x31, 0x79, 0x8f, 0xf5, 0xa4, 0xc0, 0xb9, 0xde, 0xbe, 0x9c, 0xe0, 0x97, 0x87, 0x9a, 0xe0, 0xae,
-	0xb7, 0xaf, 0xc5, 0xc9, 0x31, 0xd1, 0x08, 0x8c, 0x4a, 0xe3, 0xad, 0xab, 0x37, 0x7c, 0x3e, 0x9f,
-	0x33, 0xb7, 0x6e, 0x65, 0xb5, 0x9a, 0x68, 0xdd, 0xca, 0x6a, 0x15, 0x39, 0x63, 0x36, 0x36, 0x9e,
-	0xb5, 0x27, 0xa7, 0x7f, 0xb6, 0xb1, 0x41, 0x6b, 0x2f, 0x3e, 0x36, 0x68, 0xed, 0x21, 0xe3, 0xca,
-	0x98, 0xbb, 0xbe, 0xcf, 0x67, 0x7b, 0x56, 0xe6, 0x9b, 0xd5, 0x6a, 0x9c, 0xf9, 0x66, 0xb5, 0x8a,
-	0x8c, 0x2b, 0x9f, 0x55, 0x35, 0x9f, 0xaf, 0x8e, 0xcc, 0xb3, 0x6a, 0x39, 0xc1, 0x7c, 0x6d, 0xb9,
-	0x8a, 0x8c, 0xab, 0xd9, 0x84, 0x53, 0x0a, 0x83, 0xb4, 0xeb, 0xfa, 0x36, 0x1f, 0x1a, 0xda, 0x20,
-	0x8b, 0x50, 0xac, 0xb9, 0x4e, 0xc3, 0x6e, 0x6e, 0x58, 0x5d, 0xb9, 0xfb, 0xea, 0x6d, 0x7b, 0x59,
-	0x21, 0x30, 0xa4, 0x21, 0x4f, 0xc2, 0xe8, 0x2e, 0xdd, 0x97, 0xdb, 0x70, 0x49, 0x92, 0x8e, 0x5e,
-	0xa1, 0xfb, 0xc8, 0xe0, 0xe6, 0x77, 0x0c, 0x98, 0x4d, 0x99, 0x16, 0xac, 0x58, 0xcf, 0x6b, 0x4b,
-	0x09, 0xba, 0xd8, 0x75, 0xbc, 0x8a, 0x0c, 0x4e, 0xbe, 0x6a, 0xc0, 0x54, 0x64, 0x9e, 0x2c, 0xf5,
-	0xe4, 0x4e, 0x9f, 0x7d, 0x0b, 0x8b, 0xf1, 0xaa, 0x9c, 0x91, 0x12, 0xa7, 0x12, 0x08, 0x4c, 0x4a,
-	0x35, 0x7f, 0xc0, 0x55, 0x8b, 0x18, 0x8c, 0x58, 0x70, 0xa2, 0xe7, 0x53, 0x8f, 0x9d, 0x43, 0x55,
-	0x5a, 0xf3, 0x68, 0x20, 0xb5, 0x8c, 0xa7, 0x17, 0x84, 0x0e, 0xc4, 0x6a, 0xb1, 0xc0, 0x34, 0xbe,
-	0x85, 0x3b, 0xcf, 0x2d, 0x08, 0x8a, 0x2b, 0x74, 0xbf, 0x4a, 0xdb, 0x94, 0xf1, 0xa8, 0x90, 0xc3,
-	0x83, 0xf9, 0x13, 0xd7, 0x63, 0x0c, 0x30, 0xc1, 0x90, 0x89, 0xe8, 0x5a, 0xbe, 0xbf, 0xe7, 0x7a,
-	0x75, 0x29, 0x62, 0xe4, 0x81, 0x45, 0x6c, 0xc5, 0x18, 0x60, 0x82, 0xa1, 0xf9, 0xf7, 0x06, 0x8c,
-	0x57, 0xac, 0xda, 0xae, 0xdb, 0x68, 0xb0, 0xcd, 0xbb, 0xde, 0xf3, 0xc4, 0x11, 0x27, 0xc6, 0x44,
-	0x6f, 0xde, 0x2b, 0x12, 0x8e, 0x9a, 0x82, 0x6c, 0x43, 0x5e, 0x74, 0x87, 0xac, 0xd4, 0xa7, 0x22,
-	0x95, 0xd2, 0xba, 0x1f, 0x1f, 0x0e, 0xa6, 0xfb, 0x2d, 0x08, 0xdd, 0x6f, 0x61, 0xdd, 0x09, 0x36,
-	0x99, 0x3e, 0x65, 0x3b, 0xcd, 0x0a, 0x1c, 0x1e, 0xcc, 0xe7, 0x57, 0x39, 0x0f, 0x94, 0xbc, 0xc8,
-	0x0b, 0x50, 0xea, 0x58, 0xef, 0x2a, 0x71, 0x7c, 0x3b, 0x2d, 0x56, 0x66, 0x65, 0x35, 0x4a, 0x1b,
-	0x21, 0x0a, 0xa3, 0x74, 0xe6, 0x5b, 0x90, 0x5b, 0xb6, 0x6a, 0x2d, 0x4a, 0xae, 0x27, 0xa7, 0x6e,
-	0xe9, 0xe2, 0x85, 0xb4, 0xde, 0xd2, 0xd3, 0x38, 0xda, 0x61, 0x93, 0x83, 0x26, 0xb8, 0xf9, 0x91,
-	0x01, 0x67, 0x96, 0xdb, 0x3d, 0x3f, 0xa0, 0xde, 0x4d, 0x39, 0xaf, 0xb6, 0x69, 0xa7, 0xdb, 0xb6,
-	0x02, 0x4a, 0x7e, 0x13, 0x0a, 0x4c, 0xef, 0xae, 0x5b, 0x81, 0x25, 0x25, 0x0e, 0xee, 0x0a, 0x3e,
-	0x33, 0x19, 0x35, 0xab, 0xc3, 0xe6, 0xce, 0x6d, 0x5a, 0x0b, 0x36, 0x68, 0x60, 0x85, 0x87, 0x78,
-	0x08, 0x43, 0xcd, 0x95, 0xec, 0xc2, 0x98, 0xdf, 0xa5, 0x35, 0xd9, 0xd1, 0xeb, 0x99, 0x26, 0x7f,
-	0xb2, 0xda, 0xd5, 0x2e, 0xad, 0x85, 0x1a, 0x0f, 0xfb, 0x42, 0x2e, 0xc4, 0xfc, 0x0f, 0x03, 0x9e,
-	0x18, 0xd0, 0xd4, 0xab, 0xb6, 0x1f, 0x90, 0x37, 0xfb, 0x9a, 0xbb, 0x70, 0xb4, 0xe6, 0xb2, 0xd2,
-	0xbc, 0xb1, 0x7a, 0x56, 0x29, 0x48, 0xa4, 0xa9, 0xef, 0x40, 0xce, 0x0e, 0x68, 0x47, 0x29, 0x9b,
-	0x57, 0x33, 0xb5, 0x75, 0x40, 0xf5, 0x2b, 0x93, 0xca, 0x9e, 0x58, 0x67, 0x22, 0x50, 0x48, 0x32,
-	0xff, 0xc9, 0x00, 0x36, 0xe8, 0x75, 0x5b, 0xea, 0x14, 0x63, 0xc1, 0x7e, 0x57, 0x29, 0x9d, 0x4f,
-	0xaa, 0x0e, 0xda, 0xde, 0xef, 0x32, 0x03, 0x64, 0x52, 0x13, 0x32, 0x00, 0x72, 0x52, 0xf2, 0x16,
-	0xe4, 0xfd, 0xc0, 0x0a, 0x7a, 0xbe, 0xdc, 0x00, 0x57, 0x65, 0xa1, 0x7c, 0x95, 0x43, 0xef, 0x1e,
-	0xcc, 0x1f, 0xc9, 0x6a, 0x5b, 0xd0, 0xbc, 0x45, 0x39, 0x94, 0x5c, 0x99, 0x9a, 0xd4, 0xa1, 0xbe,
-	0x6f, 0x35, 0xa9, 0x5c, 0x0f, 0x5a, 0x4d, 0xda, 0x10, 0x60, 0x54, 0x78, 0xf3, 0xf3, 0x00, 0xcb,
-	0xae, 0x13, 0xd8, 0x4e, 0x8f, 0x6e, 0x3a, 0xe4, 0x29, 0xc8, 0x51, 0xcf, 0x73, 0x3d, 0xa9, 0x19,
-	0xe9, 0xe6, 0x5f, 0x62, 0x40, 0x14, 0x38, 0xf2, 0x0c, 0x5b, 0xc7, 0x76, 0x9b, 0xd6, 0x79, 0xed,
-	0x0b, 0x95, 0x13, 0xaa, 0xf6, 0xab, 0x1c, 0x8a, 0x12, 0x6b, 0x2e, 0xc0, 0xf8, 0x32, 0x33, 0xd2,
-	0xa8, 0xc7, 0xf8, 0x46, 0xcd, 0xb4, 0xc9, 0x98, 0x99, 0xa6, 0xcc, 0xb1, 0xef, 0x8d, 0xc0, 0xc4,
-	0xb2, 0xe7, 0x3a, 0x6a, 0x14, 0x1e, 0xc1, 0x3a, 0x69, 0xc6, 0xd6, 0x49, 0x36, 0xfd, 0x3c, 0x5a,
-	0xe5, 0x41, 0x6b, 0x84, 0xb8, 0x7a, 0xc4, 0x85, 0xbe, 0xb7, 0x36, 0xbc, 0x28, 0xce, 0x2e, 0xec,
-	0xfc, 0xf8, 0x14, 0x30, 0x7f, 0x68, 0xc0, 0x74, 0x94, 0xfc, 0x11, 0xac, 0xc4, 0x46, 0x7c, 0x25,
-	0x2e, 0x0d, 0xdd, 0xc4, 0x01, 0xcb, 0xef, 0xbf, 0x72, 0xf1, 0xa6, 0xb1, 0x6e, 0x66, 0x66, 0xd7,
-	0xc4, 0x5e, 0x04, 0x20, 0xdb, 0xb7, 0x34, 0xd4, 0xd6, 0xc7, 0x87, 0xf3, 0xe3, 0xb2, 0x12, 0x13,
-	0x51, 0xe8, 0xdd, 0xc4, 0x37, 0xc6, 0x84, 0xb3, 0x83, 0xd1, 0xaf, 0xb5, 0x68, 0xbd, 0xd7, 0xa6,
-	0x72, 0x89, 0xeb, 0x8e, 0xab, 0x4a, 0x38, 0x6a, 0x0a, 0xf2, 0x26, 0xcc, 0xd4, 0x5c, 0xa7, 0xd6,
-	0xf3, 0x3c, 0xea, 0xd4, 0xf6, 0xb7, 0xb8, 0x3f, 0x47, 0x2e, 0xdc, 0x05, 0x59, 0x6c, 0x66, 0x39,
-	0x49, 0x70, 0x37, 0x0d, 0x88, 0xfd, 0x8c, 0x84, 0xcd, 0xe4, 0x77, 0xa9, 0x53, 0xe7, 0xd6, 0x40,
-	0x21, 0x6a, 0x33, 0x71, 0x30, 0x2a, 0x3c, 0xb9, 0x0e, 0x67, 0xfc, 0x80, 0xa9, 0x32, 0x4e, 0x73,
-	0x85, 0x5a, 0xf5, 0xb6, 0xed, 0x30, 0xc5, 0xc2, 0x75, 0xea, 0x3e, 0x57, 0xf0, 0x47, 0x2b, 0x4f,
-	0x1c, 0x1e, 0xcc, 0x9f, 0xa9, 0xa6, 0x93, 0xe0, 0xa0, 0xb2, 0xe4, 0x2d, 0x98, 0xf3, 0x7b, 0xb5,
-	0x1a, 0xf5, 0xfd, 0x46, 0xaf, 0xfd, 0xba, 0xbb, 0xe3, 0x5f, 0xb6, 0x7d, 0xa6, 0x15, 0x5d, 0xb5,
-	0x3b, 0x76, 0xc0, 0x95, 0xf8, 0x5c, 0xe5, 0xdc, 0xe1, 0xc1, 0xfc, 0x5c, 0x75, 0x20, 0x15, 0xde,
-	0x83, 0x03, 0x41, 0x38, 0x2d, 0xb6, 0x9c, 0x3e, 0xde, 0xe3, 0x9c, 0xf7, 0xdc, 0xe1, 0xc1, 0xfc,
-	0xe9, 0xd5, 0x54, 0x0a, 0x1c, 0x50, 0x92, 0x8d, 0x60, 0x60, 0x77, 0xe8, 0x17, 0x5d, 0x87, 0x72,
-	0x4d, 0x3d, 0x32, 0x82, 0xdb, 0x12, 0x8e, 0x9a, 0x82, 0xdc, 0x0e, 0x27, 0x1f, 0x5b, 0x14, 0x52,
-	0xfd, 0x7e, 0xf0, 0xdd, 0xea, 0x24, 0x33, 0xeb, 0x6f, 0x46, 0x38, 0xb1, 0x85, 0x85, 0x31, 0xde,
-	0xe6, 0x3f, 0x8e, 0x00, 0xe9, 0xdf, 0x08, 0xc8, 0x15, 0xc8, 0x5b, 0xb5, 0x80, 0x19, 0xed, 0xc2,
-	0xd1, 0xf3, 0x54, 0x9a, 0x12, 0x23, 0x44, 0x21, 0x6d, 0x50, 0x36, 0x43, 0x68, 0xb8, 0x7b, 0x2c,
-	0xf1, 0xa2, 0x28, 0x59, 0x10, 0x17, 0x66, 0xda, 0x96, 0x1f, 0xa8, 0xb9, 0x5a, 0x67, 0x4d, 0x96,
-	0x9b, 0xe4, 0xff, 0x39, 0x5a, 0xa3, 0x58, 0x89, 0xca, 0x29, 0x36, 0x73, 0xaf, 0x26, 0x19, 0x61,
-	0x3f, 0x6f, 0xe2, 0x01, 0xd4, 0xd4, 0x61, 0xc6, 0xf6, 0xc8, 0xec, 0xae, 0x2a, 0x7d, 0x26, 0x86,
-	0x5b, 0xbf, 0x06, 0xf9, 0x18, 0x91, 0x62, 0xfe, 0x2c, 0x0f, 0xe3, 0x2b, 0x4b, 0x6b, 0xdb, 0x96,
-	0xbf, 0x7b, 0x04, 0xcf, 0x11, 0x9b, 0x10, 0x52, 0x2d, 0x48, 0x2e, 0x69, 0xa5, 0x2e, 0xa0, 0xa6,
-	0x20, 0x2e, 0x14, 0x2d, 0xe5, 0x87, 0x93, 0x5b, 0xfe, 0x2b, 0x19, 0x4d, 0x10, 0xc9, 0x25, 0xea,
-	0x07, 0x93, 0x20, 0x0c, 0x65, 0x10, 0x1f, 0x4a, 0x4a, 0x38, 0xd2, 0x86, 0xb4, 0xfb, 0x33, 0xfa,
-	0x2f, 0x43, 0x3e, 0xc2, 0x0e, 0x8f, 0x00, 0x30, 0x2a, 0x85, 0x7c, 0x1a, 0x26, 0xea, 0x94, 0xed,
-	0x1c, 0xd4, 0xa9, 0xd9, 0x94, 0x6d, 0x12, 0xa3, 0xac, 0x5f, 0xd8, 0x66, 0xb9, 0x12, 0x81, 0x63,
-	0x8c, 0x8a, 0xdc, 0x86, 0xe2, 0x9e, 0x1d, 0xb4, 0xf8, 0x9e, 0x5e, 0xce, 0xf3, 0xa1, 0xfe, 0x4c,
-	0xa6, 0x8a, 0x32, 0x0e, 0x61, 0xb7, 0xdc, 0x54, 0x3c, 0x31, 0x64, 0xcf, 0x0c, 0x53, 0xf6, 0xc1,
-	0x9d, 0x95, 0x7c, 0x37, 0x28, 0xc6, 0x0b, 0x70, 0x04, 0x86, 0x34, 0xc4, 0x87, 0x09, 0xf6, 0x51,
-	0xa5, 0xef, 0xf4, 0xd8, 0x0a, 0x91, 0x56, 0x7a, 0x36, 0x17, 0xa6, 0x62, 0x22, 0x7a, 0xe4, 0x66,
-	0x84, 0x2d, 0xc6, 0x84, 0xb0, 0xd9, 0xb7, 0xd7, 0xa2, 0x8e, 0xf4, 0x69, 0xe9, 0xd9, 0x77, 0xb3,
-	0x45, 0x1d, 0xe4, 0x18, 0xe2, 0xf2, 0xf5, 0x21, 0xd5, 0xb4, 0x32, 0x0c, 0xe1, 0x8d, 0x0a, 0xb5,
-	0xbd, 0xca, 0x09, 0xb9, 0x38, 0xe4, 0x37, 0x46, 0x44, 0x30, 0x25, 0xcf, 0x75, 0x2e, 0xbd, 0x6b,
-	0x07, 0xe5, 0x12, 0xaf, 0x94, 0xde, 0x29, 0x36, 0x39, 0x14, 0x25, 0x96, 0x9d, 0x2e, 0x62, 0x70,
-	0xfd, 0xf2, 0x44, 0x5c, 0xd5, 0x14, 0x33, 0xc0, 0x47, 0x85, 0x37, 0xff, 0xce, 0x80, 0x12, 0x5b,
-	0x6f, 0x6a, 0x8d, 0x3c, 0x03, 0xf9, 0xc0, 0xf2, 0x9a, 0xd2, 0x0e, 0x8e, 0x88, 0xd8, 0xe6, 0x50,
-	0x94, 0x58, 0x62, 0x41, 0x2e, 0xb0, 0xfc, 0x5d, 0xa5, 0x57, 0x7c, 0x2e, 0x53, 0xb3, 0xe5, 0x42,
-	0x0f, 0x55, 0x0a, 0xf6, 0xe5, 0xa3, 0xe0, 0x4c, 0x2e, 0x40, 0x81, 0x9d, 0x03, 0xab, 0x96, 0x2f,
-	0x1c, 0x72, 0x85, 0xca, 0x04, 0x5b, 0xd8, 0xab, 0x12, 0x86, 0x1a, 0x6b, 0xbe, 0x00, 0xb9, 0x4b,
-	0x77, 0xa8, 0xc3, 0x0f, 0x08, 0x5f, 0x9a, 0x81, 0x49, 0xdb, 0x57, 0x99, 0x87, 0xa8, 0x29, 0xcc,
-	0x37, 0xe1, 0xc4, 0xa5, 0x77, 0x69, 0xad, 0x17, 0xb8, 0x9e, 0x30, 0x17, 0xc9, 0xeb, 0x40, 0x7c,
-	0xea, 0xdd, 0xb1, 0x6b, 0x74, 0xa9, 0x56, 0x63, 0x6a, 0xf2, 0xb5, 0x70, 0xff, 0x99, 0x93, 0x9c,
-	0x48, 0xb5, 0x8f, 0x02, 0x53, 0x4a, 0x99, 0x7f, 0x6a, 0x40, 0x29, 0xe2, 0xb5, 0x61, 0xbb, 0x4f,
-	0x73, 0xb9, 0x5a, 0xe9, 0xd5, 0x76, 0xb5, 0x93, 0xe1, 0x95, 0xac, 0xae, 0x20, 0xc1, 0x25, 0x5c,
-	0x35, 0x1a, 0x84, 0xa1, 0x8c, 0xfb, 0xb9, 0x73, 0xfe, 0xc6, 0x80, 0xb0, 0x1c, 0x1b, 0xf7, 0x9d,
-	0xb0, 0x6a, 0x91, 0x71, 0x97, 0x7c, 0x25, 0x96, 0xbc, 0x6f, 0xc0, 0x99, 0x78, 0x63, 0xb9, 0xe9,
-	0xfd, 0xe0, 0x6e, 0x8d, 0x79, 0x29, 0xe0, 0x4c, 0x35, 0x9d, 0x1b, 0x0e, 0x12, 0x63, 0xde, 0x80,
-	0xdc, 0x9a, 0xd5, 0x6b, 0xd2, 0x23, 0x19, 0x30, 0x6c, 0x16, 0x79, 0xd4, 0x6a, 0x07, 0xea, 0xb0,
-	0x94, 0xb3, 0x08, 0x25, 0x0c, 0x35, 0xd6, 0xfc, 0xab, 0x31, 0x28, 0x45, 0x9c, 0xb7, 0x6c, 0x03,
-	0xf0, 0x68, 0xd7, 0x4d, 0x1e, 0x3f, 0x48, 0xbb, 0x2e, 0x72, 0x0c, 0x9b, 0x6e, 0x1e, 0xbd, 0x63,
-	0xfb, 0xb6, 0xeb, 0x24, 0x8f, 0x1f, 0x94, 0x70, 0xd4, 0x14, 0x64, 0x1e, 0x72, 0x75, 0xda, 0x0d,
-	0x5a, 0x7c, 0x32, 0x8f, 0x55, 0x8a, 0xac, 0xaa, 0x2b, 0x0c, 0x80, 0x02, 0xce, 0x08, 0x1a, 0x34,
-	0xa8, 0xb5, 0xca, 0x63, 0x7c, 0xcb, 0xe6, 0x04, 0xab, 0x0c, 0x80, 0x02, 0x9e, 0xe2, 0xac, 0xca,
-	0x3d, 0x7c, 0x67, 0x55, 0xfe, 0x98, 0x9d, 0x55, 0xa4, 0x0b, 0xb3, 0xbe, 0xdf, 0xda, 0xf2, 0xec,
-	0x3b, 0x56, 0x40, 0xc3, 0xd9, 0x33, 0xfe, 0x20, 0x72, 0xce, 0x1c, 0x1e, 0xcc, 0xcf, 0x56, 0xab,
-	0x97, 0x93, 0x5c, 0x30, 0x8d, 0x35, 0xa9, 0xc2, 0x29, 0xdb, 0xf1, 0x69, 0xad, 0xe7, 0xd1, 0xf5,
-	0xa6, 0xe3, 0x7a, 0xf4, 0xb2, 0xeb, 0x33, 0x76, 0x32, 0xb8, 0xa1, 0xdc, 0x03, 0xa7, 0xd6, 0xd3,
-	0x88, 0x30, 0xbd, 0xac, 0xf9, 0x3d, 0x03, 0x26, 0xa2, 0xfe, 0x6a, 0xe2, 0x03, 0xb4, 0x56, 0x56,
-	0xab, 0x62, 0x2b, 0x91, 0x2b, 0xfc, 0xd5, 0xcc, 0x6e, 0x70, 0xc1, 0x26, 0xd4, 0x97, 0x42, 0x18,
-	0x46, 0xc4, 0x1c, 0x21, 0x76, 0xf6, 0x14, 0xe4, 0x1a, 0xae, 0x57, 0xa3, 0x72, 0x0f, 0xd5, 0xab,
-	0x64, 0x95, 0x01, 0x51, 0xe0, 0xcc, 0x8f, 0x0c, 0x88, 0x48, 0x20, 0xbf, 0x03, 0x93, 0x4c, 0xc6,
-	0x15, 0x6f, 0x27, 0xd6, 0x9a, 0x4a, 0xe6, 0xd6, 0x68, 0x4e, 0x95, 0x53, 0x52, 0xfe, 0x64, 0x0c,
-	0x8c, 0x71, 0x79, 0xe4, 0xff, 0x42, 0xd1, 0xaa, 0xd7, 0x3d, 0xea, 0xfb, 0x54, 0x1c, 0x31, 0x45,
-	0xe1, 0xd6, 0x5b, 0x52, 0x40, 0x0c, 0xf1, 0x6c, 0x19, 0xb6, 0xea, 0x0d, 0x9f, 0xcd, 0x6c, 0x69,
-	0xa1, 0xe9, 0x65, 0xc8, 0x84, 0x30, 0x38, 0x6a, 0x0a, 0xf3, 0x1b, 0x63, 0x10, 0x97, 0x4d, 0xea,
-	0x30, 0xb5, 0xeb, 0xed, 0x2c, 0x73, 0xd7, 0x63, 0x16, 0x27, 0xf0, 0xec, 0xe1, 0xc1, 0xfc, 0xd4,
-	0x95, 0x38, 0x07, 0x4c, 0xb2, 0x94, 0x52, 0xae, 0xd0, 0xfd, 0xc0, 0xda, 0xc9, 0xb2, 0x61, 0x2a,
-	0x29, 0x51, 0x0e, 0x98, 0x64, 0x49, 0x5e, 0x80, 0xd2, 0xae, 0xb7, 0xa3, 0x16, 0x79, 0xd2, 0xf3,
-	0x7a, 0x25, 0x44, 0x61, 0x94, 0x8e, 0x75, 0xe1, 0xae, 0xb7, 0xc3, 0x36, 0x45, 0x15, 0x46, 0xd5,
-	0x5d, 0x78, 0x45, 0xc2, 0x51, 0x53, 0x90, 0x2e, 0x90, 0x5d, 0xd5, 0x7b, 0xda, 0xd1, 0x2a, 0xf7,
-	0xa2, 0xa3, 0xfb, 0x69, 0x4f, 0xb3, 0xc3, 0xf4, 0x4a, 0x1f, 0x1f, 0x4c, 0xe1, 0x4d, 0x3e, 0x0f,
-	0x67, 0x76, 0xbd, 0x1d, 0x79, 0x54, 0x6c, 0x79, 0xb6, 0x53, 0xb3, 0xbb, 0xb1, 0xf8, 0xa9, 0x3e,
-	0x4e, 0xae, 0xa4, 0x93, 0xe1, 0xa0, 0xf2, 0xe6, 0x27, 0x61, 0x22, 0x1a, 0x54, 0xbb, 0x4f, 0x38,
-	0xc3, 0xfc, 0xc0, 0x80, 0x22, 0x37, 0x4a, 0x9b, 0x4c, 0x33, 0xd5, 0x47, 0xd0, 0xe8, 0x3d, 0x8e,
-	0xa0, 0x06, 0x8c, 0x8b, 0xd3, 0xd3, 0xe7, 0x3b, 0x7b, 0xe9, 0xe2, 0x4b, 0xd9, 0xac, 0x0e, 0x9e,
-	0x5d, 0x11, 0xea, 0x72, 0xe2, 0x64, 0xf6, 0x51, 0x31, 0x37, 0xff, 0xdd, 0x80, 0xfc, 0xba, 0xd3,
-	0xed, 0xfd, 0x8a, 0x64, 0x19, 0x6c, 0xc0, 0x18, 0xb3, 0x27, 0xe2, 0xe9, 0x26, 0x13, 0x95, 0xa7,
-	0xa3, 0xa9, 0x26, 0xe5, 0x78, 0xaa, 0x09, 0x5a, 0x7b, 0xca, 0xd9, 0x2a, 0xca, 0x7c, 0xb6, 0xf0,
-	0xc1, 0x9f, 0xcd, 0x3f, 0xf6, 0xfe, 0x8f, 0xce, 0x3f, 0x66, 0xb6, 0x61, 0xec, 0xaa, 0xed, 0x1c,
-	0xc5, 0xea, 0x7c, 0x0a, 0x72, 0x7e, 0xcd, 0xed, 0x2a, 0x93, 0x53, 0x0f, 0x7a, 0x95, 0x01, 0x51,
-	0xe0, 0xd4, 0x34, 0x1a, 0x1d, 0x30, 0x8d, 0xbe, 0x6c, 0xc0, 0xcc, 0x06, 0xed, 0xb8, 0xf6, 0x17,
-	0xad, 0xd0, 0x57, 0xcc, 0x0a, 0xb5, 0xec, 0x40, 0x3a, 0x7a, 0x75, 0xa1, 0xcb, 0x76, 0x80, 0x0c,
-	0x7e, 0x1f, 0x8d, 0x8e, 0x07, 0xfc, 0xd8, 0x86, 0x73, 0x2d, 0x5c, 0xf9, 0x61, 0xc0, 0x4f, 0x21,
-	0x30, 0xa4, 0x31, 0xbf, 0x62, 0xc0, 0xb8, 0xa8, 0x04, 0x55, 0xbc, 0x8d, 0x01, 0xbc, 0x6f, 0x41,
-	0x8e, 0x97, 0x93, 0x7b, 0xd6, 0x67, 0xb3, 0x99, 0x39, 0x8c, 0x83, 0xd0, 0x6b, 0xf8, 0x4f, 0x14,
-	0x3c, 0xcd, 0xf7, 0x47, 0xa1, 0xa0, 0x1c, 0x2b, 0xe4, 0x2b, 0x06, 0x94, 0x2c, 0xc7, 0x71, 0x03,
-	0x4b, 0xf8, 0x1d, 0xc4, 0xe4, 0xbd, 0x96, 0x49, 0xa0, 0x62, 0xba, 0xb0, 0x14, 0x32, 0xbc, 0xe4,
-	0x04, 0xde, 0x7e, 0xb8, 0x25, 0x46, 0x30, 0x18, 0x95, 0x4b, 0xde, 0x81, 0x7c, 0xdb, 0xda, 0xa1,
-	0x6d, 0x35, 0x97, 0xd7, 0x87, 0xab, 0xc1, 0x55, 0xce, 0x4b, 0x08, 0xd7, 0xca, 0xb5, 0x00, 0xa2,
-	0x14, 0x34, 0xf7, 0x0a, 0x4c, 0x27, 0x2b, 0x4a, 0xa6, 0x23, 0xe3, 0x22, 0x86, 0xe2, 0xa4, 0x9a,
-	0xef, 0x7c, 0x1e, 0xa8, 0x89, 0x3c, 0xf2, 0xa2, 0x31, 0xf7, 0x19, 0x28, 0x45, 0xc4, 0x3c, 0x48,
-	0x51, 0xf3, 0x0d, 0x28, 0x6d, 0xd0, 0xc0, 0xb3, 0x6b, 0x9c, 0xc1, 0xfd, 0x66, 0xc3, 0x53, 0x31,
-	0x3e, 0x03, 0x42, 0x07, 0x5f, 0x64, 0x93, 0x8b, 0xb1, 0xf4, 0x99, 0xa5, 0xdc, 0xf5, 0xdc, 0x0e,
-	0x0d, 0x5a, 0xb4, 0xa7, 0x46, 0x34, 0x9b, 0x6a, 0xb4, 0xa5, 0xd9, 0x08, 0x4b, 0x39, 0xfc, 0xc6,
-	0x88, 0x08, 0xf3, 0x59, 0xc8, 0x6d, 0xf4, 0x02, 0xfa, 0xee, 0xfd, 0x57, 0xb3, 0x79, 0x0b, 0x26,
-	0x38, 0xe9, 0x65, 0xb7, 0xcd, 0x36, 0x0a, 0xd6, 0xb6, 0x0e, 0xfb, 0x4e, 0x5a, 0x15, 0x9c, 0x08,
-	0x05, 0x8e, 0x99, 0x4b, 0x2d, 0xb7, 0x5d, 0xa7, 0x9e, 0xec, 0x01, 0x3d, 0xa2, 0x97, 0x39, 0x14,
-	0x25, 0xd6, 0xfc, 0xa9, 0x01, 0x25, 0x5e, 0x50, 0x2e, 0xf0, 0x36, 0x8c, 0xb7, 0x84, 0x1c, 0xd9,
-	0x0b, 0xd9, 0x7c, 0xe1, 0xd1, 0x0a, 0x87, 0x07, 0x82, 0x04, 0xa0, 0x12, 0xc1, 0xa4, 0xed, 0x59,
-	0x76, 0xc0, 0xa4, 0x8d, 0x1c, 0xbb, 0xb4, 0x9b, 0x82, 0x33, 0x2a, 0x11, 0xe6, 0x2f, 0x26, 0x01,
-	0xae, 0xb9, 0x75, 0x2a, 0x9b, 0x3a, 0x07, 0x23, 0x76, 0x5d, 0x76, 0x22, 0xc8, 0x42, 0x23, 0xeb,
-	0x2b, 0x38, 0x62, 0xd7, 0xf5, 0xa8, 0x8c, 0x0c, 0xdc, 0x63, 0x5f, 0x80, 0x52, 0xdd, 0xf6, 0xbb,
-	0x6d, 0x6b, 0xff, 0x5a, 0x8a, 0x1e, 0xb3, 0x12, 0xa2, 0x30, 0x4a, 0x47, 0x3e, 0x21, 0xe3, 0x7e,
-	0x42, 0x87, 0x29, 0x27, 0xe2, 0x7e, 0x05, 0x56, 0xbd, 0x48, 0xc8, 0xef, 0x45, 0x98, 0x50, 0x9e,
-	0x33, 0x2e, 0x25, 0xc7, 0x4b, 0x9d, 0x54, 0xb1, 0x85, 0xed, 0x08, 0x0e, 0x63, 0x94, 0x49, 0xcf,
-	0x5e, 0xfe, 0x91, 0x78, 0xf6, 0x56, 0x60, 0xda, 0x0f, 0x5c, 0x8f, 0xd6, 0x15, 0xc5, 0xfa, 0x4a,
-	0x99, 0xc4, 0x1a, 0x3a, 0x5d, 0x4d, 0xe0, 0xb1, 0xaf, 0x04, 0xd9, 0x82, 0x93, 0x7b, 0x89, 0x90,
-	0x2a, 0x6f, 0xfc, 0x2c, 0xe7, 0x74, 0x56, 0x72, 0x3a, 0x79, 0x33, 0x85, 0x06, 0x53, 0x4b, 0x92,
-	0x97, 0x60, 0x52, 0x55, 0x93, 0x1f, 0x81, 0xe5, 0x93, 0x9c, 0x95, 0xd6, 0xf4, 0xb7, 0xa3, 0x48,
-	0x8c, 0xd3, 0x92, 0x4f, 0x41, 0xae, 0xdb, 0xb2, 0x7c, 0x2a, 0x1d, 0x81, 0xca, 0xcb, 0x92, 0xdb,
-	0x62, 0xc0, 0xbb, 0x07, 0xf3, 0x45, 0x36, 0x66, 0xfc, 0x03, 0x05, 0x21, 0xb9, 0x08, 0xb0, 0xe3,
-	0xf6, 0x9c, 0xba, 0xe5, 0xed, 0xaf, 0xaf, 0xc8, 0x38, 0x80, 0xd6, 0x4d, 0x2a, 0x1a, 0x83, 0x11,
-	0xaa, 0x68, 0xf0, 0xb5, 0x78, 0xef, 0xe0, 0x2b, 0xb9, 0x05, 0x45, 0x1e, 0x33, 0xa1, 0xf5, 0xa5,
-	0x40, 0x3a, 0xf5, 0x1e, 0xc4, 0xbd, 0xae, 0x4f, 0xdc, 0xaa, 0x62, 0x82, 0x21, 0x3f, 0xf2, 0x16,
-	0x40, 0xc3, 0x76, 0x6c, 0xbf, 0xc5, 0xb9, 0x97, 0x1e, 0x98, 0xbb, 0x6e, 0xe7, 0xaa, 0xe6, 0x82,
-	0x11, 0x8e, 0xe4, 0xdf, 0x0c, 0x98, 0xf1, 0xa8, 0xef, 0xf6, 0xbc, 0x1a, 0xf5, 0x75, 0xfe, 0xc5,
-	0x29, 0xbe, 0xf8, 0x6f, 0x64, 0x4c, 0x6d, 0x55, 0x2b, 0x7a, 0x01, 0x93, 0x8c, 0xc5, 0x69, 0x46,
-	0x55, 0x38, 0xac, 0x0f, 0x7f, 0x37, 0x0d, 0xf8, 0xe5, 0x1f, 0xcf, 0xcf, 0xf7, 0x67, 0x53, 0x6b,
-	0xe6, 0x6c, 0x46, 0xfd, 0xfe, 0x8f, 0xe7, 0xa7, 0xd5, 0xb7, 0xce, 0x14, 0xe9, 0x6f, 0x17, 0xdb,
-	0xaa, 0xbb, 0x6e, 0x7d, 0x7d, 0x4b, 0x7a, 0x39, 0xf5, 0x56, 0xbd, 0xc5, 0x80, 0x28, 0x70, 0xe4,
-	0x02, 0x14, 0xea, 0x16, 0xed, 0xb8, 0x0e, 0xad, 0x97, 0x27, 0x43, 0x07, 0xd0, 0x8a, 0x84, 0xa1,
-	0xc6, 0x92, 0xb7, 0x21, 0x6f, 0x73, 0xf5, 0xb9, 0x7c, 0x82, 0x0f, 0x4c, 0x36, 0x35, 0x5d, 0x68,
-	0xe0, 0x22, 0x2d, 0x46, 0xfc, 0x46, 0xc9, 0x96, 0xd4, 0x60, 0xdc, 0xed, 0x05, 0x5c, 0xc2, 0x14,
-	0x97, 0x90, 0xcd, 0x6d, 0xba, 0x29, 0x78, 0x88, 0xe4, 0x4d, 0xf9, 0x81, 0x8a, 0x33, 0x6b, 0x6f,
-	0xad, 0x65, 0xb7, 0xeb, 0x1e, 0x75, 0xca, 0xd3, 0xdc, 0x72, 0xe6, 0xed, 0x5d, 0x96, 0x30, 0xd4,
-	0x58, 0xf2, 0xff, 0x61, 0xd2, 0xed, 0x05, 0x7c, 0x95, 0xb0, 0x51, 0xf6, 0xcb, 0x33, 0x9c, 0x7c,
-	0x86, 0xad, 0xd9, 0xcd, 0x28, 0x02, 0xe3, 0x74, 0x6c, 0xdf, 0x6c, 0xb9, 0x7e, 0xc0, 0x3e, 0xf8,
-	0xd6, 0x71, 0x3a, 0xbe, 0x6f, 0x5e, 0x8e, 0xe0, 0x30, 0x46, 0x49, 0xbe, 0x6e, 0xc0, 0x4c, 0x27,
-	0xa9, 0xf6, 0x96, 0xcf, 0xf0, 0xce, 0x58, 0xcd, 0xa8, 0x60, 0x25, 0xb8, 0x89, 0x00, 0x57, 0x1f,
-	0x18, 0xfb, 0xe5, 0xce, 0xad, 0xc0, 0xe9, 0xf4, 0x39, 0x7d, 0x3f, 0xd5, 0x69, 0x34, 0xaa, 0x3a,
-	0x9d, 0x80, 0x89, 0x68, 0xfe, 0x37, 0x77, 0xfc, 0x46, 0x72, 0x01, 0x89, 0x0b, 0x45, 0xb7, 0x7a,
-	0x1c, 0x8e, 0xdf, 0xcd, 0x6a, 0x9f, 0xe3, 0x57, 0x83, 0x30, 0x94, 0x71, 0x3f, 0xc7, 0xef, 0x5f,
-	0x8f, 0x40, 0x58, 0x8e, 0x59, 0xfe, 0xd4, 0xa9, 0x77, 0x5d, 0xdb, 0x09, 0x92, 0x2e, 0xf3, 0x4b,
-	0x12, 0x8e, 0x9a, 0x22, 0xe2, 0x26, 0x1e, 0xb9, 0xa7, 0x9b, 0xb8, 0x05, 0x53, 0x16, 0x0f, 0x0d,
-	0x87, 0xfe, 0xbd, 0xd1, 0x07, 0xf2, 0xef, 0xe9, 0xa4, 0xbe, 0x38, 0x17, 0x4c, 0xb2, 0x65, 0x92,
-	0xfc, 0xb0, 0x38, 0x97, 0x34, 0x96, 0x49, 0x52, 0x35, 0xce, 0x05, 0x93, 0x6c, 0xcd, 0xbf, 0x1d,
-	0x01, 0xb5, 0xda, 0x7e, 0x15, 0xec, 0x6b, 0x62, 0x42, 0xde, 0xa3, 0x7e, 0xaf, 0x1d, 0x48, 0xed,
-	0x8b, 0xef, 0x68, 0xc8, 0x21, 0x28, 0x31, 0x6c, 0xb3, 0xa1, 0xef, 0xda, 0xc1, 0xb2, 0x5b, 0x57,
-	0x3a, 0x17, 0xdf, 0x6c, 0x2e, 0x49, 0x18, 0x6a, 0xac, 0xb9, 0x07, 0x93, 0xac, 0x5d, 0xed, 0x36,
-	0x6d, 0x57, 0x03, 0xda, 0xf5, 0x49, 0x03, 0x72, 0x3e, 0xfb, 0x31, 0x94, 0x22, 0x1c, 0xc6, 0xdb,
-	0x69, 0x37, 0x62, 0x88, 0x33, 0xbe, 0x28, 0xd8, 0x9b, 0x77, 0x47, 0xa0, 0xa8, 0x7b, 0xf4, 0x08,
-	0xd6, 0xfd, 0x4d, 0x18, 0xaf, 0xd3, 0x86, 0xc5, 0xda, 0x9d, 0x35, 0x25, 0xb2, 0x24, 0x42, 0x6d,
-	0x9c, 0x09, 0x2a, 0x6e, 0xe4, 0x8d, 0xa8, 0xaf, 0x28, 0x0b, 0xdb, 0x62, 0x9f, 0x67, 0x69, 0x17,
-	0x8a, 0xfc, 0xc7, 0xaa, 0xba, 0xfe, 0x90, 0x75, 0x16, 0xde, 0x50, 0x5c, 0x84, 0x9b, 0x55, 0x7f,
-	0x62, 0xc8, 0x3f, 0x71, 0x6d, 0x21, 0x77, 0x94, 0x6b, 0x0b, 0xe6, 0x2a, 0xb0, 0xc3, 0x78, 0x6d,
-	0x99, 0xbc, 0x0c, 0x05, 0x5f, 0x6e, 0x90, 0xb2, 0xef, 0x3f, 0xa6, 0x23, 0x73, 0x12, 0x7e, 0xf7,
-	0x60, 0x7e, 0x92, 0x13, 0x2b, 0x00, 0xea, 0x22, 0xe6, 0x57, 0xc7, 0x20, 0x62, 0xea, 0x1d, 0x61,
-	0x14, 0xeb, 0x09, 0xeb, 0xfd, 0xb5, 0xac, 0xd6, 0xbb, 0x32, 0x89, 0xc5, 0xf4, 0x8f, 0x1b, 0xec,
-	0xac, 0x1e, 0x2d, 0xda, 0xee, 0xca, 0x05, 0xa2, 0xeb, 0x71, 0x99, 0xb6, 0xbb, 0xc8, 0x31, 0x3a,
-	0x8a, 0x3c, 0x36, 0x30, 0x8a, 0x7c, 0x0b, 0x72, 0x4d, 0xab, 0xd7, 0xa4, 0xd2, 0x7f, 0x9a, 0xcd,
-	0xb3, 0xc2, 0x03, 0x62, 0x62, 0x82, 0xf0, 0x9f, 0x28, 0x78, 0xb2, 0x09, 0xd2, 0x52, 0xce, 0x4a,
-	0x69, 0xa5, 0x64, 0x9b, 0x20, 0xda, 0xe5, 0x29, 0x26, 0x88, 0xfe, 0xc4, 0x90, 0x3f, 0x53, 0x6f,
-	0x6a, 0x22, 0xb7, 0x50, 0x06, 0x73, 0x3e, 0x97, 0x31, 0x18, 0xce, 0x79, 0x88, 0x55, 0x24, 0x3f,
-	0x50, 0x71, 0x36, 0x17, 0xa1, 0x14, 0xc9, 0xe2, 0x67, 0xfd, 0xab, 0x33, 0xe7, 0x22, 0xfd, 0xbb,
-	0x62, 0x05, 0x16, 0x72, 0x8c, 0xf9, 0xed, 0x51, 0xd0, 0xca, 0x64, 0x34, 0xcc, 0x6d, 0xd5, 0x22,
-	0x29, 0xd2, 0xb1, 0x9c, 0x1b, 0xd7, 0x41, 0x89, 0x65, 0xa6, 0x4d, 0x87, 0x7a, 0x4d, 0x7d, 0xb8,
-	0xcb, 0x63, 0x4f, 0x9b, 0x36, 0x1b, 0x51, 0x24, 0xc6, 0x69, 0xd9, 0xd1, 0xda, 0xb1, 0x1c, 0xbb,
-	0x41, 0xfd, 0x20, 0x19, 0x97, 0xd8, 0x90, 0x70, 0xd4, 0x14, 0x64, 0x0d, 0x66, 0x7c, 0x1a, 0x6c,
-	0xee, 0x39, 0xd4, 0xd3, 0xb9, 0x40, 0x32, 0x39, 0xec, 0x71, 0xa5, 0x61, 0x57, 0x93, 0x04, 0xd8,
-	0x5f, 0x86, 0x9b, 0x89, 0x22, 0x2f, 0x4b, 0xe7, 0xd8, 0xc8, 0xd5, 0x1a, 0x9a, 0x89, 0x09, 0x3c,
-	0xf6, 0x95, 0x60, 0x5c, 0x1a, 0x96, 0xdd, 0xee, 0x79, 0x34, 0xe4, 0x92, 0x8f, 0x73, 0x59, 0x4d,
-	0xe0, 0xb1, 0xaf, 0x04, 0x0f, 0x69, 0xb6, 0xad, 0xa6, 0x5f, 0x1e, 0x8f, 0x84, 0x34, 0x19, 0x00,
-	0x05, 0xdc, 0xfc, 0x60, 0x04, 0x26, 0x91, 0x06, 0xde, 0xbe, 0xee, 0xb5, 0x37, 0x20, 0xd7, 0xe6,
-	0x79, 0x62, 0xc6, 0x30, 0xdb, 0xa4, 0x48, 0x24, 0x13, 0x9c, 0xc8, 0x0a, 0x94, 0x3c, 0x26, 0x43,
-	0x66, 0xf1, 0x89, 0x31, 0x34, 0x95, 0x33, 0x01, 0x43, 0xd4, 0xdd, 0xf8, 0x27, 0x46, 0x8b, 0x11,
-	0x07, 0xc6, 0x77, 0x44, 0x8e, 0xbd, 0xdc, 0xc1, 0xb3, 0x4d, 0x6f, 0x99, 0xa7, 0xcf, 0xc3, 0x1f,
-	0x2a, 0x69, 0xff, 0x6e, 0xf8, 0x13, 0x95, 0x10, 0xf3, 0x03, 0x03, 0x20, 0xbc, 0xa6, 0x44, 0x76,
-	0xa1, 0xe0, 0x3f, 0x1f, 0xd3, 0x22, 0x33, 0x26, 0xc0, 0x48, 0x26, 0x91, 0xd4, 0x08, 0x09, 0x41,
-	0x2d, 0xe0, 0x7e, 0x2a, 0xe4, 0x47, 0xa3, 0xa0, 0x4b, 0x3d, 0x24, 0x0d, 0xf2, 0x19, 0xa6, 0x7d,
-	0x34, 0xc3, 0xdb, 0x03, 0x9a, 0x0e, 0x39, 0x14, 0x25, 0x96, 0x69, 0x20, 0x2a, 0x3e, 0x2b, 0x57,
-	0x0b, 0xd7, 0x40, 0x54, 0x28, 0x17, 0x35, 0x36, 0x4d, 0x27, 0xcd, 0x3d, 0x32, 0x9d, 0x34, 0xff,
-	0x50, 0x74, 0x52, 0xf2, 0x2c, 0x8c, 0x7b, 0x6e, 0x9b, 0x2e, 0xe1, 0x35, 0xe9, 0x3f, 0xd1, 0x7e,
-	0x0d, 0x14, 0x60, 0x54, 0x78, 0xf2, 0x02, 0x94, 0x7a, 0x3e, 0xad, 0xae, 0x5c, 0x59, 0xf6, 0x68,
-	0xdd, 0x97, 0xa1, 0x6f, 0xed, 0x51, 0xbb, 0x1e, 0xa2, 0x30, 0x4a, 0x67, 0xfe, 0x9e, 0x01, 0x27,
-	0xaa, 0x35, 0xcf, 0xee, 0x06, 0x7a, 0xf3, 0xbc, 0xc6, 0x6f, 0x67, 0x04, 0x16, 0x5b, 0x8a, 0x72,
-	0x2a, 0x3e, 0x39, 0x20, 0xea, 0x27, 0x88, 0x62, 0xf7, 0x8e, 0x04, 0x08, 0x43, 0x16, 0x6c, 0xa8,
-	0xc5, 0xf6, 0x9c, 0x9c, 0x12, 0x55, 0x0e, 0x45, 0x89, 0x35, 0x6f, 0xc3, 0x74, 0x95, 0x76, 0xac,
-	0x6e, 0x8b, 0x47, 0xe1, 0x85, 0x8b, 0x73, 0x11, 0x8a, 0xbe, 0x82, 0x25, 0x2f, 0x39, 0x69, 0x62,
-	0x0c, 0x69, 0xc8, 0xd3, 0xc2, 0x03, 0xcb, 0xd4, 0x76, 0x11, 0x57, 0x2e, 0x29, 0xd7, 0x29, 0xd3,
-	0xb7, 0x15, 0xce, 0xdc, 0x83, 0x89, 0xb0, 0x38, 0x6d, 0x90, 0x26, 0x4c, 0xd5, 0x22, 0x41, 0x4c,
-	0xa4, 0x8d, 0x07, 0xbe, 0x97, 0xc2, 0x03, 0xb8, 0xcb, 0x71, 0x26, 0x98, 0xe4, 0x6a, 0xfe, 0xc2,
-	0x80, 0x29, 0x2d, 0x59, 0xba, 0x52, 0xbb, 0x49, 0xaf, 0xf1, 0xa5, 0x8c, 0xa9, 0x6f, 0xf1, 0xce,
-	0xbb, 0x87, 0xe7, 0xb8, 0x9b, 0xf4, 0x1c, 0x1f, 0xb7, 0xc4, 0x3e, 0xef, 0xf1, 0x9f, 0x8f, 0x40,
-	0x41, 0xe7, 0xde, 0xbd, 0x01, 0x39, 0x7e, 0xde, 0x0f, 0x77, 0x06, 0x70, 0xdd, 0x01, 0x05, 0x27,
-	0xc6, 0x92, 0xbb, 0xe1, 0x32, 0x2b, 0xf5, 0x45, 0x61, 0x59, 0x58, 0x5e, 0x80, 0x82, 0x13, 0xb9,
-	0x02, 0xa3, 0xd4, 0xa9, 0x67, 0x56, 0xe7, 0xf9, 0x35, 0xbe, 0x4b, 0x4e, 0x1d, 0x19, 0x17, 0x7e,
-	0x81, 0xc3, 0xf5, 0x3a, 0x56, 0x20, 0x55, 0xc5, 0xf0, 0x02, 0x07, 0x87, 0xa2, 0xc4, 0x9a, 0x7f,
-	0x30, 0x02, 0xf9, 0x6a, 0x6f, 0x87, 0x1d, 0x6b, 0x7f, 0x6c, 0xc0, 0x6c, 0xd2, 0x21, 0x1b, 0x4e,
-	0xcc, 0xcb, 0xc7, 0x72, 0xc1, 0x08, 0x69, 0xa3, 0xf2, 0x84, 0xac, 0xca, 0x6c, 0x0a, 0x12, 0xd3,
-	0x6a, 0xc0, 0xd4, 0xce, 0x30, 0xd3, 0x76, 0xe4, 0x58, 0x32, 0x6d, 0x27, 0x07, 0x65, 0xd9, 0x9a,
-	0xff, 0x30, 0x06, 0x20, 0x7a, 0x64, 0xb3, 0x1b, 0x1c, 0xc5, 0x36, 0x78, 0x11, 0x26, 0xd4, 0xe3,
-	0x05, 0xd7, 0xc2, 0x28, 0x84, 0x76, 0x5f, 0xad, 0x45, 0x70, 0x18, 0xa3, 0x64, 0x26, 0x10, 0x75,
-	0x02, 0x6f, 0x5f, 0x1c, 0x76, 0x63, 0x71, 0x13, 0xe8, 0x92, 0xc6, 0x60, 0x84, 0x8a, 0x2c, 0xc4,
-	0x5c, 0x05, 0x22, 0x1b, 0xf7, 0xc4, 0x3d, 0xcc, 0xfc, 0x97, 0x60, 0x52, 0x7f, 0xad, 0xda, 0x6d,
-	0x95, 0xe0, 0xa0, 0x55, 0xce, 0xad, 0x28, 0x12, 0xe3, 0xb4, 0xe4, 0x15, 0x38, 0x11, 0x4f, 0x9b,
-	0x93, 0xc7, 0xc2, 0x69, 0x59, 0xfa, 0x44, 0x3c, 0xdb, 0x0e, 0x13, 0xd4, 0x6c, 0x16, 0xd6, 0xbd,
-	0x7d, 0xec, 0x39, 0xf2, 0x7c, 0xd0, 0xb3, 0x70, 0x85, 0x43, 0x51, 0x62, 0x59, 0x17, 0xb2, 0x92,
-	0xd4, 0x13, 0x70, 0xee, 0x54, 0x2f, 0x84, 0x5d, 0x58, 0x8d, 0xe0, 0x30, 0x46, 0xc9, 0x24, 0x48,
-	0xc3, 0x0c, 0xe2, 0xf3, 0x3c, 0x61, 0x5a, 0x75, 0xe1, 0x84, 0x1b, 0xd7, 0x85, 0x85, 0xb7, 0xfc,
-	0xd3, 0x47, 0xcc, 0xdf, 0x8f, 0x95, 0x15, 0x79, 0x69, 0x09, 0xd5, 0x39, 0xc1, 0xdf, 0x9c, 0x85,
-	0x99, 0x6a, 0xaf, 0xdb, 0x6d, 0xdb, 0xb4, 0xae, 0xed, 0x5f, 0xf3, 0x55, 0x98, 0x92, 0x37, 0x32,
-	0xf4, 0xf1, 0xf7, 0x40, 0x17, 0x2c, 0xcd, 0x03, 0xb6, 0x9f, 0xef, 0x3b, 0xb5, 0x96, 0xe7, 0x3a,
-	0xd2, 0xfb, 0x48, 0x9c, 0xe4, 0xa1, 0x95, 0xd5, 0xfd, 0x11, 0x3d, 0xa2, 0xc4, 0x0a, 0x49, 0x3d,
-	0xf3, 0x6e, 0xa9, 0x90, 0xe6, 0x30, 0xc1, 0x7b, 0x1e, 0x05, 0x14, 0xbb, 0x60, 0x34, 0x14, 0x6a,
-	0xfe, 0xcc, 0x80, 0x53, 0x89, 0x06, 0xca, 0x63, 0xeb, 0x9d, 0xfe, 0x66, 0xae, 0x0c, 0xd7, 0x4c,
-	0xe9, 0xe1, 0x1d, 0xdc, 0x52, 0x2b, 0xde, 0xd2, 0xd7, 0xb2, 0xb7, 0x54, 0x8a, 0xea, 0x6f, 0xef,
-	0x7f, 0x1a, 0x50, 0xda, 0xde, 0xbe, 0xaa, 0xed, 0x15, 0x84, 0xd3, 0xbe, 0xb8, 0x53, 0xb3, 0xd4,
-	0x08, 0xa8, 0xb7, 0xec, 0x76, 0xba, 0x6d, 0xaa, 0x27, 0x87, 0xbc, 0xe8, 0x52, 0x4d, 0xa5, 0xc0,
-	0x01, 0x25, 0xc9, 0x3a, 0xcc, 0x46, 0x31, 0xd2, 0x5c, 0xe3, 0x8d, 0xca, 0xc9, 0xdc, 0xc7, 0x7e,
-	0x34, 0xa6, 0x95, 0x49, 0xb2, 0x92, 0x36, 0x9b, 0x7c, 0x49, 0xa3, 0x8f, 0x95, 0x44, 0x63, 0x5a,
-	0x19, 0x73, 0x13, 0x4a, 0x91, 0x67, 0x54, 0xc8, 0x6b, 0x30, 0x5d, 0x73, 0x3b, 0x5d, 0x8f, 0xfa,
-	0xbe, 0xed, 0x3a, 0x57, 0xe9, 0x1d, 0xda, 0x96, 0x4d, 0xe6, 0x37, 0x66, 0x96, 0x13, 0x38, 0xec,
-	0xa3, 0x36, 0x7f, 0xf0, 0x04, 0xe8, 0x7b, 0x1a, 0xbf, 0xbe, 0xed, 0x91, 0x29, 0x26, 0x5c, 0xd3,
-	0x31, 0xab, 0xdc, 0xf0, 0x31, 0x2b, 0xbd, 0x17, 0x27, 0xe2, 0x56, 0xcd, 0x30, 0x6e, 0x95, 0x3f,
-	0x86, 0xb8, 0x95, 0x56, 0x02, 0xfb, 0x62, 0x57, 0x5f, 0x33, 0x60, 0xc2, 0x71, 0xeb, 0x54, 0xe9,
-	0xcc, 0xdc, 0x6d, 0x50, 0xba, 0xb8, 0x39, 0x54, 0x27, 0x8a, 0x10, 0xa6, 0xe4, 0x28, 0x42, 0x96,
-	0xfa, 0xa0, 0x8a, 0xa2, 0x30, 0x26, 0x9a, 0xac, 0x42, 0xc1, 0x6a, 0x34, 0x6c, 0xc7, 0x0e, 0xf6,
-	0xe5, 0x85, 0x93, 0xb3, 0x69, 0xaa, 0xfe, 0x92, 0xa4, 0x11, 0x66, 0xa7, 0xfa, 0x42, 0x5d, 0x96,
-	0xd9, 0xed, 0xfa, 0x7e, 0x67, 0x71, 0x08, 0xbb, 0x5d, 0x65, 0x12, 0x45, 0x9c, 0x48, 0xea, 0x2e,
-	0x5a, 0x78, 0xdd, 0xd3, 0x84, 0xbc, 0x08, 0x67, 0xf2, 0xd3, 0xb5, 0x20, 0x9c, 0x96, 0x22, 0xd4,
-	0x89, 0x12, 0x43, 0x9a, 0xca, 0xf1, 0x5e, 0xe2, 0x9d, 0x5b, 0xc9, 0x1c, 0xb6, 0xd0, 0xbe, 0xfc,
-	0x74, 0xcf, 0x3b, 0x79, 0x3d, 0x6a, 0x27, 0x4e, 0x1c, 0xc5, 0x4e, 0x9c, 0x1c, 0x68, 0x23, 0x36,
-	0x21, 0xef, 0x73, 0x2b, 0x94, 0xc7, 0x70, 0x4b, 0x17, 0x97, 0xb3, 0x1d, 0x24, 0x31, 0x43, 0x56,
-	0xf4, 0x8e, 0x80, 0xa1, 0x64, 0x4f, 0x5c, 0x28, 0xa8, 0x40, 0xb3, 0x0c, 0x03, 0x67, 0x33, 0x7d,
-	0x92, 0x2e, 0x47, 0x75, 0xed, 0x40, 0x40, 0x51, 0x0b, 0x21, 0xb7, 0x60, 0xb4, 0x6e, 0x35, 0x65,
-	0x40, 0xf8, 0xb5, 0xcc, 0xf7, 0x68, 0x94, 0x18, 0x6e, 0x55, 0xac, 0x2c, 0xad, 0x21, 0xe3, 0x4a,
-	0x76, 0xc3, 0x7b, 0xa6, 0xd3, 0xc3, 0x1c, 0xc0, 0x71, 0x15, 0x48, 0xd8, 0xcc, 0x7d, 0x37, 0x55,
-	0x2f, 0xc1, 0xf8, 0x1d, 0xb7, 0xdd, 0xeb, 0xc8, 0x48, 0x72, 0xe9, 0xe2, 0x5c, 0xda, 0x68, 0xdf,
-	0xe0, 0x24, 0xe1, 0x26, 0x20, 0xbe, 0x7d, 0x54, 0x65, 0xc9, 0x97, 0x0d, 0x38, 0xc1, 0x96, 0x8e,
-	0x9e, 0x07, 0x7e, 0x99, 0x0c, 0x31, 0x53, 0xaf, 0xfb, 0xec, 0x68, 0x55, 0x33, 0x4c, 0x2b, 0xc2,
-	0xeb, 0x31, 0x09, 0x98, 0x90, 0x48, 0xba, 0x50, 0xf0, 0xed, 0x3a, 0xad, 0x59, 0x9e, 0x5f, 0x9e,
-	0x3d, 0x36, 0xe9, 0xa1, 0xcb, 0x4d, 0xf2, 0x46, 0x2d, 0x85, 0xfc, 0x2e, 0x7f, 0x27, 0x45, 0xbe,
-	0x71, 0x24, 0x9f, 0xa8, 0x3a, 0x79, 0x9c, 0x4f, 0x54, 0xcd, 0x8a, 0x47, 0x52, 0x62, 0x12, 0x30,
-	0x29, 0x92, 0x7c, 0xc9, 0x80, 0x53, 0xe2, 0xc2, 0x69, 0xf2, 0xb6, 0xf1, 0xa9, 0x8c, 0x76, 0xee,
-	0xe3, 0x87, 0x07, 0xf3, 0xa7, 0x96, 0xd2, 0x58, 0x62, 0xba, 0x24, 0xf2, 0x1e, 0x4c, 0x7a, 0x51,
-	0x9f, 0x30, 0x4f, 0x30, 0xc8, 0x3a, 0x02, 0x31, 0xef, 0xb2, 0x48, 0x6e, 0x88, 0x81, 0x30, 0x2e,
-	0x8b, 0x3c, 0x07, 0xa5, 0xae, 0xdc, 0xdc, 0x6c, 0xbf, 0xc3, 0x73, 0x13, 0x46, 0xc5, 0x21, 0xbc,
-	0x15, 0x82, 0x31, 0x4a, 0x43, 0xae, 0x43, 0x29, 0x70, 0xdb, 0xd4, 0x93, 0x19, 0xab, 0x65, 0x3e,
-	0x5f, 0xce, 0xa5, 0x4d, 0xfe, 0x6d, 0x4d, 0x16, 0xba, 0xde, 0x42, 0x98, 0x8f, 0x51, 0x3e, 0xcc,
-	0x12, 0x54, 0xd7, 0xd1, 0x3d, 0x6e, 0xa8, 0x3e, 0x1e, 0xb7, 0x04, 0xab, 0x51, 0x24, 0xc6, 0x69,
-	0xc9, 0x1a, 0xcc, 0x74, 0x3d, 0xdb, 0xf5, 0xec, 0x60, 0x7f, 0xb9, 0x6d, 0xf9, 0x3e, 0x67, 0x30,
-	0xc7, 0x19, 0xe8, 0x70, 0xc2, 0x56, 0x92, 0x00, 0xfb, 0xcb, 0x90, 0x0b, 0x50, 0x50, 0xc0, 0xf2,
-	0x13, 0x5c, 0xbd, 0xe3, 0x3b, 0x99, 0x2a, 0x8b, 0x1a, 0x3b, 0xe0, 0xf6, 0xdc, 0xd9, 0x2c, 0xb7,
-	0xe7, 0x48, 0x1d, 0xce, 0x5a, 0xbd, 0xc0, 0xe5, 0x29, 0xef, 0xf1, 0x22, 0xdb, 0xee, 0x2e, 0x75,
-	0xca, 0xe7, 0xf9, 0xf1, 0x76, 0xfe, 0xf0, 0x60, 0xfe, 0xec, 0xd2, 0x3d, 0xe8, 0xf0, 0x9e, 0x5c,
-	0x48, 0x07, 0x0a, 0x54, 0xde, 0x00, 0x2c, 0x7f, 0x6c, 0x88, 0x73, 0x25, 0x7e, 0x8d, 0x50, 0xc5,
-	0xc0, 0x05, 0x0c, 0xb5, 0x08, 0xb2, 0x0d, 0xa5, 0x96, 0xeb, 0x07, 0x4b, 0x6d, 0xdb, 0xf2, 0xa9,
-	0x5f, 0x7e, 0x92, 0xcf, 0x93, 0xd4, 0x23, 0xf1, 0xb2, 0x22, 0x0b, 0xa7, 0xc9, 0xe5, 0xb0, 0x24,
-	0x46, 0xd9, 0x10, 0xca, 0xbd, 0xcd, 0x3d, 0x3e, 0x6a, 0xae, 0x13, 0xd0, 0x77, 0x83, 0xf2, 0x39,
-	0xde, 0x96, 0x67, 0xd2, 0x38, 0x6f, 0xb9, 0xf5, 0x6a, 0x9c, 0x5a, 0x6c, 0x0c, 0x09, 0x20, 0x26,
-	0x79, 0x32, 0x93, 0xbf, 0xeb, 0xd6, 0xab, 0x5d, 0x5a, 0xdb, 0xb2, 0x82, 0x5a, 0xab, 0x3c, 0x1f,
-	0xf7, 0x9a, 0x6c, 0x45, 0x70, 0x18, 0xa3, 0x24, 0x1b, 0x30, 0xeb, 0x51, 0x9f, 0x7b, 0x68, 0xb6,
-	0xa8, 0x53, 0xb7, 0x9d, 0xe6, 0x96, 0x5b, 0xf7, 0xcb, 0x26, 0x1f, 0x42, 0xed, 0x5c, 0xc2, 0x7e,
-	0x12, 0x4c, 0x2b, 0x47, 0x6a, 0x30, 0xde, 0x11, 0x79, 0xc5, 0xe5, 0xa7, 0x86, 0xd0, 0x46, 0x65,
-	0x6e, 0xb2, 0x38, 0xcb, 0xe4, 0x07, 0x2a, 0xce, 0xe4, 0x5b, 0x06, 0x4c, 0xf9, 0x71, 0xab, 0xb6,
-	0xfc, 0xf1, 0x61, 0x4e, 0xd0, 0x38, 0xaf, 0xca, 0x33, 0xbc, 0xcf, 0xe3, 0xc0, 0xbb, 0xfd, 0x20,
-	0x4c, 0x56, 0x42, 0xb4, 0x9e, 0xa7, 0xec, 0x97, 0x9f, 0x1e, 0xaa, 0xf5, 0x9c, 0x87, 0x6a, 0x3d,
-	0xff, 0x40, 0xc5, 0x79, 0xee, 0x55, 0x98, 0xe9, 0x53, 0x9a, 0x1f, 0x28, 0x9d, 0xfc, 0x27, 0xcc,
-	0x48, 0x8e, 0x98, 0x29, 0xc7, 0x6d, 0xdc, 0xad, 0xc1, 0x8c, 0x7c, 0xc3, 0x94, 0x69, 0x54, 0xed,
-	0x9e, 0x7e, 0x66, 0x2a, 0x12, 0x2c, 0xc5, 0x24, 0x01, 0xf6, 0x97, 0x61, 0xb3, 0xba, 0x26, 0xde,
-	0x19, 0x12, 0xa9, 0xab, 0x63, 0x71, 0x47, 0xd6, 0x72, 0x04, 0x87, 0x31, 0x4a, 0xf3, 0x2f, 0x0c,
-	0x98, 0x8c, 0x9d, 0xee, 0xc7, 0x1e, 0x17, 0x59, 0x05, 0xd2, 0xb1, 0x3d, 0xcf, 0xf5, 0x84, 0x8a,
-	0xb4, 0xc1, 0xf6, 0x2d, 0x5f, 0x5e, 0x62, 0xe5, 0x97, 0xa7, 0x36, 0xfa, 0xb0, 0x98, 0x52, 0xc2,
-	0xfc, 0xcb, 0x51, 0x08, 0x33, 0x3a, 0xf4, 0x8d, 0x41, 0x63, 0xe0, 0x8d, 0xc1, 0x4f, 0x40, 0xe1,
-	0xb6, 0xef, 0x3a, 0x5b, 0xe1, 0xbd, 0x42, 0x3d, 0x14, 0xaf, 0x57, 0x37, 0xaf, 0x71, 0x4a, 0x4d,
-	0xc1, 0xa9, 0xdf, 0x59, 0xb5, 0xdb, 0x41, 0xff, 0xed, 0xbb, 0xd7, 0xdf, 0x10, 0x70, 0xd4, 0x14,
-	0xfc, 0x31, 0xa3, 0x3b, 0x54, 0xfb, 0x25, 0xc3, 0xc7, 0x8c, 0x18, 0x10, 0x05, 0x8e, 0x2c, 0x42,
-	0x51, 0xbb, 0x35, 0xa5, 0x97, 0x55, 0xf7, 0x94, 0x76, 0x7f, 0x62, 0x48, 0xc3, 0xb5, 0x35, 0xe9,
-	0xba, 0x93, 0x16, 0xea, 0x6a, 0x46, 0x3d, 0x37, 0xe1, 0xff, 0x13, 0x5b, 0xb9, 0x02, 0xa3, 0x96,
-	0x12, 0xcd, 0x12, 0xca, 0x1d, 0x67, 0x96, 0x90, 0xf9, 0x95, 0x51, 0x18, 0xbf, 0x41, 0x3d, 0x7e,
-	0x63, 0xf8, 0x59, 0x18, 0xbf, 0x23, 0x7e, 0xca, 0xd1, 0x0a, 0x95, 0x66, 0x01, 0x46, 0x85, 0x67,
-	0x5d, 0xb6, 0xd3, 0xb3, 0xdb, 0xf5, 0x95, 0x70, 0xfd, 0xe8, 0x2e, 0xab, 0x28, 0x04, 0x86, 0x34,
-	0xac, 0x40, 0x93, 0x69, 0xbc, 0x9d, 0x8e, 0x1d, 0x24, 0x2f, 0x0b, 0xad, 0x29, 0x04, 0x86, 0x34,
-	0xe4, 0x19, 0xc8, 0x37, 0xed, 0x60, 0xdb, 0x6a, 0x26, 0x03, 0x14, 0x6b, 0x1c, 0x8a, 0x12, 0xcb,
-	0xbd, 0xeb, 0x76, 0xb0, 0xed, 0x51, 0xee, 0xad, 0xeb, 0x4b, 0xaa, 0x5f, 0x8b, 0xe0, 0x30, 0x46,
-	0xc9, 0xab, 0xe4, 0xca, 0x96, 0x49, 0xaf, 0x77, 0x58, 0x25, 0x85, 0xc0, 0x90, 0x86, 0x4d, 0xbd,
-	0x9a, 0xdb, 0xe9, 0xda, 0x6d, 0x99, 0x71, 0x12, 0x99, 0x7a, 0xcb, 0x12, 0x8e, 0x9a, 0x82, 0x51,
-	0xb3, 0xcd, 0xa3, 0xe1, 0x7a, 0x9d, 0xe4, 0xeb, 0x31, 0x5b, 0x12, 0x8e, 0x9a, 0xc2, 0xfc, 0xce,
-	0x08, 0x14, 0x1e, 0xe1, 0xa3, 0x57, 0xb5, 0xd8, 0xa3, 0x57, 0xc7, 0xf0, 0x42, 0x52, 0xda, 0x83,
-	0x57, 0xbb, 0x89, 0x07, 0xaf, 0x96, 0x87, 0xcc, 0xb9, 0xbb, 0xe7, 0x63, 0x57, 0x3f, 0x35, 0x40,
-	0x5f, 0x22, 0xe0, 0xab, 0xbb, 0x62, 0xf3, 0x33, 0xfc, 0x11, 0x74, 0xa6, 0x1b, 0xeb, 0xcc, 0x8d,
-	0xa1, 0x5a, 0x19, 0xad, 0xfa, 0xc0, 0xd7, 0xf6, 0x3e, 0x32, 0xa0, 0x9c, 0x56, 0xe0, 0x11, 0x3c,
-	0xf0, 0xe5, 0xc4, 0x1f, 0xf8, 0x5a, 0x3f, 0xb6, 0xc6, 0x0e, 0x78, 0xe8, 0xeb, 0x47, 0x03, 0x9a,
-	0xca, 0x9f, 0xd8, 0x7a, 0x5b, 0xed, 0xee, 0xc6, 0x10, 0x81, 0x06, 0xc1, 0x35, 0xfd, 0x64, 0x78,
-	0x1b, 0xf2, 0x42, 0x21, 0x94, 0x63, 0xfb, 0x52, 0xc6, 0x6d, 0x9e, 0xb1, 0x90, 0xee, 0x1f, 0xfe,
-	0x1b, 0x25, 0x5b, 0xf3, 0xfb, 0x06, 0x4c, 0x3c, 0xc2, 0xe7, 0xd9, 0x76, 0xe2, 0xa3, 0xf7, 0xf2,
-	0x50, 0xa3, 0x37, 0x60, 0xc4, 0xbe, 0xf6, 0x38, 0xc4, 0x9e, 0x45, 0x23, 0x0e, 0x14, 0x95, 0x22,
-	0xa5, 0xb2, 0x6f, 0x5f, 0x1e, 0xca, 0xc3, 0x1a, 0x6e, 0xd3, 0x0a, 0xe2, 0x63, 0x28, 0x22, 0x11,
-	0x35, 0x1d, 0x39, 0x52, 0xd4, 0xf4, 0x91, 0x7b, 0xef, 0xd3, 0x8d, 0xd7, 0xb1, 0x87, 0x62, 0xbc,
-	0x9e, 0x3d, 0x76, 0xe3, 0xf5, 0xc9, 0x87, 0x6f, 0xbc, 0x46, 0xbc, 0x7b, 0xb9, 0x21, 0xbc, 0x7b,
-	0xef, 0xc1, 0x49, 0xf1, 0x73, 0xb9, 0x6d, 0xd9, 0x1d, 0x3d, 0x5f, 0xe4, 0x9b, 0x53, 0xcf, 0xa6,
-	0x9a, 0xac, 0xec, 0xb8, 0xf7, 0x03, 0xea, 0x04, 0x37, 0xc2, 0x92, 0xe1, 0x4d, 0xb5, 0x1b, 0x29,
-	0xec, 0x30, 0x55, 0x48, 0xd2, 0xb7, 0x33, 0x7e, 0x04, 0xdf, 0xce, 0xb7, 0x0d, 0x38, 0x65, 0xa5,
-	0xbd, 0xaf, 0x2c, 0x83, 0x02, 0xaf, 0x0f, 0xe5, 0x9c, 0x8b, 0x71, 0x94, 0x9e, 0xb2, 0x34, 0x14,
-	0xa6, 0xd7, 0x81, 0x3c, 0x1d, 0xfa, 0x77, 0x45, 0x08, 0x3e, 0xdd, 0x33, 0xfb, 0x8d, 0x64, 0x5c,
-	0x05, 0x78, 0x6f, 0x57, 0x87, 0x56, 0x33, 0x8e, 0x21, 0xb6, 0x52, 0x1a, 0x22, 0xb6, 0x92, 0x70,
-	0xbc, 0x4d, 0x1c, 0x93, 0xe3, 0xcd, 0x81, 0x69, 0xbb, 0x63, 0x35, 0xe9, 0x56, 0xaf, 0xdd, 0x16,
-	0x89, 0x76, 0x7e, 0x79, 0x92, 0xf3, 0x4e, 0xcd, 0xf6, 0xba, 0xea, 0xd6, 0xac, 0x76, 0xf2, 0x15,
-	0x3f, 0x9d, 0x25, 0xbb, 0x9e, 0xe0, 0x84, 0x7d, 0xbc, 0xd9, 0xb4, 0xe4, 0xb7, 0xa4, 0x68, 0xc0,
-	0x7a, 0x9b, 0x87, 0x1d, 0xe4, 0x0b, 0xf8, 0x97, 0x43, 0x30, 0x46, 0x69, 0xc8, 0x15, 0x28, 0xd6,
-	0x1d, 0x5f, 0x26, 0xb4, 0x4e, 0xf1, 0x5d, 0xea, 0x93, 0x6c, 0x6f, 0x5b, 0xb9, 0x56, 0xd5, 0xa9,
-	0xac, 0x67, 0x53, 0xae, 0xd9, 0x69, 0x3c, 0x86, 0xe5, 0xc9, 0x06, 0x67, 0x26, 0x9f, 0x7a, 0x11,
-	0x71, 0x82, 0xf3, 0x03, 0x7c, 0x47, 0x2b, 0xd7, 0xd4, 0xcb, 0x34, 0x93, 0x52, 0x9c, 0x7c, 0xc0,
-	0x25, 0xe4, 0x10, 0x79, 0xa6, 0x6c, 0xe6, 0x9e, 0xcf, 0x94, 0x5d, 0x87, 0x33, 0x41, 0xd0, 0x8e,
-	0x85, 0x9f, 0xe5, 0x4d, 0x46, 0x7e, 0xad, 0x35, 0x27, 0x5e, 0xb6, 0xdc, 0xde, 0xbe, 0x9a, 0x46,
-	0x82, 0x83, 0xca, 0xf2, 0x38, 0x6c, 0xd0, 0xd6, 0xbe, 0xe3, 0x73, 0xc3, 0xc4, 0x61, 0xc3, 0x38,
-	0xbf, 0x8c, 0xc3, 0x86, 0x00, 0x8c, 0x4a, 0x21, 0x9b, 0x83, 0xbc, 0xe6, 0xb3, 0x7c, 0x8f, 0x79,
-	0x70, 0x1f, 0x78, 0xd4, 0xed, 0x7a, 0xf2, 0x9e, 0x6e, 0xd7, 0x3e, 0x37, 0xf1, 0xa9, 0x07, 0x70,
-	0x13, 0xdf, 0xe2, 0x57, 0x28, 0xd7, 0x96, 0xa5, 0x8b, 0x3d, 0x9b, 0xc6, 0xc6, 0x6f, 0x6d, 0x88,
-	0x54, 0x09, 0xfe, 0x13, 0x05, 0x4f, 0xb2, 0x05, 0x27, 0xbb, 0x6e, 0xbd, 0xcf, 0xcb, 0xcc, 0x7d,
-	0xea, 0x91, 0xab, 0xc6, 0x5b, 0x29, 0x34, 0x98, 0x5a, 0x92, 0x6f, 0xe0, 0x21, 0xbc, 0x5c, 0xe6,
-	0x1d, 0x23, 0x36, 0xf0, 0x10, 0x8c, 0x51, 0x9a, 0xa4, 0xd3, 0xf5, 0xf1, 0x87, 0xe6, 0x74, 0x9d,
-	0x7b, 0x04, 0x4e, 0xd7, 0x27, 0x8e, 0xec, 0x74, 0xfd, 0x6d, 0x98, 0xed, 0xba, 0xf5, 0x15, 0xdb,
-	0xf7, 0x7a, 0xfc, 0x8f, 0x3a, 0x2a, 0xbd, 0x7a, 0x93, 0x06, 0xdc, 0x6b, 0x5b, 0xba, 0x78, 0x31,
-	0x5a, 0x49, 0xf1, 0x5f, 0x45, 0x0b, 0xf2, 0xbf, 0x8a, 0xf8, 0x22, 0x4f, 0x94, 0xe2, 0x76, 0x0f,
-	0xcf, 0x15, 0x49, 0x41, 0x62, 0x9a, 0x9c, 0xa8, 0x93, 0xf6, 0xfc, 0x43, 0x73, 0xd2, 0xbe, 0x06,
-	0x05, 0xbf, 0xd5, 0x0b, 0xea, 0xee, 0x9e, 0xc3, 0xdd, 0xf7, 0x45, 0xfd, 0x2e, 0x70, 0xa1, 0x2a,
-	0xe1, 0x77, 0x0f, 0xe6, 0xa7, 0xd5, 0xef, 0xc8, 0xbd, 0x22, 0x09, 0x21, 0xdf, 0x1c, 0x90, 0x42,
-	0x69, 0x1e, 0x73, 0x0a, 0xe5, 0x99, 0x07, 0x4a, 0x9f, 0x4c, 0x73, 0x3e, 0x3f, 0xf5, 0x4b, 0xe0,
-	0x7c, 0x1e, 0xde, 0x2f, 0xfc, 0xcd, 0x29, 0x38, 0x91, 0x78, 0x23, 0x57, 0x3f, 0x00, 0x60, 0x1c,
-	0xf5, 0x01, 0x80, 0xd8, 0x0d, 0xfd, 0x91, 0x87, 0x7a, 0x43, 0x7f, 0xf4, 0xd8, 0x6f, 0xe8, 0x47,
-	0x5e, 0x22, 0x18, 0xbb, 0xcf, 0x4b, 0x04, 0x4b, 0x30, 0xa5, 0x52, 0xa6, 0xa8, 0xbc, 0xa1, 0x2d,
-	0x9c, 0x69, 0xfa, 0x7e, 0xc0, 0x72, 0x1c, 0x8d, 0x49, 0x7a, 0xf2, 0x5b, 0x90, 0x73, 0x78, 0xc1,
-	0xfc, 0x10, 0xaf, 0xe8, 0xc4, 0x07, 0x8c, 0xab, 0x7c, 0xf2, 0x21, 0x1b, 0x15, 0x4d, 0xcf, 0x71,
-	0xd8, 0x5d, 0xf5, 0x03, 0x85, 0x50, 0xf2, 0x26, 0x94, 0xdd, 0x46, 0xa3, 0xed, 0x5a, 0xf5, 0xf0,
-	0x15, 0x01, 0xe5, 0xdf, 0x13, 0xd9, 0x9f, 0xe7, 0x25, 0x83, 0xf2, 0xe6, 0x00, 0x3a, 0x1c, 0xc8,
-	0x81, 0x69, 0xe6, 0x53, 0xf1, 0xd7, 0x2d, 0xfc, 0x72, 0x91, 0x37, 0xf3, 0x37, 0x8e, 0xa3, 0x99,
-	0xf1, 0xa7, 0x34, 0x64, 0x83, 0xc3, 0x9b, 0x19, 0x71, 0x2c, 0x26, 0x6b, 0x42, 0x3c, 0x38, 0xdd,
-	0x4d, 0xb3, 0x5b, 0x7c, 0x99, 0xd3, 0x74, 0x2f, 0xeb, 0xe9, 0x9c, 0x94, 0x72, 0x3a, 0xd5, 0xf2,
-	0xf1, 0x71, 0x00, 0xe7, 0xe8, 0xfb, 0x02, 0x85, 0x87, 0xf6, 0xbe, 0x40, 0xfc, 0x55, 0xe8, 0xc9,
-	0x47, 0xf1, 0x2a, 0x34, 0xf9, 0x79, 0xea, 0xb3, 0x16, 0x42, 0xdd, 0xff, 0xc2, 0x71, 0x0c, 0xf6,
-	0x2f, 0xdd, 0xd3, 0x16, 0x7f, 0x62, 0xc0, 0x9c, 0x98, 0x52, 0x69, 0x7f, 0xf9, 0x21, 0x33, 0x93,
-	0x8e, 0xc1, 0x4d, 0xcc, 0xe3, 0x48, 0xd5, 0x98, 0x20, 0xee, 0xd7, 0xbc, 0x87, 0x70, 0xf2, 0xb5,
-	0x94, 0xe3, 0x69, 0x6a, 0x08, 0x63, 0x38, 0x35, 0x7b, 0x58, 0x2a, 0x48, 0xf7, 0x3b, 0x91, 0xf6,
-	0xc5, 0x9b, 0x43, 0x03, 0x5f, 0xbc, 0xba, 0x1e, 0x3d, 0x8a, 0xb2, 0x3e, 0x3a, 0x15, 0xee, 0x3d,
-	0xd1, 0xd7, 0xb6, 0xbe, 0x64, 0xc0, 0xc9, 0xb4, 0x4d, 0x22, 0xa5, 0x16, 0xd5, 0x78, 0x2d, 0x86,
-	0xf3, 0xb6, 0x45, 0xeb, 0x70, 0x3c, 0x2f, 0x58, 0xfc, 0x51, 0x3e, 0xe2, 0x21, 0x0c, 0x68, 0xf7,
-	0xd7, 0xb9, 0xb8, 0x99, 0x72, 0x71, 0x63, 0x6f, 0xa8, 0xe7, 0x1e, 0xe1, 0x1b, 0xea, 0xf9, 0x0c,
-	0x6f, 0xa8, 0x8f, 0x3f, 0xca, 0x37, 0xd4, 0x0b, 0x47, 0x7c, 0x43, 0xbd, 0xf8, 0x4b, 0xf3, 0x86,
-	0xba, 0xf9, 0xa1, 0x01, 0xd3, 0xff, 0xdb, 0xff, 0x24, 0xea, 0x27, 0x91, 0x10, 0xdd, 0x23, 0xfc,
-	0x77, 0xa8, 0xdb, 0xf1, 0xa0, 0xc7, 0xa5, 0x63, 0x69, 0xe4, 0x80, 0xe0, 0xc7, 0x3b, 0x90, 0x66,
-	0x76, 0x1d, 0xed, 0x92, 0x58, 0x2c, 0x31, 0x64, 0xe4, 0xc8, 0x89, 0x21, 0xff, 0x9d, 0xd2, 0xab,
-	0xfc, 0xdc, 0x7c, 0xef, 0x61, 0xfd, 0x1b, 0xce, 0xc9, 0xb4, 0x7f, 0xc3, 0x49, 0xfc, 0xfb, 0x4d,
-	0xf2, 0xdf, 0x50, 0x46, 0x1e, 0xde, 0xbf, 0xa1, 0x54, 0x16, 0xbe, 0xfb, 0xe1, 0xb9, 0xc7, 0xbe,
-	0xff, 0xe1, 0xb9, 0xc7, 0x7e, 0xf8, 0xe1, 0xb9, 0xc7, 0xde, 0x3f, 0x3c, 0x67, 0x7c, 0xf7, 0xf0,
-	0x9c, 0xf1, 0xfd, 0xc3, 0x73, 0xc6, 0x0f, 0x0f, 0xcf, 0x19, 0x3f, 0x39, 0x3c, 0x67, 0xfc, 0xe1,
-	0xbf, 0x9e, 0x7b, 0xec, 0x0b, 0x05, 0xd5, 0x98, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xae, 0x88,
-	0xa4, 0x4f, 0x07, 0x7a, 0x00, 0x00,
+	// 8074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x3d, 0x5b, 0x70, 0x24, 0xd7,
+	0x55, 0x9e, 0x91, 0x46, 0x1a, 0x5d, 0x3d, 0x56, 0xdb, 0xfb, 0x1a, 0xcb, 0xeb, 0xdd, 0x4d, 0x3b,
+	0x36, 0x36, 0x10, 0xad, 0xbd, 0x4e, 0xc0, 0x79, 0xd9, 0xd6, 0xe8, 0xb1, 0x92, 0x77, 0xb5, 0x92,
+	0xcf, 0xc8, 0xbb, 0x49, 0x1c, 0x62, 0x5a, 0x33, 0xad, 0xd1, 0xac, 0x46, 0xd3, 0xe3, 0xee, 0x9e,
+	0xd5, 0xca, 0x06, 0xe2, 0x04, 0x52, 0xe1, 0x95, 0x82, 0xaa, 0x14, 0x10, 0x2a, 0x45, 0x8a, 0x1f,
+	0x92, 0x1f, 0xf8, 0xa2, 0xc8, 0x07, 0x50, 0x49, 0x15, 0x05, 0x45, 0x48, 0x51, 0x45, 0xbe, 0x48,
+	0xa8, 0x0a, 0xce, 0xeb, 0x83, 0x50, 0x01, 0xf2, 0x45, 0x51, 0xb5, 0x1f, 0xc0, 0x3d, 0xf7, 0x7d,
+	0x7b, 0x7a, 0x76, 0x47, 0x3d, 0x5a, 0x25, 0x90, 0x7c, 0xa8, 0x4a, 0x73, 0xee, 0xbd, 0xe7, 0xdc,
+	0xe7, 0xb9, 0xe7, 0x75, 0x4f, 0x93, 0xf9, 0x7a, 0x23, 0xde, 0xee, 0x6c, 0xce, 0x56, 0x83, 0xdd,
+	0x8b, 0x5e, 0x58, 0x0f, 0xda, 0x61, 0x70, 0x93, 0xfd, 0x73, 0xb1, 0xbd, 0x53, 0xbf, 0xe8, 0xb5,
+	0x1b, 0xd1, 0xc5, 0xbd, 0x20, 0xdc, 0xd9, 0x6a, 0x06, 0x7b, 0x17, 0x6f, 0x3d, 0xe5, 0x35, 0xdb,
+	0xdb, 0xde, 0x53, 0x17, 0xeb, 0x7e, 0xcb, 0x0f, 0xbd, 0xd8, 0xaf, 0xcd, 0xd2, 0xea, 0x71, 0xe0,
+	0x3c, 0xad, 0x91, 0xcc, 0x4a, 0x24, 0xec, 0x9f, 0x59, 0x8a, 0x64, 0x16, 0x91, 0xcc, 0x4a, 0x24,
+	0xb3, 0x12, 0xc9, 0xcc, 0xdb, 0x0c, 0xca, 0xf5, 0x00, 0x09, 0x22, 0xae, 0xcd, 0xce, 0x16, 0xfb,
+	0xc5, 0x7e, 0xb0, 0xff, 0x38, 0x8d, 0x19, 0x77, 0xe7, 0x99, 0x68, 0xb6, 0x11, 0x60, 0x97, 0x2e,
+	0x56, 0x83, 0xd0, 0xa7, 0xbd, 0x49, 0xf6, 0x63, 0xe6, 0x09, 0xa3, 0x4e, 0x3b, 0x68, 0x36, 0xaa,
+	0xfb, 0xb4, 0xd6, 0xa6, 0x1f, 0x77, 0x77, 0x79, 0xe6, 0xed, 0xba, 0xea, 0xae, 0x57, 0xdd, 0x6e,
+	0xd0, 0xd2, 0x7d, 0x3d, 0xe4, 0x5d, 0xda, 0x26, 0x8d, 0xc0, 0xc5, 0x5e, 0xad, 0xc2, 0x4e, 0x2b,
+	0x6e, 0xec, 0xfa, 0x5d, 0x0d, 0x7e, 0xe6, 0x5e, 0x0d, 0xa2, 0xea, 0xb6, 0xbf, 0xeb, 0x75, 0xb5,
+	0x7b, 0xba, 0x57, 0xbb, 0x4e, 0xdc, 0x68, 0x5e, 0x6c, 0xb4, 0xe2, 0x28, 0x0e, 0x93, 0x8d, 0xdc,
+	0x45, 0x32, 0x32, 0xb7, 0x1b, 0x50, 0xc4, 0xce, 0xbb, 0x49, 0xe1, 0x96, 0xd7, 0xec, 0xf8, 0xa5,
+	0xdc, 0x85, 0xdc, 0xe3, 0x63, 0xe5, 0x47, 0xbf, 0xf4, 0xe6, 0xf9, 0x07, 0xbe, 0xfd, 0xe6, 0xf9,
+	0xc2, 0x75, 0x04, 0xde, 0x79, 0xf3, 0xfc, 0x49, 0xbf, 0x55, 0x0d, 0x6a, 0x8d, 0x56, 0xfd, 0xe2,
+	0xcd, 0x28, 0x68, 0xcd, 0x5e, 0xeb, 0xec, 0x6e, 0xfa, 0x21, 0xf0, 0x36, 0xee, 0x3f, 0xe4, 0xc8,
+	0xb1, 0xb9, 0x90, 0x12, 0xbd, 0xe5, 0x57, 0x62, 0xc4, 0x5f, 0xdf, 0x77, 0x5e, 0x26, 0x43, 0xb1,
+	0x17, 0x32, 0x74, 0xe3, 0x97, 0x9e, 0x9f, 0xcd, 0xb0, 0xde, 0xb3, 0x1b, 0x5e, 0x28, 0xd1, 0x95,
+	0x47, 0x69, 0x67, 0x86, 0x28, 0x00, 0x10, 0xab, 0xf3, 0x0a, 0x19, 0x6e, 0x05, 0x2d, 0xbf, 0x94,
+	0x67, 0xd8, 0xe7, 0x32, 0x61, 0xbf, 0x46, 0x11, 0x28, 0xf4, 0x45, 0x8a, 0x7e, 0x18, 0x21, 0xc0,
+	0x10, 0xbb, 0xdf, 0xcf, 0x91, 0xb1, 0xb9, 0xb0, 0xde, 0xd9, 0xf5, 0xe9, 0xc4, 0x39, 0x21, 0x21,
+	0x6d, 0x2f, 0xf4, 0xe8, 0x1a, 0xfb, 0x61, 0x44, 0x87, 0x34, 0x44, 0x89, 0x3e, 0x9b, 0x89, 0xe8,
+	0xba, 0x44, 0x53, 0x76, 0xc4, 0x0c, 0x13, 0x05, 0x8a, 0xc0, 0xa0, 0xe2, 0xb4, 0xc8, 0x98, 0x17,
+	0xc6, 0x8d, 0x2d, 0xaf, 0x1a, 0x47, 0x74, 0x9c, 0x48, 0xf2, 0xbd, 0x99, 0x48, 0xce, 0x09, 0x2c,
+	0xe5, 0xe3, 0x82, 0xe2, 0x98, 0x84, 0x44, 0xa0, 0x49, 0xb8, 0xff, 0x38, 0x4c, 0x8a, 0xb2, 0xc0,
+	0xb9, 0x40, 0xe7, 0x97, 0x76, 0x44, 0x6c, 0x86, 0x09, 0xd1, 0x70, 0xf8, 0x1a, 0x85, 0x01, 0x2b,
+	0xc1, 0x1a, 0x6d, 0x2f, 0xde, 0x66, 0x2b, 0x60, 0xd4, 0x58, 0xa7, 0x30, 0x60, 0x25, 0xce, 0x59,
+	0x32, 0xbc, 0x1b, 0xd4, 0xfc, 0xd2, 0x10, 0xad, 0x51, 0xe0, 0x13, 0xbc, 0x4a, 0x7f, 0x03, 0x83,
+	0x62, 0xfb, 0xad, 0x30, 0xd8, 0x2d, 0x0d, 0xdb, 0xed, 0x97, 0x28, 0x0c, 0x58, 0x89, 0xf3, 0x1b,
+	0x39, 0x32, 0x2d, 0xbb, 0x77, 0x35, 0xa8, 0x7a, 0x71, 0x23, 0x68, 0x95, 0x0a, 0x6c, 0xc1, 0x17,
+	0x07, 0x9a, 0x08, 0x89, 0xac, 0x5c, 0x12, 0x54, 0xa7, 0x93, 0x25, 0xd0, 0x45, 0xd8, 0xb9, 0x44,
+	0x48, 0xbd, 0x19, 0x6c, 0x7a, 0x4d, 0x9c, 0x83, 0xd2, 0x08, 0xeb, 0xb5, 0x5a, 0xc2, 0xcb, 0xaa,
+	0x04, 0x8c, 0x5a, 0xce, 0x0e, 0x19, 0xf5, 0xf8, 0xa9, 0x28, 0x8d, 0xb2, 0x7e, 0x2f, 0x64, 0xec,
+	0xb7, 0x75, 0xb2, 0xca, 0xe3, 0x94, 0xe4, 0xa8, 0x00, 0x82, 0xa4, 0xe0, 0xfc, 0x34, 0x29, 0x06,
+	0x6d, 0xec, 0xaa, 0xd7, 0x2c, 0x15, 0x29, 0xb5, 0x62, 0x79, 0x5a, 0x74, 0xaf, 0xb8, 0x26, 0xe0,
+	0xa0, 0x6a, 0x38, 0x4f, 0x90, 0xd1, 0xa8, 0xb3, 0x89, 0xab, 0x55, 0x1a, 0x63, 0x63, 0x39, 0x26,
+	0x2a, 0x8f, 0x56, 0x38, 0x18, 0x64, 0xb9, 0xf3, 0x2c, 0x99, 0xc2, 0xf5, 0x58, 0xbc, 0xdd, 0x0e,
+	0xfd, 0x28, 0xc2, 0x45, 0x20, 0xac, 0xc5, 0x69, 0xd1, 0x62, 0x6a, 0xc9, 0x2a, 0x85, 0x44, 0x6d,
+	0xf7, 0x29, 0x32, 0x29, 0xe7, 0x77, 0x9e, 0x32, 0x26, 0xff, 0xde, 0x9b, 0xcb, 0xfd, 0xfc, 0x28,
+	0xe9, 0x5a, 0x13, 0xe7, 0x29, 0x32, 0x2e, 0xc6, 0x7a, 0x35, 0xa8, 0x47, 0xac, 0x75, 0xb1, 0x7c,
+	0x8c, 0xb6, 0x1c, 0x9f, 0xd3, 0x60, 0x30, 0xeb, 0x38, 0x37, 0x48, 0x3e, 0x7a, 0x5a, 0x30, 0x89,
+	0xe7, 0x32, 0xcd, 0x7d, 0xe5, 0x69, 0x75, 0x7c, 0x46, 0x28, 0xa9, 0x7c, 0xe5, 0x69, 0xa0, 0x28,
+	0x91, 0xb9, 0x51, 0x6c, 0x6c, 0x6b, 0x67, 0x65, 0x6e, 0x97, 0x1b, 0xb1, 0x42, 0xcd, 0x98, 0x1b,
+	0x05, 0x00, 0x62, 0x45, 0xe6, 0xb6, 0x1d, 0xc7, 0x6d, 0x76, 0x34, 0xb2, 0x32, 0xb7, 0xe5, 0x8d,
+	0x8d, 0x75, 0x85, 0x9e, 0x9d, 0x3d, 0x84, 0x00, 0x43, 0xec, 0xbc, 0x8e, 0x33, 0xc9, 0xcb, 0x82,
+	0x70, 0x5f, 0x9c, 0xa9, 0xe5, 0x81, 0xce, 0x14, 0xc5, 0xa3, 0xc8, 0x89, 0x35, 0x51, 0x05, 0x60,
+	0x52, 0x63, 0xa3, 0xab, 0x6d, 0x45, 0xec, 0x08, 0x65, 0x1e, 0xdd, 0xc2, 0x52, 0x25, 0x31, 0x3a,
+	0x0a, 0x01, 0x86, 0x18, 0xd7, 0x26, 0xf4, 0xf6, 0xc4, 0x89, 0xcb, 0xb6, 0x36, 0xe0, 0xed, 0xd9,
+	0x6b, 0x43, 0x01, 0x80, 0x58, 0x11, 0x79, 0x10, 0x45, 0xec, 0x80, 0x65, 0x45, 0xbe, 0x56, 0xa9,
+	0xd8, 0xc8, 0x29, 0x00, 0x10, 0x2b, 0xdb, 0x55, 0xd5, 0x88, 0x1d, 0xc8, 0xcc, 0xbb, 0x6a, 0x3e,
+	0x81, 0x9c, 0x02, 0x00, 0xb1, 0x3a, 0x55, 0x52, 0xf0, 0x5e, 0xeb, 0x84, 0x3e, 0x3b, 0xbd, 0xe3,
+	0x97, 0xca, 0xd9, 0x96, 0x1b, 0x31, 0x28, 0x02, 0x63, 0x28, 0x20, 0x30, 0x10, 0x70, 0xdc, 0x6e,
+	0x9d, 0x9c, 0x92, 0xa5, 0xe0, 0xb7, 0x83, 0xa8, 0xc1, 0xd6, 0xdf, 0xdf, 0x72, 0x2e, 0x92, 0xb1,
+	0x6a, 0xd0, 0xda, 0x6a, 0xd4, 0x57, 0xbd, 0xb6, 0x38, 0xf8, 0xea, 0x3a, 0x9a, 0x97, 0x05, 0xa0,
+	0xeb, 0x38, 0x0f, 0x93, 0xa1, 0x1d, 0x7f, 0x5f, 0x5c, 0x2f, 0xe3, 0xa2, 0xea, 0xd0, 0x15, 0x7f,
+	0x1f, 0x10, 0xee, 0x7e, 0x21, 0x47, 0x4e, 0xa4, 0xec, 0x3d, 0x6c, 0xd6, 0x09, 0x9b, 0x82, 0x82,
+	0x6a, 0xf6, 0x12, 0x5c, 0x05, 0x84, 0x3b, 0x1f, 0xa7, 0x82, 0x8a, 0xb1, 0x19, 0xe7, 0x3a, 0xe2,
+	0x06, 0xcb, 0xce, 0x9a, 0x2d, 0x5c, 0xe5, 0x33, 0x82, 0xe2, 0xb1, 0x44, 0x01, 0x24, 0xa9, 0xba,
+	0x5f, 0x65, 0x22, 0x93, 0x05, 0x73, 0x3c, 0x32, 0xd5, 0x89, 0xfc, 0x10, 0x59, 0x60, 0xc5, 0xaf,
+	0x86, 0x7e, 0x2c, 0xa4, 0xa7, 0x47, 0x67, 0xb9, 0x6c, 0x87, 0xbd, 0x98, 0x45, 0x49, 0x96, 0x76,
+	0x60, 0x96, 0xd7, 0xa0, 0x13, 0x52, 0xf1, 0x9b, 0x3e, 0xe2, 0x28, 0x3b, 0xc8, 0x8c, 0x5f, 0xb2,
+	0x10, 0x40, 0x02, 0x21, 0x92, 0x68, 0x7b, 0x51, 0x44, 0x47, 0x52, 0x13, 0x24, 0xf2, 0x07, 0x26,
+	0xb1, 0x6e, 0x21, 0x80, 0x04, 0x42, 0xf7, 0x6f, 0x73, 0x94, 0xe1, 0x9b, 0xfb, 0xc4, 0xf9, 0x64,
+	0x8e, 0x38, 0x6c, 0x7f, 0x94, 0xe9, 0xdd, 0x48, 0x57, 0x3b, 0xf6, 0x50, 0x3a, 0x15, 0x83, 0xbb,
+	0x9c, 0x7d, 0x23, 0x5a, 0xe8, 0xca, 0x33, 0x62, 0xee, 0x9d, 0xee, 0x32, 0x48, 0x21, 0x8f, 0xd7,
+	0xd0, 0x26, 0x05, 0x24, 0x25, 0x18, 0xac, 0x04, 0xac, 0xc4, 0xfd, 0x62, 0x9e, 0xa4, 0x20, 0xc3,
+	0x9b, 0xd6, 0x6f, 0xd5, 0xda, 0x01, 0x95, 0xa9, 0xc5, 0x46, 0x53, 0x37, 0xed, 0xa2, 0x80, 0x83,
+	0xaa, 0x21, 0x76, 0xbe, 0x18, 0x72, 0xbe, 0x6b, 0xe7, 0x8b, 0x0e, 0xea, 0x3a, 0x4e, 0x9d, 0x8a,
+	0x3d, 0xd5, 0x2a, 0x0a, 0xe5, 0x6c, 0xe6, 0xd9, 0x22, 0x0d, 0x1d, 0x64, 0x91, 0x4e, 0x32, 0x91,
+	0x26, 0x81, 0x02, 0xba, 0x90, 0xe2, 0x5e, 0x88, 0xbc, 0x68, 0x23, 0xd8, 0xf1, 0x5b, 0x82, 0xcc,
+	0xf0, 0x81, 0xf7, 0x42, 0x65, 0xae, 0x62, 0x20, 0x80, 0x04, 0x42, 0xf7, 0xb3, 0x39, 0x32, 0x55,
+	0xf6, 0xaa, 0x3b, 0x5b, 0x8d, 0x66, 0xf3, 0x46, 0xa3, 0x55, 0x0b, 0xf6, 0x9c, 0xab, 0x42, 0xf0,
+	0xe3, 0xab, 0xff, 0x93, 0x06, 0x2d, 0xa5, 0xb6, 0xe8, 0x85, 0x47, 0xad, 0x0a, 0xa9, 0x6f, 0x50,
+	0xc5, 0x27, 0x55, 0x48, 0x5c, 0x22, 0xf9, 0x38, 0x10, 0x7b, 0xf8, 0x20, 0xb8, 0x88, 0xc0, 0x95,
+	0xdf, 0x08, 0x80, 0x62, 0x70, 0xff, 0x3a, 0x47, 0x46, 0xb1, 0xa3, 0xc1, 0xd6, 0x16, 0xae, 0x6f,
+	0xad, 0x13, 0x72, 0x79, 0x33, 0xb1, 0xbe, 0x0b, 0x02, 0x0e, 0xaa, 0x86, 0xb3, 0x41, 0x46, 0xf8,
+	0x19, 0x16, 0xbd, 0x78, 0xb2, 0x67, 0x2f, 0x50, 0x11, 0x9b, 0xe5, 0x8a, 0xd8, 0xec, 0x4a, 0x2b,
+	0x5e, 0x43, 0xe5, 0x86, 0x2a, 0x54, 0x65, 0x42, 0x31, 0x8f, 0x2c, 0x31, 0x1c, 0x20, 0x70, 0x39,
+	0xef, 0x20, 0xe3, 0xbb, 0xde, 0x6d, 0x49, 0x8e, 0xad, 0xff, 0x58, 0xf9, 0x84, 0xe8, 0xc6, 0xf8,
+	0xaa, 0x2e, 0x02, 0xb3, 0x9e, 0xfb, 0xd9, 0x3c, 0x29, 0x70, 0x21, 0xeb, 0xa5, 0x24, 0xc3, 0x1d,
+	0xbf, 0xf4, 0x78, 0xda, 0xba, 0x2a, 0xe6, 0x6b, 0x2e, 0xed, 0x64, 0x4f, 0xb6, 0xfc, 0x3e, 0x32,
+	0x14, 0xbd, 0xda, 0x14, 0x43, 0xcd, 0xa6, 0x8f, 0x54, 0x5e, 0xbc, 0xca, 0xba, 0xc8, 0xef, 0x27,
+	0xfa, 0x0b, 0x10, 0xa5, 0xd3, 0x24, 0x45, 0xc9, 0x23, 0xc5, 0x76, 0x2f, 0x0f, 0xc4, 0x92, 0x39,
+	0x8d, 0x09, 0x5c, 0x35, 0x75, 0x25, 0x29, 0x0a, 0xee, 0x67, 0xf2, 0xe4, 0xd4, 0xfc, 0x76, 0xa3,
+	0x59, 0xbb, 0x21, 0x10, 0x6c, 0xf8, 0xbb, 0xed, 0x26, 0x95, 0xaf, 0x91, 0x59, 0x9d, 0xd8, 0x4b,
+	0x00, 0xe9, 0x0d, 0x26, 0xe6, 0x30, 0x9b, 0x94, 0x74, 0xa3, 0x1b, 0x5f, 0xf9, 0x0c, 0xed, 0xd9,
+	0x89, 0x94, 0x02, 0x48, 0xa3, 0xee, 0x04, 0xa8, 0x0d, 0x0a, 0x75, 0x54, 0xcc, 0xfe, 0xb3, 0x19,
+	0xa7, 0x47, 0x60, 0x31, 0xd5, 0x41, 0x01, 0x02, 0x4d, 0xc3, 0xfd, 0x6e, 0x8e, 0x9c, 0x99, 0x6f,
+	0x76, 0x22, 0xaa, 0x8b, 0x76, 0x4d, 0xd1, 0xcf, 0x93, 0x22, 0x1e, 0xa6, 0x9a, 0x17, 0x7b, 0x62,
+	0x5a, 0x9e, 0xec, 0xef, 0xe8, 0xad, 0x6d, 0xde, 0xa4, 0x1b, 0x6c, 0x95, 0xfe, 0xd2, 0xba, 0x93,
+	0x86, 0x81, 0xc2, 0x4a, 0x35, 0xa7, 0xe1, 0xa8, 0xed, 0x57, 0xc5, 0x48, 0x57, 0x0e, 0x65, 0xd2,
+	0x2b, 0x14, 0xa1, 0xe6, 0x21, 0xf8, 0x0b, 0x18, 0x11, 0xf7, 0x3f, 0x72, 0xe4, 0xa1, 0x1e, 0x43,
+	0xbd, 0xda, 0x88, 0x62, 0xe7, 0x83, 0x5d, 0xc3, 0x9d, 0xed, 0x6f, 0xb8, 0xd8, 0x9a, 0x0d, 0x56,
+	0xf1, 0x0f, 0x09, 0x31, 0x86, 0xfa, 0x2a, 0x29, 0x34, 0x62, 0x7f, 0x57, 0xea, 0xf8, 0x57, 0x33,
+	0x8d, 0xb5, 0x47, 0xf7, 0xcb, 0x93, 0xd2, 0x8c, 0xb3, 0x82, 0x24, 0x80, 0x53, 0x72, 0xff, 0x2e,
+	0x47, 0xf0, 0x74, 0xd7, 0x1a, 0x42, 0xaf, 0x1a, 0x8e, 0xf7, 0xdb, 0x52, 0x1d, 0x7b, 0x58, 0x4e,
+	0xd0, 0x06, 0x85, 0xdd, 0x79, 0xf3, 0xfc, 0xa4, 0xaa, 0x88, 0x00, 0x60, 0x55, 0x9d, 0x0f, 0x91,
+	0x91, 0x28, 0xf6, 0xe2, 0x4e, 0x24, 0x2e, 0xb4, 0x25, 0xd1, 0x68, 0xa4, 0xc2, 0xa0, 0xb4, 0x59,
+	0x5f, 0xc6, 0xb2, 0x59, 0x85, 0x9b, 0xb7, 0x03, 0x81, 0x15, 0xb5, 0xd3, 0x5d, 0xaa, 0x3d, 0x7a,
+	0x75, 0x5f, 0x70, 0x3e, 0xa5, 0x9d, 0xae, 0x72, 0x30, 0xc8, 0x72, 0xf7, 0xbf, 0xa9, 0xb4, 0xa1,
+	0xae, 0xd1, 0x6b, 0x68, 0x59, 0xb8, 0x66, 0x5e, 0xb8, 0x7c, 0xbd, 0x1e, 0xee, 0xc1, 0xf9, 0x84,
+	0xe4, 0x70, 0xf7, 0xfb, 0xf8, 0xed, 0x64, 0xa2, 0xe6, 0xb7, 0xe9, 0x7d, 0xee, 0xb7, 0xaa, 0x0d,
+	0x9f, 0xaf, 0x13, 0xbd, 0x12, 0x68, 0xfd, 0x89, 0x05, 0x03, 0x0e, 0x56, 0x2d, 0x7a, 0x8b, 0x8f,
+	0x06, 0x9d, 0xb8, 0xdd, 0xa1, 0xc7, 0x95, 0x73, 0xb3, 0xf7, 0x64, 0x53, 0x16, 0x38, 0x0e, 0x3d,
+	0x01, 0x02, 0x00, 0x12, 0xbb, 0xfb, 0xaf, 0x39, 0x72, 0x52, 0xf5, 0xbb, 0xe2, 0xc7, 0xea, 0x94,
+	0xde, 0x22, 0x44, 0x0d, 0x42, 0x1a, 0xad, 0xb2, 0xb1, 0x54, 0x6b, 0x7e, 0xf5, 0xc9, 0x55, 0xe0,
+	0x08, 0x0c, 0x4a, 0xce, 0xfb, 0xc9, 0xc4, 0xad, 0xa0, 0x49, 0xd9, 0xc8, 0x2a, 0x8a, 0x1b, 0x72,
+	0x5f, 0x9f, 0x4f, 0x5b, 0x82, 0xeb, 0xba, 0x5e, 0xf9, 0xa4, 0x40, 0x3b, 0x61, 0x00, 0xe9, 0xa4,
+	0x9a, 0xa8, 0xdc, 0xf7, 0x13, 0x46, 0xb4, 0xd1, 0xea, 0xf8, 0x6b, 0x2d, 0xe7, 0x11, 0x52, 0xf0,
+	0xc3, 0x30, 0x08, 0x85, 0x29, 0x40, 0xed, 0xf5, 0x45, 0x04, 0x02, 0x2f, 0x73, 0x1e, 0xc3, 0xeb,
+	0xb9, 0xd1, 0xf4, 0x6b, 0x6c, 0xab, 0x16, 0xcb, 0x53, 0x72, 0xab, 0x2e, 0x31, 0x28, 0x88, 0x52,
+	0x77, 0x96, 0x8c, 0xce, 0x23, 0x11, 0xba, 0xe0, 0x8f, 0xd8, 0xa6, 0xd0, 0x49, 0xcb, 0x14, 0x2a,
+	0x4d, 0x9e, 0x5f, 0xce, 0x93, 0x89, 0xf9, 0x30, 0x68, 0xc9, 0x23, 0x77, 0x04, 0x4c, 0xb1, 0x6e,
+	0x31, 0xc5, 0x6c, 0x36, 0x30, 0xb3, 0xcb, 0xbd, 0x18, 0x22, 0xbd, 0x6c, 0xe4, 0xf1, 0x1e, 0x1a,
+	0x40, 0x44, 0xb7, 0x48, 0x31, 0x74, 0x7a, 0xf2, 0xed, 0xf3, 0xee, 0x7e, 0x2d, 0x47, 0xa6, 0xcd,
+	0xea, 0x47, 0xc0, 0x76, 0xb7, 0x6c, 0xb6, 0x3b, 0x37, 0xf0, 0x10, 0x7b, 0xf0, 0xda, 0x7f, 0x1a,
+	0xb1, 0x87, 0x86, 0xd3, 0x8c, 0xa6, 0xcd, 0x89, 0x3d, 0x03, 0x20, 0xc6, 0x37, 0x37, 0xd0, 0x3d,
+	0xc7, 0x96, 0xf3, 0xad, 0xf2, 0x14, 0x99, 0xd0, 0x3b, 0x89, 0xdf, 0x60, 0x11, 0x47, 0x79, 0x17,
+	0x7d, 0x0a, 0xb5, 0x4e, 0xd3, 0x17, 0xfc, 0x5c, 0x4d, 0x5c, 0x45, 0xc0, 0x41, 0xd5, 0xa0, 0xcb,
+	0x72, 0x9c, 0x1e, 0xf6, 0x6a, 0x27, 0x0c, 0x29, 0xa7, 0xdb, 0x5f, 0x67, 0x3e, 0x13, 0xc1, 0xa5,
+	0x67, 0x45, 0xb3, 0xe3, 0xf3, 0xc9, 0x0a, 0x77, 0xd2, 0x80, 0xd0, 0x8d, 0x88, 0xdb, 0x25, 0x23,
+	0xe4, 0xa3, 0x4c, 0x19, 0x29, 0x9a, 0x76, 0x49, 0x06, 0x06, 0x59, 0x4e, 0x25, 0xdc, 0x33, 0x74,
+	0xfb, 0x50, 0x81, 0xae, 0x55, 0x5f, 0xf0, 0xbd, 0x5a, 0x93, 0xee, 0x06, 0xaa, 0x75, 0xd0, 0x1b,
+	0x25, 0x62, 0x16, 0xad, 0xa1, 0xf2, 0x43, 0xb4, 0xd9, 0x99, 0x4a, 0x7a, 0x15, 0xe8, 0xd5, 0x96,
+	0xde, 0x6d, 0x33, 0x51, 0xa7, 0x5a, 0xa5, 0xd7, 0xcb, 0x56, 0xa7, 0xf9, 0x42, 0xb0, 0x19, 0x2d,
+	0xd3, 0xcd, 0x43, 0x35, 0xf4, 0xab, 0x8d, 0xdd, 0x46, 0xcc, 0xac, 0x56, 0x85, 0xf2, 0x39, 0x8a,
+	0x79, 0xa6, 0xd2, 0xb3, 0x16, 0xdc, 0x05, 0x83, 0x03, 0xe4, 0x34, 0x67, 0x39, 0x5d, 0xb8, 0x47,
+	0x19, 0xee, 0x19, 0x8a, 0xfb, 0xf4, 0x52, 0x6a, 0x0d, 0xe8, 0xd1, 0x12, 0x57, 0x10, 0x5d, 0x43,
+	0xaf, 0xa1, 0x4b, 0xa4, 0x68, 0xaf, 0xe0, 0x86, 0x80, 0x83, 0xaa, 0xe1, 0xdc, 0xd4, 0x9b, 0x0f,
+	0x0f, 0x85, 0xb0, 0x37, 0x1d, 0x9c, 0x5b, 0x31, 0x3d, 0xf3, 0x86, 0x81, 0x09, 0x0f, 0x16, 0x58,
+	0xb8, 0x9d, 0x9f, 0x22, 0x63, 0x72, 0xe7, 0x44, 0x25, 0xc2, 0x6e, 0x4e, 0xa6, 0x5c, 0xc8, 0x8d,
+	0x45, 0x65, 0x4e, 0x55, 0xee, 0xcc, 0x12, 0xe2, 0xdf, 0xae, 0x52, 0x51, 0x86, 0x4a, 0x04, 0x51,
+	0x69, 0x9c, 0xd5, 0x9e, 0x42, 0x76, 0xb8, 0xa8, 0xa0, 0x60, 0xd4, 0x70, 0xbf, 0x38, 0x44, 0x9c,
+	0x6e, 0x2e, 0xe3, 0x5c, 0x21, 0x23, 0x54, 0xc4, 0x47, 0xab, 0x3b, 0xbf, 0xf4, 0x1e, 0x49, 0xbb,
+	0x7a, 0xf8, 0x38, 0xa8, 0x68, 0xed, 0xe3, 0xf6, 0xf3, 0x35, 0x6b, 0x9a, 0x63, 0x4d, 0x41, 0xa0,
+	0xa0, 0xbc, 0xf0, 0x78, 0xd3, 0x8b, 0x62, 0xd9, 0xdf, 0x1a, 0xce, 0x67, 0x06, 0x7d, 0xf3, 0x14,
+	0x1e, 0x8b, 0xab, 0x49, 0x44, 0xd0, 0x8d, 0x1b, 0x7d, 0x4d, 0x55, 0x29, 0x16, 0x21, 0x03, 0xce,
+	0xee, 0x6b, 0x52, 0xd2, 0x95, 0x75, 0x65, 0x0b, 0xcc, 0x60, 0x50, 0x71, 0xf6, 0x08, 0xd9, 0x14,
+	0x5a, 0xba, 0x8f, 0x07, 0x0f, 0x69, 0xce, 0x67, 0xa2, 0x69, 0x2b, 0xfb, 0x9a, 0x70, 0x59, 0xa1,
+	0x07, 0x83, 0x94, 0xfb, 0xbd, 0x22, 0x19, 0x5d, 0x98, 0xbb, 0xbc, 0xe1, 0x45, 0x3b, 0x7d, 0xf8,
+	0x9c, 0x70, 0x9b, 0x0b, 0xe9, 0x26, 0xc9, 0xa8, 0x94, 0xae, 0xa4, 0x6a, 0xd8, 0x2a, 0xd3, 0xd0,
+	0xfd, 0x57, 0x99, 0x9c, 0x88, 0x8c, 0xc7, 0x86, 0xc2, 0x38, 0x3c, 0x88, 0xe7, 0xd3, 0x50, 0x14,
+	0x99, 0x39, 0xdd, 0x54, 0x10, 0x4d, 0x2a, 0x5d, 0xd2, 0x69, 0xa1, 0x2f, 0xe9, 0xf4, 0x26, 0x19,
+	0xdb, 0xa3, 0xdd, 0x62, 0x37, 0x15, 0xe5, 0x69, 0xb8, 0xde, 0xef, 0xcc, 0xd4, 0x51, 0xc4, 0xa0,
+	0xa7, 0xe5, 0x86, 0xc4, 0x09, 0x1a, 0x3d, 0x1a, 0xc0, 0xf0, 0x07, 0x73, 0x73, 0x32, 0x1e, 0x37,
+	0x66, 0x37, 0x60, 0x05, 0xa0, 0xeb, 0xd0, 0x79, 0x9c, 0xc0, 0x1f, 0x15, 0xff, 0xd5, 0x0e, 0x1e,
+	0x4d, 0x61, 0x6c, 0xcf, 0x68, 0x6c, 0x10, 0x48, 0xf8, 0x8c, 0xdc, 0x30, 0xd0, 0x82, 0x45, 0x04,
+	0x77, 0xdf, 0xde, 0xb6, 0xdf, 0x12, 0xde, 0x30, 0xb5, 0xfb, 0x6e, 0x50, 0x18, 0xb0, 0x12, 0xba,
+	0x9f, 0x98, 0x94, 0xcb, 0x85, 0x4f, 0x61, 0x45, 0x7f, 0x2e, 0xb3, 0x3c, 0xcd, 0xd1, 0x70, 0xf6,
+	0xa6, 0x7f, 0x83, 0x41, 0x02, 0x45, 0xd7, 0xa0, 0xb5, 0x78, 0x9b, 0xde, 0x0c, 0xe3, 0xac, 0x53,
+	0x8a, 0x45, 0xad, 0x31, 0x28, 0x88, 0x52, 0xbc, 0x33, 0xf9, 0xe2, 0x46, 0xa5, 0x09, 0x5b, 0x5b,
+	0xe2, 0x3b, 0x80, 0x2a, 0x0b, 0xa2, 0xdc, 0xf9, 0x25, 0x52, 0xd8, 0x0e, 0x82, 0x9d, 0xa8, 0x34,
+	0xc9, 0xd6, 0x3c, 0x9b, 0x60, 0x27, 0x0e, 0xec, 0xec, 0x32, 0x62, 0x5a, 0x6c, 0xc5, 0xe1, 0x7e,
+	0xf9, 0xbc, 0x94, 0x7d, 0x18, 0x8c, 0xde, 0xf6, 0x53, 0x57, 0x1b, 0x5b, 0x7e, 0x75, 0xbf, 0xda,
+	0xf4, 0x19, 0x04, 0x38, 0xd9, 0x99, 0x5f, 0x20, 0x44, 0xb7, 0x72, 0xa6, 0xb9, 0x8d, 0x9f, 0x1d,
+	0x78, 0x66, 0xd6, 0x77, 0xde, 0x27, 0x45, 0xef, 0xfc, 0x00, 0x16, 0x20, 0x8b, 0xb4, 0x90, 0xd7,
+	0xdf, 0x95, 0x7f, 0x26, 0xe7, 0xfe, 0x55, 0x8e, 0x8c, 0x63, 0xe7, 0x25, 0x87, 0xa0, 0x13, 0x4c,
+	0x85, 0x80, 0xba, 0x2f, 0xcd, 0xb8, 0x6a, 0x82, 0x37, 0x18, 0x14, 0x44, 0xa9, 0xe3, 0x91, 0x42,
+	0x4c, 0x07, 0x2c, 0x65, 0xc5, 0xf7, 0x0c, 0x32, 0x6b, 0x5a, 0x4c, 0xc4, 0x5f, 0x74, 0x62, 0x18,
+	0x66, 0xe7, 0x71, 0x52, 0xc4, 0xbb, 0x7d, 0x89, 0x5e, 0x07, 0x8c, 0x57, 0x15, 0xb9, 0xe5, 0x6a,
+	0x49, 0xc0, 0x40, 0x95, 0xba, 0xef, 0x20, 0x85, 0xc5, 0x5b, 0x94, 0xdf, 0x30, 0xb1, 0x4d, 0x18,
+	0xec, 0x92, 0x66, 0x4a, 0x69, 0xc8, 0x03, 0x55, 0xc3, 0xfd, 0x20, 0x99, 0x5a, 0xbc, 0xed, 0x57,
+	0x3b, 0xf4, 0x7f, 0x6e, 0xd8, 0x73, 0x5e, 0x20, 0x4e, 0xe4, 0x87, 0xb7, 0x1a, 0x55, 0x5f, 0xd8,
+	0x8a, 0xaf, 0x69, 0xee, 0xab, 0x6c, 0xe9, 0x95, 0xae, 0x1a, 0x90, 0xd2, 0xca, 0xfd, 0x03, 0x3a,
+	0xb3, 0x86, 0xeb, 0x09, 0x79, 0x6f, 0x7d, 0xbe, 0x52, 0xee, 0x54, 0x77, 0x94, 0x13, 0xe3, 0xd9,
+	0xac, 0xfe, 0x2c, 0x8e, 0x45, 0xf3, 0x0c, 0x05, 0x02, 0x4d, 0xe3, 0x5e, 0xee, 0xa2, 0xcf, 0xe7,
+	0x88, 0x6e, 0x87, 0xeb, 0xbe, 0xa9, 0xbb, 0x66, 0xac, 0xbb, 0xc0, 0x2b, 0x4a, 0x9d, 0x37, 0x72,
+	0x54, 0xc4, 0xb4, 0x06, 0xab, 0x2d, 0xf2, 0x07, 0x72, 0x9b, 0xc8, 0xe3, 0x71, 0xa6, 0x92, 0x8e,
+	0x0d, 0x7a, 0x91, 0x71, 0xaf, 0x93, 0xc2, 0x65, 0xaf, 0x53, 0xf7, 0xfb, 0x52, 0x4a, 0x71, 0x17,
+	0x85, 0xbe, 0xd7, 0x8c, 0xa5, 0x8c, 0x22, 0x76, 0x11, 0x08, 0x18, 0xa8, 0x52, 0xf7, 0x8f, 0x87,
+	0xe9, 0x82, 0x69, 0x0f, 0x34, 0xb2, 0xbf, 0xd0, 0x6f, 0x07, 0xc9, 0xcb, 0x17, 0x9d, 0x78, 0xc0,
+	0x4a, 0x70, 0xbb, 0x85, 0xfe, 0xad, 0x06, 0x0b, 0x00, 0x48, 0x5c, 0xbe, 0x20, 0xe0, 0xa0, 0x6a,
+	0x38, 0xe7, 0x49, 0x81, 0xf2, 0x9c, 0x78, 0x9b, 0x6d, 0xe6, 0x61, 0xee, 0x29, 0x5c, 0x40, 0x00,
+	0x70, 0x38, 0x56, 0xd8, 0xf2, 0xe3, 0xea, 0x36, 0x93, 0x36, 0xc6, 0x78, 0x85, 0x25, 0x04, 0x00,
+	0x87, 0xa7, 0x38, 0xc3, 0x0a, 0xf7, 0xdf, 0x19, 0x36, 0x72, 0xc8, 0xce, 0x30, 0xa7, 0x4d, 0x4e,
+	0x44, 0xd1, 0xf6, 0x7a, 0xd8, 0xb8, 0x45, 0x19, 0x8e, 0xde, 0x3d, 0xa3, 0x07, 0xa1, 0xc3, 0x2c,
+	0xc5, 0x95, 0xca, 0x72, 0x12, 0x0b, 0xa4, 0xa1, 0x76, 0x2a, 0xe4, 0x54, 0xa3, 0x15, 0xd1, 0x93,
+	0x1e, 0xfa, 0x2b, 0xf5, 0x16, 0x45, 0xba, 0x1c, 0x44, 0x88, 0x4e, 0x04, 0x85, 0x48, 0xfb, 0xde,
+	0xa9, 0x95, 0xb4, 0x4a, 0x90, 0xde, 0xd6, 0xfd, 0x32, 0x55, 0x58, 0x4d, 0xa7, 0x3b, 0xbd, 0xa3,
+	0xc9, 0x36, 0xfd, 0xcd, 0x59, 0x89, 0x38, 0xe1, 0xcf, 0x65, 0xf6, 0xe5, 0x73, 0x34, 0x5a, 0x5a,
+	0xd4, 0x30, 0x30, 0xc8, 0xf4, 0x11, 0x73, 0x44, 0x4f, 0xc9, 0x56, 0x10, 0x56, 0x7d, 0xc1, 0x43,
+	0xd5, 0x29, 0x59, 0x42, 0x20, 0xf0, 0x32, 0x34, 0x6d, 0x1b, 0x14, 0x9c, 0x0f, 0x93, 0x49, 0xa4,
+	0x71, 0x25, 0xdc, 0xb4, 0x46, 0x53, 0xce, 0x3c, 0x1a, 0x85, 0xa9, 0x7c, 0x4a, 0xd0, 0x9f, 0xb4,
+	0xc0, 0x60, 0xd3, 0x43, 0x1d, 0xc9, 0xab, 0xd5, 0x30, 0x5c, 0x46, 0x59, 0x17, 0x99, 0x8e, 0x34,
+	0x27, 0x81, 0xa0, 0xcb, 0xf1, 0x18, 0x62, 0x94, 0x03, 0xee, 0x6c, 0xa1, 0x75, 0xab, 0x63, 0x88,
+	0x44, 0x10, 0x0e, 0xaa, 0x86, 0xfb, 0x89, 0x61, 0x62, 0xd3, 0x76, 0x6a, 0xe4, 0xd8, 0x0e, 0xfd,
+	0xc1, 0xbc, 0x23, 0x59, 0x9c, 0xcc, 0x27, 0xd0, 0xbb, 0x7d, 0xc5, 0xc6, 0x00, 0x49, 0x94, 0x82,
+	0x0a, 0x6d, 0x17, 0x7b, 0x9b, 0x59, 0x18, 0xa6, 0xa4, 0x62, 0x62, 0x80, 0x24, 0x4a, 0x74, 0x92,
+	0x51, 0x90, 0x3c, 0xe4, 0x49, 0x27, 0xd9, 0x15, 0x5d, 0x04, 0x66, 0x3d, 0x9c, 0x42, 0xfa, 0x13,
+	0x99, 0xa2, 0x0c, 0x3f, 0x53, 0x53, 0x78, 0x45, 0xc0, 0x41, 0xd5, 0xa0, 0x27, 0xd8, 0xd9, 0x91,
+	0xb3, 0xa7, 0x5c, 0x62, 0x82, 0x17, 0xf5, 0xef, 0x51, 0x3b, 0x8d, 0x97, 0xe9, 0x95, 0x2e, 0x3c,
+	0x90, 0x82, 0xdb, 0x79, 0x3f, 0x39, 0x43, 0xa1, 0xe2, 0xaa, 0xa0, 0xe7, 0x9b, 0x8a, 0xec, 0x6d,
+	0x2b, 0xee, 0x4c, 0x5d, 0x27, 0x57, 0xd2, 0xab, 0x41, 0xaf, 0xf6, 0xee, 0x3f, 0xe7, 0x09, 0x0b,
+	0x04, 0xc2, 0x2b, 0x90, 0xea, 0xa7, 0xdb, 0x41, 0x2d, 0x79, 0x05, 0xae, 0x32, 0x28, 0x88, 0x52,
+	0x19, 0x4f, 0x91, 0xef, 0x11, 0x4f, 0x71, 0x93, 0x8c, 0x6e, 0xfb, 0x5e, 0x0d, 0x0d, 0xcc, 0x5c,
+	0x53, 0x7d, 0x2e, 0x73, 0xb4, 0xd2, 0x32, 0xc3, 0xa3, 0x65, 0x57, 0xfe, 0x9b, 0xca, 0xae, 0x82,
+	0x00, 0xf3, 0xd7, 0x07, 0xb5, 0xfd, 0x64, 0xc4, 0x60, 0x99, 0xc2, 0x80, 0x95, 0x38, 0xef, 0x22,
+	0x53, 0x78, 0xb9, 0x05, 0x9d, 0xd8, 0x36, 0x04, 0x31, 0x46, 0xbd, 0x61, 0x95, 0x40, 0xa2, 0xa6,
+	0xb3, 0x40, 0xa6, 0x85, 0xd1, 0x46, 0xe9, 0xc8, 0x62, 0xb6, 0x55, 0x94, 0x60, 0x25, 0x51, 0x0e,
+	0x5d, 0x2d, 0xdc, 0xb7, 0x51, 0x36, 0x69, 0x44, 0x5e, 0xdd, 0x23, 0x1c, 0x05, 0x43, 0x25, 0x88,
+	0x1e, 0x7b, 0x1f, 0x1a, 0xf0, 0x23, 0xa6, 0x7c, 0xdc, 0x4b, 0x0a, 0x08, 0xc9, 0x18, 0xfb, 0x07,
+	0xdd, 0xe4, 0x42, 0xf1, 0x5d, 0x1c, 0x70, 0x59, 0x2a, 0x41, 0x87, 0x72, 0x4e, 0xce, 0x96, 0xae,
+	0x4b, 0xdc, 0xa0, 0xc9, 0xb8, 0x01, 0x99, 0x4e, 0xd6, 0x76, 0x5e, 0x26, 0x13, 0x91, 0x3c, 0xd9,
+	0xda, 0x83, 0xda, 0x27, 0x07, 0x60, 0xfa, 0x5a, 0xc5, 0x68, 0x0e, 0x16, 0x32, 0xf7, 0x53, 0x54,
+	0xa2, 0x63, 0x36, 0xb0, 0x3a, 0xaa, 0x8c, 0x6a, 0x5e, 0x86, 0xee, 0x32, 0x2f, 0x5b, 0x64, 0x94,
+	0x0b, 0x76, 0x91, 0x30, 0x71, 0xbc, 0x3b, 0x9b, 0x39, 0x80, 0x05, 0x4c, 0xeb, 0x8d, 0xca, 0x85,
+	0x46, 0xba, 0x51, 0x05, 0x72, 0xf7, 0xdf, 0x72, 0x64, 0x64, 0xa5, 0x85, 0xce, 0x99, 0x1f, 0x89,
+	0xc0, 0xe1, 0x55, 0x32, 0x8c, 0x8a, 0xbe, 0x1d, 0x41, 0x3e, 0x51, 0x7e, 0xd4, 0x8c, 0x1e, 0x2f,
+	0xd9, 0xd1, 0xe3, 0xe0, 0xed, 0x49, 0x47, 0x9e, 0x50, 0xcf, 0x8a, 0x9f, 0xfa, 0xc3, 0xf3, 0x0f,
+	0xbc, 0xf1, 0xf5, 0x0b, 0x0f, 0xb8, 0x5f, 0xcd, 0x93, 0x49, 0x4b, 0x83, 0xb3, 0xcc, 0x3e, 0xb9,
+	0x83, 0x99, 0x7d, 0xf2, 0x47, 0x6f, 0xf6, 0x19, 0x3a, 0x12, 0xb3, 0xcf, 0x25, 0x34, 0x95, 0xaa,
+	0x80, 0xdc, 0x61, 0x3b, 0x1c, 0xd9, 0x08, 0xc6, 0x35, 0x6a, 0xb9, 0x4d, 0x32, 0x7c, 0xb5, 0xd1,
+	0xda, 0xe9, 0x8f, 0xcd, 0x44, 0xd5, 0xa0, 0xdd, 0xc5, 0x66, 0x2a, 0x08, 0x04, 0x5e, 0x26, 0x79,
+	0xdb, 0x50, 0x0f, 0xde, 0xf6, 0xa7, 0x39, 0x72, 0x7c, 0xd5, 0xdf, 0x0d, 0x1a, 0xaf, 0x79, 0xda,
+	0xc3, 0x8b, 0x8d, 0xb6, 0x1b, 0xb1, 0xf0, 0xd8, 0xa9, 0x46, 0xcb, 0x18, 0xfa, 0x4a, 0xe1, 0xf7,
+	0x50, 0xe3, 0x58, 0x2c, 0x15, 0x4a, 0x19, 0xd7, 0xf4, 0x75, 0xaf, 0x7d, 0xb7, 0xb2, 0x00, 0x74,
+	0x1d, 0xd5, 0x00, 0x7d, 0xd7, 0x62, 0x96, 0xec, 0x06, 0xcc, 0xa9, 0xad, 0xeb, 0xb8, 0x7f, 0x92,
+	0x23, 0xa3, 0xbc, 0xd7, 0xbe, 0xec, 0x4c, 0xae, 0x47, 0x67, 0x5e, 0x26, 0x05, 0xd6, 0x4e, 0x6c,
+	0xb2, 0x77, 0x65, 0x33, 0x05, 0xb1, 0x28, 0x15, 0xa6, 0xfd, 0xb0, 0x7f, 0x81, 0xe3, 0x64, 0xf7,
+	0xb3, 0x77, 0x7b, 0x4e, 0x39, 0xc0, 0xf5, 0xfd, 0xcc, 0xa0, 0x20, 0x4a, 0xdd, 0x37, 0x86, 0x48,
+	0x51, 0x9a, 0xde, 0x9d, 0x8f, 0x51, 0x2d, 0xdc, 0x6b, 0xb5, 0x82, 0xd8, 0xe3, 0xc6, 0x63, 0xce,
+	0x6f, 0xae, 0x65, 0xea, 0x98, 0x44, 0x3a, 0x3b, 0xa7, 0x11, 0x72, 0x5b, 0x8f, 0x12, 0xb0, 0x8c,
+	0x12, 0x30, 0xe9, 0x3a, 0xaf, 0x92, 0x91, 0xa6, 0xb7, 0xe9, 0x37, 0x25, 0xfb, 0x59, 0x19, 0xac,
+	0x07, 0x57, 0x19, 0x2e, 0x4e, 0x5c, 0xcd, 0x03, 0x07, 0x82, 0x20, 0x34, 0xf3, 0x2c, 0x99, 0x4e,
+	0x76, 0x34, 0xc5, 0xbc, 0x74, 0xd2, 0xba, 0x3e, 0x0d, 0xd3, 0xd0, 0xcc, 0x3b, 0xc9, 0xb8, 0x41,
+	0xe6, 0x20, 0x4d, 0xdd, 0x17, 0xc9, 0x38, 0xed, 0x6a, 0xd8, 0xa8, 0x32, 0x04, 0xf7, 0xda, 0x35,
+	0xfd, 0xdc, 0xe0, 0xee, 0x6b, 0xb8, 0x09, 0x11, 0x65, 0x84, 0x56, 0x47, 0x3a, 0x5b, 0x28, 0x8d,
+	0xf9, 0x1d, 0xb9, 0xa2, 0xd9, 0x84, 0xac, 0x75, 0x85, 0x86, 0x5b, 0x1d, 0xf5, 0x6f, 0x30, 0x48,
+	0xb8, 0x37, 0x48, 0x61, 0xb5, 0x13, 0xfb, 0xb7, 0xfb, 0xb3, 0xc7, 0xe3, 0x02, 0x6d, 0x7a, 0x91,
+	0x34, 0x37, 0xe8, 0x40, 0x39, 0x01, 0x07, 0x55, 0xc3, 0xa5, 0xe2, 0x00, 0x43, 0xbc, 0x1c, 0x34,
+	0xf1, 0x26, 0xc0, 0x99, 0xd8, 0xc5, 0xdf, 0x49, 0x8b, 0x06, 0xab, 0x04, 0xbc, 0x0c, 0xcf, 0xc1,
+	0x36, 0xad, 0xaf, 0x42, 0x27, 0xd5, 0xfa, 0x2f, 0x33, 0x28, 0x88, 0x52, 0x8c, 0x82, 0x18, 0x67,
+	0x0d, 0x05, 0x9f, 0x69, 0x52, 0xc1, 0x94, 0xd3, 0x11, 0x73, 0x96, 0xcd, 0xb7, 0x6a, 0x76, 0xd8,
+	0x10, 0x4d, 0x39, 0x00, 0x24, 0x09, 0xa4, 0xb6, 0xe7, 0x35, 0xd0, 0x9b, 0x38, 0x90, 0x3b, 0x39,
+	0x9d, 0xda, 0x0d, 0x8e, 0x19, 0x24, 0x09, 0xf7, 0x3b, 0x53, 0x84, 0x60, 0x24, 0x86, 0x18, 0xea,
+	0x0c, 0xc9, 0x37, 0xa4, 0x18, 0xaf, 0xc2, 0x1a, 0x57, 0x16, 0x80, 0x42, 0xd5, 0x1a, 0xe6, 0x7b,
+	0xae, 0x21, 0xd5, 0xa1, 0x6a, 0x8d, 0x88, 0xde, 0x2b, 0xfb, 0xd7, 0x52, 0x74, 0xa8, 0x05, 0x5d,
+	0x04, 0x66, 0x3d, 0xba, 0xf4, 0x3c, 0x68, 0x68, 0xd8, 0x12, 0x91, 0x65, 0xd0, 0x50, 0x11, 0xbb,
+	0x67, 0xc4, 0x0b, 0x3d, 0x43, 0x26, 0xe4, 0xe5, 0xc5, 0xa8, 0x14, 0x58, 0x2b, 0x15, 0xf1, 0xb1,
+	0x61, 0x94, 0x81, 0x55, 0x33, 0x79, 0xb9, 0x8e, 0x1c, 0xc9, 0xe5, 0x8a, 0xba, 0x00, 0x95, 0x41,
+	0xfd, 0x9a, 0xac, 0xb1, 0xb2, 0x50, 0x72, 0x12, 0xba, 0x40, 0xa2, 0x1c, 0xba, 0x5a, 0x38, 0xeb,
+	0xe4, 0x64, 0x32, 0x92, 0x8f, 0x0d, 0xfe, 0x04, 0xc3, 0x74, 0x56, 0x60, 0x3a, 0x79, 0x23, 0xa5,
+	0x0e, 0xa4, 0xb6, 0xa4, 0x12, 0xd6, 0xa4, 0xec, 0x26, 0xbb, 0x89, 0x4b, 0x27, 0x19, 0x2a, 0x65,
+	0x65, 0xd8, 0x30, 0x0b, 0xc1, 0xae, 0xeb, 0x3c, 0x49, 0x0a, 0x74, 0x1a, 0x22, 0x5f, 0xb8, 0x60,
+	0xa4, 0x85, 0xb7, 0xb0, 0x8e, 0x40, 0xba, 0x66, 0x63, 0xb8, 0x66, 0xec, 0x07, 0xf0, 0x8a, 0x28,
+	0x63, 0x6c, 0x52, 0x51, 0xb7, 0xe6, 0x85, 0xfb, 0x74, 0x02, 0x8a, 0xb6, 0x8c, 0x51, 0x56, 0x25,
+	0x60, 0xd4, 0x32, 0x23, 0xb7, 0xc6, 0xee, 0x1e, 0xb9, 0x45, 0xef, 0xcf, 0x31, 0xe6, 0x83, 0xf7,
+	0x6b, 0x73, 0xb1, 0x70, 0xa7, 0x1c, 0xc4, 0xa3, 0xaa, 0xee, 0xf1, 0x8a, 0x44, 0x02, 0x1a, 0x9f,
+	0xf3, 0x21, 0x42, 0xb6, 0x1a, 0xad, 0x46, 0xb4, 0xcd, 0xb0, 0x8f, 0x1f, 0x18, 0xbb, 0x1a, 0xe7,
+	0x92, 0xc2, 0x02, 0x06, 0x46, 0xe7, 0x7b, 0x54, 0xba, 0xa1, 0x72, 0x15, 0x53, 0x74, 0x22, 0x15,
+	0xa6, 0x7b, 0x8a, 0x1d, 0xfe, 0xeb, 0x19, 0x9f, 0x23, 0xca, 0x13, 0x3d, 0x0b, 0x49, 0xc4, 0xfc,
+	0xee, 0xf3, 0x65, 0x78, 0x45, 0x57, 0xf9, 0x9d, 0x34, 0xe0, 0x47, 0xbf, 0x71, 0xfe, 0x7c, 0xf7,
+	0x0b, 0x58, 0x85, 0x1c, 0x77, 0xd4, 0xaf, 0x7f, 0xe3, 0xfc, 0xb4, 0xfc, 0xad, 0x02, 0x8a, 0xbb,
+	0xc7, 0x85, 0xac, 0xba, 0x1d, 0xd4, 0x56, 0xd6, 0x85, 0x7f, 0x49, 0xb1, 0xea, 0x75, 0x04, 0x02,
+	0x2f, 0x43, 0xe3, 0x73, 0xcd, 0xa3, 0xa2, 0x53, 0xcb, 0xaf, 0x95, 0x26, 0xb5, 0xf1, 0x79, 0x41,
+	0xc0, 0x40, 0x95, 0x3a, 0xaf, 0x90, 0x91, 0x06, 0xd3, 0x8f, 0x4a, 0x53, 0x6c, 0x61, 0xb2, 0xe9,
+	0x61, 0x5c, 0xc5, 0xe2, 0xd1, 0xd3, 0xfc, 0x7f, 0x10, 0x68, 0x9d, 0xaa, 0x0e, 0xbe, 0x3b, 0x76,
+	0x08, 0xc1, 0x77, 0xe3, 0x69, 0x81, 0x77, 0x38, 0xde, 0x2a, 0x46, 0x10, 0x87, 0x7e, 0xab, 0x34,
+	0xcd, 0xac, 0x76, 0x6c, 0xbc, 0xf3, 0x02, 0x06, 0xaa, 0xd4, 0xf9, 0x59, 0x32, 0x49, 0x1b, 0xb1,
+	0x53, 0x82, 0xab, 0x1c, 0x95, 0x8e, 0xb3, 0xea, 0xc7, 0xf1, 0xcc, 0xae, 0x99, 0x05, 0x60, 0xd7,
+	0x43, 0xbe, 0xb9, 0x1d, 0x44, 0x31, 0xfe, 0x60, 0xac, 0xe3, 0xb4, 0xcd, 0x37, 0x97, 0x8d, 0x32,
+	0xb0, 0x6a, 0x62, 0x84, 0xd1, 0xf1, 0xdd, 0xa4, 0xf4, 0x5d, 0x3a, 0xc3, 0x26, 0x63, 0x29, 0xa3,
+	0x38, 0x96, 0xc0, 0xc6, 0x63, 0x1a, 0xba, 0xc0, 0xd0, 0x4d, 0x17, 0x19, 0x57, 0xd5, 0x0c, 0xb6,
+	0x2e, 0x95, 0x6c, 0xc6, 0x65, 0x45, 0x62, 0x83, 0x5d, 0x17, 0x65, 0xf8, 0x78, 0x3b, 0x0c, 0xe2,
+	0x18, 0x63, 0x13, 0x1e, 0x64, 0x1b, 0x4b, 0x9d, 0xfd, 0x0d, 0x59, 0x00, 0xba, 0xce, 0xcc, 0x02,
+	0x39, 0x9d, 0x7e, 0x82, 0xee, 0x25, 0xd6, 0x0d, 0x99, 0x62, 0xdd, 0x14, 0x99, 0x30, 0x5f, 0x08,
+	0x33, 0x17, 0x97, 0xf1, 0x74, 0x0b, 0xf5, 0xcc, 0xa0, 0x72, 0x18, 0x2e, 0xae, 0xb5, 0x4a, 0x97,
+	0x8b, 0x4b, 0x81, 0x40, 0xd3, 0xb8, 0x97, 0x8b, 0xeb, 0xcf, 0xf2, 0x44, 0xb7, 0x3b, 0xe0, 0x1b,
+	0x15, 0xed, 0x10, 0xcb, 0xdf, 0xd5, 0x21, 0xb6, 0x4d, 0x8e, 0x79, 0xcc, 0xe2, 0x95, 0xf1, 0x65,
+	0x8a, 0x7e, 0x1e, 0x65, 0x63, 0x81, 0x24, 0x5a, 0xa4, 0x14, 0xe9, 0xe6, 0x07, 0x7f, 0x9c, 0xa2,
+	0x28, 0x55, 0x6c, 0x2c, 0x90, 0x44, 0xeb, 0xfe, 0x45, 0x9e, 0xc8, 0xb3, 0xfd, 0xa3, 0x60, 0xae,
+	0x71, 0x5c, 0x32, 0x42, 0x19, 0x7c, 0xa7, 0x19, 0x0b, 0x59, 0x8f, 0xf1, 0x4f, 0x60, 0x10, 0x10,
+	0x25, 0xc8, 0xda, 0xfc, 0xdb, 0x8d, 0x78, 0x1e, 0x9f, 0x6f, 0x0b, 0x73, 0x2b, 0xdb, 0x39, 0x02,
+	0x06, 0xaa, 0xd4, 0xdd, 0x23, 0x93, 0x38, 0xae, 0x66, 0xd3, 0x6f, 0x56, 0x62, 0xbf, 0x1d, 0x61,
+	0x5c, 0x65, 0x84, 0xff, 0x0c, 0x24, 0x76, 0xeb, 0x80, 0x2e, 0xbf, 0x6d, 0x58, 0x1f, 0x10, 0x2f,
+	0x70, 0xf4, 0xee, 0x1d, 0xba, 0xdd, 0xd5, 0x8c, 0xf6, 0xa1, 0xab, 0xdc, 0xc0, 0x20, 0x89, 0x2d,
+	0x0f, 0xc7, 0x9d, 0xf5, 0x9d, 0xce, 0x38, 0x0f, 0xa9, 0x60, 0x48, 0x40, 0x62, 0x73, 0x5e, 0x34,
+	0x4d, 0x8f, 0x59, 0xd0, 0x8e, 0x75, 0x19, 0x2a, 0x77, 0x4c, 0x03, 0xee, 0xf0, 0x00, 0xac, 0x45,
+	0x99, 0x6a, 0x7b, 0x5b, 0x6e, 0x13, 0x0f, 0xdb, 0x0b, 0xfd, 0x3c, 0x6c, 0x77, 0x97, 0x08, 0x5e,
+	0xfd, 0x97, 0xe7, 0x9d, 0xf7, 0x92, 0x62, 0x24, 0x18, 0xa4, 0x98, 0xfb, 0xb7, 0xa8, 0x18, 0x04,
+	0x01, 0xc7, 0x37, 0x04, 0xac, 0xb2, 0x04, 0x80, 0x6a, 0xe2, 0x7e, 0x7c, 0x98, 0x18, 0x6a, 0x68,
+	0x1f, 0xab, 0x58, 0x4b, 0x58, 0x16, 0x9e, 0xcf, 0x6a, 0x59, 0x90, 0xea, 0x3a, 0xdf, 0xfe, 0xb6,
+	0x31, 0x01, 0xfb, 0xb1, 0xed, 0x37, 0xdb, 0xe2, 0x80, 0xa8, 0x7e, 0x2c, 0x53, 0x18, 0xb0, 0x12,
+	0x15, 0x2d, 0x34, 0xdc, 0x33, 0x5a, 0xe8, 0x65, 0x52, 0xa8, 0xa3, 0xe3, 0x5e, 0x78, 0x8a, 0xb2,
+	0x59, 0x87, 0x98, 0xeb, 0x9f, 0x6f, 0x10, 0xf6, 0x2f, 0x70, 0x9c, 0xb8, 0x41, 0xb6, 0xa5, 0xed,
+	0x5b, 0xe8, 0x44, 0xd9, 0x36, 0x88, 0xb2, 0xa0, 0xf3, 0x0d, 0xa2, 0x7e, 0x82, 0xc6, 0x8f, 0xc2,
+	0x54, 0x95, 0x47, 0xc6, 0x0b, 0xb7, 0xf5, 0x7b, 0x32, 0x06, 0x3d, 0x31, 0x1c, 0xfc, 0x14, 0x89,
+	0x1f, 0x20, 0x31, 0xbb, 0x17, 0xc9, 0xb8, 0xf1, 0xe8, 0x1a, 0xe7, 0x57, 0xc5, 0x7d, 0x1b, 0xf3,
+	0x8b, 0x56, 0x05, 0x60, 0x25, 0xee, 0xa7, 0x87, 0x88, 0x12, 0x5d, 0xcd, 0x80, 0x1e, 0x0c, 0xdb,
+	0x54, 0xef, 0xf6, 0xac, 0xa0, 0x4e, 0x2a, 0xda, 0x8a, 0x52, 0x94, 0x47, 0x76, 0xfd, 0xb0, 0xae,
+	0x2e, 0x77, 0x71, 0xed, 0x29, 0x79, 0x64, 0xd5, 0x2c, 0x04, 0xbb, 0x2e, 0x5e, 0xad, 0xbb, 0x5e,
+	0xab, 0xb1, 0xe5, 0x47, 0x71, 0xd2, 0x03, 0xbb, 0x2a, 0xe0, 0xa0, 0x6a, 0x38, 0x97, 0xc9, 0xf1,
+	0xc8, 0x8f, 0xd7, 0xf6, 0xf0, 0x51, 0x89, 0x0c, 0x36, 0x15, 0xa1, 0xcd, 0x0f, 0x4a, 0x79, 0xbe,
+	0x92, 0xac, 0x00, 0xdd, 0x6d, 0x52, 0x1d, 0x54, 0x85, 0x83, 0x3a, 0xa8, 0x10, 0x0b, 0x46, 0x12,
+	0x75, 0x42, 0xbf, 0xa7, 0x9b, 0x6b, 0x29, 0x51, 0x0e, 0x5d, 0x2d, 0x58, 0xf0, 0x46, 0xd3, 0xab,
+	0x47, 0x74, 0x43, 0xe8, 0xe0, 0x0d, 0x04, 0x00, 0x87, 0xbb, 0x9f, 0xc9, 0x91, 0x49, 0xa0, 0xa7,
+	0x6c, 0x7f, 0x6e, 0x0b, 0x95, 0xa6, 0x78, 0xdf, 0xf9, 0xcd, 0x1c, 0x99, 0x6e, 0xd1, 0x1b, 0x63,
+	0xae, 0x15, 0x37, 0x24, 0x50, 0x88, 0x4d, 0x2f, 0x64, 0x7b, 0xa3, 0x8f, 0xe8, 0xaf, 0x25, 0x30,
+	0xf2, 0x98, 0xe4, 0x24, 0x14, 0xba, 0x28, 0xbb, 0x67, 0xc8, 0xa9, 0x54, 0x04, 0xee, 0x9f, 0x0f,
+	0x89, 0x9e, 0xab, 0xf5, 0xa6, 0x0c, 0xbe, 0xc9, 0xe2, 0xb3, 0x73, 0x83, 0x30, 0x78, 0x1e, 0xc0,
+	0xcd, 0x31, 0xd1, 0x55, 0x18, 0x0f, 0x91, 0x86, 0x88, 0x9e, 0xe7, 0xbb, 0xcf, 0x95, 0x46, 0x17,
+	0xd0, 0x45, 0x77, 0xec, 0x9f, 0x60, 0x36, 0xa3, 0x92, 0xc3, 0xe8, 0x26, 0x7f, 0xb2, 0x3a, 0xd0,
+	0x13, 0x23, 0xf1, 0xec, 0x95, 0xb9, 0xa8, 0xe5, 0x1b, 0xd8, 0x3b, 0xfa, 0x5f, 0x90, 0x44, 0xd8,
+	0x0b, 0x4d, 0xb9, 0x72, 0xc3, 0x03, 0xc4, 0x48, 0x58, 0x1b, 0x43, 0xbc, 0xd0, 0x94, 0x2b, 0xa5,
+	0x28, 0x24, 0x3c, 0x1c, 0x85, 0xbe, 0x3c, 0x1c, 0x9f, 0xca, 0x11, 0xa2, 0x33, 0x76, 0x50, 0x36,
+	0x59, 0x8c, 0x9e, 0xb6, 0x24, 0xf4, 0x8c, 0x41, 0xa4, 0x02, 0x89, 0x11, 0x60, 0x27, 0x20, 0xa0,
+	0x08, 0xdc, 0x4b, 0x3c, 0xff, 0xee, 0x10, 0x51, 0xad, 0xee, 0x93, 0x74, 0xfe, 0x18, 0x4a, 0x76,
+	0x75, 0xfd, 0x5c, 0x58, 0xd5, 0x03, 0x06, 0x05, 0x51, 0x8a, 0xd2, 0x9d, 0x8c, 0xf2, 0x11, 0x9c,
+	0x88, 0xad, 0x81, 0x0c, 0x08, 0x02, 0x55, 0x9a, 0x26, 0xef, 0x17, 0x8e, 0x4c, 0xde, 0x1f, 0xb9,
+	0x2f, 0xf2, 0x3e, 0x5a, 0xa8, 0xc2, 0xa0, 0xe9, 0xcf, 0xc1, 0x35, 0x61, 0x09, 0x53, 0x16, 0x2a,
+	0xe0, 0x60, 0x90, 0xe5, 0x68, 0x1b, 0xed, 0x44, 0x7e, 0x65, 0xe1, 0xca, 0x7c, 0xe8, 0xd7, 0x22,
+	0x11, 0x40, 0xa5, 0x6c, 0xa3, 0x2f, 0xe9, 0x22, 0x30, 0xeb, 0xb9, 0x74, 0x75, 0xe5, 0x1b, 0xe7,
+	0x3e, 0x72, 0xdd, 0xfc, 0x6a, 0x8e, 0x4c, 0x55, 0xaa, 0x61, 0xa3, 0xad, 0x5f, 0xee, 0x1d, 0xf6,
+	0x0b, 0x46, 0xba, 0x31, 0xf8, 0x45, 0x99, 0xdc, 0x40, 0xdc, 0x51, 0x0f, 0xa2, 0xd4, 0xbd, 0x49,
+	0xa6, 0x2b, 0xfe, 0xae, 0xd7, 0xde, 0x66, 0x91, 0x5f, 0xdc, 0xb4, 0x4d, 0xb5, 0xef, 0x48, 0xc2,
+	0x92, 0x89, 0x3b, 0x54, 0x65, 0xd0, 0x75, 0x9c, 0x47, 0xb9, 0xe5, 0x1d, 0x15, 0x28, 0x1e, 0xcb,
+	0x34, 0x2e, 0x4d, 0xe6, 0x3c, 0x9a, 0x83, 0xff, 0xe3, 0xfe, 0x4b, 0x8e, 0x4c, 0xe8, 0xf6, 0xfe,
+	0x96, 0x53, 0x27, 0xc7, 0xaa, 0x46, 0xe4, 0x8c, 0x0e, 0x18, 0xe8, 0x3f, 0xc8, 0x86, 0x45, 0x0d,
+	0xcd, 0xdb, 0x48, 0x20, 0x89, 0x95, 0xb2, 0x4d, 0xdb, 0x6b, 0x91, 0x35, 0xf7, 0x47, 0x65, 0xbf,
+	0x55, 0x55, 0x6e, 0x0e, 0x7f, 0x4b, 0x5a, 0xbb, 0xba, 0xfc, 0x1e, 0xff, 0x99, 0x23, 0xc7, 0xd4,
+	0x48, 0x85, 0x41, 0xa4, 0x9d, 0x74, 0x4f, 0x2c, 0x66, 0x8c, 0x6e, 0xb7, 0x57, 0xeb, 0x2e, 0x2e,
+	0x8a, 0x76, 0xd2, 0x45, 0x71, 0xd8, 0x14, 0xbb, 0xdc, 0x14, 0x7f, 0x94, 0xa7, 0xe7, 0x40, 0x86,
+	0xd7, 0xd3, 0x4b, 0x94, 0x89, 0x7a, 0x83, 0x5d, 0xa2, 0x4c, 0x6c, 0x04, 0x8e, 0x09, 0x51, 0x32,
+	0x7b, 0x6f, 0x66, 0x7d, 0x6e, 0x8c, 0x2b, 0x95, 0x14, 0x05, 0x70, 0x4c, 0xce, 0x15, 0x32, 0x84,
+	0x2f, 0xcf, 0xb2, 0x6a, 0x72, 0x2c, 0xa1, 0x01, 0x65, 0xe0, 0x80, 0x58, 0xd8, 0xcb, 0xd3, 0x20,
+	0xdc, 0xf5, 0x62, 0xa1, 0x25, 0xe8, 0x97, 0xa7, 0x0c, 0x0a, 0xa2, 0xd4, 0xfd, 0xad, 0x3c, 0x19,
+	0xa9, 0x74, 0x36, 0x51, 0x2e, 0xf8, 0xbd, 0x23, 0xca, 0x3d, 0xf0, 0x90, 0xe8, 0x4a, 0xff, 0xf9,
+	0x07, 0x76, 0x0e, 0x3f, 0xaa, 0x62, 0xb2, 0x67, 0xee, 0x81, 0xbf, 0xa1, 0x6a, 0x21, 0x9f, 0x91,
+	0xb5, 0x76, 0xdc, 0x8f, 0x5a, 0xf8, 0x0c, 0x99, 0x90, 0x99, 0x0d, 0xaf, 0x69, 0x77, 0x97, 0xb2,
+	0x93, 0x5e, 0x36, 0xca, 0xc0, 0xaa, 0xc9, 0xa4, 0x0c, 0x34, 0x0d, 0xf2, 0xbb, 0x38, 0x19, 0x47,
+	0xa1, 0x4a, 0xc0, 0xa8, 0x85, 0xcf, 0xd4, 0x0c, 0x2b, 0x51, 0x41, 0x3f, 0x53, 0xeb, 0x61, 0xe1,
+	0xa1, 0xda, 0x86, 0xfa, 0xb5, 0xd4, 0x68, 0xca, 0x28, 0x3e, 0xa5, 0x6d, 0xac, 0x9b, 0x85, 0x60,
+	0xd7, 0xc5, 0xec, 0x6b, 0x76, 0x6c, 0xb8, 0xb8, 0xb5, 0x54, 0xf6, 0x35, 0x3b, 0xa4, 0x1c, 0x12,
+	0xb5, 0x71, 0x17, 0xd6, 0xc2, 0x7d, 0xe8, 0xb4, 0xc4, 0xf5, 0xa5, 0x76, 0xe1, 0x02, 0x83, 0x82,
+	0x28, 0xc5, 0x29, 0xc4, 0x96, 0x7e, 0xc8, 0xe1, 0xcc, 0x7b, 0x53, 0xd4, 0x53, 0x58, 0x31, 0xca,
+	0xc0, 0xaa, 0x89, 0x14, 0x84, 0x4e, 0x4e, 0xec, 0x7d, 0x9e, 0xd0, 0xaa, 0xdb, 0x64, 0x2a, 0xb0,
+	0xd5, 0x20, 0xee, 0x96, 0x79, 0x7b, 0x9f, 0x0f, 0x0f, 0xad, 0xb6, 0x3c, 0xa6, 0x2f, 0xa1, 0x35,
+	0x25, 0xf0, 0xbb, 0x27, 0xc8, 0xf1, 0x4a, 0xa7, 0xdd, 0x6e, 0x36, 0xfc, 0x9a, 0x32, 0x7d, 0xb8,
+	0xcf, 0x51, 0x6e, 0xcc, 0x5f, 0x90, 0xaa, 0xfb, 0xf6, 0x40, 0x09, 0x5f, 0xdc, 0x27, 0x29, 0x02,
+	0x9b, 0xf5, 0xdf, 0xc3, 0xe7, 0xef, 0xfe, 0xe5, 0x10, 0x6f, 0xb2, 0x1d, 0x06, 0x2d, 0x61, 0x18,
+	0x47, 0xb3, 0x9f, 0x7d, 0xaf, 0x66, 0xb5, 0x95, 0x99, 0x97, 0xa8, 0x78, 0x5b, 0x99, 0x76, 0x2d,
+	0xbf, 0x2c, 0xbd, 0xed, 0x83, 0x44, 0xab, 0x30, 0x07, 0x35, 0xe7, 0x9b, 0x96, 0x97, 0xbe, 0x43,
+	0x88, 0xa2, 0x24, 0x23, 0x41, 0x0f, 0x61, 0x34, 0xea, 0x20, 0x2a, 0x28, 0x3d, 0x58, 0x9a, 0x90,
+	0xe3, 0x93, 0x51, 0x46, 0xdf, 0x97, 0x01, 0x7d, 0x83, 0x8c, 0x4a, 0x3b, 0x2a, 0x39, 0x4a, 0x90,
+	0xb8, 0xdd, 0x7f, 0xcf, 0x91, 0x53, 0x89, 0xe5, 0x13, 0xd7, 0xf8, 0xab, 0xdd, 0x8b, 0xb8, 0x30,
+	0xd8, 0xb0, 0x85, 0x6b, 0xa5, 0xf7, 0x3a, 0x7a, 0xf6, 0x3a, 0x3e, 0x9f, 0x7d, 0xc4, 0x82, 0x54,
+	0xd7, 0x6a, 0xba, 0xff, 0x95, 0x23, 0xe3, 0x1b, 0x1b, 0x57, 0x95, 0x02, 0x0c, 0xe4, 0x74, 0xc4,
+	0xa3, 0x64, 0xe7, 0xb6, 0x28, 0x5b, 0x9a, 0x0f, 0xe8, 0xb1, 0xf1, 0xd5, 0x61, 0x11, 0x2f, 0x96,
+	0x2b, 0xa9, 0x35, 0xa0, 0x47, 0x4b, 0x67, 0x85, 0x9c, 0x30, 0x4b, 0x84, 0xe5, 0x82, 0x0d, 0xaa,
+	0x20, 0x1e, 0x3c, 0x74, 0x17, 0x43, 0x5a, 0x9b, 0x24, 0x2a, 0x61, 0xbe, 0x10, 0x69, 0x47, 0xbb,
+	0x50, 0x89, 0x62, 0x48, 0x6b, 0xe3, 0xae, 0xd1, 0x81, 0xeb, 0x9c, 0xb3, 0xce, 0xf3, 0x64, 0x9a,
+	0x4e, 0xab, 0xd4, 0x2e, 0xaf, 0xfa, 0xb7, 0xfc, 0xa6, 0x18, 0x32, 0x33, 0x33, 0xcc, 0x27, 0xca,
+	0xa0, 0xab, 0xb6, 0xfb, 0xb9, 0x73, 0x44, 0xc5, 0x28, 0xfe, 0xf8, 0x81, 0x6b, 0xa6, 0x60, 0x8c,
+	0xaa, 0x72, 0x16, 0x17, 0x06, 0x77, 0x16, 0xab, 0xbb, 0x29, 0xe1, 0x30, 0x36, 0xb2, 0xb5, 0x8c,
+	0xdc, 0xcf, 0x6c, 0x2d, 0xce, 0xaf, 0x51, 0xb5, 0x07, 0x8d, 0x51, 0x52, 0x67, 0x61, 0x16, 0xb4,
+	0xf1, 0x4b, 0x6b, 0x03, 0x4d, 0x22, 0x8f, 0x1d, 0x10, 0x18, 0x79, 0xac, 0x80, 0xba, 0xb8, 0xcd,
+	0x22, 0xb0, 0x48, 0x3b, 0x4b, 0x86, 0x3d, 0x87, 0xbf, 0xb1, 0x3d, 0x9b, 0xa6, 0x6a, 0xdd, 0xd3,
+	0x52, 0xb3, 0x63, 0x24, 0xea, 0x18, 0x1b, 0xc0, 0xcc, 0x22, 0x03, 0xfe, 0x0c, 0x7b, 0xaa, 0x4c,
+	0x2a, 0xa0, 0xf3, 0x76, 0xb8, 0x54, 0x9e, 0x61, 0x71, 0x04, 0x4c, 0xda, 0x28, 0x72, 0xfb, 0x3d,
+	0x8f, 0x31, 0x00, 0x51, 0x42, 0x57, 0x53, 0xf8, 0xa0, 0xc6, 0x07, 0x48, 0x7a, 0x63, 0xb9, 0xb5,
+	0xd2, 0x9d, 0x50, 0xce, 0x0b, 0xa6, 0xa2, 0x3e, 0xd1, 0x8f, 0xa2, 0x3e, 0x79, 0x97, 0xb4, 0x7f,
+	0x23, 0x11, 0x33, 0x03, 0xb0, 0xe0, 0x89, 0xac, 0xef, 0xef, 0x6d, 0x4b, 0x02, 0x9f, 0x1d, 0x0e,
+	0x03, 0x81, 0x9e, 0xb2, 0x8d, 0xa2, 0x8c, 0xf0, 0x10, 0xf1, 0x17, 0x8b, 0x19, 0xcd, 0x78, 0xb6,
+	0xf5, 0x5d, 0xbe, 0x35, 0xe4, 0x50, 0x50, 0x44, 0x30, 0xad, 0x69, 0xcd, 0xab, 0x8b, 0x48, 0x8c,
+	0xe7, 0x33, 0x3f, 0x9e, 0x95, 0x64, 0x98, 0x96, 0x45, 0x01, 0x80, 0x58, 0x31, 0xc7, 0xb2, 0x4c,
+	0x18, 0x32, 0x3d, 0xc8, 0x05, 0x6c, 0x8b, 0x84, 0xdc, 0x68, 0xd1, 0x95, 0x72, 0xe4, 0x86, 0xc8,
+	0xcc, 0xfb, 0x18, 0xa3, 0xf4, 0xce, 0xcc, 0x8f, 0x2a, 0xba, 0x32, 0xf2, 0x7e, 0x98, 0x4c, 0x54,
+	0x8d, 0x1c, 0x4e, 0xa5, 0x9f, 0x18, 0x20, 0xef, 0x59, 0x5a, 0x32, 0x28, 0xfe, 0x9c, 0xc2, 0x2c,
+	0x01, 0x8b, 0xa0, 0x13, 0x93, 0xa2, 0xc4, 0x54, 0x7a, 0x7c, 0x00, 0xab, 0x7c, 0x6a, 0x4e, 0x3d,
+	0xbe, 0x33, 0x54, 0x7c, 0x87, 0xa2, 0xe4, 0x2c, 0x92, 0x51, 0x9e, 0xdf, 0x89, 0x87, 0xc4, 0x8c,
+	0x5f, 0x9a, 0xe9, 0x9d, 0x25, 0x4a, 0x33, 0x55, 0xfe, 0x9b, 0x32, 0x55, 0xd1, 0xd6, 0xf9, 0x68,
+	0x8e, 0x4c, 0x21, 0x2b, 0xd2, 0x09, 0xa9, 0x4a, 0xce, 0x00, 0x27, 0x1f, 0x1f, 0x8a, 0xe9, 0x13,
+	0xab, 0x14, 0xad, 0x15, 0x8b, 0x02, 0x24, 0x28, 0x52, 0xf5, 0xa6, 0x18, 0x35, 0x6a, 0x7e, 0xd5,
+	0xa3, 0xd4, 0x4f, 0x1c, 0x1a, 0x75, 0x6d, 0x71, 0x16, 0xb8, 0x41, 0x51, 0x71, 0x7e, 0x85, 0x25,
+	0xb3, 0x15, 0xd9, 0xae, 0x45, 0x7e, 0xf4, 0x93, 0x87, 0x99, 0x1f, 0xfd, 0x04, 0xcf, 0x64, 0x6b,
+	0x51, 0x80, 0x24, 0x49, 0xe7, 0x23, 0x54, 0x3c, 0xe6, 0xc9, 0x52, 0x92, 0x69, 0x78, 0x4e, 0x65,
+	0xb4, 0xa3, 0x3c, 0x88, 0xef, 0x53, 0xe7, 0xd2, 0x50, 0x42, 0x3a, 0x25, 0xe7, 0x75, 0x32, 0x19,
+	0x9a, 0x4e, 0x1b, 0x16, 0x29, 0x35, 0x90, 0x7f, 0x42, 0x65, 0x5b, 0x67, 0x51, 0x5a, 0x16, 0x08,
+	0x6c, 0x5a, 0x98, 0x98, 0xbc, 0x2d, 0x2e, 0x8b, 0x46, 0xb4, 0xcb, 0x82, 0xac, 0x86, 0xb8, 0x50,
+	0xb3, 0xae, 0xc1, 0x60, 0xd6, 0x71, 0x5e, 0xa2, 0x92, 0x54, 0xd0, 0xf4, 0x43, 0x11, 0xa8, 0x5f,
+	0x62, 0xfb, 0xe5, 0x5c, 0xda, 0xe6, 0xdf, 0x50, 0xd5, 0xb4, 0xe5, 0x59, 0xc3, 0x22, 0x30, 0xf1,
+	0xa0, 0xa5, 0x41, 0x66, 0xd3, 0x09, 0x99, 0x21, 0xe4, 0x41, 0xdb, 0xd2, 0x50, 0x31, 0x0b, 0xc1,
+	0xae, 0x8b, 0x9e, 0xca, 0x76, 0xd8, 0x08, 0x42, 0x7a, 0xa5, 0xcf, 0x37, 0xbd, 0x28, 0x62, 0x08,
+	0x66, 0x18, 0x02, 0xe5, 0xa9, 0x5c, 0x4f, 0x56, 0x80, 0xee, 0x36, 0xe8, 0x5f, 0x90, 0xc0, 0xd2,
+	0x43, 0x4c, 0x5c, 0x66, 0xe7, 0x5f, 0xb6, 0x05, 0x55, 0xda, 0x23, 0x05, 0xc1, 0xd9, 0x2c, 0x29,
+	0x08, 0x9c, 0x1a, 0x39, 0xeb, 0x75, 0xe2, 0x80, 0x3d, 0xce, 0xb2, 0x9b, 0xb0, 0x84, 0xb4, 0xa5,
+	0x0b, 0x4c, 0x5c, 0xb8, 0x40, 0x31, 0x9e, 0x9d, 0xbb, 0x4b, 0x3d, 0xb8, 0x2b, 0x16, 0x67, 0x17,
+	0x23, 0x63, 0x78, 0x1a, 0x85, 0xd2, 0x5b, 0x06, 0xb8, 0xa7, 0xed, 0x5c, 0x0c, 0x32, 0xbc, 0x86,
+	0xc3, 0x40, 0x91, 0x70, 0x36, 0xc8, 0x38, 0x86, 0xf5, 0xcd, 0x35, 0x1b, 0x1e, 0x3e, 0x0e, 0x7e,
+	0x98, 0xed, 0x93, 0x54, 0x11, 0x63, 0x59, 0x56, 0xd3, 0xdb, 0x64, 0x59, 0xb7, 0x04, 0x13, 0x0d,
+	0xd5, 0x9b, 0x8f, 0x31, 0x07, 0x0f, 0xae, 0x1a, 0xe5, 0x34, 0xfe, 0xed, 0xb8, 0x74, 0x8e, 0x8d,
+	0xe5, 0xb1, 0x34, 0xcc, 0xeb, 0x01, 0x3e, 0x66, 0x37, 0x6b, 0x73, 0xc6, 0x90, 0x00, 0x42, 0x12,
+	0x27, 0x9a, 0x94, 0xda, 0xb4, 0x6d, 0xdb, 0xaf, 0xae, 0x7b, 0xf8, 0xd2, 0xff, 0xbc, 0x6d, 0x95,
+	0x5b, 0x37, 0xca, 0xc0, 0xaa, 0xe9, 0xac, 0x92, 0x13, 0x18, 0x89, 0x84, 0x16, 0xc0, 0x75, 0x7a,
+	0xef, 0x52, 0x9e, 0x40, 0x2b, 0x47, 0x25, 0x97, 0x2d, 0xa1, 0x32, 0x5e, 0x42, 0x77, 0x15, 0x48,
+	0x6b, 0x87, 0x11, 0x0c, 0xbb, 0xfc, 0x39, 0x45, 0xe9, 0x91, 0x01, 0xa4, 0x7b, 0xf1, 0x24, 0x83,
+	0xcb, 0x06, 0xe2, 0x07, 0x48, 0xcc, 0xce, 0xef, 0x52, 0x6e, 0x1c, 0xd9, 0x56, 0x82, 0xd2, 0x5b,
+	0x07, 0x74, 0x2f, 0x18, 0xb8, 0xca, 0x8f, 0xb1, 0x39, 0xb7, 0x81, 0x77, 0xba, 0x41, 0x90, 0xec,
+	0x04, 0x1f, 0x3d, 0x7b, 0xd1, 0x54, 0x7a, 0x74, 0xa0, 0xd1, 0x33, 0x1c, 0x72, 0xf4, 0xec, 0x07,
+	0x48, 0xcc, 0x33, 0xcf, 0x91, 0xe3, 0x5d, 0x4a, 0xc8, 0x81, 0x5e, 0xd1, 0x7c, 0x13, 0x8d, 0x0e,
+	0x86, 0xda, 0x77, 0xd8, 0xca, 0x32, 0xe5, 0x6e, 0xe2, 0x03, 0x3a, 0x28, 0xa1, 0x36, 0x3b, 0x2a,
+	0xad, 0xb2, 0x11, 0x87, 0x01, 0xc9, 0x0a, 0xd0, 0xdd, 0x06, 0x77, 0x75, 0x95, 0x67, 0x5b, 0xe5,
+	0x31, 0xf8, 0xc3, 0xb6, 0xa1, 0x74, 0xde, 0x28, 0x03, 0xab, 0xa6, 0xfb, 0xb9, 0x1c, 0x99, 0xb4,
+	0x6e, 0xf7, 0x43, 0x77, 0xf4, 0x2d, 0x11, 0x67, 0xb7, 0x81, 0x69, 0x2f, 0xaf, 0xdb, 0x09, 0x38,
+	0xb1, 0x87, 0xec, 0x05, 0xfa, 0x6a, 0x57, 0x29, 0xa4, 0xb4, 0x70, 0xdf, 0x1c, 0x22, 0x3a, 0x58,
+	0x4c, 0xa5, 0x5d, 0xc8, 0xf5, 0x4c, 0xbb, 0x40, 0x97, 0x02, 0xdf, 0x75, 0xae, 0xeb, 0xe4, 0x0c,
+	0x6a, 0x29, 0x5e, 0xa8, 0xac, 0x5d, 0x63, 0x35, 0x55, 0x0d, 0x56, 0xfb, 0xd5, 0xa5, 0x46, 0x33,
+	0xee, 0x4e, 0x61, 0xf0, 0xc2, 0x8b, 0x1c, 0x0e, 0xaa, 0x06, 0xcb, 0xf2, 0x89, 0xf9, 0x6e, 0x84,
+	0xdd, 0x5b, 0x67, 0xf9, 0x44, 0x20, 0xf0, 0x32, 0xf4, 0x52, 0x2a, 0xb3, 0x79, 0xf2, 0x9d, 0x9f,
+	0x32, 0xaf, 0x83, 0xae, 0xc3, 0xa4, 0x35, 0x61, 0x1a, 0x16, 0x1a, 0xff, 0x52, 0x46, 0xbd, 0x21,
+	0x61, 0x5f, 0xe6, 0xac, 0x5c, 0x82, 0x41, 0x51, 0x49, 0xc4, 0x33, 0x14, 0xfb, 0x89, 0x67, 0x30,
+	0x83, 0x16, 0x0b, 0x87, 0x19, 0xb4, 0xe8, 0x7e, 0x6c, 0x88, 0x8c, 0x5e, 0xa7, 0x52, 0x2b, 0x12,
+	0x79, 0x82, 0x0a, 0xe1, 0xfc, 0x5f, 0xb1, 0xc2, 0x5a, 0xd0, 0xe6, 0x60, 0x90, 0xe5, 0x38, 0xcd,
+	0x9b, 0x1d, 0x2a, 0xe0, 0x2f, 0xe8, 0x33, 0xa7, 0xa6, 0xb9, 0x2c, 0x0b, 0x40, 0xd7, 0xc1, 0x06,
+	0x75, 0x94, 0x92, 0x77, 0x77, 0x1b, 0x71, 0xf2, 0xc1, 0xe6, 0x65, 0x59, 0x00, 0xba, 0x0e, 0x3a,
+	0x13, 0xe8, 0x8f, 0x0d, 0xaa, 0x2e, 0x26, 0x9c, 0x66, 0x97, 0x19, 0x14, 0x44, 0x29, 0xf3, 0xf8,
+	0xd0, 0xff, 0x42, 0x9f, 0x59, 0x4c, 0xbb, 0x5e, 0x14, 0x5d, 0x36, 0xca, 0xc0, 0xaa, 0xc9, 0xba,
+	0x14, 0x88, 0x91, 0x09, 0x4f, 0x8c, 0xee, 0x92, 0x2c, 0x00, 0x5d, 0x07, 0xb7, 0x2b, 0xda, 0xf5,
+	0x1a, 0x4d, 0x11, 0x00, 0x67, 0x6c, 0xd7, 0x79, 0x01, 0x07, 0x55, 0x03, 0x6b, 0x23, 0xc3, 0x41,
+	0xdf, 0x5e, 0x32, 0x15, 0xe3, 0xba, 0x80, 0x83, 0xaa, 0xe1, 0x7e, 0x21, 0x4f, 0x8a, 0x47, 0x98,
+	0x41, 0xb6, 0x6a, 0x65, 0x90, 0x3d, 0x84, 0x74, 0xa3, 0x69, 0xd9, 0x63, 0x77, 0x12, 0xd9, 0x63,
+	0xe7, 0x07, 0x0c, 0x01, 0xbe, 0x6b, 0xe6, 0xd8, 0xbf, 0xcf, 0x11, 0xf5, 0x82, 0xca, 0xcc, 0x77,
+	0xe7, 0xdc, 0x26, 0x63, 0x12, 0xa9, 0x8c, 0x45, 0xbe, 0x3c, 0x50, 0x47, 0x14, 0xf6, 0x7d, 0x23,
+	0x7d, 0x9d, 0xa4, 0x00, 0x9a, 0x58, 0x4a, 0x16, 0x8a, 0x7c, 0xbf, 0x59, 0x28, 0x30, 0x9b, 0xb5,
+	0xd3, 0x4d, 0xb0, 0x8f, 0xcb, 0xf0, 0xe7, 0x8c, 0x64, 0x60, 0x7c, 0x75, 0x9f, 0xee, 0x33, 0x59,
+	0x2e, 0x3a, 0xe7, 0x54, 0x04, 0xc5, 0x44, 0x7a, 0xf6, 0x30, 0xfd, 0x78, 0x6c, 0xa8, 0xdf, 0xc7,
+	0x63, 0xef, 0x20, 0xe3, 0x0d, 0x96, 0x42, 0x68, 0x8d, 0xbd, 0xde, 0x1c, 0xb6, 0x63, 0x67, 0x56,
+	0x74, 0x11, 0x98, 0xf5, 0x58, 0x36, 0x6b, 0x39, 0x01, 0x8c, 0xc3, 0x97, 0x1b, 0x4c, 0x8e, 0x3b,
+	0x82, 0xc3, 0x11, 0x58, 0x87, 0x63, 0x75, 0xa0, 0xcd, 0x62, 0x76, 0xbd, 0x67, 0xde, 0xf9, 0xef,
+	0xe6, 0x48, 0x29, 0xad, 0xc1, 0x11, 0x64, 0x3f, 0x6e, 0xd9, 0xd9, 0x8f, 0x57, 0x0e, 0x6d, 0xb0,
+	0x3d, 0xb2, 0x20, 0x7f, 0xbd, 0xc7, 0x50, 0x59, 0xfe, 0xe1, 0x57, 0xe4, 0x0d, 0x9f, 0x1b, 0xc0,
+	0x35, 0xc9, 0xb1, 0xa6, 0x4b, 0x07, 0xaf, 0x50, 0x8e, 0xc4, 0x94, 0x02, 0xb1, 0xb6, 0xef, 0xce,
+	0x78, 0xd5, 0x23, 0x0a, 0x61, 0x52, 0x65, 0xff, 0x83, 0x40, 0xeb, 0x7e, 0x25, 0x47, 0x26, 0x8e,
+	0x30, 0x77, 0xf5, 0xa6, 0xbd, 0x7a, 0xef, 0x1d, 0x68, 0xf5, 0x7a, 0xac, 0xd8, 0xc7, 0xce, 0x12,
+	0x2b, 0x67, 0x34, 0x3a, 0xac, 0xa5, 0x30, 0x2d, 0x19, 0xea, 0x7b, 0x07, 0xf2, 0x5a, 0x18, 0xaf,
+	0xb8, 0x24, 0x5e, 0xd0, 0x24, 0x12, 0x91, 0x19, 0xf9, 0xbe, 0x22, 0x33, 0x8e, 0xdc, 0x23, 0x96,
+	0x6e, 0xc0, 0x18, 0xbe, 0x2f, 0x06, 0x8c, 0xb3, 0x87, 0x6e, 0xc0, 0x78, 0xf8, 0xfe, 0x1b, 0x30,
+	0x0c, 0x0b, 0x6f, 0x61, 0x00, 0x0b, 0xef, 0xeb, 0xe4, 0x24, 0xff, 0x77, 0xbe, 0xe9, 0x35, 0x76,
+	0xd5, 0x7e, 0x11, 0xa9, 0x6b, 0x9f, 0x48, 0x35, 0x5b, 0xa0, 0xf8, 0x46, 0x95, 0xaf, 0x56, 0x7c,
+	0x5d, 0xb7, 0xd4, 0xcf, 0xae, 0xaf, 0xa7, 0xa0, 0x83, 0x54, 0x22, 0x49, 0xfb, 0xde, 0x68, 0x1f,
+	0xf6, 0xbd, 0x4f, 0xa3, 0x4d, 0x34, 0xed, 0x43, 0x68, 0xc2, 0xd1, 0xf6, 0xc2, 0x40, 0x06, 0x5a,
+	0x0b, 0xa3, 0xb0, 0x96, 0xa6, 0x15, 0x41, 0x7a, 0x1f, 0x30, 0x44, 0x53, 0xfa, 0x4c, 0x78, 0x98,
+	0x4f, 0xba, 0xb7, 0xe3, 0x13, 0x49, 0x5f, 0x25, 0x61, 0xb3, 0x5d, 0x19, 0x58, 0x6c, 0x3c, 0x04,
+	0x7f, 0xe5, 0xf8, 0x00, 0xfe, 0xca, 0x84, 0xf1, 0x75, 0xe2, 0x90, 0x8c, 0xaf, 0x2d, 0x32, 0x4d,
+	0x39, 0x7b, 0xdd, 0x5f, 0xef, 0x34, 0x9b, 0x3c, 0xd6, 0x58, 0xa6, 0xd9, 0x4d, 0x8d, 0x60, 0x45,
+	0xfb, 0x79, 0x33, 0x99, 0x85, 0x5c, 0x3d, 0xc2, 0x58, 0x49, 0x60, 0x82, 0x2e, 0xdc, 0xb8, 0x2d,
+	0xd9, 0x93, 0x5f, 0x3f, 0xc6, 0xd9, 0x66, 0xae, 0x3c, 0xf1, 0x3d, 0xcc, 0x65, 0x0d, 0x06, 0xb3,
+	0x8e, 0x73, 0x85, 0x8c, 0xd5, 0x5a, 0x91, 0x78, 0x75, 0x70, 0x8c, 0x71, 0xa9, 0xb7, 0x21, 0x6f,
+	0x5b, 0xb8, 0x56, 0x51, 0xef, 0x0d, 0xce, 0xa6, 0xbc, 0x19, 0x57, 0xe5, 0xa0, 0xdb, 0x3b, 0xab,
+	0x0c, 0x99, 0xc8, 0x99, 0xc8, 0x7d, 0x6f, 0x17, 0x7a, 0xd8, 0x0f, 0x69, 0x7b, 0xc1, 0x27, 0x26,
+	0x05, 0x39, 0x91, 0x09, 0x51, 0x63, 0x30, 0xb2, 0x1d, 0x1f, 0xbf, 0x6b, 0xb6, 0xe3, 0x97, 0xc8,
+	0x99, 0x38, 0x6e, 0x5a, 0x21, 0x1d, 0xe2, 0x59, 0x3e, 0xcb, 0xd1, 0x50, 0xe0, 0x69, 0xff, 0x31,
+	0x7e, 0x25, 0xa5, 0x0a, 0xf4, 0x6a, 0xcb, 0x62, 0x1b, 0x68, 0x91, 0xf4, 0x1f, 0x9c, 0x1b, 0x24,
+	0xb6, 0x41, 0xc7, 0xce, 0x88, 0xd8, 0x06, 0x0d, 0x00, 0x93, 0x8a, 0xb3, 0xd6, 0xcb, 0x73, 0x72,
+	0x82, 0xf1, 0x98, 0x83, 0xfb, 0x41, 0x4c, 0xd3, 0xfb, 0xc9, 0xbb, 0x9a, 0xde, 0xbb, 0x5c, 0x05,
+	0xa7, 0x0e, 0xe0, 0x2a, 0x78, 0x99, 0xe5, 0x03, 0xb8, 0x3c, 0x2f, 0xdc, 0x2c, 0xd9, 0x24, 0x36,
+	0xf6, 0x28, 0x90, 0x87, 0x1f, 0xb1, 0x7f, 0x81, 0xe3, 0xc4, 0xbc, 0x19, 0xf4, 0x9f, 0x2e, 0x4f,
+	0x03, 0xf3, 0xab, 0x18, 0x79, 0x33, 0xd6, 0x53, 0xea, 0x40, 0x6a, 0x4b, 0xc6, 0xc0, 0x35, 0x9c,
+	0x3d, 0x3e, 0x2f, 0x08, 0x06, 0xae, 0xc1, 0x60, 0xd6, 0x49, 0x1a, 0xde, 0x1f, 0xbc, 0x6f, 0x86,
+	0xf7, 0x99, 0x23, 0x30, 0xbc, 0x3f, 0xd4, 0xb7, 0xe1, 0xfd, 0x17, 0xc9, 0x09, 0xfa, 0x7b, 0xa1,
+	0x11, 0x85, 0x1d, 0xf6, 0xa5, 0xe0, 0x72, 0xa7, 0x86, 0xe9, 0xb1, 0xcf, 0xb3, 0x4e, 0x5e, 0x32,
+	0x3b, 0xc9, 0x3f, 0x96, 0x3e, 0x2b, 0x3e, 0x96, 0xce, 0x0e, 0x79, 0xa2, 0x15, 0xd3, 0x7b, 0x58,
+	0xfc, 0x55, 0x4a, 0x21, 0xa4, 0xd1, 0x31, 0x0d, 0xf5, 0x17, 0xee, 0x9b, 0xa1, 0xfe, 0x79, 0xaa,
+	0x2a, 0x6f, 0x77, 0xe2, 0x5a, 0xb0, 0xd7, 0x62, 0x2e, 0x9c, 0x31, 0xf5, 0xd1, 0x94, 0x62, 0x45,
+	0xc0, 0xef, 0xe0, 0x63, 0x3a, 0xf1, 0xbf, 0xf1, 0x6c, 0x55, 0x40, 0x7a, 0x7e, 0x22, 0xce, 0xfd,
+	0x81, 0x7e, 0x22, 0x2e, 0xcd, 0x01, 0xf1, 0xc8, 0x0f, 0x83, 0x03, 0xe2, 0x97, 0x73, 0x32, 0xeb,
+	0xfc, 0x5b, 0x07, 0xf8, 0xc4, 0x99, 0x25, 0x40, 0x1c, 0x3c, 0xf5, 0xbc, 0x73, 0x8b, 0x5e, 0x57,
+	0x3c, 0x0b, 0xfe, 0x5a, 0x4b, 0x38, 0x42, 0x56, 0x0e, 0xc7, 0x20, 0xd4, 0xf0, 0x45, 0xc0, 0xe6,
+	0x82, 0xc4, 0x0f, 0x9a, 0xd4, 0xc0, 0x9e, 0x91, 0x1f, 0x70, 0xce, 0xfc, 0x4f, 0x1e, 0x23, 0x53,
+	0x89, 0xef, 0xab, 0x28, 0x63, 0x50, 0xae, 0x5f, 0x63, 0x90, 0x95, 0xea, 0x27, 0x7f, 0x5f, 0x53,
+	0xfd, 0x0c, 0x1d, 0x7a, 0xaa, 0x1f, 0x23, 0xa5, 0xd1, 0xf0, 0x3d, 0x52, 0x1a, 0xcd, 0xe1, 0x1b,
+	0x26, 0x1e, 0x02, 0xea, 0x8b, 0x54, 0x2f, 0xdc, 0x30, 0xad, 0x9e, 0xa7, 0xcd, 0xdb, 0xc5, 0x90,
+	0xac, 0xef, 0xfc, 0x02, 0x29, 0xb4, 0x58, 0xc3, 0x91, 0x01, 0x92, 0xf7, 0xd9, 0x0b, 0xc6, 0xc4,
+	0x6d, 0x71, 0x5a, 0x64, 0x34, 0x4b, 0x81, 0xc1, 0xee, 0xc8, 0x7f, 0x80, 0x13, 0x75, 0x3e, 0x48,
+	0x4a, 0xc1, 0x16, 0x6d, 0xe9, 0xd5, 0x74, 0x3a, 0x22, 0x69, 0x2b, 0xe7, 0xd1, 0xfd, 0x17, 0x04,
+	0x82, 0xd2, 0x5a, 0x8f, 0x7a, 0xd0, 0x13, 0x03, 0x6a, 0x45, 0xc7, 0xec, 0x34, 0x59, 0xf8, 0xb1,
+	0x6b, 0x1c, 0xe6, 0xfb, 0x0e, 0x63, 0x98, 0x76, 0x4e, 0x2e, 0x31, 0x60, 0xfd, 0x30, 0xd0, 0x2e,
+	0x85, 0x64, 0x4f, 0x9c, 0x90, 0x9c, 0x6e, 0xa7, 0xe9, 0x8c, 0x91, 0x88, 0xd1, 0xbc, 0x9b, 0xe6,
+	0x7a, 0x4e, 0x50, 0x39, 0x9d, 0xaa, 0x75, 0x46, 0xd0, 0x03, 0xb3, 0x99, 0xa8, 0xa8, 0x78, 0xdf,
+	0x12, 0x15, 0xd9, 0x5f, 0x14, 0x9a, 0x3c, 0x92, 0x2f, 0x0a, 0x7d, 0x3f, 0x35, 0x3f, 0x16, 0x57,
+	0xb5, 0x3e, 0x70, 0x18, 0x8b, 0xfd, 0x43, 0x97, 0x23, 0xeb, 0xf7, 0x73, 0x64, 0x86, 0x6f, 0xa9,
+	0xb4, 0x0f, 0x8f, 0x8a, 0x48, 0xcb, 0x43, 0x70, 0xb9, 0x30, 0x3f, 0x6e, 0xc5, 0x22, 0xc4, 0x6c,
+	0xca, 0x77, 0x21, 0x8e, 0x51, 0xc7, 0x5d, 0xa2, 0xc1, 0xb1, 0x01, 0x0c, 0x11, 0xa9, 0xaf, 0x21,
+	0x84, 0x70, 0x7a, 0x0f, 0x69, 0x60, 0x66, 0x9f, 0x27, 0x2f, 0xec, 0x79, 0x9d, 0xbd, 0x64, 0x5f,
+	0x67, 0xcf, 0x0d, 0x98, 0x4c, 0xcd, 0xbc, 0x49, 0x3f, 0x92, 0x23, 0x27, 0xd3, 0x98, 0x44, 0x4a,
+	0x2f, 0x2a, 0x76, 0x2f, 0x06, 0xb3, 0x74, 0x9a, 0x7d, 0x38, 0x9c, 0xe4, 0x54, 0xbf, 0x53, 0x34,
+	0xac, 0xb3, 0xb1, 0xdf, 0xfe, 0xf1, 0xdb, 0x82, 0x4c, 0x6f, 0x0b, 0xac, 0xcf, 0x60, 0x15, 0x8e,
+	0xf0, 0x33, 0x58, 0x23, 0x19, 0x3e, 0x83, 0x35, 0x7a, 0x94, 0x9f, 0xc1, 0x2a, 0xf6, 0xf9, 0x19,
+	0xac, 0xb1, 0x1f, 0x9e, 0xcf, 0x60, 0x69, 0x35, 0x63, 0xe2, 0x30, 0xd4, 0x0c, 0x7a, 0xaa, 0xfe,
+	0xef, 0x7d, 0xe1, 0xea, 0x5b, 0x39, 0x32, 0xfd, 0xff, 0xfd, 0x73, 0xdd, 0xdf, 0x34, 0x5c, 0xc4,
+	0x47, 0xf8, 0x9d, 0xee, 0x9b, 0xb6, 0xd3, 0x6d, 0xf1, 0x50, 0x06, 0xd9, 0xc3, 0xf9, 0xf6, 0x2a,
+	0x49, 0x53, 0xfb, 0xfb, 0x7b, 0x08, 0x6d, 0x05, 0xa7, 0xe5, 0xfb, 0x0e, 0x4e, 0xfb, 0x9f, 0x94,
+	0x59, 0x65, 0xb2, 0xc3, 0xeb, 0xf7, 0xeb, 0x53, 0xb5, 0x27, 0xd3, 0x3e, 0x55, 0x9b, 0xf8, 0x34,
+	0x6d, 0xf2, 0x53, 0xa5, 0xf9, 0xfb, 0xf7, 0xa9, 0xd2, 0xf2, 0xec, 0x97, 0xbe, 0x75, 0xee, 0x81,
+	0xaf, 0xd0, 0xbf, 0xaf, 0xd1, 0xbf, 0x37, 0xbe, 0x7d, 0x2e, 0xf7, 0x25, 0xfa, 0xf7, 0x15, 0xfa,
+	0xf7, 0x35, 0xfa, 0xf7, 0x4d, 0xfa, 0xf7, 0xdb, 0xdf, 0x39, 0xf7, 0xc0, 0x07, 0x8a, 0x72, 0x30,
+	0xff, 0x0b, 0xde, 0x15, 0xfa, 0x6d, 0x08, 0x91, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -3167,6 +3725,11 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.FromExpression)
+	copy(dAtA[i:], m.FromExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
+	i--
+	dAtA[i] = 0x52
 	i -= len(m.SubPath)
 	copy(dAtA[i:], m.SubPath)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubPath)))
@@ -3230,6 +3793,34 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3250,6 +3841,18 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -3477,7 +4080,7 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Backoff) Marshal() (dAtA []byte, err error) {
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3487,42 +4090,35 @@ func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.MaxDuration)
-	copy(dAtA[i:], m.MaxDuration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
 	i--
-	dAtA[i] = 0x1a
-	if m.Factor != nil {
-		{
-			size, err := m.Factor.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.Duration)
-	copy(dAtA[i:], m.Duration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Cache) Marshal() (dAtA []byte, err error) {
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3532,19 +4128,19 @@ func (m *Cache) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConfigMap != nil {
+	if m.SASTokenSecret != nil {
 		{
-			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SASTokenSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3552,55 +4148,34 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ClusterWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ClusterWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterWorkflowTemplateList) Marshal() (dAtA []byte, err error) {
+func (m *BackfillWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3610,32 +4185,28 @@ func (m *ClusterWorkflowTemplateList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterWorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackfillWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterWorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackfillWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3647,7 +4218,7 @@ func (m *ClusterWorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *Condition) Marshal() (dAtA []byte, err error) {
+func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3657,35 +4228,42 @@ func (m *Condition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
+func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i -= len(m.MaxDuration)
+	copy(dAtA[i:], m.MaxDuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	if m.Factor != nil {
+		{
+			size, err := m.Factor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
+func (m *Cache) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3695,36 +4273,56 @@ func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContinueOn) MarshalTo(dAtA []byte) (int, error) {
+func (m *Cache) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContinueOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Failed {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x10
-	i--
-	if m.Error {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *Counter) Marshal() (dAtA []byte, err error) {
+func (m *ChildWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3734,25 +4332,42 @@ func (m *Counter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChildWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChildWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.WorkflowTemplateRef != nil {
+		{
+			size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
+func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3762,26 +4377,16 @@ func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CronWorkflow) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterWorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterWorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3805,7 +4410,7 @@ func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
+func (m *ClusterWorkflowTemplateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3815,12 +4420,12 @@ func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CronWorkflowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterWorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterWorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3852,7 +4457,7 @@ func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
+func (m *Condition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3862,143 +4467,35 @@ func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CronWorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Condition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WorkflowMetadata != nil {
-		{
-			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	i -= len(m.Timezone)
-	copy(dAtA[i:], m.Timezone)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x42
-	if m.FailedJobsHistoryLimit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.FailedJobsHistoryLimit))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SuccessfulJobsHistoryLimit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SuccessfulJobsHistoryLimit))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.StartingDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.StartingDeadlineSeconds))
-		i--
-		dAtA[i] = 0x28
-	}
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
 	i--
-	if m.Suspend {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.ConcurrencyPolicy)
-	copy(dAtA[i:], m.ConcurrencyPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Schedule)
-	copy(dAtA[i:], m.Schedule)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.WorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *CronWorkflowStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CronWorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.LastScheduledTime != nil {
-		{
-			size, err := m.LastScheduledTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Active) > 0 {
-		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Active[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DAGTask) Marshal() (dAtA []byte, err error) {
+func (m *ContainerNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4008,97 +4505,37 @@ func (m *DAGTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DAGTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Depends)
-	copy(dAtA[i:], m.Depends)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x5a
-	if m.ContinueOn != nil {
-		{
-			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	i -= len(m.When)
-	copy(dAtA[i:], m.When)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
-	i--
-	dAtA[i] = 0x4a
-	if m.WithSequence != nil {
-		{
-			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x42
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.WithParam)
-	copy(dAtA[i:], m.WithParam)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
 	i--
-	dAtA[i] = 0x3a
-	if len(m.WithItems) > 0 {
-		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
+	dAtA[i] = 0x1a
 	if len(m.Dependencies) > 0 {
 		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Dependencies[iNdEx])
 			copy(dAtA[i:], m.Dependencies[iNdEx])
 			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
 			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.TemplateRef != nil {
-		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x22
 	}
 	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4106,21 +4543,11 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DAGTemplate) Marshal() (dAtA []byte, err error) {
+func (m *ContainerSetTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4130,30 +4557,34 @@ func (m *DAGTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DAGTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerSetTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FailFast != nil {
-		i--
-		if *m.FailFast {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4161,18 +4592,13 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.Target)
-	copy(dAtA[i:], m.Target)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4182,25 +4608,36 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContinueOn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContinueOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Selector)
-	copy(dAtA[i:], m.Selector)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
 	i--
-	dAtA[i] = 0xa
+	if m.Failed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.Error {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
+func (m *Counter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4210,25 +4647,25 @@ func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecutorConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
+func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4238,23 +4675,18 @@ func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GCSArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *CronWorkflow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.GCSBucket.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4262,32 +4694,9 @@ func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GCSBucket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GCSBucket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x1a
 	{
-		size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4296,15 +4705,20 @@ func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Bucket)
-	copy(dAtA[i:], m.Bucket)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Gauge) Marshal() (dAtA []byte, err error) {
+func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4314,35 +4728,44 @@ func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+func (m *CronWorkflowList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Realtime != nil {
-		i--
-		if *m.Realtime {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
+func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4352,39 +4775,37 @@ func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *CronWorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.InsecureIgnoreHostKey {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.Exclusions) > 0 {
+		for iNdEx := len(m.Exclusions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclusions[iNdEx])
+			copy(dAtA[i:], m.Exclusions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Exclusions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	i--
-	dAtA[i] = 0x40
-	if m.SSHPrivateKeySecret != nil {
-		{
-			size, err := m.SSHPrivateKeySecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Schedules[iNdEx])
+			copy(dAtA[i:], m.Schedules[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedules[iNdEx])))
+			i--
+			dAtA[i] = 0x52
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if m.PasswordSecret != nil {
+	if m.WorkflowMetadata != nil {
 		{
-			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4392,48 +4813,60 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if m.UsernameSecret != nil {
-		{
-			size, err := m.UsernameSecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x42
+	if m.FailedJobsHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.FailedJobsHistoryLimit))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x38
 	}
-	if len(m.Fetch) > 0 {
-		for iNdEx := len(m.Fetch) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fetch[iNdEx])
-			copy(dAtA[i:], m.Fetch[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fetch[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.SuccessfulJobsHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SuccessfulJobsHistoryLimit))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Depth != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Depth))
+	if m.StartingDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.StartingDeadlineSeconds))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
 	}
-	i -= len(m.Revision)
-	copy(dAtA[i:], m.Revision)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	if m.Suspend {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.ConcurrencyPolicy)
+	copy(dAtA[i:], m.ConcurrencyPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Repo)
-	copy(dAtA[i:], m.Repo)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	{
+		size, err := m.WorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *HDFSArtifact) Marshal() (dAtA []byte, err error) {
+func (m *CronWorkflowStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4443,43 +4876,74 @@ func (m *HDFSArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HDFSArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *CronWorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HDFSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Force {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.Backfilled) > 0 {
+		for iNdEx := len(m.Backfilled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backfilled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.HDFSConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastScheduledTime != nil {
+		{
+			size, err := m.LastScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Active) > 0 {
+		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Active[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *HDFSConfig) Marshal() (dAtA []byte, err error) {
+func (m *DAGTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4489,71 +4953,58 @@ func (m *HDFSConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HDFSConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *DAGTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HDFSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.HDFSUser)
-	copy(dAtA[i:], m.HDFSUser)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HDFSUser)))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	{
-		size, err := m.HDFSKrbConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Depends)
+	copy(dAtA[i:], m.Depends)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *HDFSKrbConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *HDFSKrbConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.KrbServicePrincipalName)
-	copy(dAtA[i:], m.KrbServicePrincipalName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbServicePrincipalName)))
+	dAtA[i] = 0x62
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
 	i--
-	dAtA[i] = 0x32
-	if m.KrbConfigConfigMap != nil {
+	dAtA[i] = 0x5a
+	if m.ContinueOn != nil {
 		{
-			size, err := m.KrbConfigConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4561,21 +5012,16 @@ func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x52
 	}
-	i -= len(m.KrbRealm)
-	copy(dAtA[i:], m.KrbRealm)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbRealm)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.KrbUsername)
-	copy(dAtA[i:], m.KrbUsername)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbUsername)))
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
 	i--
-	dAtA[i] = 0x1a
-	if m.KrbKeytabSecret != nil {
+	dAtA[i] = 0x4a
+	if m.WithSequence != nil {
 		{
-			size, err := m.KrbKeytabSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4583,11 +5029,39 @@ func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
 	}
-	if m.KrbCCacheSecret != nil {
+	i -= len(m.WithParam)
+	copy(dAtA[i:], m.WithParam)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.WithItems) > 0 {
+		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TemplateRef != nil {
 		{
-			size, err := m.KrbCCacheSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4595,40 +5069,32 @@ func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HTTPArtifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HTTPArtifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Histogram) Marshal() (dAtA []byte, err error) {
+func (m *DAGTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4638,20 +5104,30 @@ func (m *Histogram) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+func (m *DAGTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Buckets) > 0 {
-		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+	if m.FailFast != nil {
+		i--
+		if *m.FailFast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4659,18 +5135,18 @@ func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i -= len(m.Target)
+	copy(dAtA[i:], m.Target)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Target)))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Inputs) Marshal() (dAtA []byte, err error) {
+func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4680,48 +5156,25 @@ func (m *Inputs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Inputs) MarshalTo(dAtA []byte) (int, error) {
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Inputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Artifacts) > 0 {
-		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Item) Marshal() (dAtA []byte, err error) {
+func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4731,27 +5184,25 @@ func (m *Item) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutorConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Link) Marshal() (dAtA []byte, err error) {
+func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4761,35 +5212,35 @@ func (m *Link) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Link) MarshalTo(dAtA []byte) (int, error) {
+func (m *GCSArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GCSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Scope)
-	copy(dAtA[i:], m.Scope)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	{
+		size, err := m.GCSBucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
+func (m *GCSBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4799,38 +5250,35 @@ func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MemoizationStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *GCSBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.CacheName)
-	copy(dAtA[i:], m.CacheName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	{
+		size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
 	i--
-	if m.Hit {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Memoize) Marshal() (dAtA []byte, err error) {
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4840,37 +5288,35 @@ func (m *Memoize) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Memoize) MarshalTo(dAtA []byte) (int, error) {
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Cache != nil {
-		{
-			size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Realtime != nil {
+		i--
+		if *m.Realtime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4880,68 +5326,88 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
+	i--
+	if m.InsecureIgnoreHostKey {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	if m.SSHPrivateKeySecret != nil {
+		{
+			size, err := m.SSHPrivateKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PasswordSecret != nil {
+		{
+			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
+	if m.UsernameSecret != nil {
+		{
+			size, err := m.UsernameSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fetch) > 0 {
+		for iNdEx := len(m.Fetch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fetch[iNdEx])
+			copy(dAtA[i:], m.Fetch[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fetch[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
+	if m.Depth != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Repo)
+	copy(dAtA[i:], m.Repo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repo)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MetricLabel) Marshal() (dAtA []byte, err error) {
+func (m *HDFSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4951,30 +5417,43 @@ func (m *MetricLabel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetricLabel) MarshalTo(dAtA []byte) (int, error) {
+func (m *HDFSArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetricLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HDFSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	if m.Force {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	{
+		size, err := m.HDFSConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Metrics) Marshal() (dAtA []byte, err error) {
+func (m *HDFSConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4984,34 +5463,44 @@ func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+func (m *HDFSConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HDFSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prometheus) > 0 {
-		for iNdEx := len(m.Prometheus) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prometheus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	i -= len(m.HDFSUser)
+	copy(dAtA[i:], m.HDFSUser)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HDFSUser)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Addresses[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
-	return len(dAtA) - i, nil
-}
+	{
+		size, err := m.HDFSKrbConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
-func (m *Mutex) Marshal() (dAtA []byte, err error) {
+func (m *HDFSKrbConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5021,25 +5510,71 @@ func (m *Mutex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Mutex) MarshalTo(dAtA []byte) (int, error) {
+func (m *HDFSKrbConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Mutex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.KrbServicePrincipalName)
+	copy(dAtA[i:], m.KrbServicePrincipalName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbServicePrincipalName)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x32
+	if m.KrbConfigConfigMap != nil {
+		{
+			size, err := m.KrbConfigConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.KrbRealm)
+	copy(dAtA[i:], m.KrbRealm)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbRealm)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.KrbUsername)
+	copy(dAtA[i:], m.KrbUsername)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KrbUsername)))
+	i--
+	dAtA[i] = 0x1a
+	if m.KrbKeytabSecret != nil {
+		{
+			size, err := m.KrbKeytabSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.KrbCCacheSecret != nil {
+		{
+			size, err := m.KrbCCacheSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MutexHolding) Marshal() (dAtA []byte, err error) {
+func (m *HTTP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5049,30 +5584,59 @@ func (m *MutexHolding) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MutexHolding) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTP) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MutexHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Holder)
-	copy(dAtA[i:], m.Holder)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holder)))
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x32
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Mutex)
-	copy(dAtA[i:], m.Mutex)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mutex)))
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MutexStatus) Marshal() (dAtA []byte, err error) {
+func (m *HTTPArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5082,48 +5646,25 @@ func (m *MutexStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MutexStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MutexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Waiting) > 0 {
-		for iNdEx := len(m.Waiting) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Waiting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Holding) > 0 {
-		for iNdEx := len(m.Holding) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Holding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
+func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5133,19 +5674,19 @@ func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MemoizationStatus != nil {
+	if m.ValueFrom != nil {
 		{
-			size, err := m.MemoizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5153,87 +5694,44 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
-	}
-	i -= len(m.HostNodeName)
-	copy(dAtA[i:], m.HostNodeName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HostNodeName)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xb2
-	if len(m.ResourcesDuration) > 0 {
-		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
-		for k := range m.ResourcesDuration {
-			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
-		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
-			baseI := i
-			i = encodeVarintGenerated(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(keysForResourcesDuration[iNdEx])
-			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
+		dAtA[i] = 0x1a
 	}
-	i -= len(m.TemplateScope)
-	copy(dAtA[i:], m.TemplateScope)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateScope)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	i -= len(m.WorkflowTemplateName)
-	copy(dAtA[i:], m.WorkflowTemplateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplateName)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	i -= len(m.StoredTemplateID)
-	copy(dAtA[i:], m.StoredTemplateID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StoredTemplateID)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x92
-	if len(m.OutboundNodes) > 0 {
-		for iNdEx := len(m.OutboundNodes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OutboundNodes[iNdEx])
-			copy(dAtA[i:], m.OutboundNodes[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutboundNodes[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Children[iNdEx])
-			copy(dAtA[i:], m.Children[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Children[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPHeaderSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Outputs != nil {
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
 		{
-			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5241,172 +5739,12 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
-	}
-	if m.Inputs != nil {
-		{
-			size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Daemoned != nil {
-		i--
-		if *m.Daemoned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	i -= len(m.PodIP)
-	copy(dAtA[i:], m.PodIP)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodIP)))
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x4a
-	i -= len(m.BoundaryID)
-	copy(dAtA[i:], m.BoundaryID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BoundaryID)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0x3a
-	if m.TemplateRef != nil {
-		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	i -= len(m.TemplateName)
-	copy(dAtA[i:], m.TemplateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NoneStrategy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *OSSArtifact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OSSArtifact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OSSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.OSSBucket.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OSSBucket) Marshal() (dAtA []byte, err error) {
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5416,50 +5754,39 @@ func (m *OSSBucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OSSBucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OSSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Bucket)
-	copy(dAtA[i:], m.Bucket)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Endpoint)
-	copy(dAtA[i:], m.Endpoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Outputs) Marshal() (dAtA []byte, err error) {
+func (m *Inputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5469,30 +5796,16 @@ func (m *Outputs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Outputs) MarshalTo(dAtA []byte) (int, error) {
+func (m *Inputs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Inputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExitCode != nil {
-		i -= len(*m.ExitCode)
-		copy(dAtA[i:], *m.ExitCode)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ExitCode)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Result != nil {
-		i -= len(*m.Result)
-		copy(dAtA[i:], *m.Result)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Result)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Artifacts) > 0 {
 		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5524,7 +5837,7 @@ func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
+func (m *Item) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5534,34 +5847,27 @@ func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParallelSteps) MarshalTo(dAtA []byte) (int, error) {
+func (m *Item) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParallelSteps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Value != nil {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
+func (m *LifecycleHook) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5571,36 +5877,24 @@ func (m *Parameter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+func (m *LifecycleHook) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LifecycleHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.GlobalName)
-	copy(dAtA[i:], m.GlobalName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GlobalName)))
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
 	i--
-	dAtA[i] = 0x2a
-	if m.ValueFrom != nil {
-		{
-			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
+	dAtA[i] = 0x22
+	if m.TemplateRef != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5610,18 +5904,54 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Default != nil {
-		{
-			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Link) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Link) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Scope)
+	copy(dAtA[i:], m.Scope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -5630,7 +5960,7 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PodGC) Marshal() (dAtA []byte, err error) {
+func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5640,25 +5970,43 @@ func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodGC) MarshalTo(dAtA []byte) (int, error) {
+func (m *MemoizationStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Strategy)
-	copy(dAtA[i:], m.Strategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	i -= len(m.CacheName)
+	copy(dAtA[i:], m.CacheName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.Hit {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *Prometheus) Marshal() (dAtA []byte, err error) {
+func (m *Memoize) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5668,19 +6016,24 @@ func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Prometheus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Memoize) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Counter != nil {
+	i -= len(m.MaxAge)
+	copy(dAtA[i:], m.MaxAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Cache != nil {
 		{
-			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Cache.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5688,65 +6041,88 @@ func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	if m.Histogram != nil {
-		{
-			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	i -= len(m.When)
-	copy(dAtA[i:], m.When)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Help)
-	copy(dAtA[i:], m.Help)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Help)))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Labels) > 0 {
-		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
+func (m *MetricLabel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5756,25 +6132,30 @@ func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RawArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *MetricLabel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MetricLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Data)
-	copy(dAtA[i:], m.Data)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5784,62 +6165,34 @@ func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Flags) > 0 {
-		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Flags[iNdEx])
-			copy(dAtA[i:], m.Flags[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Flags[iNdEx])))
+	if len(m.Prometheus) > 0 {
+		for iNdEx := len(m.Prometheus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prometheus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.FailureCondition)
-	copy(dAtA[i:], m.FailureCondition)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.SuccessCondition)
-	copy(dAtA[i:], m.SuccessCondition)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
-	i--
-	dAtA[i] = 0x2a
-	i--
-	if m.SetOwnerReference {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.Manifest)
-	copy(dAtA[i:], m.Manifest)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manifest)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.MergeStrategy)
-	copy(dAtA[i:], m.MergeStrategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeStrategy)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Action)
-	copy(dAtA[i:], m.Action)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+func (m *Mutex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5849,49 +6202,33 @@ func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Mutex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Backoff != nil {
-		{
-			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	i -= len(m.RetryPolicy)
-	copy(dAtA[i:], m.RetryPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryPolicy)))
 	i--
-	dAtA[i] = 0x12
-	if m.Limit != nil {
-		{
-			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if m.Database {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+func (m *MutexHolding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5901,35 +6238,30 @@ func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *MutexHolding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MutexHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i -= len(m.Holder)
+	copy(dAtA[i:], m.Holder)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holder)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.S3Bucket.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Mutex)
+	copy(dAtA[i:], m.Mutex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mutex)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
+func (m *MutexStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5939,78 +6271,48 @@ func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
+func (m *MutexStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MutexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.UseSDKCreds {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x40
-	i -= len(m.RoleARN)
-	copy(dAtA[i:], m.RoleARN)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Waiting) > 0 {
+		for iNdEx := len(m.Waiting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waiting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Insecure != nil {
-		i--
-		if *m.Insecure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Holding) > 0 {
+		for iNdEx := len(m.Holding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	i -= len(m.Region)
-	copy(dAtA[i:], m.Region)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Bucket)
-	copy(dAtA[i:], m.Bucket)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Endpoint)
-	copy(dAtA[i:], m.Endpoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
+func (m *NodeStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6020,94 +6322,124 @@ func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScriptTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Source)
-	copy(dAtA[i:], m.Source)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
 	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Throttled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SemaphoreHolding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc8
+	i -= len(m.ChildWorkflow)
+	copy(dAtA[i:], m.ChildWorkflow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChildWorkflow)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.MemoizationStatus != nil {
+		{
+			size, err := m.MemoizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SemaphoreHolding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SemaphoreHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Holders) > 0 {
-		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Holders[iNdEx])
-			copy(dAtA[i:], m.Holders[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holders[iNdEx])))
+	i -= len(m.HostNodeName)
+	copy(dAtA[i:], m.HostNodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HostNodeName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.ResourcesDuration) > 0 {
+		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
+		for k := range m.ResourcesDuration {
+			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
+			i -= len(keysForResourcesDuration[iNdEx])
+			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	i -= len(m.Semaphore)
-	copy(dAtA[i:], m.Semaphore)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Semaphore)))
+	i -= len(m.TemplateScope)
+	copy(dAtA[i:], m.TemplateScope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateScope)))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SemaphoreRef) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	i -= len(m.WorkflowTemplateName)
+	copy(dAtA[i:], m.WorkflowTemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkflowTemplateName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.StoredTemplateID)
+	copy(dAtA[i:], m.StoredTemplateID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StoredTemplateID)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.OutboundNodes) > 0 {
+		for iNdEx := len(m.OutboundNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutboundNodes[iNdEx])
+			copy(dAtA[i:], m.OutboundNodes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutboundNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SemaphoreRef) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConfigMapKeyRef != nil {
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Children[iNdEx])
+			copy(dAtA[i:], m.Children[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Children[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Outputs != nil {
 		{
-			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6115,12 +6447,111 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x7a
+	}
+	if m.Inputs != nil {
+		{
+			size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Daemoned != nil {
+		i--
+		if *m.Daemoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	i -= len(m.PodIP)
+	copy(dAtA[i:], m.PodIP)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodIP)))
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.BoundaryID)
+	copy(dAtA[i:], m.BoundaryID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BoundaryID)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x3a
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SemaphoreStatus) Marshal() (dAtA []byte, err error) {
+func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6130,48 +6561,20 @@ func (m *SemaphoreStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SemaphoreStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *NoneStrategy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SemaphoreStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Waiting) > 0 {
-		for iNdEx := len(m.Waiting) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Waiting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Holding) > 0 {
-		for iNdEx := len(m.Holding) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Holding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Sequence) Marshal() (dAtA []byte, err error) {
+func (m *OSSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6181,61 +6584,35 @@ func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSSArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSSArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Format)
-	copy(dAtA[i:], m.Format)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
-	dAtA[i] = 0x22
-	if m.End != nil {
-		{
-			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Start != nil {
-		{
-			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Count != nil {
-		{
-			size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x12
+	{
+		size, err := m.OSSBucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Submit) Marshal() (dAtA []byte, err error) {
+func (m *OSSBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6245,30 +6622,28 @@ func (m *Submit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
+func (m *OSSBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OSSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Arguments != nil {
-		{
-			size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	{
-		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6276,11 +6651,21 @@ func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SubmitOpts) Marshal() (dAtA []byte, err error) {
+func (m *Outputs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6290,87 +6675,62 @@ func (m *SubmitOpts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubmitOpts) MarshalTo(dAtA []byte) (int, error) {
+func (m *Outputs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubmitOpts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Outputs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OwnerReference != nil {
-		{
-			size, err := m.OwnerReference.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.ExitCode != nil {
+		i -= len(*m.ExitCode)
+		copy(dAtA[i:], *m.ExitCode)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ExitCode)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x22
 	}
-	i -= len(m.Labels)
-	copy(dAtA[i:], m.Labels)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels)))
-	i--
-	dAtA[i] = 0x52
-	i--
-	if m.ServerDryRun {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.Result != nil {
+		i -= len(*m.Result)
+		copy(dAtA[i:], *m.Result)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Result)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x48
-	i--
-	if m.DryRun {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.Artifacts) > 0 {
+		for iNdEx := len(m.Artifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Artifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	i--
-	dAtA[i] = 0x40
-	i -= len(m.ServiceAccount)
-	copy(dAtA[i:], m.ServiceAccount)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccount)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.ParameterFile)
-	copy(dAtA[i:], m.ParameterFile)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParameterFile)))
-	i--
-	dAtA[i] = 0x32
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Parameters[iNdEx])
-			copy(dAtA[i:], m.Parameters[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.Entrypoint)
-	copy(dAtA[i:], m.Entrypoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.GenerateName)
-	copy(dAtA[i:], m.GenerateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GenerateName)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SuppliedValueFrom) Marshal() (dAtA []byte, err error) {
+func (m *ParallelSteps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6380,48 +6740,34 @@ func (m *SuppliedValueFrom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SuppliedValueFrom) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParallelSteps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuppliedValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParallelSteps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SuspendTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Duration)
-	copy(dAtA[i:], m.Duration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Synchronization) Marshal() (dAtA []byte, err error) {
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6431,19 +6777,24 @@ func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Synchronization) MarshalTo(dAtA []byte) (int, error) {
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Mutex != nil {
+	i -= len(m.GlobalName)
+	copy(dAtA[i:], m.GlobalName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GlobalName)))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValueFrom != nil {
 		{
-			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6451,11 +6802,11 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.Semaphore != nil {
+	if m.Value != nil {
 		{
-			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6463,34 +6814,79 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Default != nil {
+		{
+			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PodGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *SynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodGC) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PodGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Mutex != nil {
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Prometheus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Prometheus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Counter != nil {
 		{
-			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6498,11 +6894,11 @@ func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.Semaphore != nil {
+	if m.Histogram != nil {
 		{
-			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6510,12 +6906,53 @@ func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
+	}
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Help)
+	copy(dAtA[i:], m.Help)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Help)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TTLStrategy) Marshal() (dAtA []byte, err error) {
+func (m *RawArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6525,35 +6962,25 @@ func (m *TTLStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TTLStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *RawArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TTLStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SecondsAfterFailure != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterFailure))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SecondsAfterSuccess != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterSuccess))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SecondsAfterCompletion != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterCompletion))
-		i--
-		dAtA[i] = 0x8
-	}
+	i -= len(m.Data)
+	copy(dAtA[i:], m.Data)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
+func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6563,25 +6990,62 @@ func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TarStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompressionLevel != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.CompressionLevel))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Flags[iNdEx])
+			copy(dAtA[i:], m.Flags[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Flags[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.FailureCondition)
+	copy(dAtA[i:], m.FailureCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.SetOwnerReference {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Manifest)
+	copy(dAtA[i:], m.Manifest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manifest)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.MergeStrategy)
+	copy(dAtA[i:], m.MergeStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MergeStrategy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Template) Marshal() (dAtA []byte, err error) {
+func (m *RetryAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6591,19 +7055,19 @@ func (m *Template) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetryAffinity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetryAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Memoize != nil {
+	if m.NodeAntiAffinity != nil {
 		{
-			size, err := m.Memoize.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.NodeAntiAffinity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6611,13 +7075,62 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xa
 	}
-	if m.Synchronization != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryNodeAntiAffinity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryNodeAntiAffinity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Affinity != nil {
 		{
-			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6625,13 +7138,11 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x22
 	}
-	if m.Metrics != nil {
+	if m.Backoff != nil {
 		{
-			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6639,23 +7150,16 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x1a
 	}
+	i -= len(m.RetryPolicy)
+	copy(dAtA[i:], m.RetryPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryPolicy)))
 	i--
-	if m.ResubmitPendingPods {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0x90
-	if m.Executor != nil {
+	dAtA[i] = 0x12
+	if m.Limit != nil {
 		{
-			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6663,388 +7167,131 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0xa
 	}
-	if m.AutomountServiceAccountToken != nil {
-		i--
-		if *m.AutomountServiceAccountToken {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x80
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.PodSpecPatch)
-	copy(dAtA[i:], m.PodSpecPatch)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
-	i--
-	dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *S3Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
-	dAtA[i] = 0xfa
-	if m.SecurityContext != nil {
-		{
-			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
-	}
-	if len(m.HostAliases) > 0 {
-		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
+	dAtA[i] = 0x12
+	{
+		size, err := m.S3Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
-	i--
-	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xe2
-	if m.Priority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *S3Bucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.PriorityClassName)
-	copy(dAtA[i:], m.PriorityClassName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
-	i--
-	dAtA[i] = 0x1
+	return dAtA[:n], nil
+}
+
+func (m *S3Bucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	i--
-	dAtA[i] = 0xd2
-	i -= len(m.SchedulerName)
-	copy(dAtA[i:], m.SchedulerName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	if m.UseSDKCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x40
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
 	i--
-	dAtA[i] = 0xca
-	if len(m.Tolerations) > 0 {
-		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SecretKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Parallelism != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
-	}
-	if m.RetryStrategy != nil {
-		{
-			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AccessKeySecret.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.ActiveDeadlineSeconds != nil {
-		{
-			size, err := m.ActiveDeadlineSeconds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x2a
+	if m.Insecure != nil {
 		i--
-		dAtA[i] = 0xaa
-	}
-	if m.ArchiveLocation != nil {
-		{
-			size, err := m.ArchiveLocation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		if *m.Insecure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
-	}
-	if len(m.Sidecars) > 0 {
-		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-	}
-	if len(m.InitContainers) > 0 {
-		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-	}
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-	}
-	if m.Suspend != nil {
-		{
-			size, err := m.Suspend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.DAG != nil {
-		{
-			size, err := m.DAG.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.Resource != nil {
-		{
-			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Script != nil {
-		{
-			size, err := m.Script.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Container != nil {
-		{
-			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.Daemon != nil {
-		i--
-		if *m.Daemon {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Affinity != nil {
-		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.NodeSelector) > 0 {
-		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
-		for k := range m.NodeSelector {
-			keysForNodeSelector = append(keysForNodeSelector, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodeSelector[iNdEx])
-			copy(dAtA[i:], keysForNodeSelector[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	{
-		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TemplateRef != nil {
-		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		dAtA[i] = 0x20
 	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
+func (m *SQLCache) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7054,37 +7301,16 @@ func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *SQLCache) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SQLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.ClusterScope {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x20
-	i--
-	if m.RuntimeResolution {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
-	i--
-	dAtA[i] = 0x12
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -7093,7 +7319,7 @@ func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UserContainer) Marshal() (dAtA []byte, err error) {
+func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7103,30 +7329,25 @@ func (m *UserContainer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserContainer) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScriptTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScriptTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MirrorVolumeMounts != nil {
-		i--
-		if *m.MirrorVolumeMounts {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
@@ -7136,7 +7357,7 @@ func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
+func (m *SemaphoreHolding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7146,24 +7367,56 @@ func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
+func (m *SemaphoreHolding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SemaphoreHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Event)
-	copy(dAtA[i:], m.Event)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Event)))
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Holders[iNdEx])
+			copy(dAtA[i:], m.Holders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Holders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Semaphore)
+	copy(dAtA[i:], m.Semaphore)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Semaphore)))
 	i--
-	dAtA[i] = 0x3a
-	if m.Supplied != nil {
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SemaphoreRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SemaphoreRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Database != nil {
 		{
-			size, err := m.Supplied.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7171,11 +7424,11 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if m.Default != nil {
+	if m.ConfigMapKeyRef != nil {
 		{
-			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7183,32 +7436,12 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	i -= len(m.Parameter)
-	copy(dAtA[i:], m.Parameter)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.JQFilter)
-	copy(dAtA[i:], m.JQFilter)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQFilter)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.JSONPath)
-	copy(dAtA[i:], m.JSONPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Version) Marshal() (dAtA []byte, err error) {
+func (m *SemaphoreStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7218,60 +7451,48 @@ func (m *Version) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+func (m *SemaphoreStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Version) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SemaphoreStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Platform)
-	copy(dAtA[i:], m.Platform)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Platform)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Compiler)
-	copy(dAtA[i:], m.Compiler)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compiler)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.GoVersion)
-	copy(dAtA[i:], m.GoVersion)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GoVersion)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.GitTreeState)
-	copy(dAtA[i:], m.GitTreeState)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitTreeState)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.GitTag)
-	copy(dAtA[i:], m.GitTag)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitTag)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.GitCommit)
-	copy(dAtA[i:], m.GitCommit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitCommit)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.BuildDate)
-	copy(dAtA[i:], m.BuildDate)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BuildDate)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0xa
+	if len(m.Waiting) > 0 {
+		for iNdEx := len(m.Waiting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waiting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Holding) > 0 {
+		for iNdEx := len(m.Holding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Workflow) Marshal() (dAtA []byte, err error) {
+func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7281,50 +7502,61 @@ func (m *Workflow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Workflow) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Workflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x22
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Count != nil {
+		{
+			size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowEventBinding) Marshal() (dAtA []byte, err error) {
+func (m *Submit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7334,28 +7566,30 @@ func (m *WorkflowEventBinding) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowEventBinding) MarshalTo(dAtA []byte) (int, error) {
+func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowEventBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Arguments != nil {
+		{
+			size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -7367,7 +7601,7 @@ func (m *WorkflowEventBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowEventBindingList) Marshal() (dAtA []byte, err error) {
+func (m *SubmitOpts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7377,44 +7611,87 @@ func (m *WorkflowEventBindingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowEventBindingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubmitOpts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowEventBindingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubmitOpts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.OwnerReference != nil {
+		{
+			size, err := m.OwnerReference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i -= len(m.Labels)
+	copy(dAtA[i:], m.Labels)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels)))
+	i--
+	dAtA[i] = 0x52
+	i--
+	if m.ServerDryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.ServiceAccount)
+	copy(dAtA[i:], m.ServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccount)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.ParameterFile)
+	copy(dAtA[i:], m.ParameterFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParameterFile)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Entrypoint)
+	copy(dAtA[i:], m.Entrypoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.GenerateName)
+	copy(dAtA[i:], m.GenerateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GenerateName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowEventBindingSpec) Marshal() (dAtA []byte, err error) {
+func (m *SuppliedValueFrom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7424,42 +7701,48 @@ func (m *WorkflowEventBindingSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowEventBindingSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuppliedValueFrom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuppliedValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Submit != nil {
-		{
-			size, err := m.Submit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
+func (m *SyncDatabaseRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7469,44 +7752,25 @@ func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncDatabaseRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncDatabaseRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
+func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7516,33 +7780,47 @@ func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Synchronization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Synchronization != nil {
-		{
-			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Mutexes) > 0 {
+		for iNdEx := len(m.Mutexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mutexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
 	}
-	if m.WorkflowTemplateRef != nil {
+	if len(m.Semaphores) > 0 {
+		for iNdEx := len(m.Semaphores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Semaphores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mutex != nil {
 		{
-			size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7550,20 +7828,11 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x12
 	}
-	i -= len(m.Shutdown)
-	copy(dAtA[i:], m.Shutdown)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shutdown)))
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0x8a
-	if m.Metrics != nil {
+	if m.Semaphore != nil {
 		{
-			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7571,13 +7840,34 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xa
 	}
-	if m.PodDisruptionBudget != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *SynchronizationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronizationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mutex != nil {
 		{
-			size, err := m.PodDisruptionBudget.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7585,13 +7875,11 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+		dAtA[i] = 0x12
 	}
-	if m.TTLStrategy != nil {
+	if m.Semaphore != nil {
 		{
-			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Semaphore.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7599,13 +7887,100 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+		dAtA[i] = 0xa
 	}
-	if m.Executor != nil {
-		{
-			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+
+func (m *TTLStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondsAfterFailure != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterFailure))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SecondsAfterSuccess != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterSuccess))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SecondsAfterCompletion != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterCompletion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TarStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TarStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompressionLevel != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CompressionLevel))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Template) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7613,9 +7988,103 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0xc2
+	}
+	if m.ContainerSet != nil {
+		{
+			size, err := m.ContainerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Memoize != nil {
+		{
+			size, err := m.Memoize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Synchronization != nil {
+		{
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	i--
+	if m.ResubmitPendingPods {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x90
+	if m.Executor != nil {
+		{
+			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
 	if m.AutomountServiceAccountToken != nil {
 		i--
@@ -7625,9 +8094,9 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xe0
+		dAtA[i] = 0x80
 	}
 	i -= len(m.PodSpecPatch)
 	copy(dAtA[i:], m.PodSpecPatch)
@@ -7635,7 +8104,7 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xda
+	dAtA[i] = 0xfa
 	if m.SecurityContext != nil {
 		{
 			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
@@ -7648,7 +8117,7 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xd2
+		dAtA[i] = 0xf2
 	}
 	if len(m.HostAliases) > 0 {
 		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
@@ -7663,26 +8132,63 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0xea
 		}
 	}
-	if m.PodPriority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.PodPriority))
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc0
+		dAtA[i] = 0xd8
 	}
-	i -= len(m.PodPriorityClassName)
-	copy(dAtA[i:], m.PodPriorityClassName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodPriorityClassName)))
+	i -= len(m.PriorityClassName)
+	copy(dAtA[i:], m.PriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xba
-	if m.PodGC != nil {
+	dAtA[i] = 0xd2
+	i -= len(m.SchedulerName)
+	copy(dAtA[i:], m.SchedulerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.RetryStrategy != nil {
 		{
-			size, err := m.PodGC.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7694,44 +8200,85 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xb2
 	}
-	i -= len(m.SchedulerName)
-	copy(dAtA[i:], m.SchedulerName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if m.Priority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
 	if m.ActiveDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+		{
+			size, err := m.ActiveDeadlineSeconds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0xaa
 	}
-	if m.TTLSecondsAfterFinished != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+	if m.ArchiveLocation != nil {
+		{
+			size, err := m.ArchiveLocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x90
+		dAtA[i] = 0xa2
 	}
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	if m.DNSConfig != nil {
+	if len(m.Sidecars) > 0 {
+		for iNdEx := len(m.Sidecars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sidecars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.InitContainers) > 0 {
+		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Suspend != nil {
 		{
-			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Suspend.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7743,41 +8290,58 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x82
 	}
-	if m.DNSPolicy != nil {
-		i -= len(*m.DNSPolicy)
-		copy(dAtA[i:], *m.DNSPolicy)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
+	if m.DAG != nil {
+		{
+			size, err := m.DAG.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x7a
 	}
-	if m.HostNetwork != nil {
+	if m.Resource != nil {
+		{
+			size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		if *m.HostNetwork {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x72
+	}
+	if m.Script != nil {
+		{
+			size, err := m.Script.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x6a
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x6a
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.Tolerations) > 0 {
-		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7785,9 +8349,29 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Daemon != nil {
+		i--
+		if *m.Daemon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x4a
 	if m.Affinity != nil {
 		{
 			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
@@ -7798,7 +8382,7 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x42
 	}
 	if len(m.NodeSelector) > 0 {
 		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
@@ -7821,22 +8405,32 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.Suspend != nil {
-		i--
-		if *m.Suspend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x48
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.ArtifactRepositoryRef != nil {
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Inputs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TemplateRef != nil {
 		{
-			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7844,46 +8438,8 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.Parallelism != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.VolumeClaimTemplates) > 0 {
-		for iNdEx := len(m.VolumeClaimTemplates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VolumeClaimTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+		dAtA[i] = 0x22
 	}
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
-	i--
-	dAtA[i] = 0x22
 	{
 		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7894,29 +8450,20 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Entrypoint)
-	copy(dAtA[i:], m.Entrypoint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
-	i--
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
 	dAtA[i] = 0x12
-	if len(m.Templates) > 0 {
-		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowStatus) Marshal() (dAtA []byte, err error) {
+func (m *TemplateRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7926,187 +8473,77 @@ func (m *WorkflowStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *TemplateRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Synchronization != nil {
-		{
-			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.StoredWorkflowSpec != nil {
-		{
-			size, err := m.StoredWorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.ResourcesDuration) > 0 {
-		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
-		for k := range m.ResourcesDuration {
-			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
-		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
-			baseI := i
-			i = encodeVarintGenerated(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(keysForResourcesDuration[iNdEx])
-			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	i -= len(m.OffloadNodeStatusVersion)
-	copy(dAtA[i:], m.OffloadNodeStatusVersion)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OffloadNodeStatusVersion)))
 	i--
-	dAtA[i] = 0x52
-	if len(m.StoredTemplates) > 0 {
-		keysForStoredTemplates := make([]string, 0, len(m.StoredTemplates))
-		for k := range m.StoredTemplates {
-			keysForStoredTemplates = append(keysForStoredTemplates, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
-		for iNdEx := len(keysForStoredTemplates) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.StoredTemplates[string(keysForStoredTemplates[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForStoredTemplates[iNdEx])
-			copy(dAtA[i:], keysForStoredTemplates[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStoredTemplates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.Outputs != nil {
-		{
-			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PersistentVolumeClaims) > 0 {
-		for iNdEx := len(m.PersistentVolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PersistentVolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if len(m.Nodes) > 0 {
-		keysForNodes := make([]string, 0, len(m.Nodes))
-		for k := range m.Nodes {
-			keysForNodes = append(keysForNodes, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-		for iNdEx := len(keysForNodes) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Nodes[string(keysForNodes[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodes[iNdEx])
-			copy(dAtA[i:], keysForNodes[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.RuntimeResolution {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	i -= len(m.CompressedNodes)
-	copy(dAtA[i:], m.CompressedNodes)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompressedNodes)))
 	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	dAtA[i] = 0x18
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
 	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MirrorVolumeMounts != nil {
+		i--
+		if *m.MirrorVolumeMounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -8114,16 +8551,11 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowStep) Marshal() (dAtA []byte, err error) {
+func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8133,41 +8565,29 @@ func (m *WorkflowStep) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowStep) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.OnExit)
-	copy(dAtA[i:], m.OnExit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
-	i--
-	dAtA[i] = 0x5a
-	if m.ContinueOn != nil {
-		{
-			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	i -= len(m.When)
-	copy(dAtA[i:], m.When)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
 	i--
 	dAtA[i] = 0x42
-	if m.WithSequence != nil {
+	i -= len(m.Event)
+	copy(dAtA[i:], m.Event)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Event)))
+	i--
+	dAtA[i] = 0x3a
+	if m.Supplied != nil {
 		{
-			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Supplied.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8175,30 +8595,11 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	i -= len(m.WithParam)
-	copy(dAtA[i:], m.WithParam)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
-	i--
-	dAtA[i] = 0x32
-	if len(m.WithItems) > 0 {
-		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+		dAtA[i] = 0x32
 	}
-	if m.TemplateRef != nil {
+	if m.Default != nil {
 		{
-			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Default.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8206,32 +8607,95 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	{
-		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.JQFilter)
+	copy(dAtA[i:], m.JQFilter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQFilter)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Version) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Platform)
+	copy(dAtA[i:], m.Platform)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Platform)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Compiler)
+	copy(dAtA[i:], m.Compiler)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compiler)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.GoVersion)
+	copy(dAtA[i:], m.GoVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GoVersion)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.GitTreeState)
+	copy(dAtA[i:], m.GitTreeState)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitTreeState)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.GitTag)
+	copy(dAtA[i:], m.GitTag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitTag)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.GitCommit)
+	copy(dAtA[i:], m.GitCommit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitCommit)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Template)
-	copy(dAtA[i:], m.Template)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i -= len(m.BuildDate)
+	copy(dAtA[i:], m.BuildDate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BuildDate)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
+func (m *Workflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8241,16 +8705,26 @@ func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Workflow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Workflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8274,7 +8748,7 @@ func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowDependencies) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8284,20 +8758,25 @@ func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowDependencies) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowDependencies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Workflows) > 0 {
+		for iNdEx := len(m.Workflows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Workflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -8305,23 +8784,13 @@ func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateRef) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowDependency) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8331,24 +8800,41 @@ func (m *WorkflowTemplateRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowDependency) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	i--
-	if m.ClusterScope {
+	if m.IgnoreOlder {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x10
+	dAtA[i] = 0x20
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -8357,7 +8843,7 @@ func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
+func (m *WorkflowEventBinding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8367,30 +8853,28 @@ func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkflowTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkflowEventBinding) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WorkflowEventBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WorkflowMetadata != nil {
-		{
-			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.WorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -8402,913 +8886,1155 @@ func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Amount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowEventBindingList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArchiveStrategy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tar != nil {
-		l = m.Tar.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.None != nil {
-		l = m.None.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+func (m *WorkflowEventBindingList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Arguments) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowEventBindingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Artifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Mode != nil {
-		n += 1 + sovGenerated(uint64(*m.Mode))
-	}
-	l = len(m.From)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ArtifactLocation.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GlobalName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Archive != nil {
-		l = m.Archive.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WorkflowEventBindingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += 2
-	l = len(m.SubPath)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArtifactLocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowEventBindingSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ArchiveLogs != nil {
-		n += 2
-	}
-	if m.S3 != nil {
-		l = m.S3.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.HTTP != nil {
-		l = m.HTTP.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Artifactory != nil {
-		l = m.Artifactory.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.HDFS != nil {
-		l = m.HDFS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Raw != nil {
-		l = m.Raw.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.OSS != nil {
-		l = m.OSS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Submit != nil {
+		{
+			size, err := m.Submit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GCS != nil {
-		l = m.GCS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ArtifactRepositoryRef) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ConfigMap)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArtifactoryArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ArtifactoryAuth.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArtifactoryAuth) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UsernameSecret != nil {
-		l = m.UsernameSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Backoff) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Duration)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Factor != nil {
-		l = m.Factor.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WorkflowSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MaxDuration)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Cache) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ConfigMap != nil {
-		l = m.ConfigMap.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.DependsOn != nil {
+		{
+			size, err := m.DependsOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
 	}
-	return n
-}
-
-func (m *ClusterWorkflowTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ClusterWorkflowTemplateList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Synchronization != nil {
+		{
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
 	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.WorkflowTemplateRef != nil {
+		{
+			size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
-}
-
-func (m *Condition) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.Shutdown)
+	copy(dAtA[i:], m.Shutdown)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shutdown)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ContinueOn) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PodDisruptionBudget != nil {
+		{
+			size, err := m.PodDisruptionBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	var l int
-	_ = l
-	n += 2
-	n += 2
-	return n
-}
-
-func (m *Counter) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TTLStrategy != nil {
+		{
+			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *CronWorkflow) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Executor != nil {
+		{
+			size, err := m.Executor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *CronWorkflowList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.AutomountServiceAccountToken != nil {
+		i--
+		if *m.AutomountServiceAccountToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.PodSpecPatch)
+	copy(dAtA[i:], m.PodSpecPatch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatch)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.SecurityContext != nil {
+		{
+			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	return n
-}
-
-func (m *CronWorkflowSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.HostAliases) > 0 {
+		for iNdEx := len(m.HostAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	var l int
-	_ = l
-	l = m.WorkflowSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Schedule)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConcurrencyPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.StartingDeadlineSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.StartingDeadlineSeconds))
+	if m.PodPriority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PodPriority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.SuccessfulJobsHistoryLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.SuccessfulJobsHistoryLimit))
+	i -= len(m.PodPriorityClassName)
+	copy(dAtA[i:], m.PodPriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodPriorityClassName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.PodGC != nil {
+		{
+			size, err := m.PodGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.FailedJobsHistoryLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.FailedJobsHistoryLimit))
+	i -= len(m.SchedulerName)
+	copy(dAtA[i:], m.SchedulerName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SchedulerName)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = len(m.Timezone)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WorkflowMetadata != nil {
-		l = m.WorkflowMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.ActiveDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	return n
-}
-
-func (m *CronWorkflowStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TTLSecondsAfterFinished != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	var l int
-	_ = l
-	if len(m.Active) > 0 {
-		for _, e := range m.Active {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.DNSConfig != nil {
+		{
+			size, err := m.DNSConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.LastScheduledTime != nil {
-		l = m.LastScheduledTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.DNSPolicy != nil {
+		i -= len(*m.DNSPolicy)
+		copy(dAtA[i:], *m.DNSPolicy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.DNSPolicy)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.HostNetwork != nil {
+		i--
+		if *m.HostNetwork {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *DAGTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Dependencies) > 0 {
-		for _, s := range m.Dependencies {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.WithItems) > 0 {
-		for _, e := range m.WithItems {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	l = len(m.WithParam)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WithSequence != nil {
-		l = m.WithSequence.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.When)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ContinueOn != nil {
-		l = m.ContinueOn.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.OnExit)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Depends)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *DAGTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Suspend != nil {
+		i--
+		if *m.Suspend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.FailFast != nil {
-		n += 2
+	if m.ArtifactRepositoryRef != nil {
+		{
+			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Selector)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ExecutorConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ServiceAccountName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *GCSArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GCSBucket.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *GCSBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bucket)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ServiceAccountKeySecret.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *Gauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Realtime != nil {
-		n += 2
-	}
-	return n
-}
-
-func (m *GitArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Repo)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Depth != nil {
-		n += 1 + sovGenerated(uint64(*m.Depth))
+	if m.Parallelism != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Parallelism))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Fetch) > 0 {
-		for _, s := range m.Fetch {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VolumeClaimTemplates) > 0 {
+		for iNdEx := len(m.VolumeClaimTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeClaimTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.UsernameSecret != nil {
-		l = m.UsernameSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.PasswordSecret != nil {
-		l = m.PasswordSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SSHPrivateKeySecret != nil {
-		l = m.SSHPrivateKeySecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	n += 2
-	return n
-}
-
-func (m *HDFSArtifact) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = m.HDFSConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
-
-func (m *HDFSConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.HDFSKrbConfig.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Entrypoint)
+	copy(dAtA[i:], m.Entrypoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Entrypoint)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.HDFSUser)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HDFSKrbConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.KrbCCacheSecret != nil {
-		l = m.KrbCCacheSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.KrbKeytabSecret != nil {
-		l = m.KrbKeytabSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.KrbUsername)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.KrbRealm)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.KrbConfigConfigMap != nil {
-		l = m.KrbConfigConfigMap.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *WorkflowStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.KrbServicePrincipalName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HTTPArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Histogram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Buckets) > 0 {
-		for _, e := range m.Buckets {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Synchronization != nil {
+		{
+			size, err := m.Synchronization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
-}
-
-func (m *Inputs) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StoredWorkflowSpec != nil {
+		{
+			size, err := m.StoredWorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	var l int
-	_ = l
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.Artifacts) > 0 {
-		for _, e := range m.Artifacts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ResourcesDuration) > 0 {
+		keysForResourcesDuration := make([]string, 0, len(m.ResourcesDuration))
+		for k := range m.ResourcesDuration {
+			keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+		for iNdEx := len(keysForResourcesDuration) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(keysForResourcesDuration[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForResourcesDuration[iNdEx])
+			copy(dAtA[i:], keysForResourcesDuration[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResourcesDuration[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	return n
-}
-
-func (m *Item) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.OffloadNodeStatusVersion)
+	copy(dAtA[i:], m.OffloadNodeStatusVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OffloadNodeStatusVersion)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.StoredTemplates) > 0 {
+		keysForStoredTemplates := make([]string, 0, len(m.StoredTemplates))
+		for k := range m.StoredTemplates {
+			keysForStoredTemplates = append(keysForStoredTemplates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
+		for iNdEx := len(keysForStoredTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StoredTemplates[string(keysForStoredTemplates[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForStoredTemplates[iNdEx])
+			copy(dAtA[i:], keysForStoredTemplates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStoredTemplates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = len(m.Value)
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *Link) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PersistentVolumeClaims) > 0 {
+		for iNdEx := len(m.PersistentVolumeClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PersistentVolumeClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Scope)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if len(m.Nodes) > 0 {
+		keysForNodes := make([]string, 0, len(m.Nodes))
+		for k := range m.Nodes {
+			keysForNodes = append(keysForNodes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+		for iNdEx := len(keysForNodes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Nodes[string(keysForNodes[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodes[iNdEx])
+			copy(dAtA[i:], keysForNodes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.CompressedNodes)
+	copy(dAtA[i:], m.CompressedNodes)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompressedNodes)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MemoizationStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 2
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CacheName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Memoize) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Cache != nil {
-		l = m.Cache.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+func (m *WorkflowStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	return n
+	i -= len(m.OnExit)
+	copy(dAtA[i:], m.OnExit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
+	i--
+	dAtA[i] = 0x5a
+	if m.ContinueOn != nil {
+		{
+			size, err := m.ContinueOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x42
+	if m.WithSequence != nil {
+		{
+			size, err := m.WithSequence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.WithParam)
+	copy(dAtA[i:], m.WithParam)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WithParam)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.WithItems) > 0 {
+		for iNdEx := len(m.WithItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MetricLabel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Metrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Prometheus) > 0 {
-		for _, e := range m.Prometheus {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Mutex) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MutexHolding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Mutex)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Holder)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WorkflowTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MutexStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WorkflowTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Holding) > 0 {
-		for _, e := range m.Holding {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Waiting) > 0 {
-		for _, e := range m.Waiting {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.BoundaryID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.FinishedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PodIP)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Daemoned != nil {
-		n += 2
-	}
-	if m.Inputs != nil {
-		l = m.Inputs.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Outputs != nil {
-		l = m.Outputs.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			l = len(s)
-			n += 2 + l + sovGenerated(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OutboundNodes) > 0 {
-		for _, s := range m.OutboundNodes {
-			l = len(s)
-			n += 2 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkflowMetadata != nil {
+		{
+			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.StoredTemplateID)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.WorkflowTemplateName)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateScope)
-	n += 2 + l + sovGenerated(uint64(l))
-	if len(m.ResourcesDuration) > 0 {
-		for k, v := range m.ResourcesDuration {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
-			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.WorkflowSpec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.HostNodeName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.MemoizationStatus != nil {
-		l = m.MemoizationStatus.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NoneStrategy) Size() (n int) {
-	if m == nil {
-		return 0
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	var l int
-	_ = l
-	return n
+	dAtA[offset] = uint8(v)
+	return base
 }
-
-func (m *OSSArtifact) Size() (n int) {
+func (m *Amount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.OSSBucket.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *OSSBucket) Size() (n int) {
+func (m *ArchiveStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Endpoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Bucket)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.AccessKeySecret.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SecretKeySecret.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.Tar != nil {
+		l = m.Tar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.None != nil {
+		l = m.None.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *Outputs) Size() (n int) {
+func (m *Arguments) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9326,33 +10052,10 @@ func (m *Outputs) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Result != nil {
-		l = len(*m.Result)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ExitCode != nil {
-		l = len(*m.ExitCode)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *ParallelSteps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *Parameter) Size() (n int) {
+func (m *Artifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9360,137 +10063,145 @@ func (m *Parameter) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Default != nil {
-		l = m.Default.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Mode != nil {
+		n += 1 + sovGenerated(uint64(*m.Mode))
 	}
-	if m.ValueFrom != nil {
-		l = m.ValueFrom.Size()
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ArtifactLocation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GlobalName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Archive != nil {
+		l = m.Archive.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.GlobalName)
+	n += 2
+	l = len(m.SubPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PodGC) Size() (n int) {
+func (m *ArtifactCache) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Strategy)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Prometheus) Size() (n int) {
+func (m *ArtifactLocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.ArchiveLogs != nil {
+		n += 2
 	}
-	l = len(m.Help)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.When)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
+	if m.S3 != nil {
+		l = m.S3.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Histogram != nil {
-		l = m.Histogram.Size()
+	if m.Git != nil {
+		l = m.Git.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Counter != nil {
-		l = m.Counter.Size()
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifactory != nil {
+		l = m.Artifactory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HDFS != nil {
+		l = m.HDFS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Raw != nil {
+		l = m.Raw.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OSS != nil {
+		l = m.OSS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GCS != nil {
+		l = m.GCS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *RawArtifact) Size() (n int) {
+func (m *ArtifactRepositoryRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.ConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ResourceTemplate) Size() (n int) {
+func (m *ArtifactoryArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Action)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MergeStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Manifest)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.SuccessCondition)
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FailureCondition)
+	l = m.ArtifactoryAuth.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Flags) > 0 {
-		for _, s := range m.Flags {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *RetryStrategy) Size() (n int) {
+func (m *ArtifactoryAuth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Limit != nil {
-		l = m.Limit.Size()
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.RetryPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Backoff != nil {
-		l = m.Backoff.Size()
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *S3Artifact) Size() (n int) {
+func (m *AzureArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.S3Bucket.Size()
+	l = m.AzureBlobContainer.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	l = len(m.Blob)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *S3Bucket) Size() (n int) {
+func (m *AzureBlobContainer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9498,389 +10209,299 @@ func (m *S3Bucket) Size() (n int) {
 	_ = l
 	l = len(m.Endpoint)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Bucket)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Region)
+	l = len(m.Container)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Insecure != nil {
-		n += 2
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASTokenSecret != nil {
+		l = m.SASTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.AccessKeySecret.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SecretKeySecret.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.RoleARN)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *ScriptTemplate) Size() (n int) {
+func (m *BackfillWindow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Container.Size()
+	l = m.From.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Source)
+	l = m.To.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SemaphoreHolding) Size() (n int) {
+func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Semaphore)
+	l = len(m.Duration)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Holders) > 0 {
-		for _, s := range m.Holders {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Factor != nil {
+		l = m.Factor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SemaphoreRef) Size() (n int) {
+func (m *Cache) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ConfigMapKeyRef != nil {
-		l = m.ConfigMapKeyRef.Size()
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *SemaphoreStatus) Size() (n int) {
+func (m *ChildWorkflowTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Holding) > 0 {
-		for _, e := range m.Holding {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Waiting) > 0 {
-		for _, e := range m.Waiting {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.WorkflowTemplateRef != nil {
+		l = m.WorkflowTemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Sequence) Size() (n int) {
+func (m *ClusterWorkflowTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Count != nil {
-		l = m.Count.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.End != nil {
-		l = m.End.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Format)
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Submit) Size() (n int) {
+func (m *ClusterWorkflowTemplateList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.WorkflowTemplateRef.Size()
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Arguments != nil {
-		l = m.Arguments.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *SubmitOpts) Size() (n int) {
+func (m *Condition) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GenerateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Entrypoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Parameters) > 0 {
-		for _, s := range m.Parameters {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.ParameterFile)
+	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ServiceAccount)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 2
-	l = len(m.Labels)
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.OwnerReference != nil {
-		l = m.OwnerReference.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *SuppliedValueFrom) Size() (n int) {
+func (m *ContainerNode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Outputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SuspendTemplate) Size() (n int) {
+func (m *ContainerSetTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Duration)
-	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Synchronization) Size() (n int) {
+func (m *ContinueOn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Semaphore != nil {
-		l = m.Semaphore.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Mutex != nil {
-		l = m.Mutex.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	n += 2
+	n += 2
 	return n
 }
 
-func (m *SynchronizationStatus) Size() (n int) {
+func (m *Counter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Semaphore != nil {
-		l = m.Semaphore.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Mutex != nil {
-		l = m.Mutex.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TTLStrategy) Size() (n int) {
+func (m *CronWorkflow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SecondsAfterCompletion != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterCompletion))
-	}
-	if m.SecondsAfterSuccess != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterSuccess))
-	}
-	if m.SecondsAfterFailure != nil {
-		n += 1 + sovGenerated(uint64(*m.SecondsAfterFailure))
-	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TarStrategy) Size() (n int) {
+func (m *CronWorkflowList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CompressionLevel != nil {
-		n += 1 + sovGenerated(uint64(*m.CompressionLevel))
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *Template) Size() (n int) {
+func (m *CronWorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.WorkflowSpec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
+	l = len(m.Schedule)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
+	l = len(m.ConcurrencyPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.StartingDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.StartingDeadlineSeconds))
 	}
-	l = m.Inputs.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Outputs.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	if m.SuccessfulJobsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.SuccessfulJobsHistoryLimit))
 	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.FailedJobsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.FailedJobsHistoryLimit))
 	}
-	l = m.Metadata.Size()
+	l = len(m.Timezone)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Daemon != nil {
-		n += 2
+	if m.WorkflowMetadata != nil {
+		l = m.WorkflowMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
+	if len(m.Schedules) > 0 {
+		for _, s := range m.Schedules {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Script != nil {
-		l = m.Script.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.DAG != nil {
-		l = m.DAG.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Suspend != nil {
-		l = m.Suspend.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+	if len(m.Exclusions) > 0 {
+		for _, s := range m.Exclusions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.InitContainers) > 0 {
-		for _, e := range m.InitContainers {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
-		}
+	return n
+}
+
+func (m *CronWorkflowStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Sidecars) > 0 {
-		for _, e := range m.Sidecars {
+	var l int
+	_ = l
+	if len(m.Active) > 0 {
+		for _, e := range m.Active {
 			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.ArchiveLocation != nil {
-		l = m.ArchiveLocation.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.ActiveDeadlineSeconds != nil {
-		l = m.ActiveDeadlineSeconds.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.Parallelism != nil {
-		n += 2 + sovGenerated(uint64(*m.Parallelism))
+	if m.LastScheduledTime != nil {
+		l = m.LastScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
 			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.SchedulerName)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.PriorityClassName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.Priority != nil {
-		n += 2 + sovGenerated(uint64(*m.Priority))
-	}
-	l = len(m.ServiceAccountName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if len(m.HostAliases) > 0 {
-		for _, e := range m.HostAliases {
+	if len(m.Backfilled) > 0 {
+		for _, e := range m.Backfilled {
 			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.PodSpecPatch)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.AutomountServiceAccountToken != nil {
-		n += 3
-	}
-	if m.Executor != nil {
-		l = m.Executor.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	n += 3
-	if m.Metrics != nil {
-		l = m.Metrics.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.Synchronization != nil {
-		l = m.Synchronization.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.Memoize != nil {
-		l = m.Memoize.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *TemplateRef) Size() (n int) {
+func (m *DAGTask) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9890,436 +10511,267 @@ func (m *TemplateRef) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Template)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 2
-	return n
-}
-
-func (m *UserContainer) Size() (n int) {
-	if m == nil {
-		return 0
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	l = m.Container.Size()
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.WithItems) > 0 {
+		for _, e := range m.WithItems {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.WithParam)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.MirrorVolumeMounts != nil {
-		n += 2
+	if m.WithSequence != nil {
+		l = m.WithSequence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ContinueOn != nil {
+		l = m.ContinueOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnExit)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Depends)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *ValueFrom) Size() (n int) {
+func (m *DAGTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.JSONPath)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.JQFilter)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Parameter)
+	l = len(m.Target)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Default != nil {
-		l = m.Default.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Supplied != nil {
-		l = m.Supplied.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.FailFast != nil {
+		n += 2
 	}
-	l = len(m.Event)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Version) Size() (n int) {
+func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.BuildDate)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitCommit)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitTag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitTreeState)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GoVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Compiler)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Platform)
+	l = len(m.Selector)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Workflow) Size() (n int) {
+func (m *ExecutorConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.ServiceAccountName)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowEventBinding) Size() (n int) {
+func (m *GCSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = m.GCSBucket.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowEventBindingList) Size() (n int) {
+func (m *GCSBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ServiceAccountKeySecret.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *WorkflowEventBindingSpec) Size() (n int) {
+func (m *Gauge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Event.Size()
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Submit != nil {
-		l = m.Submit.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Realtime != nil {
+		n += 2
 	}
 	return n
 }
 
-func (m *WorkflowList) Size() (n int) {
+func (m *GitArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Repo)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *WorkflowSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Entrypoint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ServiceAccountName)
+	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Depth != nil {
+		n += 1 + sovGenerated(uint64(*m.Depth))
 	}
-	if len(m.VolumeClaimTemplates) > 0 {
-		for _, e := range m.VolumeClaimTemplates {
-			l = e.Size()
+	if len(m.Fetch) > 0 {
+		for _, s := range m.Fetch {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Parallelism != nil {
-		n += 1 + sovGenerated(uint64(*m.Parallelism))
-	}
-	if m.ArtifactRepositoryRef != nil {
-		l = m.ArtifactRepositoryRef.Size()
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Suspend != nil {
-		n += 2
-	}
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, e := range m.ImagePullSecrets {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.HostNetwork != nil {
-		n += 2
-	}
-	if m.DNSPolicy != nil {
-		l = len(*m.DNSPolicy)
+	if m.SSHPrivateKeySecret != nil {
+		l = m.SSHPrivateKeySecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.DNSConfig != nil {
-		l = m.DNSConfig.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.OnExit)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.TTLSecondsAfterFinished != nil {
-		n += 2 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
-	}
-	if m.ActiveDeadlineSeconds != nil {
-		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
-	}
-	if m.Priority != nil {
-		n += 2 + sovGenerated(uint64(*m.Priority))
-	}
-	l = len(m.SchedulerName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.PodGC != nil {
-		l = m.PodGC.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.PodPriorityClassName)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.PodPriority != nil {
-		n += 2 + sovGenerated(uint64(*m.PodPriority))
-	}
-	if len(m.HostAliases) > 0 {
-		for _, e := range m.HostAliases {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.PodSpecPatch)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.AutomountServiceAccountToken != nil {
-		n += 3
-	}
-	if m.Executor != nil {
-		l = m.Executor.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.TTLStrategy != nil {
-		l = m.TTLStrategy.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.PodDisruptionBudget != nil {
-		l = m.PodDisruptionBudget.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.Metrics != nil {
-		l = m.Metrics.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Shutdown)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.WorkflowTemplateRef != nil {
-		l = m.WorkflowTemplateRef.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
-	if m.Synchronization != nil {
-		l = m.Synchronization.Size()
-		n += 2 + l + sovGenerated(uint64(l))
-	}
+	n += 2
 	return n
 }
 
-func (m *WorkflowStatus) Size() (n int) {
+func (m *HDFSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.FinishedAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = m.HDFSConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CompressedNodes)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Nodes) > 0 {
-		for k, v := range m.Nodes {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.PersistentVolumeClaims) > 0 {
-		for _, e := range m.PersistentVolumeClaims {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.Outputs != nil {
-		l = m.Outputs.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.StoredTemplates) > 0 {
-		for k, v := range m.StoredTemplates {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+	n += 2
+	return n
+}
+
+func (m *HDFSConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.OffloadNodeStatusVersion)
+	var l int
+	_ = l
+	l = m.HDFSKrbConfig.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.ResourcesDuration) > 0 {
-		for k, v := range m.ResourcesDuration {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.StoredWorkflowSpec != nil {
-		l = m.StoredWorkflowSpec.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Synchronization != nil {
-		l = m.Synchronization.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = len(m.HDFSUser)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowStep) Size() (n int) {
+func (m *HDFSKrbConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Arguments.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TemplateRef != nil {
-		l = m.TemplateRef.Size()
+	if m.KrbCCacheSecret != nil {
+		l = m.KrbCCacheSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.WithItems) > 0 {
-		for _, e := range m.WithItems {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.WithParam)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WithSequence != nil {
-		l = m.WithSequence.Size()
+	if m.KrbKeytabSecret != nil {
+		l = m.KrbKeytabSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.When)
+	l = len(m.KrbUsername)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.ContinueOn != nil {
-		l = m.ContinueOn.Size()
+	l = len(m.KrbRealm)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.KrbConfigConfigMap != nil {
+		l = m.KrbConfigConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.OnExit)
+	l = len(m.KrbServicePrincipalName)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowTemplate) Size() (n int) {
+func (m *HTTP) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Method)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.SuccessCondition)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WorkflowTemplateList) Size() (n int) {
+func (m *HTTPArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *WorkflowTemplateRef) Size() (n int) {
+func (m *HTTPHeader) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10327,1446 +10779,4890 @@ func (m *WorkflowTemplateRef) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *WorkflowTemplateSpec) Size() (n int) {
+func (m *HTTPHeaderSource) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.WorkflowSpec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.WorkflowMetadata != nil {
-		l = m.WorkflowMetadata.Size()
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Amount) String() string {
-	if this == nil {
-		return "nil"
+func (m *Histogram) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Amount{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArchiveStrategy) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ArchiveStrategy{`,
-		`Tar:` + strings.Replace(this.Tar.String(), "TarStrategy", "TarStrategy", 1) + `,`,
-		`None:` + strings.Replace(this.None.String(), "NoneStrategy", "NoneStrategy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Arguments) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Inputs) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Arguments{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Artifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Item) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Artifact{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Mode:` + valueToStringGenerated(this.Mode) + `,`,
-		`From:` + fmt.Sprintf("%v", this.From) + `,`,
-		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
-		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
-		`Archive:` + strings.Replace(this.Archive.String(), "ArchiveStrategy", "ArchiveStrategy", 1) + `,`,
-		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
-		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactLocation) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = len(m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArtifactLocation{`,
-		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
-		`S3:` + strings.Replace(this.S3.String(), "S3Artifact", "S3Artifact", 1) + `,`,
-		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
-		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPArtifact", "HTTPArtifact", 1) + `,`,
-		`Artifactory:` + strings.Replace(this.Artifactory.String(), "ArtifactoryArtifact", "ArtifactoryArtifact", 1) + `,`,
-		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifact", "HDFSArtifact", 1) + `,`,
-		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
-		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
-		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ArtifactRepositoryRef) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *LifecycleHook) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArtifactRepositoryRef{`,
-		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactoryArtifact) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArtifactoryArtifact{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`ArtifactoryAuth:` + strings.Replace(strings.Replace(this.ArtifactoryAuth.String(), "ArtifactoryAuth", "ArtifactoryAuth", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArtifactoryAuth) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Link) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArtifactoryAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Scope)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Backoff) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MemoizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Backoff{`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`Factor:` + strings.Replace(fmt.Sprintf("%v", this.Factor), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Cache) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Memoize) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Cache{`,
-		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterWorkflowTemplate) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Cache != nil {
+		l = m.Cache.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ClusterWorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.MaxAge)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ClusterWorkflowTemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]ClusterWorkflowTemplate{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterWorkflowTemplate", "ClusterWorkflowTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ClusterWorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Condition) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&Condition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ContinueOn) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MetricLabel) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ContinueOn{`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Counter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Counter{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CronWorkflow) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Prometheus) > 0 {
+		for _, e := range m.Prometheus {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&CronWorkflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CronWorkflowList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]CronWorkflow{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "CronWorkflow", "CronWorkflow", 1), `&`, ``, 1) + ","
+
+func (m *Mutex) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&CronWorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *CronWorkflowSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MutexHolding) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CronWorkflowSpec{`,
-		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
-		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
-		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
-		`StartingDeadlineSeconds:` + valueToStringGenerated(this.StartingDeadlineSeconds) + `,`,
-		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
-		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
-		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Mutex)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Holder)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *CronWorkflowStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MutexStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForActive := "[]ObjectReference{"
-	for _, f := range this.Active {
-		repeatedStringForActive += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if len(m.Holding) > 0 {
+		for _, e := range m.Holding {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForActive += "}"
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	if len(m.Waiting) > 0 {
+		for _, e := range m.Waiting {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&CronWorkflowStatus{`,
-		`Active:` + repeatedStringForActive + `,`,
-		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *DAGTask) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NodeStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForWithItems := "[]Item{"
-	for _, f := range this.WithItems {
-		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWithItems += "}"
-	s := strings.Join([]string{`&DAGTask{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
-		`WithItems:` + repeatedStringForWithItems + `,`,
-		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
-		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
-		`When:` + fmt.Sprintf("%v", this.When) + `,`,
-		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DAGTemplate) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BoundaryID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodIP)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Daemoned != nil {
+		n += 2
 	}
-	repeatedStringForTasks := "[]DAGTask{"
-	for _, f := range this.Tasks {
-		repeatedStringForTasks += strings.Replace(strings.Replace(f.String(), "DAGTask", "DAGTask", 1), `&`, ``, 1) + ","
+	if m.Inputs != nil {
+		l = m.Inputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTasks += "}"
-	s := strings.Join([]string{`&DAGTemplate{`,
-		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
-		`Tasks:` + repeatedStringForTasks + `,`,
-		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Event) String() string {
-	if this == nil {
-		return "nil"
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Event{`,
-		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ExecutorConfig) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ExecutorConfig{`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GCSArtifact) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.OutboundNodes) > 0 {
+		for _, s := range m.OutboundNodes {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&GCSArtifact{`,
-		`GCSBucket:` + strings.Replace(strings.Replace(this.GCSBucket.String(), "GCSBucket", "GCSBucket", 1), `&`, ``, 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GCSBucket) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.StoredTemplateID)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.WorkflowTemplateName)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateScope)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&GCSBucket{`,
-		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`ServiceAccountKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Gauge) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.HostNodeName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.MemoizationStatus != nil {
+		l = m.MemoizationStatus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Gauge{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Realtime:` + valueToStringGenerated(this.Realtime) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.ChildWorkflow)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 3
+	return n
 }
-func (this *GitArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NoneStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitArtifact{`,
-		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
-		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *HDFSArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OSSArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HDFSArtifact{`,
-		`HDFSConfig:` + strings.Replace(strings.Replace(this.HDFSConfig.String(), "HDFSConfig", "HDFSConfig", 1), `&`, ``, 1) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.OSSBucket.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *HDFSConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OSSBucket) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HDFSConfig{`,
-		`HDFSKrbConfig:` + strings.Replace(strings.Replace(this.HDFSKrbConfig.String(), "HDFSKrbConfig", "HDFSKrbConfig", 1), `&`, ``, 1) + `,`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`HDFSUser:` + fmt.Sprintf("%v", this.HDFSUser) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.AccessKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *HDFSKrbConfig) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Outputs) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HDFSKrbConfig{`,
-		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
-		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
-		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
-		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HTTPArtifact) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&HTTPArtifact{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Histogram) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Artifacts) > 0 {
+		for _, e := range m.Artifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForBuckets := "[]Amount{"
-	for _, f := range this.Buckets {
-		repeatedStringForBuckets += strings.Replace(strings.Replace(f.String(), "Amount", "Amount", 1), `&`, ``, 1) + ","
+	if m.Result != nil {
+		l = len(*m.Result)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForBuckets += "}"
-	s := strings.Join([]string{`&Histogram{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Buckets:` + repeatedStringForBuckets + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Inputs) String() string {
-	if this == nil {
-		return "nil"
+	if m.ExitCode != nil {
+		l = len(*m.ExitCode)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *ParallelSteps) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Inputs{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Link) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Parameter) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Link{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MemoizationStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Default != nil {
+		l = m.Default.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&MemoizationStatus{`,
-		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.GlobalName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Memoize) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodGC) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Memoize{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Metadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Prometheus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	l = len(m.Help)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if m.Counter != nil {
+		l = m.Counter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&Metadata{`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`Labels:` + mapStringForLabels + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MetricLabel) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RawArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MetricLabel{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Data)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Metrics) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResourceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPrometheus := "[]*Prometheus{"
-	for _, f := range this.Prometheus {
-		repeatedStringForPrometheus += strings.Replace(f.String(), "Prometheus", "Prometheus", 1) + ","
+	var l int
+	_ = l
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MergeStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Manifest)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForPrometheus += "}"
-	s := strings.Join([]string{`&Metrics{`,
-		`Prometheus:` + repeatedStringForPrometheus + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Mutex) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RetryAffinity) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Mutex{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.NodeAntiAffinity != nil {
+		l = m.NodeAntiAffinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *MutexHolding) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RetryNodeAntiAffinity) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MutexHolding{`,
-		`Mutex:` + fmt.Sprintf("%v", this.Mutex) + `,`,
-		`Holder:` + fmt.Sprintf("%v", this.Holder) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *MutexStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForHolding := "[]MutexHolding{"
-	for _, f := range this.Holding {
-		repeatedStringForHolding += strings.Replace(strings.Replace(f.String(), "MutexHolding", "MutexHolding", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForHolding += "}"
-	repeatedStringForWaiting := "[]MutexHolding{"
-	for _, f := range this.Waiting {
-		repeatedStringForWaiting += strings.Replace(strings.Replace(f.String(), "MutexHolding", "MutexHolding", 1), `&`, ``, 1) + ","
+	l = len(m.RetryPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWaiting += "}"
-	s := strings.Join([]string{`&MutexStatus{`,
-		`Holding:` + repeatedStringForHolding + `,`,
-		`Waiting:` + repeatedStringForWaiting + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *NodeStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *S3Artifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
-	for k := range this.ResourcesDuration {
-		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	var l int
+	_ = l
+	l = m.S3Bucket.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *S3Bucket) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
-	mapStringForResourcesDuration := "ResourcesDuration{"
-	for _, k := range keysForResourcesDuration {
-		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Insecure != nil {
+		n += 2
 	}
-	mapStringForResourcesDuration += "}"
-	s := strings.Join([]string{`&NodeStatus{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
-		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
-		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
-		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
-		`Children:` + fmt.Sprintf("%v", this.Children) + `,`,
-		`OutboundNodes:` + fmt.Sprintf("%v", this.OutboundNodes) + `,`,
-		`StoredTemplateID:` + fmt.Sprintf("%v", this.StoredTemplateID) + `,`,
-		`WorkflowTemplateName:` + fmt.Sprintf("%v", this.WorkflowTemplateName) + `,`,
-		`TemplateScope:` + fmt.Sprintf("%v", this.TemplateScope) + `,`,
-		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
-		`HostNodeName:` + fmt.Sprintf("%v", this.HostNodeName) + `,`,
-		`MemoizationStatus:` + strings.Replace(this.MemoizationStatus.String(), "MemoizationStatus", "MemoizationStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.AccessKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SecretKeySecret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *NoneStrategy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SQLCache) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&NoneStrategy{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *OSSArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ScriptTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&OSSArtifact{`,
-		`OSSBucket:` + strings.Replace(strings.Replace(this.OSSBucket.String(), "OSSBucket", "OSSBucket", 1), `&`, ``, 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *OSSBucket) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SemaphoreHolding) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&OSSBucket{`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Semaphore)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Holders) > 0 {
+		for _, s := range m.Holders {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *Outputs) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SemaphoreRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters := "[]Parameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForArtifacts := "[]Artifact{"
-	for _, f := range this.Artifacts {
-		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	if m.Database != nil {
+		l = m.Database.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArtifacts += "}"
-	s := strings.Join([]string{`&Outputs{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Artifacts:` + repeatedStringForArtifacts + `,`,
-		`Result:` + valueToStringGenerated(this.Result) + `,`,
-		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ParallelSteps) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SemaphoreStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps := "[]WorkflowStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "WorkflowStep", "WorkflowStep", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Holding) > 0 {
+		for _, e := range m.Holding {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForSteps += "}"
-	s := strings.Join([]string{`&ParallelSteps{`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Parameter) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Waiting) > 0 {
+		for _, e := range m.Waiting {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Parameter{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Default:` + strings.Replace(fmt.Sprintf("%v", this.Default), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
-		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PodGC) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Sequence) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PodGC{`,
-		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Prometheus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Count != nil {
+		l = m.Count.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForLabels := "[]*MetricLabel{"
-	for _, f := range this.Labels {
-		repeatedStringForLabels += strings.Replace(f.String(), "MetricLabel", "MetricLabel", 1) + ","
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForLabels += "}"
-	s := strings.Join([]string{`&Prometheus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Labels:` + repeatedStringForLabels + `,`,
-		`Help:` + fmt.Sprintf("%v", this.Help) + `,`,
-		`When:` + fmt.Sprintf("%v", this.When) + `,`,
-		`Gauge:` + strings.Replace(this.Gauge.String(), "Gauge", "Gauge", 1) + `,`,
-		`Histogram:` + strings.Replace(this.Histogram.String(), "Histogram", "Histogram", 1) + `,`,
-		`Counter:` + strings.Replace(this.Counter.String(), "Counter", "Counter", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RawArtifact) String() string {
-	if this == nil {
-		return "nil"
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&RawArtifact{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ResourceTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Submit) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ResourceTemplate{`,
-		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
-		`MergeStrategy:` + fmt.Sprintf("%v", this.MergeStrategy) + `,`,
-		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
-		`SetOwnerReference:` + fmt.Sprintf("%v", this.SetOwnerReference) + `,`,
-		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
-		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
-		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RetryStrategy) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.WorkflowTemplateRef.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Arguments != nil {
+		l = m.Arguments.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&RetryStrategy{`,
-		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
-		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *S3Artifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SubmitOpts) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&S3Artifact{`,
-		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *S3Bucket) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GenerateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Entrypoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&S3Bucket{`,
-		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
-		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
-		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
-		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
-		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.ParameterFile)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	l = len(m.Labels)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.OwnerReference != nil {
+		l = m.OwnerReference.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *ScriptTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SuppliedValueFrom) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ScriptTemplate{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *SemaphoreHolding) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SuspendTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SemaphoreHolding{`,
-		`Semaphore:` + fmt.Sprintf("%v", this.Semaphore) + `,`,
-		`Holders:` + fmt.Sprintf("%v", this.Holders) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SemaphoreRef) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncDatabaseRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SemaphoreRef{`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SemaphoreStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Synchronization) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForHolding := "[]SemaphoreHolding{"
-	for _, f := range this.Holding {
-		repeatedStringForHolding += strings.Replace(strings.Replace(f.String(), "SemaphoreHolding", "SemaphoreHolding", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Semaphore != nil {
+		l = m.Semaphore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForHolding += "}"
-	repeatedStringForWaiting := "[]SemaphoreHolding{"
-	for _, f := range this.Waiting {
-		repeatedStringForWaiting += strings.Replace(strings.Replace(f.String(), "SemaphoreHolding", "SemaphoreHolding", 1), `&`, ``, 1) + ","
+	if m.Mutex != nil {
+		l = m.Mutex.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWaiting += "}"
-	s := strings.Join([]string{`&SemaphoreStatus{`,
-		`Holding:` + repeatedStringForHolding + `,`,
-		`Waiting:` + repeatedStringForWaiting + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Sequence) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Semaphores) > 0 {
+		for _, e := range m.Semaphores {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Sequence{`,
-		`Count:` + strings.Replace(fmt.Sprintf("%v", this.Count), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Submit) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Mutexes) > 0 {
+		for _, e := range m.Mutexes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Submit{`,
-		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
-		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SubmitOpts) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SynchronizationStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SubmitOpts{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`GenerateName:` + fmt.Sprintf("%v", this.GenerateName) + `,`,
-		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
-		`Parameters:` + fmt.Sprintf("%v", this.Parameters) + `,`,
-		`ParameterFile:` + fmt.Sprintf("%v", this.ParameterFile) + `,`,
-		`ServiceAccount:` + fmt.Sprintf("%v", this.ServiceAccount) + `,`,
-		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
-		`ServerDryRun:` + fmt.Sprintf("%v", this.ServerDryRun) + `,`,
-		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
-		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v11.OwnerReference", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuppliedValueFrom) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Semaphore != nil {
+		l = m.Semaphore.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&SuppliedValueFrom{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuspendTemplate) String() string {
-	if this == nil {
-		return "nil"
+	if m.Mutex != nil {
+		l = m.Mutex.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&SuspendTemplate{`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Synchronization) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TTLStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Synchronization{`,
-		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
-		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SynchronizationStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.SecondsAfterCompletion != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterCompletion))
 	}
-	s := strings.Join([]string{`&SynchronizationStatus{`,
-		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreStatus", "SemaphoreStatus", 1) + `,`,
-		`Mutex:` + strings.Replace(this.Mutex.String(), "MutexStatus", "MutexStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TTLStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.SecondsAfterSuccess != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterSuccess))
 	}
-	s := strings.Join([]string{`&TTLStrategy{`,
-		`SecondsAfterCompletion:` + valueToStringGenerated(this.SecondsAfterCompletion) + `,`,
-		`SecondsAfterSuccess:` + valueToStringGenerated(this.SecondsAfterSuccess) + `,`,
-		`SecondsAfterFailure:` + valueToStringGenerated(this.SecondsAfterFailure) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TarStrategy) String() string {
-	if this == nil {
-		return "nil"
+	if m.SecondsAfterFailure != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterFailure))
 	}
-	s := strings.Join([]string{`&TarStrategy{`,
-		`CompressionLevel:` + valueToStringGenerated(this.CompressionLevel) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Template) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForSteps := "[]ParallelSteps{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "ParallelSteps", "ParallelSteps", 1), `&`, ``, 1) + ","
+
+func (m *TarStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if m.CompressionLevel != nil {
+		n += 1 + sovGenerated(uint64(*m.CompressionLevel))
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForInitContainers := "[]UserContainer{"
-	for _, f := range this.InitContainers {
-		repeatedStringForInitContainers += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *Template) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForInitContainers += "}"
-	repeatedStringForSidecars := "[]UserContainer{"
-	for _, f := range this.Sidecars {
-		repeatedStringForSidecars += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSidecars += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	l = m.Inputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Outputs.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForHostAliases := "[]HostAlias{"
-	for _, f := range this.HostAliases {
-		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForHostAliases += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Daemon != nil {
+		n += 2
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&Template{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
-		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
-		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
-		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
-		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
-		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
-		`Suspend:` + strings.Replace(this.Suspend.String(), "SuspendTemplate", "SuspendTemplate", 1) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`InitContainers:` + repeatedStringForInitContainers + `,`,
-		`Sidecars:` + repeatedStringForSidecars + `,`,
-		`ArchiveLocation:` + strings.Replace(this.ArchiveLocation.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
-		`ActiveDeadlineSeconds:` + strings.Replace(fmt.Sprintf("%v", this.ActiveDeadlineSeconds), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
-		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
-		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
-		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
-		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
-		`ResubmitPendingPods:` + fmt.Sprintf("%v", this.ResubmitPendingPods) + `,`,
-		`Metrics:` + strings.Replace(this.Metrics.String(), "Metrics", "Metrics", 1) + `,`,
-		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
-		`Memoize:` + strings.Replace(this.Memoize.String(), "Memoize", "Memoize", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TemplateRef) String() string {
-	if this == nil {
-		return "nil"
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&TemplateRef{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`RuntimeResolution:` + fmt.Sprintf("%v", this.RuntimeResolution) + `,`,
-		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UserContainer) String() string {
-	if this == nil {
-		return "nil"
+	if m.Script != nil {
+		l = m.Script.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&UserContainer{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
-		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ValueFrom) String() string {
-	if this == nil {
-		return "nil"
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ValueFrom{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
-		`JQFilter:` + fmt.Sprintf("%v", this.JQFilter) + `,`,
-		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
-		`Default:` + strings.Replace(fmt.Sprintf("%v", this.Default), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
-		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Version) String() string {
-	if this == nil {
-		return "nil"
+	if m.DAG != nil {
+		l = m.DAG.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Version{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`BuildDate:` + fmt.Sprintf("%v", this.BuildDate) + `,`,
-		`GitCommit:` + fmt.Sprintf("%v", this.GitCommit) + `,`,
-		`GitTag:` + fmt.Sprintf("%v", this.GitTag) + `,`,
-		`GitTreeState:` + fmt.Sprintf("%v", this.GitTreeState) + `,`,
-		`GoVersion:` + fmt.Sprintf("%v", this.GoVersion) + `,`,
-		`Compiler:` + fmt.Sprintf("%v", this.Compiler) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Workflow) String() string {
-	if this == nil {
-		return "nil"
+	if m.Suspend != nil {
+		l = m.Suspend.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Workflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowEventBinding) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&WorkflowEventBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventBindingSpec", "WorkflowEventBindingSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowEventBindingList) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.InitContainers) > 0 {
+		for _, e := range m.InitContainers {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems := "[]WorkflowEventBinding{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowEventBinding", "WorkflowEventBinding", 1), `&`, ``, 1) + ","
+	if len(m.Sidecars) > 0 {
+		for _, e := range m.Sidecars {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WorkflowEventBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowEventBindingSpec) String() string {
-	if this == nil {
-		return "nil"
+	if m.ArchiveLocation != nil {
+		l = m.ArchiveLocation.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&WorkflowEventBindingSpec{`,
-		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
-		`Submit:` + strings.Replace(this.Submit.String(), "Submit", "Submit", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Workflow{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Workflow", "Workflow", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowSpec) String() string {
-	if this == nil {
-		return "nil"
+	if m.ActiveDeadlineSeconds != nil {
+		l = m.ActiveDeadlineSeconds.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTemplates := "[]Template{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTemplates += "}"
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	if m.Parallelism != nil {
+		n += 2 + sovGenerated(uint64(*m.Parallelism))
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForVolumeClaimTemplates := "[]PersistentVolumeClaim{"
-	for _, f := range this.VolumeClaimTemplates {
-		repeatedStringForVolumeClaimTemplates += fmt.Sprintf("%v", f) + ","
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForVolumeClaimTemplates += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	l = len(m.SchedulerName)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.PriorityClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.Priority != nil {
+		n += 2 + sovGenerated(uint64(*m.Priority))
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
-	for _, f := range this.ImagePullSecrets {
-		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	l = len(m.ServiceAccountName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.HostAliases) > 0 {
+		for _, e := range m.HostAliases {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImagePullSecrets += "}"
-	repeatedStringForHostAliases := "[]HostAlias{"
-	for _, f := range this.HostAliases {
-		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForHostAliases += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	l = len(m.PodSpecPatch)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.AutomountServiceAccountToken != nil {
+		n += 3
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	if m.Executor != nil {
+		l = m.Executor.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&WorkflowSpec{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`VolumeClaimTemplates:` + repeatedStringForVolumeClaimTemplates + `,`,
-		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
-		`ArtifactRepositoryRef:` + strings.Replace(this.ArtifactRepositoryRef.String(), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
-		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
-		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
-		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
-		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
-		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
-		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
-		`PodPriority:` + valueToStringGenerated(this.PodPriority) + `,`,
-		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
-		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
-		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
-		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
-		`PodDisruptionBudget:` + strings.Replace(fmt.Sprintf("%v", this.PodDisruptionBudget), "PodDisruptionBudgetSpec", "v1beta1.PodDisruptionBudgetSpec", 1) + `,`,
-		`Metrics:` + strings.Replace(this.Metrics.String(), "Metrics", "Metrics", 1) + `,`,
-		`Shutdown:` + fmt.Sprintf("%v", this.Shutdown) + `,`,
-		`WorkflowTemplateRef:` + strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1) + `,`,
-		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowStatus) String() string {
-	if this == nil {
-		return "nil"
+	n += 3
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPersistentVolumeClaims := "[]Volume{"
-	for _, f := range this.PersistentVolumeClaims {
-		repeatedStringForPersistentVolumeClaims += fmt.Sprintf("%v", f) + ","
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPersistentVolumeClaims += "}"
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	if m.Memoize != nil {
+		l = m.Memoize.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForConditions += "}"
-	keysForNodes := make([]string, 0, len(this.Nodes))
-	for k := range this.Nodes {
-		keysForNodes = append(keysForNodes, k)
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
-	mapStringForNodes := "Nodes{"
-	for _, k := range keysForNodes {
-		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	if m.ContainerSet != nil {
+		l = m.ContainerSet.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	mapStringForNodes += "}"
-	keysForStoredTemplates := make([]string, 0, len(this.StoredTemplates))
-	for k := range this.StoredTemplates {
-		keysForStoredTemplates = append(keysForStoredTemplates, k)
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
-	mapStringForStoredTemplates := "map[string]Template{"
-	for _, k := range keysForStoredTemplates {
-		mapStringForStoredTemplates += fmt.Sprintf("%v: %v,", k, this.StoredTemplates[k])
+	return n
+}
+
+func (m *TemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForStoredTemplates += "}"
-	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
-	for k := range this.ResourcesDuration {
-		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *UserContainer) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
-	mapStringForResourcesDuration := "ResourcesDuration{"
-	for _, k := range keysForResourcesDuration {
-		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MirrorVolumeMounts != nil {
+		n += 2
 	}
-	mapStringForResourcesDuration += "}"
-	s := strings.Join([]string{`&WorkflowStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
-		`Nodes:` + mapStringForNodes + `,`,
-		`PersistentVolumeClaims:` + repeatedStringForPersistentVolumeClaims + `,`,
-		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
-		`StoredTemplates:` + mapStringForStoredTemplates + `,`,
-		`OffloadNodeStatusVersion:` + fmt.Sprintf("%v", this.OffloadNodeStatusVersion) + `,`,
-		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`StoredWorkflowSpec:` + strings.Replace(this.StoredWorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1) + `,`,
-		`Synchronization:` + strings.Replace(this.Synchronization.String(), "SynchronizationStatus", "SynchronizationStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *WorkflowStep) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ValueFrom) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForWithItems := "[]Item{"
-	for _, f := range this.WithItems {
-		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JQFilter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Default != nil {
+		l = m.Default.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForWithItems += "}"
-	s := strings.Join([]string{`&WorkflowStep{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
-		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
-		`WithItems:` + repeatedStringForWithItems + `,`,
-		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
-		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
-		`When:` + fmt.Sprintf("%v", this.When) + `,`,
-		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
-		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Supplied != nil {
+		l = m.Supplied.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Event)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *WorkflowTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Version) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&WorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BuildDate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitCommit)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitTag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitTreeState)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GoVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Compiler)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *WorkflowTemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Workflow) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]WorkflowTemplate{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplate", "WorkflowTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowDependencies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Workflows) > 0 {
+		for _, e := range m.Workflows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *WorkflowDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *WorkflowEventBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowEventBindingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowEventBindingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Submit != nil {
+		l = m.Submit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Entrypoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeClaimTemplates) > 0 {
+		for _, e := range m.VolumeClaimTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Parallelism != nil {
+		n += 1 + sovGenerated(uint64(*m.Parallelism))
+	}
+	if m.ArtifactRepositoryRef != nil {
+		l = m.ArtifactRepositoryRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Suspend != nil {
+		n += 2
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HostNetwork != nil {
+		n += 2
+	}
+	if m.DNSPolicy != nil {
+		l = len(*m.DNSPolicy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNSConfig != nil {
+		l = m.DNSConfig.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnExit)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.TTLSecondsAfterFinished != nil {
+		n += 2 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		n += 2 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
+	}
+	if m.Priority != nil {
+		n += 2 + sovGenerated(uint64(*m.Priority))
+	}
+	l = len(m.SchedulerName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.PodGC != nil {
+		l = m.PodGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodPriorityClassName)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.PodPriority != nil {
+		n += 2 + sovGenerated(uint64(*m.PodPriority))
+	}
+	if len(m.HostAliases) > 0 {
+		for _, e := range m.HostAliases {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodSpecPatch)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.AutomountServiceAccountToken != nil {
+		n += 3
+	}
+	if m.Executor != nil {
+		l = m.Executor.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.TTLStrategy != nil {
+		l = m.TTLStrategy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.PodDisruptionBudget != nil {
+		l = m.PodDisruptionBudget.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Shutdown)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.WorkflowTemplateRef != nil {
+		l = m.WorkflowTemplateRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.DependsOn != nil {
+		l = m.DependsOn.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CompressedNodes)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		for k, v := range m.Nodes {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PersistentVolumeClaims) > 0 {
+		for _, e := range m.PersistentVolumeClaims {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.StoredTemplates) > 0 {
+		for k, v := range m.StoredTemplates {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.OffloadNodeStatusVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ResourcesDuration) > 0 {
+		for k, v := range m.ResourcesDuration {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StoredWorkflowSpec != nil {
+		l = m.StoredWorkflowSpec.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Synchronization != nil {
+		l = m.Synchronization.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.WithItems) > 0 {
+		for _, e := range m.WithItems {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.WithParam)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WithSequence != nil {
+		l = m.WithSequence.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ContinueOn != nil {
+		l = m.ContinueOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.OnExit)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WorkflowTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *WorkflowTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WorkflowSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WorkflowMetadata != nil {
+		l = m.WorkflowMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Amount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Amount{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArchiveStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArchiveStrategy{`,
+		`Tar:` + strings.Replace(this.Tar.String(), "TarStrategy", "TarStrategy", 1) + `,`,
+		`None:` + strings.Replace(this.None.String(), "NoneStrategy", "NoneStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Arguments) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Arguments{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Artifact{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Mode:` + valueToStringGenerated(this.Mode) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
+		`Archive:` + strings.Replace(this.Archive.String(), "ArchiveStrategy", "ArchiveStrategy", 1) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
+		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactLocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactLocation{`,
+		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
+		`S3:` + strings.Replace(this.S3.String(), "S3Artifact", "S3Artifact", 1) + `,`,
+		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPArtifact", "HTTPArtifact", 1) + `,`,
+		`Artifactory:` + strings.Replace(this.Artifactory.String(), "ArtifactoryArtifact", "ArtifactoryArtifact", 1) + `,`,
+		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifact", "HDFSArtifact", 1) + `,`,
+		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
+		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
+		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactRepositoryRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactRepositoryRef{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactoryArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactoryArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`ArtifactoryAuth:` + strings.Replace(strings.Replace(this.ArtifactoryAuth.String(), "ArtifactoryAuth", "ArtifactoryAuth", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactoryAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactoryAuth{`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SASTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SASTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BackfillWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BackfillWindow{`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + strings.Replace(fmt.Sprintf("%v", this.Factor), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Cache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Cache{`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLCache", "SQLCache", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactCache", "ArtifactCache", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflowTemplate{`,
+		`WorkflowTemplateRef:` + strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterWorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterWorkflowTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterWorkflowTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterWorkflowTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterWorkflowTemplate", "ClusterWorkflowTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterWorkflowTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Condition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Condition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerNode{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerSetTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerNode{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerNode", "ContainerNode", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&ContainerSetTemplate{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContinueOn) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContinueOn{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Counter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Counter{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflow{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]CronWorkflow{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "CronWorkflow", "CronWorkflow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&CronWorkflowList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowSpec{`,
+		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
+		`StartingDeadlineSeconds:` + valueToStringGenerated(this.StartingDeadlineSeconds) + `,`,
+		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
+		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
+		`Exclusions:` + fmt.Sprintf("%v", this.Exclusions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActive := "[]ObjectReference{"
+	for _, f := range this.Active {
+		repeatedStringForActive += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForActive += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForBackfilled := "[]BackfillWindow{"
+	for _, f := range this.Backfilled {
+		repeatedStringForBackfilled += strings.Replace(strings.Replace(f.String(), "BackfillWindow", "BackfillWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBackfilled += "}"
+	s := strings.Join([]string{`&CronWorkflowStatus{`,
+		`Active:` + repeatedStringForActive + `,`,
+		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`Backfilled:` + repeatedStringForBackfilled + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWithItems := "[]Item{"
+	for _, f := range this.WithItems {
+		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
+	s := strings.Join([]string{`&DAGTask{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`WithItems:` + repeatedStringForWithItems + `,`,
+		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
+		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DAGTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTasks := "[]DAGTask{"
+	for _, f := range this.Tasks {
+		repeatedStringForTasks += strings.Replace(strings.Replace(f.String(), "DAGTask", "DAGTask", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTasks += "}"
+	s := strings.Join([]string{`&DAGTemplate{`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`Tasks:` + repeatedStringForTasks + `,`,
+		`FailFast:` + valueToStringGenerated(this.FailFast) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Event{`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecutorConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutorConfig{`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GCSArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GCSArtifact{`,
+		`GCSBucket:` + strings.Replace(strings.Replace(this.GCSBucket.String(), "GCSBucket", "GCSBucket", 1), `&`, ``, 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GCSBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GCSBucket{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`ServiceAccountKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Gauge) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Gauge{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Realtime:` + valueToStringGenerated(this.Realtime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitArtifact{`,
+		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
+		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSArtifact{`,
+		`HDFSConfig:` + strings.Replace(strings.Replace(this.HDFSConfig.String(), "HDFSConfig", "HDFSConfig", 1), `&`, ``, 1) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSConfig{`,
+		`HDFSKrbConfig:` + strings.Replace(strings.Replace(this.HDFSKrbConfig.String(), "HDFSKrbConfig", "HDFSKrbConfig", 1), `&`, ``, 1) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`HDFSUser:` + fmt.Sprintf("%v", this.HDFSUser) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HDFSKrbConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HDFSKrbConfig{`,
+		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
+		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
+		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTP{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeader{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "HTTPHeaderSource", "HTTPHeaderSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeaderSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderSource{`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Histogram) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBuckets := "[]Amount{"
+	for _, f := range this.Buckets {
+		repeatedStringForBuckets += strings.Replace(strings.Replace(f.String(), "Amount", "Amount", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBuckets += "}"
+	s := strings.Join([]string{`&Histogram{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Buckets:` + repeatedStringForBuckets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Inputs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Inputs{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LifecycleHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LifecycleHook{`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Link) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Link{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoizationStatus{`,
+		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Memoize) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Memoize{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
+		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&Metadata{`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricLabel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricLabel{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPrometheus := "[]*Prometheus{"
+	for _, f := range this.Prometheus {
+		repeatedStringForPrometheus += strings.Replace(f.String(), "Prometheus", "Prometheus", 1) + ","
+	}
+	repeatedStringForPrometheus += "}"
+	s := strings.Join([]string{`&Metrics{`,
+		`Prometheus:` + repeatedStringForPrometheus + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Mutex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Mutex{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Database:` + fmt.Sprintf("%v", this.Database) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MutexHolding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MutexHolding{`,
+		`Mutex:` + fmt.Sprintf("%v", this.Mutex) + `,`,
+		`Holder:` + fmt.Sprintf("%v", this.Holder) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MutexStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHolding := "[]MutexHolding{"
+	for _, f := range this.Holding {
+		repeatedStringForHolding += strings.Replace(strings.Replace(f.String(), "MutexHolding", "MutexHolding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHolding += "}"
+	repeatedStringForWaiting := "[]MutexHolding{"
+	for _, f := range this.Waiting {
+		repeatedStringForWaiting += strings.Replace(strings.Replace(f.String(), "MutexHolding", "MutexHolding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaiting += "}"
+	s := strings.Join([]string{`&MutexStatus{`,
+		`Holding:` + repeatedStringForHolding + `,`,
+		`Waiting:` + repeatedStringForWaiting + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
+	for k := range this.ResourcesDuration {
+		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+	mapStringForResourcesDuration := "ResourcesDuration{"
+	for _, k := range keysForResourcesDuration {
+		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForResourcesDuration += "}"
+	s := strings.Join([]string{`&NodeStatus{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
+		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
+		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`Children:` + fmt.Sprintf("%v", this.Children) + `,`,
+		`OutboundNodes:` + fmt.Sprintf("%v", this.OutboundNodes) + `,`,
+		`StoredTemplateID:` + fmt.Sprintf("%v", this.StoredTemplateID) + `,`,
+		`WorkflowTemplateName:` + fmt.Sprintf("%v", this.WorkflowTemplateName) + `,`,
+		`TemplateScope:` + fmt.Sprintf("%v", this.TemplateScope) + `,`,
+		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
+		`HostNodeName:` + fmt.Sprintf("%v", this.HostNodeName) + `,`,
+		`MemoizationStatus:` + strings.Replace(this.MemoizationStatus.String(), "MemoizationStatus", "MemoizationStatus", 1) + `,`,
+		`ChildWorkflow:` + fmt.Sprintf("%v", this.ChildWorkflow) + `,`,
+		`Throttled:` + fmt.Sprintf("%v", this.Throttled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoneStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoneStrategy{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OSSArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OSSArtifact{`,
+		`OSSBucket:` + strings.Replace(strings.Replace(this.OSSBucket.String(), "OSSBucket", "OSSBucket", 1), `&`, ``, 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OSSBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OSSBucket{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Outputs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForArtifacts := "[]Artifact{"
+	for _, f := range this.Artifacts {
+		repeatedStringForArtifacts += strings.Replace(strings.Replace(f.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArtifacts += "}"
+	s := strings.Join([]string{`&Outputs{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Artifacts:` + repeatedStringForArtifacts + `,`,
+		`Result:` + valueToStringGenerated(this.Result) + `,`,
+		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParallelSteps) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]WorkflowStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "WorkflowStep", "WorkflowStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&ParallelSteps{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Parameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Parameter{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Default:` + strings.Replace(fmt.Sprintf("%v", this.Default), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Value:` + strings.Replace(fmt.Sprintf("%v", this.Value), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
+		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Prometheus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLabels := "[]*MetricLabel{"
+	for _, f := range this.Labels {
+		repeatedStringForLabels += strings.Replace(f.String(), "MetricLabel", "MetricLabel", 1) + ","
+	}
+	repeatedStringForLabels += "}"
+	s := strings.Join([]string{`&Prometheus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Labels:` + repeatedStringForLabels + `,`,
+		`Help:` + fmt.Sprintf("%v", this.Help) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Gauge:` + strings.Replace(this.Gauge.String(), "Gauge", "Gauge", 1) + `,`,
+		`Histogram:` + strings.Replace(this.Histogram.String(), "Histogram", "Histogram", 1) + `,`,
+		`Counter:` + strings.Replace(this.Counter.String(), "Counter", "Counter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RawArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RawArtifact{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceTemplate{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`MergeStrategy:` + fmt.Sprintf("%v", this.MergeStrategy) + `,`,
+		`Manifest:` + fmt.Sprintf("%v", this.Manifest) + `,`,
+		`SetOwnerReference:` + fmt.Sprintf("%v", this.SetOwnerReference) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryAffinity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryAffinity{`,
+		`NodeAntiAffinity:` + strings.Replace(this.NodeAntiAffinity.String(), "RetryNodeAntiAffinity", "RetryNodeAntiAffinity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryNodeAntiAffinity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryNodeAntiAffinity{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Artifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Artifact{`,
+		`S3Bucket:` + strings.Replace(strings.Replace(this.S3Bucket.String(), "S3Bucket", "S3Bucket", 1), `&`, ``, 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *S3Bucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&S3Bucket{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
+		`AccessKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1), `&`, ``, 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScriptTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScriptTemplate{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SemaphoreHolding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SemaphoreHolding{`,
+		`Semaphore:` + fmt.Sprintf("%v", this.Semaphore) + `,`,
+		`Holders:` + fmt.Sprintf("%v", this.Holders) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SemaphoreRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SemaphoreRef{`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SemaphoreStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHolding := "[]SemaphoreHolding{"
+	for _, f := range this.Holding {
+		repeatedStringForHolding += strings.Replace(strings.Replace(f.String(), "SemaphoreHolding", "SemaphoreHolding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHolding += "}"
+	repeatedStringForWaiting := "[]SemaphoreHolding{"
+	for _, f := range this.Waiting {
+		repeatedStringForWaiting += strings.Replace(strings.Replace(f.String(), "SemaphoreHolding", "SemaphoreHolding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaiting += "}"
+	s := strings.Join([]string{`&SemaphoreStatus{`,
+		`Holding:` + repeatedStringForHolding + `,`,
+		`Waiting:` + repeatedStringForWaiting + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sequence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sequence{`,
+		`Count:` + strings.Replace(fmt.Sprintf("%v", this.Count), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Submit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Submit{`,
+		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
+		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubmitOpts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubmitOpts{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`GenerateName:` + fmt.Sprintf("%v", this.GenerateName) + `,`,
+		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
+		`Parameters:` + fmt.Sprintf("%v", this.Parameters) + `,`,
+		`ParameterFile:` + fmt.Sprintf("%v", this.ParameterFile) + `,`,
+		`ServiceAccount:` + fmt.Sprintf("%v", this.ServiceAccount) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`ServerDryRun:` + fmt.Sprintf("%v", this.ServerDryRun) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v11.OwnerReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuppliedValueFrom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuppliedValueFrom{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuspendTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuspendTemplate{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncDatabaseRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncDatabaseRef{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Synchronization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSemaphores := "[]SemaphoreRef{"
+	for _, f := range this.Semaphores {
+		repeatedStringForSemaphores += strings.Replace(strings.Replace(f.String(), "SemaphoreRef", "SemaphoreRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSemaphores += "}"
+	repeatedStringForMutexes := "[]Mutex{"
+	for _, f := range this.Mutexes {
+		repeatedStringForMutexes += strings.Replace(strings.Replace(f.String(), "Mutex", "Mutex", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMutexes += "}"
+	s := strings.Join([]string{`&Synchronization{`,
+		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
+		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
+		`Semaphores:` + repeatedStringForSemaphores + `,`,
+		`Mutexes:` + repeatedStringForMutexes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SynchronizationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SynchronizationStatus{`,
+		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreStatus", "SemaphoreStatus", 1) + `,`,
+		`Mutex:` + strings.Replace(this.Mutex.String(), "MutexStatus", "MutexStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TTLStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TTLStrategy{`,
+		`SecondsAfterCompletion:` + valueToStringGenerated(this.SecondsAfterCompletion) + `,`,
+		`SecondsAfterSuccess:` + valueToStringGenerated(this.SecondsAfterSuccess) + `,`,
+		`SecondsAfterFailure:` + valueToStringGenerated(this.SecondsAfterFailure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TarStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TarStrategy{`,
+		`CompressionLevel:` + valueToStringGenerated(this.CompressionLevel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Template) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]ParallelSteps{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "ParallelSteps", "ParallelSteps", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForInitContainers := "[]UserContainer{"
+	for _, f := range this.InitContainers {
+		repeatedStringForInitContainers += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForInitContainers += "}"
+	repeatedStringForSidecars := "[]UserContainer{"
+	for _, f := range this.Sidecars {
+		repeatedStringForSidecars += strings.Replace(strings.Replace(f.String(), "UserContainer", "UserContainer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSidecars += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForHostAliases := "[]HostAlias{"
+	for _, f := range this.HostAliases {
+		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForHostAliases += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&Template{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
+		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
+		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
+		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
+		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
+		`Suspend:` + strings.Replace(this.Suspend.String(), "SuspendTemplate", "SuspendTemplate", 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`InitContainers:` + repeatedStringForInitContainers + `,`,
+		`Sidecars:` + repeatedStringForSidecars + `,`,
+		`ArchiveLocation:` + strings.Replace(this.ArchiveLocation.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`ActiveDeadlineSeconds:` + strings.Replace(fmt.Sprintf("%v", this.ActiveDeadlineSeconds), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`HostAliases:` + repeatedStringForHostAliases + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
+		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
+		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
+		`ResubmitPendingPods:` + fmt.Sprintf("%v", this.ResubmitPendingPods) + `,`,
+		`Metrics:` + strings.Replace(this.Metrics.String(), "Metrics", "Metrics", 1) + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
+		`Memoize:` + strings.Replace(this.Memoize.String(), "Memoize", "Memoize", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
+		`ContainerSet:` + strings.Replace(this.ContainerSet.String(), "ContainerSetTemplate", "ContainerSetTemplate", 1) + `,`,
+		`Workflow:` + strings.Replace(this.Workflow.String(), "ChildWorkflowTemplate", "ChildWorkflowTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`RuntimeResolution:` + fmt.Sprintf("%v", this.RuntimeResolution) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserContainer{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValueFrom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValueFrom{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`JQFilter:` + fmt.Sprintf("%v", this.JQFilter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`Default:` + strings.Replace(fmt.Sprintf("%v", this.Default), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Version) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Version{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`BuildDate:` + fmt.Sprintf("%v", this.BuildDate) + `,`,
+		`GitCommit:` + fmt.Sprintf("%v", this.GitCommit) + `,`,
+		`GitTag:` + fmt.Sprintf("%v", this.GitTag) + `,`,
+		`GitTreeState:` + fmt.Sprintf("%v", this.GitTreeState) + `,`,
+		`GoVersion:` + fmt.Sprintf("%v", this.GoVersion) + `,`,
+		`Compiler:` + fmt.Sprintf("%v", this.Compiler) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Workflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Workflow{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowDependencies) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWorkflows := "[]WorkflowDependency{"
+	for _, f := range this.Workflows {
+		repeatedStringForWorkflows += strings.Replace(strings.Replace(f.String(), "WorkflowDependency", "WorkflowDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWorkflows += "}"
+	s := strings.Join([]string{`&WorkflowDependencies{`,
+		`Workflows:` + repeatedStringForWorkflows + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`IgnoreOlder:` + fmt.Sprintf("%v", this.IgnoreOlder) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEventBinding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowEventBinding{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventBindingSpec", "WorkflowEventBindingSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEventBindingList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowEventBinding{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowEventBinding", "WorkflowEventBinding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowEventBindingList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowEventBindingSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowEventBindingSpec{`,
+		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
+		`Submit:` + strings.Replace(this.Submit.String(), "Submit", "Submit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Workflow{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Workflow", "Workflow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]Template{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForVolumeClaimTemplates := "[]PersistentVolumeClaim{"
+	for _, f := range this.VolumeClaimTemplates {
+		repeatedStringForVolumeClaimTemplates += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeClaimTemplates += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	repeatedStringForHostAliases := "[]HostAlias{"
+	for _, f := range this.HostAliases {
+		repeatedStringForHostAliases += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForHostAliases += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
+	s := strings.Join([]string{`&WorkflowSpec{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`VolumeClaimTemplates:` + repeatedStringForVolumeClaimTemplates + `,`,
+		`Parallelism:` + valueToStringGenerated(this.Parallelism) + `,`,
+		`ArtifactRepositoryRef:` + strings.Replace(this.ArtifactRepositoryRef.String(), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
+		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
+		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
+		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`SchedulerName:` + fmt.Sprintf("%v", this.SchedulerName) + `,`,
+		`PodGC:` + strings.Replace(this.PodGC.String(), "PodGC", "PodGC", 1) + `,`,
+		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
+		`PodPriority:` + valueToStringGenerated(this.PodPriority) + `,`,
+		`HostAliases:` + repeatedStringForHostAliases + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
+		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
+		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
+		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
+		`PodDisruptionBudget:` + strings.Replace(fmt.Sprintf("%v", this.PodDisruptionBudget), "PodDisruptionBudgetSpec", "v1beta1.PodDisruptionBudgetSpec", 1) + `,`,
+		`Metrics:` + strings.Replace(this.Metrics.String(), "Metrics", "Metrics", 1) + `,`,
+		`Shutdown:` + fmt.Sprintf("%v", this.Shutdown) + `,`,
+		`WorkflowTemplateRef:` + strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1) + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`DependsOn:` + strings.Replace(this.DependsOn.String(), "WorkflowDependencies", "WorkflowDependencies", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPersistentVolumeClaims := "[]Volume{"
+	for _, f := range this.PersistentVolumeClaims {
+		repeatedStringForPersistentVolumeClaims += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPersistentVolumeClaims += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	keysForNodes := make([]string, 0, len(this.Nodes))
+	for k := range this.Nodes {
+		keysForNodes = append(keysForNodes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodes)
+	mapStringForNodes := "Nodes{"
+	for _, k := range keysForNodes {
+		mapStringForNodes += fmt.Sprintf("%v: %v,", k, this.Nodes[k])
+	}
+	mapStringForNodes += "}"
+	keysForStoredTemplates := make([]string, 0, len(this.StoredTemplates))
+	for k := range this.StoredTemplates {
+		keysForStoredTemplates = append(keysForStoredTemplates, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStoredTemplates)
+	mapStringForStoredTemplates := "map[string]Template{"
+	for _, k := range keysForStoredTemplates {
+		mapStringForStoredTemplates += fmt.Sprintf("%v: %v,", k, this.StoredTemplates[k])
+	}
+	mapStringForStoredTemplates += "}"
+	keysForResourcesDuration := make([]string, 0, len(this.ResourcesDuration))
+	for k := range this.ResourcesDuration {
+		keysForResourcesDuration = append(keysForResourcesDuration, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResourcesDuration)
+	mapStringForResourcesDuration := "ResourcesDuration{"
+	for _, k := range keysForResourcesDuration {
+		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForResourcesDuration += "}"
+	s := strings.Join([]string{`&WorkflowStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
+		`Nodes:` + mapStringForNodes + `,`,
+		`PersistentVolumeClaims:` + repeatedStringForPersistentVolumeClaims + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`StoredTemplates:` + mapStringForStoredTemplates + `,`,
+		`OffloadNodeStatusVersion:` + fmt.Sprintf("%v", this.OffloadNodeStatusVersion) + `,`,
+		`ResourcesDuration:` + mapStringForResourcesDuration + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`StoredWorkflowSpec:` + strings.Replace(this.StoredWorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1) + `,`,
+		`Synchronization:` + strings.Replace(this.Synchronization.String(), "SynchronizationStatus", "SynchronizationStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWithItems := "[]Item{"
+	for _, f := range this.WithItems {
+		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
+	s := strings.Join([]string{`&WorkflowStep{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`WithItems:` + repeatedStringForWithItems + `,`,
+		`WithParam:` + fmt.Sprintf("%v", this.WithParam) + `,`,
+		`WithSequence:` + strings.Replace(this.WithSequence.String(), "Sequence", "Sequence", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
+		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTemplateSpec", "WorkflowTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]WorkflowTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "WorkflowTemplate", "WorkflowTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WorkflowTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplateRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkflowTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkflowTemplateSpec{`,
+		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
+		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Amount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tar == nil {
+				m.Tar = &TarStrategy{}
+			}
+			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.None == nil {
+				m.None = &NoneStrategy{}
+			}
+			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Arguments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mode = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archive == nil {
+				m.Archive = &ArchiveStrategy{}
+			}
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPArtifact{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifact{}
+			}
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifact{}
+			}
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Raw == nil {
+				m.Raw = &RawArtifact{}
+			}
+			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OSS == nil {
+				m.OSS = &OSSArtifact{}
+			}
+			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GCS == nil {
+				m.GCS = &GCSArtifact{}
+			}
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WorkflowTemplateRef) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&WorkflowTemplateRef{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *WorkflowTemplateSpec) String() string {
-	if this == nil {
-		return "nil"
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&WorkflowTemplateSpec{`,
-		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
-		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Amount) Unmarshal(dAtA []byte) error {
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11789,15 +15685,47 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Amount: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11825,64 +15753,11 @@ func (m *Amount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = encoding_json.Number(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArchiveStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArchiveStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11909,16 +15784,16 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tar == nil {
-				m.Tar = &TarStrategy{}
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Tar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field None", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11945,10 +15820,10 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.None == nil {
-				m.None = &NoneStrategy{}
+			if m.SASTokenSecret == nil {
+				m.SASTokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.None.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11976,7 +15851,7 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Arguments) Unmarshal(dAtA []byte) error {
+func (m *BackfillWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11999,15 +15874,15 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12034,14 +15909,13 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12068,8 +15942,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12097,7 +15970,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Artifact) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12120,15 +15993,15 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12156,13 +16029,13 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12172,47 +16045,31 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Mode = &v
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12240,46 +16097,66 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12289,27 +16166,31 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12336,38 +16217,18 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Archive == nil {
-				m.Archive = &ArchiveStrategy{}
+			if m.SQL == nil {
+				m.SQL = &SQLCache{}
 			}
-			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Optional = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12377,23 +16238,27 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubPath = string(dAtA[iNdEx:postIndex])
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactCache{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12419,7 +16284,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12442,36 +16307,15 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12498,16 +16342,16 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
+			if m.WorkflowTemplateRef == nil {
+				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
 			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12534,52 +16378,66 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPArtifact{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12606,16 +16464,13 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifact{}
-			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12642,52 +16497,66 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifact{}
-			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Raw == nil {
-				m.Raw = &RawArtifact{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12714,16 +16583,13 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OSS == nil {
-				m.OSS = &OSSArtifact{}
-			}
-			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12750,10 +16616,8 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GCS == nil {
-				m.GCS = &GCSArtifact{}
-			}
-			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12781,7 +16645,7 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12804,15 +16668,47 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12840,11 +16736,11 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12872,7 +16768,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12898,7 +16794,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12921,15 +16817,48 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12957,11 +16886,11 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12988,7 +16917,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13016,7 +16945,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13039,15 +16968,15 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13074,16 +17003,14 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13110,10 +17037,8 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13141,7 +17066,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13164,17 +17089,17 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13184,29 +17109,17 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Error = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13216,31 +17129,68 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13268,7 +17218,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13294,7 +17244,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13307,25 +17257,91 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13352,10 +17368,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13383,7 +17396,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13406,15 +17419,15 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13441,13 +17454,13 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13474,7 +17487,8 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13502,7 +17516,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13525,15 +17539,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13560,15 +17574,15 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13578,82 +17592,139 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Condition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13681,11 +17752,47 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
+			}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13713,11 +17820,11 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13745,7 +17852,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Exclusions = append(m.Exclusions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13771,100 +17878,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Error = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Failed = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13887,17 +17901,17 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13907,80 +17921,29 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14007,13 +17970,16 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14040,13 +18006,14 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfilled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14073,7 +18040,8 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Backfilled = append(m.Backfilled, BackfillWindow{})
+			if err := m.Backfilled[len(m.Backfilled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14101,7 +18069,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14124,15 +18092,79 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14159,13 +18191,13 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14192,67 +18224,48 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14279,13 +18292,14 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14313,13 +18327,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14329,29 +18343,33 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14361,37 +18379,29 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14401,17 +18411,33 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14421,15 +18447,27 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnExit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14457,11 +18495,11 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.Depends = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14478,22 +18516,115 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14519,7 +18650,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14542,17 +18673,17 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14562,29 +18693,27 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14611,18 +18740,16 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
-			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14632,26 +18759,13 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14676,7 +18790,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14699,47 +18813,15 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14767,80 +18849,64 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14868,11 +18934,64 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14899,14 +19018,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14934,47 +19052,64 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15002,11 +19137,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15033,16 +19168,66 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
-			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15070,13 +19255,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15086,24 +19271,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15128,7 +19302,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15151,15 +19325,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15187,13 +19361,13 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15203,31 +19377,29 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15237,69 +19409,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15327,66 +19445,85 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			if iNdEx >= l {
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15396,24 +19533,48 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15438,7 +19599,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15461,15 +19622,15 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15496,13 +19657,13 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15530,8 +19691,28 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15556,7 +19737,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15579,17 +19760,17 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15599,29 +19780,30 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15631,81 +19813,27 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15733,29 +19861,8 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15780,7 +19887,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15803,17 +19910,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15823,29 +19930,33 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15855,47 +19966,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Depth = &v
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15923,49 +20018,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15975,31 +20034,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16026,18 +20081,18 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16047,12 +20102,24 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16077,7 +20144,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16100,17 +20167,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16120,28 +20187,27 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16169,84 +20235,11 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16273,13 +20266,14 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16307,11 +20301,31 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16339,7 +20353,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16365,7 +20379,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16388,17 +20402,17 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16408,67 +20422,80 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16496,11 +20523,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16528,11 +20555,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16559,45 +20586,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16622,7 +20617,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16645,17 +20640,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16665,23 +20660,27 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16957,30 +20956,218 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16990,25 +21177,23 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17296,6 +21481,38 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17417,6 +21634,38 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18013,6 +22262,26 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Database = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19128,6 +23397,58 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildWorkflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Throttled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Throttled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20673,13 +24994,98 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20689,23 +25095,27 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20731,6 +25141,59 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20864,6 +25327,74 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21294,6 +25825,91 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SQLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21560,7 +26176,43 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21587,10 +26239,10 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22545,6 +27197,91 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Synchronization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22646,6 +27383,74 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphores = append(m.Semaphores, SemaphoreRef{})
+			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutexes = append(m.Mutexes, Mutex{})
+			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24231,13 +29036,121 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if m.Metrics == nil {
 				m.Metrics = &Metrics{}
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memoize == nil {
+				m.Memoize = &Memoize{}
+			}
+			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24264,16 +29177,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.ContainerSet == nil {
+				m.ContainerSet = &ContainerSetTemplate{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memoize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24300,10 +29213,10 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memoize == nil {
-				m.Memoize = &Memoize{}
+			if m.Workflow == nil {
+				m.Workflow = &ChildWorkflowTemplate{}
 			}
-			if err := m.Memoize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24856,6 +29769,38 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25019,29 +29964,243 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitTreeState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitTreeState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compiler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compiler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Workflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitTag = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitTreeState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25051,29 +30210,30 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitTreeState = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25083,29 +30243,83 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowDependencies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowDependencies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowDependencies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compiler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25115,29 +30329,31 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Compiler = string(dAtA[iNdEx:postIndex])
+			m.Workflows = append(m.Workflows, WorkflowDependency{})
+			if err := m.Workflows[len(m.Workflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25147,24 +30363,12 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.TimeoutSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25189,7 +30393,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Workflow) Unmarshal(dAtA []byte) error {
+func (m *WorkflowDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25212,17 +30416,17 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25232,28 +30436,27 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25280,15 +30483,18 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Selector == nil {
+				m.Selector = &v11.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25298,25 +30504,44 @@ func (m *Workflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreOlder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreOlder = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26893,7 +32118,75 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shutdown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shutdown = ShutdownStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26920,18 +32213,18 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = &Metrics{}
+			if m.WorkflowTemplateRef == nil {
+				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shutdown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26941,27 +32234,31 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shutdown = ShutdownStrategy(dAtA[iNdEx:postIndex])
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 34:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26988,16 +32285,109 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTemplateRef == nil {
-				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
 			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 35:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27024,10 +32414,10 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.DependsOn == nil {
+				m.DependsOn = &WorkflowDependencies{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DependsOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28213,6 +33603,135 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 			}
 			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
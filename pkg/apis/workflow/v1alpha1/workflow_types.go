@@ -6,6 +6,7 @@ import (
 	"hash/fnv"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,13 +22,16 @@ type TemplateType string
 
 // Possible template types
 const (
-	TemplateTypeContainer TemplateType = "Container"
-	TemplateTypeSteps     TemplateType = "Steps"
-	TemplateTypeScript    TemplateType = "Script"
-	TemplateTypeResource  TemplateType = "Resource"
-	TemplateTypeDAG       TemplateType = "DAG"
-	TemplateTypeSuspend   TemplateType = "Suspend"
-	TemplateTypeUnknown   TemplateType = "Unknown"
+	TemplateTypeContainer    TemplateType = "Container"
+	TemplateTypeSteps        TemplateType = "Steps"
+	TemplateTypeScript       TemplateType = "Script"
+	TemplateTypeResource     TemplateType = "Resource"
+	TemplateTypeDAG          TemplateType = "DAG"
+	TemplateTypeSuspend      TemplateType = "Suspend"
+	TemplateTypeHTTP         TemplateType = "HTTP"
+	TemplateTypeContainerSet TemplateType = "ContainerSet"
+	TemplateTypeWorkflow     TemplateType = "Workflow"
+	TemplateTypeUnknown      TemplateType = "Unknown"
 )
 
 // NodePhase is a label for the condition of a node at the current time.
@@ -64,6 +68,9 @@ const (
 	NodeTypeRetry     NodeType = "Retry"
 	NodeTypeSkipped   NodeType = "Skipped"
 	NodeTypeSuspend   NodeType = "Suspend"
+	NodeTypeHTTP      NodeType = "HTTP"
+	NodeTypeContainer NodeType = "Container"
+	NodeTypeWorkflow  NodeType = "Workflow"
 )
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -318,6 +325,12 @@ type WorkflowSpec struct {
 
 	// Synchronization holds synchronization lock configuration for this Workflow
 	Synchronization *Synchronization `json:"synchronization,omitempty" protobuf:"bytes,35,opt,name=synchronization,casttype=Synchronization"`
+
+	// Hooks are lifecycle hooks which are invoked when their expression evaluates to true while the workflow is running
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,36,rep,name=hooks"`
+
+	// DependsOn holds the other workflows which must reach a phase before this workflow starts
+	DependsOn *WorkflowDependencies `json:"dependsOn,omitempty" protobuf:"bytes,37,opt,name=dependsOn"`
 }
 
 type ShutdownStrategy string
@@ -449,6 +462,15 @@ type Template struct {
 	// Suspend template subtype which can suspend a workflow when reaching the step
 	Suspend *SuspendTemplate `json:"suspend,omitempty" protobuf:"bytes,16,opt,name=suspend"`
 
+	// HTTP template subtype which makes an HTTP request from the controller, without a pod
+	HTTP *HTTP `json:"http,omitempty" protobuf:"bytes,38,opt,name=http"`
+
+	// ContainerSet runs several containers, which may depend on each other, in a single pod
+	ContainerSet *ContainerSetTemplate `json:"containerSet,omitempty" protobuf:"bytes,39,opt,name=containerSet"`
+
+	// Workflow template subtype which creates a child workflow from a workflow template, and waits for it to complete
+	Workflow *ChildWorkflowTemplate `json:"workflow,omitempty" protobuf:"bytes,40,opt,name=workflow"`
+
 	// Volumes is a list of volumes that can be mounted by containers in a template.
 	// +patchStrategy=merge
 	// +patchMergeKey=name
@@ -641,6 +663,10 @@ type ValueFrom struct {
 	// Supplied value to be filled in directly, either through the CLI, API, etc.
 	Supplied *SuppliedValueFrom `json:"supplied,omitempty" protobuf:"bytes,6,opt,name=supplied"`
 
+	// Expression (https://github.com/antonmedv/expr) that is evaluated to get the value of an output parameter of a steps
+	// or DAG template, e.g. `asInt(steps.a.outputs.result) + asInt(steps.b.outputs.result)`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,8,opt,name=expression"`
+
 	// Default specifies a value to be used if retrieving the value from the specified source fails
 	Default *intstr.IntOrString `json:"default,omitempty" protobuf:"bytes,5,opt,name=default"`
 }
@@ -679,6 +705,9 @@ type Artifact struct {
 
 	// SubPath allows an artifact to be sourced from a subpath within the specified source
 	SubPath string `json:"subPath,omitempty" protobuf:"bytes,9,opt,name=subPath"`
+
+	// FromExpression, if defined, is evaluated to specify the value for the artifact
+	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,10,opt,name=fromExpression"`
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -718,6 +747,7 @@ const (
 	ArtifactLocationRaw         ArtifactLocationType = "Raw"
 	ArtifactLocationOSS         ArtifactLocationType = "OSS"
 	ArtifactLocationGCS         ArtifactLocationType = "GCS"
+	ArtifactLocationAzure       ArtifactLocationType = "Azure"
 	ArtifactLocationUnknown     ArtifactLocationType = ""
 )
 
@@ -752,6 +782,9 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// Azure contains Azure Blob Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
 }
 
 // HasLocation whether or not an artifact has a location defined
@@ -763,7 +796,8 @@ func (a *ArtifactLocation) HasLocation() bool {
 		a.Raw.HasLocation() ||
 		a.HDFS.HasLocation() ||
 		a.OSS.HasLocation() ||
-		a.GCS.HasLocation()
+		a.GCS.HasLocation() ||
+		a.Azure.HasLocation()
 }
 
 func (a *ArtifactLocation) GetType() ArtifactLocationType {
@@ -800,6 +834,10 @@ func (a *ArtifactLocation) GetType() ArtifactLocationType {
 		return ArtifactLocationGCS
 	}
 
+	if a.Azure != nil {
+		return ArtifactLocationAzure
+	}
+
 	return ArtifactLocationUnknown
 
 }
@@ -870,6 +908,9 @@ type WorkflowStep struct {
 	// template, irrespective of the success, failure, or error of the
 	// primary template.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
+
+	// Hooks are lifecycle hooks which are invoked when their expression evaluates to true while the step is running
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,rep,name=hooks"`
 }
 
 var _ TemplateReferenceHolder = &WorkflowStep{}
@@ -886,6 +927,34 @@ func (step *WorkflowStep) ShouldExpand() bool {
 	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil
 }
 
+// LifecycleHooks are lifecycle hooks keyed by name
+type LifecycleHooks map[string]LifecycleHook
+
+// LifecycleHook is a template which is invoked, at most once, when its expression first evaluates to true
+type LifecycleHook struct {
+	// Template is the name of the template to execute by the hook
+	Template string `json:"template,omitempty" protobuf:"bytes,1,opt,name=template"`
+
+	// Arguments hold arguments to the template
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+
+	// TemplateRef is the reference to the template resource to execute by the hook
+	TemplateRef *TemplateRef `json:"templateRef,omitempty" protobuf:"bytes,3,opt,name=templateRef"`
+
+	// Expression is a condition expression for when the hook is invoked, e.g. `workflow.status == "Failed"`
+	Expression string `json:"expression" protobuf:"bytes,4,opt,name=expression"`
+}
+
+var _ TemplateReferenceHolder = &LifecycleHook{}
+
+func (h *LifecycleHook) GetTemplateName() string {
+	return h.Template
+}
+
+func (h *LifecycleHook) GetTemplateRef() *TemplateRef {
+	return h.TemplateRef
+}
+
 // Sequence expands a workflow step into numeric range
 type Sequence struct {
 	// Count is number of elements in the sequence (default: 0). Not to be used with end
@@ -914,24 +983,94 @@ type TemplateRef struct {
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,4,opt,name=clusterScope"`
 }
 
+// WorkflowDependencies holds the other workflows which must reach a phase before a workflow starts
+type WorkflowDependencies struct {
+	// Workflows are the workflows this workflow depends on
+	Workflows []WorkflowDependency `json:"workflows" protobuf:"bytes,1,rep,name=workflows"`
+
+	// TimeoutSeconds is the duration in seconds, from the creation of the workflow, after which the workflow fails
+	// if its dependencies have not been met. Defaults to waiting forever.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,2,opt,name=timeoutSeconds"`
+}
+
+// WorkflowDependency selects the workflows, in the same namespace, that a workflow depends on, either by name or by
+// label selector
+type WorkflowDependency struct {
+	// Name of the workflow
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+
+	// Selector selects the workflows by their labels. At least one workflow must match, and every workflow which
+	// matches must reach the phase.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+
+	// Phase is the phase the workflows must reach, one of Running, Succeeded, Failed or Error. Defaults to Succeeded.
+	// Running is reached once the workflows have started, whatever they complete with.
+	Phase NodePhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=NodePhase"`
+
+	// IgnoreOlder ignores the workflows created before this workflow, e.g. the earlier runs of a pipeline the selector
+	// matches, which ran for earlier dependents. By default a workflow of any age counts.
+	IgnoreOlder bool `json:"ignoreOlder,omitempty" protobuf:"varint,4,opt,name=ignoreOlder"`
+}
+
+// GetPhase returns the phase the workflows must reach
+func (d WorkflowDependency) GetPhase() NodePhase {
+	if d.Phase == "" {
+		return NodeSucceeded
+	}
+	return d.Phase
+}
+
 // Synchronization holds synchronization lock configuration
 type Synchronization struct {
 	// Semaphore holds the Semaphore configuration
 	Semaphore *SemaphoreRef `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex holds the Mutex lock details
 	Mutex *Mutex `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// Semaphores holds the configuration of more semaphores. All the semaphores and mutexes are acquired together.
+	Semaphores []SemaphoreRef `json:"semaphores,omitempty" protobuf:"bytes,3,rep,name=semaphores"`
+	// Mutexes holds the details of more mutexes. All the semaphores and mutexes are acquired together.
+	Mutexes []Mutex `json:"mutexes,omitempty" protobuf:"bytes,4,rep,name=mutexes"`
+}
+
+// GetSemaphores returns the semaphore, followed by the other semaphores
+func (s *Synchronization) GetSemaphores() []SemaphoreRef {
+	var semaphores []SemaphoreRef
+	if s.Semaphore != nil {
+		semaphores = append(semaphores, *s.Semaphore)
+	}
+	return append(semaphores, s.Semaphores...)
+}
+
+// GetMutexes returns the mutex, followed by the other mutexes
+func (s *Synchronization) GetMutexes() []Mutex {
+	var mutexes []Mutex
+	if s.Mutex != nil {
+		mutexes = append(mutexes, *s.Mutex)
+	}
+	return append(mutexes, s.Mutexes...)
 }
 
 // SemaphoreRef is a reference of Semaphore
 type SemaphoreRef struct {
 	// ConfigMapKeyRef is configmap selector for Semaphore configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+	// Database is the semaphore of the database, whose limit is stored in the database, shared by the controllers of
+	// the database
+	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+}
+
+// SyncDatabaseRef is a reference to a semaphore of the database
+type SyncDatabaseRef struct {
+	// Key of the semaphore
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
 }
 
 // Mutex holds Mutex configuration
 type Mutex struct {
 	// name of the mutex
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Database stores the mutex in the database, so that it is shared by the controllers of the database
+	Database bool `json:"database,omitempty" protobuf:"varint,2,opt,name=database"`
 }
 
 // WorkflowTemplateRef is a reference to a WorkflowTemplate resource.
@@ -1140,8 +1279,25 @@ type RetryStrategy struct {
 
 	// Backoff is a backoff strategy
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff,casttype=Backoff"`
+
+	// Affinity prevents running the retries of a node on the same Kubernetes nodes as its failed attempts
+	Affinity *RetryAffinity `json:"affinity,omitempty" protobuf:"bytes,4,opt,name=affinity"`
+
+	// Expression is a condition expression for when a node will be retried. It is evaluated against lastRetry.exitCode,
+	// lastRetry.status and lastRetry.message, and the node is not retried if it evaluates to false.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
 }
 
+// RetryAffinity prevents running the retries of a node on the same Kubernetes nodes as its failed attempts
+type RetryAffinity struct {
+	// NodeAntiAffinity schedules the retries away from the Kubernetes nodes where the previous attempts failed
+	NodeAntiAffinity *RetryNodeAntiAffinity `json:"nodeAntiAffinity,omitempty" protobuf:"bytes,1,opt,name=nodeAntiAffinity"`
+}
+
+// RetryNodeAntiAffinity is a node anti-affinity for the retries of a node, which requires each retry to be scheduled
+// on a Kubernetes node where none of the previous attempts failed
+type RetryNodeAntiAffinity struct{}
+
 // The amount of requested resource * the duration that request was used.
 // This is represented as duration in seconds, so can be converted to and from
 // duration (with loss of precision).
@@ -1258,6 +1414,8 @@ const (
 	ConditionTypeSpecError ConditionType = "SpecError"
 	// ConditionTypeMetricsError is an error during metric emission
 	ConditionTypeMetricsError ConditionType = "MetricsError"
+	// ConditionTypeDependenciesNotMet signifies the workflow is waiting for the workflows it depends on
+	ConditionTypeDependenciesNotMet ConditionType = "DependenciesNotMet"
 )
 
 type Condition struct {
@@ -1358,6 +1516,13 @@ type NodeStatus struct {
 
 	// MemoizationStatus holds information about cached nodes
 	MemoizationStatus *MemoizationStatus `json:"memoizationStatus,omitempty" protobuf:"varint,23,opt,name=memoizationStatus"`
+
+	// ChildWorkflow is the name of the child workflow created for a workflow node, once it has been created
+	ChildWorkflow string `json:"childWorkflow,omitempty" protobuf:"bytes,24,opt,name=childWorkflow"`
+
+	// Throttled is whether the pod of a Pending node has not been created yet because the controller is throttling the
+	// creation of pods
+	Throttled bool `json:"throttled,omitempty" protobuf:"varint,25,opt,name=throttled"`
 }
 
 func (n Nodes) GetResourcesDuration() ResourcesDuration {
@@ -1712,6 +1877,34 @@ func (o *OSSArtifact) HasLocation() bool {
 	return o != nil && o.Bucket != "" && o.Endpoint != "" && o.Key != ""
 }
 
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Endpoint is the service url associated with an account. It is most likely "https://<ACCOUNT_NAME>.blob.core.windows.net",
+	// or "http://127.0.0.1:10000/<ACCOUNT_NAME>" for the Azurite emulator
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Container is the container where resources will be stored
+	Container string `json:"container" protobuf:"bytes,2,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the Azure Blob Storage account access key
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,3,opt,name=accountKeySecret"`
+
+	// SASTokenSecret is the secret selector to a shared access signature token, used instead of an account key
+	SASTokenSecret *apiv1.SecretKeySelector `json:"sasTokenSecret,omitempty" protobuf:"bytes,4,opt,name=sasTokenSecret"`
+}
+
+// AzureArtifact is the location of an Azure Blob Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the blob name (i.e., path) in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
+}
+
 // ExecutorConfig holds configurations of an executor container.
 type ExecutorConfig struct {
 	// ServiceAccountName specifies the service account name of the executor container.
@@ -1726,6 +1919,49 @@ type ScriptTemplate struct {
 	Source string `json:"source" protobuf:"bytes,2,opt,name=source"`
 }
 
+// ContainerSetTemplate is a template subtype to run several containers in a single pod. The containers share the
+// volume mounts of the set, and each container starts once all of its dependencies have succeeded.
+type ContainerSetTemplate struct {
+	// Containers is the list of containers to run
+	Containers []ContainerNode `json:"containers" protobuf:"bytes,1,rep,name=containers"`
+
+	// VolumeMounts are mounted into every container of the set, and into the wait container so that outputs
+	// can be collected from them
+	VolumeMounts []apiv1.VolumeMount `json:"volumeMounts,omitempty" protobuf:"bytes,2,rep,name=volumeMounts"`
+}
+
+// GetContainer returns the container of the set with the given name, or nil if there is none
+func (cs *ContainerSetTemplate) GetContainer(name string) *ContainerNode {
+	for i := range cs.Containers {
+		if cs.Containers[i].Name == name {
+			return &cs.Containers[i]
+		}
+	}
+	return nil
+}
+
+// ChildWorkflowTemplate is a template subtype which creates a child workflow from a workflow template. The child
+// workflow is owned by the parent workflow, and the outputs of the child workflow become the outputs of the node.
+type ChildWorkflowTemplate struct {
+	// WorkflowTemplateRef is the workflow template to create the child workflow from
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef" protobuf:"bytes,1,opt,name=workflowTemplateRef"`
+
+	// Arguments are the arguments to the child workflow
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+}
+
+// ContainerNode is a container of a container set
+type ContainerNode struct {
+	apiv1.Container `json:",inline" protobuf:"bytes,1,opt,name=container"`
+
+	// Dependencies are the names of the containers of the set which must succeed before this container starts
+	Dependencies []string `json:"dependencies,omitempty" protobuf:"bytes,2,rep,name=dependencies"`
+
+	// Outputs are the parameters and artifacts of the container, which are collected from the volume mounts of the set
+	// once the container has succeeded, and reported on its node
+	Outputs Outputs `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
+}
+
 // ResourceTemplate is a template subtype to manipulate kubernetes resources
 type ResourceTemplate struct {
 	// Action is the action to perform to the resource.
@@ -1778,13 +2014,22 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Suspend != nil {
 		return TemplateTypeSuspend
 	}
+	if tmpl.HTTP != nil {
+		return TemplateTypeHTTP
+	}
+	if tmpl.ContainerSet != nil {
+		return TemplateTypeContainerSet
+	}
+	if tmpl.Workflow != nil {
+		return TemplateTypeWorkflow
+	}
 	return TemplateTypeUnknown
 }
 
 // IsPodType returns whether or not the template is a pod type
 func (tmpl *Template) IsPodType() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet:
 		return true
 	}
 	return false
@@ -1793,7 +2038,7 @@ func (tmpl *Template) IsPodType() bool {
 // IsLeaf returns whether or not the template is a leaf
 func (tmpl *Template) IsLeaf() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet:
 		return true
 	}
 	return false
@@ -1863,6 +2108,9 @@ type DAGTask struct {
 
 	// Depends are name of other targets which this depends on
 	Depends string `json:"depends,omitempty" protobuf:"bytes,12,opt,name=depends"`
+
+	// Hooks are lifecycle hooks which are invoked when their expression evaluates to true while the task is running
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,rep,name=hooks"`
 }
 
 var _ TemplateReferenceHolder = &DAGTask{}
@@ -1885,6 +2133,55 @@ type SuspendTemplate struct {
 	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
 }
 
+// HTTP is a template subtype to make an HTTP request from the controller
+type HTTP struct {
+	// Method is the HTTP method of the request, defaults to GET
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+
+	// URL of the request
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+
+	// Headers are the headers to send with the request
+	Headers []HTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+
+	// Body is the body of the request
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+
+	// TimeoutSeconds is the request timeout, defaults to 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,5,opt,name=timeoutSeconds"`
+
+	// SuccessCondition is an expression (https://github.com/antonmedv/expr) evaluated against the response to
+	// determine if the request succeeded, e.g. `response.statusCode == 201 && response.body contains "ok"`.
+	// Defaults to any 2xx status code.
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,6,opt,name=successCondition"`
+}
+
+// GetTimeout returns the request timeout
+func (h *HTTP) GetTimeout() time.Duration {
+	if h.TimeoutSeconds != nil {
+		return time.Duration(*h.TimeoutSeconds) * time.Second
+	}
+	return 30 * time.Second
+}
+
+// HTTPHeader is a header to send with an HTTP template request
+type HTTPHeader struct {
+	// Name of the header
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Value of the header
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+
+	// ValueFrom is the source of the header value
+	ValueFrom *HTTPHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// HTTPHeaderSource describes where to get an HTTP header value from
+type HTTPHeaderSource struct {
+	// SecretKeyRef selects a key of a secret in the workflow's namespace
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
 // GetArtifactByName returns an input artifact by its name
 func (in *Inputs) GetArtifactByName(name string) *Artifact {
 	return in.Artifacts.GetArtifactByName(name)
@@ -2236,14 +2533,51 @@ type Counter struct {
 type Memoize struct {
 	Key   string `json:"key" protobuf:"bytes,1,opt,name=key"`
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
+	// MaxAge is the maximum age (e.g. "180s", "24h", "7d") of a cache entry which is still used. Older entries are
+	// ignored, and replaced once the template has run again. Entries never expire if not set.
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,3,opt,name=maxAge"`
+}
+
+// GetMaxAge returns the maximum age of a cache entry which is still used, zero if entries never expire
+func (m *Memoize) GetMaxAge() (time.Duration, error) {
+	if m == nil || m.MaxAge == "" {
+		return 0, nil
+	}
+	// days are not a unit time.ParseDuration knows
+	if strings.HasSuffix(m.MaxAge, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(m.MaxAge, "d")); err == nil {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	}
+	return time.ParseDuration(m.MaxAge)
 }
 
 type MemoizationStatus struct {
 	Hit       bool   `json:"hit" protobuf:"bytes,1,opt,name=hit"`
 	Key       string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache, a ConfigMap cache if not set
+	CacheType string `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType"`
 }
 
+// Cache is the storage of a memoization cache. Exactly one of its fields must be set.
 type Cache struct {
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// ConfigMap stores the cache in a ConfigMap, which is limited to 1 MB of entries
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL stores the cache in a table of the database configured for persistence
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+	// Artifact stores the cache in the default artifact repository, which suits large outputs
+	Artifact *ArtifactCache `json:"artifact,omitempty" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// SQLCache is a memoization cache stored in a table of the database configured for persistence
+type SQLCache struct {
+	// Name of the cache, which keeps its entries apart from those of other caches
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// ArtifactCache is a memoization cache stored in the default artifact repository
+type ArtifactCache struct {
+	// Name of the cache. Each entry is stored as an object named memoization/<name>/<key>.json in the repository
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
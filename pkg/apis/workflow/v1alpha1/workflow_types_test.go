@@ -84,6 +84,8 @@ func TestArtifactLocation_HasLocation(t *testing.T) {
 	assert.True(t, (&ArtifactLocation{HDFS: &HDFSArtifact{HDFSConfig: HDFSConfig{Addresses: []string{"my-address"}}}}).HasLocation())
 	assert.True(t, (&ArtifactLocation{OSS: &OSSArtifact{Key: "my-key", OSSBucket: OSSBucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}}}).HasLocation())
 	assert.True(t, (&ArtifactLocation{GCS: &GCSArtifact{Key: "my-key", GCSBucket: GCSBucket{Bucket: "my-bucket"}}}).HasLocation())
+	assert.True(t, (&ArtifactLocation{Azure: &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint", Container: "my-container"}}}).HasLocation())
+	assert.False(t, (&ArtifactLocation{Azure: &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint"}}}).HasLocation())
 }
 
 func TestArtifactLocation_GetType(t *testing.T) {
@@ -96,6 +98,7 @@ func TestArtifactLocation_GetType(t *testing.T) {
 	assert.Equal(t, ArtifactLocationHDFS, (&ArtifactLocation{HDFS: &HDFSArtifact{HDFSConfig: HDFSConfig{Addresses: []string{"my-address"}}}}).GetType())
 	assert.Equal(t, ArtifactLocationOSS, (&ArtifactLocation{OSS: &OSSArtifact{Key: "my-key", OSSBucket: OSSBucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}}}).GetType())
 	assert.Equal(t, ArtifactLocationGCS, (&ArtifactLocation{GCS: &GCSArtifact{Key: "my-key", GCSBucket: GCSBucket{Bucket: "my-bucket"}}}).GetType())
+	assert.Equal(t, ArtifactLocationAzure, (&ArtifactLocation{Azure: &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint", Container: "my-container"}}}).GetType())
 }
 
 func TestArtifact_GetArchive(t *testing.T) {
@@ -217,3 +220,14 @@ func TestPrometheus_GetDescIsStable(t *testing.T) {
 		}
 	}
 }
+
+func TestMemoize_GetMaxAge(t *testing.T) {
+	for maxAge, expected := range map[string]time.Duration{"": 0, "180s": 3 * time.Minute, "24h": 24 * time.Hour, "7d": 7 * 24 * time.Hour} {
+		d, err := (&Memoize{MaxAge: maxAge}).GetMaxAge()
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected, d, maxAge)
+		}
+	}
+	_, err := (&Memoize{MaxAge: "1 day"}).GetMaxAge()
+	assert.Error(t, err)
+}
@@ -111,6 +111,22 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCache) DeepCopyInto(out *ArtifactCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCache.
+func (in *ArtifactCache) DeepCopy() *ArtifactCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -159,6 +175,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(GCSArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -253,6 +274,67 @@ func (in Artifacts) DeepCopy() Artifacts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifact) DeepCopyInto(out *AzureArtifact) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifact.
+func (in *AzureArtifact) DeepCopy() *AzureArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobContainer) DeepCopyInto(out *AzureBlobContainer) {
+	*out = *in
+	if in.AccountKeySecret != nil {
+		in, out := &in.AccountKeySecret, &out.AccountKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASTokenSecret != nil {
+		in, out := &in.SASTokenSecret, &out.SASTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainer.
+func (in *AzureBlobContainer) DeepCopy() *AzureBlobContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackfillWindow) DeepCopyInto(out *BackfillWindow) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackfillWindow.
+func (in *BackfillWindow) DeepCopy() *BackfillWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BackfillWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -282,6 +364,16 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLCache)
+		**out = **in
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactCache)
+		**out = **in
+	}
 	return
 }
 
@@ -295,6 +387,28 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildWorkflowTemplate) DeepCopyInto(out *ChildWorkflowTemplate) {
+	*out = *in
+	if in.WorkflowTemplateRef != nil {
+		in, out := &in.WorkflowTemplateRef, &out.WorkflowTemplateRef
+		*out = new(WorkflowTemplateRef)
+		**out = **in
+	}
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildWorkflowTemplate.
+func (in *ChildWorkflowTemplate) DeepCopy() *ChildWorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildWorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
 	*out = *in
@@ -413,6 +527,59 @@ func (in Conditions) DeepCopy() Conditions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerNode) DeepCopyInto(out *ContainerNode) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Outputs.DeepCopyInto(&out.Outputs)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerNode.
+func (in *ContainerNode) DeepCopy() *ContainerNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSetTemplate) DeepCopyInto(out *ContainerSetTemplate) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSetTemplate.
+func (in *ContainerSetTemplate) DeepCopy() *ContainerSetTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSetTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -530,6 +697,16 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = new(metav1.ObjectMeta)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -560,6 +737,13 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backfilled != nil {
+		in, out := &in.Backfilled, &out.Backfilled
+		*out = make([]BackfillWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -604,6 +788,13 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(LifecycleHooks, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -843,6 +1034,34 @@ func (in *HDFSKrbConfig) DeepCopy() *HDFSKrbConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP) DeepCopyInto(out *HTTP) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
+func (in *HTTP) DeepCopy() *HTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPArtifact) DeepCopyInto(out *HTTPArtifact) {
 	*out = *in
@@ -859,6 +1078,48 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(HTTPHeaderSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderSource) DeepCopyInto(out *HTTPHeaderSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderSource.
+func (in *HTTPHeaderSource) DeepCopy() *HTTPHeaderSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Histogram) DeepCopyInto(out *Histogram) {
 	*out = *in
@@ -920,6 +1181,50 @@ func (in *Item) DeepCopy() *Item {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleHook) DeepCopyInto(out *LifecycleHook) {
+	*out = *in
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHook.
+func (in *LifecycleHook) DeepCopy() *LifecycleHook {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in LifecycleHooks) DeepCopyInto(out *LifecycleHooks) {
+	{
+		in := &in
+		*out = make(LifecycleHooks, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHooks.
+func (in LifecycleHooks) DeepCopy() LifecycleHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHooks)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Link) DeepCopyInto(out *Link) {
 	*out = *in
@@ -1448,6 +1753,43 @@ func (in ResourcesDuration) DeepCopy() ResourcesDuration {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryAffinity) DeepCopyInto(out *RetryAffinity) {
+	*out = *in
+	if in.NodeAntiAffinity != nil {
+		in, out := &in.NodeAntiAffinity, &out.NodeAntiAffinity
+		*out = new(RetryNodeAntiAffinity)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryAffinity.
+func (in *RetryAffinity) DeepCopy() *RetryAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryNodeAntiAffinity) DeepCopyInto(out *RetryNodeAntiAffinity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryNodeAntiAffinity.
+func (in *RetryNodeAntiAffinity) DeepCopy() *RetryNodeAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryNodeAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -1461,6 +1803,11 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(Backoff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(RetryAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1514,6 +1861,22 @@ func (in *S3Bucket) DeepCopy() *S3Bucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLCache) DeepCopyInto(out *SQLCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLCache.
+func (in *SQLCache) DeepCopy() *SQLCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -1560,6 +1923,11 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(SyncDatabaseRef)
+		**out = **in
+	}
 	return
 }
 
@@ -1714,6 +2082,22 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncDatabaseRef.
+func (in *SyncDatabaseRef) DeepCopy() *SyncDatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncDatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	*out = *in
@@ -1727,6 +2111,18 @@ func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 		*out = new(Mutex)
 		**out = **in
 	}
+	if in.Semaphores != nil {
+		in, out := &in.Semaphores, &out.Semaphores
+		*out = make([]SemaphoreRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mutexes != nil {
+		in, out := &in.Mutexes, &out.Mutexes
+		*out = make([]Mutex, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1879,6 +2275,21 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(SuspendTemplate)
 		**out = **in
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerSet != nil {
+		in, out := &in.ContainerSet, &out.ContainerSet
+		*out = new(ContainerSetTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(ChildWorkflowTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -2090,6 +2501,55 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDependencies) DeepCopyInto(out *WorkflowDependencies) {
+	*out = *in
+	if in.Workflows != nil {
+		in, out := &in.Workflows, &out.Workflows
+		*out = make([]WorkflowDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDependencies.
+func (in *WorkflowDependencies) DeepCopy() *WorkflowDependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDependency) DeepCopyInto(out *WorkflowDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDependency.
+func (in *WorkflowDependency) DeepCopy() *WorkflowDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowEventBinding) DeepCopyInto(out *WorkflowEventBinding) {
 	*out = *in
@@ -2356,6 +2816,18 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 		*out = new(Synchronization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(LifecycleHooks, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(WorkflowDependencies)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2461,6 +2933,13 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 		*out = new(ContinueOn)
 		**out = **in
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make(LifecycleHooks, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
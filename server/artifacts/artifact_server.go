@@ -1,12 +1,22 @@
 package artifacts
 
 import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"html"
+	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -22,6 +32,9 @@ import (
 	"github.com/argoproj/argo/workflow/hydrator"
 )
 
+// sniffLen is the number of bytes http.DetectContentType considers
+const sniffLen = 512
+
 type ArtifactServer struct {
 	gatekeeper        auth.Gatekeeper
 	hydrator          hydrator.Interface
@@ -42,26 +55,24 @@ func (a *ArtifactServer) GetArtifact(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	path := strings.SplitN(r.URL.Path, "/", 6)
+	if len(path) != 6 {
+		a.httpError(w, 400, "the path must be /artifacts/{namespace}/{workflowName}/{nodeId}/{artifactName}")
+		return
+	}
 
 	namespace := path[2]
 	workflowName := path[3]
 	nodeId := path[4]
-	artifactName := path[5]
+	artifactPath := path[5]
 
-	log.WithFields(log.Fields{"namespace": namespace, "workflowName": workflowName, "nodeId": nodeId, "artifactName": artifactName}).Info("Download artifact")
+	log.WithFields(log.Fields{"namespace": namespace, "workflowName": workflowName, "nodeId": nodeId, "artifactPath": artifactPath}).Info("Download artifact")
 
 	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
 	if err != nil {
 		a.serverInternalError(err, w)
 		return
 	}
-	data, err := a.getArtifact(ctx, wf, nodeId, artifactName)
-	if err != nil {
-		a.serverInternalError(err, w)
-		return
-	}
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s.tgz"`, artifactName))
-	a.ok(w, data)
+	a.serveArtifact(ctx, w, r, wf, nodeId, artifactPath)
 }
 
 func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request) {
@@ -73,27 +84,24 @@ func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	path := strings.SplitN(r.URL.Path, "/", 6)
+	path := strings.SplitN(r.URL.Path, "/", 5)
+	if len(path) != 5 {
+		a.httpError(w, 400, "the path must be /artifacts-by-uid/{uid}/{nodeId}/{artifactName}")
+		return
+	}
 
 	uid := path[2]
 	nodeId := path[3]
-	artifactName := path[4]
+	artifactPath := path[4]
 
-	log.WithFields(log.Fields{"uid": uid, "nodeId": nodeId, "artifactName": artifactName}).Info("Download artifact")
+	log.WithFields(log.Fields{"uid": uid, "nodeId": nodeId, "artifactPath": artifactPath}).Info("Download artifact")
 
 	wf, err := a.getWorkflowByUID(ctx, uid)
 	if err != nil {
 		a.serverInternalError(err, w)
 		return
 	}
-
-	data, err := a.getArtifact(ctx, wf, nodeId, artifactName)
-	if err != nil {
-		a.serverInternalError(err, w)
-		return
-	}
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s.tgz"`, artifactName))
-	a.ok(w, data)
+	a.serveArtifact(ctx, w, r, wf, nodeId, artifactPath)
 }
 
 func (a *ArtifactServer) gateKeeping(r *http.Request) (context.Context, error) {
@@ -112,52 +120,219 @@ func (a *ArtifactServer) gateKeeping(r *http.Request) (context.Context, error) {
 	return a.gatekeeper.Context(ctx)
 }
 
-func (a *ArtifactServer) ok(w http.ResponseWriter, data []byte) {
-	w.WriteHeader(200)
-	_, err := w.Write(data)
+func (a *ArtifactServer) httpError(w http.ResponseWriter, statusCode int, message string) {
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(message))
+}
+
+func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
+	a.httpError(w, 500, err.Error())
+}
+
+// serveArtifact writes the artifact to the response. The artifact path is the name of the artifact, optionally
+// followed by the path of a file in the artifact, if it is a tar archive, or by the path of a directory in the archive
+// to list, which ends with "/", e.g. "my-artifact", "my-artifact/out/data.csv" or "my-artifact/out/".
+func (a *ArtifactServer) serveArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactPath string) {
+	artifactName, filePath := artifactPath, ""
+	inArchive := false
+	if i := strings.Index(artifactPath, "/"); i >= 0 {
+		artifactName, filePath = artifactPath[:i], artifactPath[i+1:]
+		inArchive = true
+	}
+	node := wf.Status.Nodes[nodeId]
+	art := node.Outputs.GetArtifactByName(artifactName)
+	if art == nil {
+		a.httpError(w, 404, "artifact not found")
+		return
+	}
+	driver, err := artifact.NewDriver(art, resources{auth.GetKubeClient(ctx), wf.Namespace})
 	if err != nil {
 		a.serverInternalError(err, w)
+		return
 	}
-}
+	rc, err := openArtifact(ctx, driver, art)
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+	defer func() { _ = rc.Close() }()
 
-func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
-	w.WriteHeader(500)
-	_, _ = w.Write([]byte(err.Error()))
+	modTime := node.FinishedAt.Time
+	if !inArchive {
+		if isArchived(art) {
+			a.serveContent(w, r, artifactName+".tgz", "application/gzip", modTime, rc)
+		} else {
+			a.serveContent(w, r, artifactName, "", modTime, rc)
+		}
+		return
+	}
+	gzr, err := gzip.NewReader(rc)
+	if err != nil {
+		a.httpError(w, 400, "artifact is not a tar archive")
+		return
+	}
+	tr := tar.NewReader(gzr)
+	if filePath == "" || strings.HasSuffix(filePath, "/") {
+		a.listArchive(w, tr, filePath)
+		return
+	}
+	// the archive is read until the file is found, rather than to its end
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			a.httpError(w, 404, "file not found in artifact")
+			return
+		}
+		if err != nil {
+			a.serverInternalError(err, w)
+			return
+		}
+		if header.Typeflag == tar.TypeReg && strings.TrimPrefix(header.Name, "./") == filePath {
+			a.serveContent(w, r, filepath.Base(filePath), mime.TypeByExtension(filepath.Ext(filePath)), modTime, tr)
+			return
+		}
+	}
 }
 
-func (a *ArtifactServer) getArtifact(ctx context.Context, wf *wfv1.Workflow, nodeId, artifactName string) ([]byte, error) {
-	kubeClient := auth.GetKubeClient(ctx)
+// serveContent writes the content to the response as a download. Seekable content is served by http.ServeContent,
+// which handles range requests. Other content is streamed, unless a range is requested, when it is copied to a
+// temporary file first. If the content type is empty, it is detected from the content.
+func (a *ArtifactServer) serveContent(w http.ResponseWriter, r *http.Request, name, contentType string, modTime time.Time, content io.Reader) {
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	if rs, ok := content.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, name, modTime, rs)
+		return
+	}
+	if r.Header.Get("Range") != "" {
+		tmp, err := newTempFile()
+		if err != nil {
+			a.serverInternalError(err, w)
+			return
+		}
+		defer func() { _ = tmp.Close() }()
+		_, err = io.Copy(tmp, content)
+		if err != nil {
+			a.serverInternalError(err, w)
+			return
+		}
+		http.ServeContent(w, r, name, modTime, tmp)
+		return
+	}
+	if contentType == "" {
+		br := bufio.NewReaderSize(content, sniffLen)
+		data, _ := br.Peek(sniffLen)
+		w.Header().Set("Content-Type", http.DetectContentType(data))
+		content = br
+	}
+	if !modTime.IsZero() {
+		w.Header().Set("Last-Modified", modTime.UTC().Format(http.TimeFormat))
+	}
+	w.WriteHeader(200)
+	n, err := io.Copy(w, content)
+	if err != nil {
+		// the status has been written, so the download can only be cut short
+		log.WithError(err).WithField("written", n).Warn("Failed to write artifact")
+		return
+	}
+	log.WithFields(log.Fields{"size": n}).Debug("Artifact file size")
+}
 
-	art := wf.Status.Nodes[nodeId].Outputs.GetArtifactByName(artifactName)
-	if art == nil {
-		return nil, fmt.Errorf("artifact not found")
+// listArchive writes a listing of the entries of the directory in the archive, with links to them
+func (a *ArtifactServer) listArchive(w http.ResponseWriter, tr *tar.Reader, dir string) {
+	names := map[string]bool{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			a.serverInternalError(err, w)
+			return
+		}
+		name := strings.TrimPrefix(header.Name, "./")
+		if header.Typeflag == tar.TypeDir && !strings.HasSuffix(name, "/") {
+			name += "/"
+		}
+		if !strings.HasPrefix(name, dir) || name == dir {
+			continue
+		}
+		name = strings.TrimPrefix(name, dir)
+		// only the children of the directory are listed, and the directories of the entries may not have entries of
+		// their own
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[:i+1]
+		}
+		names[name] = true
 	}
+	if len(names) == 0 && dir != "" {
+		a.httpError(w, 404, "directory not found in artifact")
+		return
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(200)
+	_, _ = fmt.Fprintln(w, "<pre>")
+	for _, name := range sorted {
+		link := url.URL{Path: name}
+		_, _ = fmt.Fprintf(w, "<a href=\"%s\">%s</a>\n", html.EscapeString(link.String()), html.EscapeString(name))
+	}
+	_, _ = fmt.Fprintln(w, "</pre>")
+}
 
-	driver, err := artifact.NewDriver(art, resources{kubeClient, wf.Namespace})
+// openArtifact opens the artifact for reading. It is streamed from the driver if the driver can, or else loaded into
+// a temporary file, which is removed once closed.
+func openArtifact(ctx context.Context, driver artifact.ArtifactDriver, art *wfv1.Artifact) (io.ReadCloser, error) {
+	if streamer, ok := driver.(artifact.ArtifactStreamer); ok {
+		return streamer.OpenStream(ctx, art)
+	}
+	tmp, err := newTempFile()
 	if err != nil {
 		return nil, err
 	}
-
-	tmp, err := ioutil.TempFile(".", "artifact")
+	err = driver.Load(art, tmp.Name())
 	if err != nil {
+		_ = tmp.Close()
 		return nil, err
 	}
-	path := tmp.Name()
-	defer func() { _ = os.Remove(path) }()
-
-	err = driver.Load(art, path)
+	// the driver may have replaced the file, so it is opened again
+	file, err := os.Open(tmp.Name())
+	_ = tmp.File.Close()
 	if err != nil {
+		_ = os.Remove(tmp.Name())
 		return nil, err
 	}
+	return &tempFile{file}, nil
+}
+
+// isArchived returns whether the artifact is a tar archive, which is how artifacts are saved to a repository unless
+// their archive strategy is none
+func isArchived(art *wfv1.Artifact) bool {
+	return art.Raw == nil && art.HTTP == nil && art.Git == nil && art.GetArchive().None == nil
+}
+
+// tempFile is a temporary file which is removed once closed
+type tempFile struct {
+	*os.File
+}
 
-	file, err := ioutil.ReadFile(path)
+func newTempFile() (*tempFile, error) {
+	file, err := ioutil.TempFile("", "artifact")
 	if err != nil {
 		return nil, err
 	}
+	return &tempFile{file}, nil
+}
 
-	log.WithFields(log.Fields{"size": len(file)}).Debug("Artifact file size")
-
-	return file, nil
+func (f *tempFile) Close() error {
+	_ = f.File.Close()
+	return os.Remove(f.Name())
 }
 
 func (a *ArtifactServer) getWorkflowAndValidate(ctx context.Context, namespace string, workflowName string) (*wfv1.Workflow, error) {
@@ -1,9 +1,13 @@
 package artifacts
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +34,32 @@ func mustParse(text string) *url.URL {
 	return u
 }
 
+// mustTarGz returns a tar archive of the files, as the executor would archive a directory
+func mustTarGz(files map[string]string) string {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for _, name := range []string{"out/", "out/data.csv", "out/logs/", "out/logs/main.log"} {
+		header := &tar.Header{Name: name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(files[name]))}
+		if strings.HasSuffix(name, "/") {
+			header.Typeflag = tar.TypeDir
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			panic(err)
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			panic(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		panic(err)
+	}
+	if err := gzw.Close(); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
 func newServer() *ArtifactServer {
 	gatekeeper := &authmocks.Gatekeeper{}
 	kube := kubefake.NewSimpleClientset()
@@ -51,6 +81,14 @@ func newServer() *ArtifactServer {
 									},
 								},
 							},
+							{
+								Name: "my-archive",
+								ArtifactLocation: wfv1.ArtifactLocation{
+									Raw: &wfv1.RawArtifact{
+										Data: mustTarGz(map[string]string{"out/data.csv": "a,b\n", "out/logs/main.log": "my-log"}),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -72,10 +110,65 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 	w := &testhttp.TestResponseWriter{}
 	s.GetArtifact(w, r)
 	assert.Equal(t, 200, w.StatusCode)
-	assert.Equal(t, "filename=\"my-artifact.tgz\"", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "attachment; filename=\"my-artifact\"", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
 	assert.Equal(t, "my-data", w.Output)
 }
 
+func TestArtifactServer_GetArtifactRange(t *testing.T) {
+	s := newServer()
+	r := &http.Request{Header: http.Header{"Range": []string{"bytes=3-"}}}
+	r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-artifact")
+	w := &testhttp.TestResponseWriter{}
+	s.GetArtifact(w, r)
+	assert.Equal(t, 206, w.StatusCode)
+	assert.Equal(t, "bytes 3-6/7", w.Header().Get("Content-Range"))
+	assert.Equal(t, "data", w.Output)
+}
+
+func TestArtifactServer_GetArtifactFile(t *testing.T) {
+	s := newServer()
+	t.Run("ListRoot", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-archive/")
+		w := &testhttp.TestResponseWriter{}
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.StatusCode)
+		assert.Equal(t, "<pre>\n<a href=\"out/\">out/</a>\n</pre>\n", w.Output)
+	})
+	t.Run("ListDirectory", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-archive/out/")
+		w := &testhttp.TestResponseWriter{}
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.StatusCode)
+		assert.Equal(t, "<pre>\n<a href=\"data.csv\">data.csv</a>\n<a href=\"logs/\">logs/</a>\n</pre>\n", w.Output)
+	})
+	t.Run("File", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-archive/out/data.csv")
+		w := &testhttp.TestResponseWriter{}
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.StatusCode)
+		assert.Equal(t, "attachment; filename=\"data.csv\"", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "a,b\n", w.Output)
+	})
+	t.Run("FileNotFound", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-archive/out/missing.csv")
+		w := &testhttp.TestResponseWriter{}
+		s.GetArtifact(w, r)
+		assert.Equal(t, 404, w.StatusCode)
+	})
+	t.Run("NotArchive", func(t *testing.T) {
+		r := &http.Request{}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-artifact/")
+		w := &testhttp.TestResponseWriter{}
+		s.GetArtifact(w, r)
+		assert.Equal(t, 400, w.StatusCode)
+	})
+}
+
 func TestArtifactServer_GetArtifactWithoutInstanceID(t *testing.T) {
 	s := newServer()
 	r := &http.Request{}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -28,6 +32,9 @@ const (
 	ClaimSetKey ContextKey = "jws.ClaimSet"
 )
 
+// how long the clients of a service account are cached for, so a rotated token is picked up eventually
+const serviceAccountClientsTTL = 10 * time.Minute
+
 type Gatekeeper interface {
 	Context(ctx context.Context) (context.Context, error)
 	UnaryServerInterceptor() grpc.UnaryServerInterceptor
@@ -41,13 +48,22 @@ type gatekeeper struct {
 	kubeClient kubernetes.Interface
 	restConfig *rest.Config
 	ssoIf      sso.Interface
+	// namespace that SSO RBAC service accounts are in
+	namespace string
+	// service account name -> *serviceAccountClients
+	serviceAccountClients *cache.Expiring
+}
+
+type serviceAccountClients struct {
+	wfClient   versioned.Interface
+	kubeClient kubernetes.Interface
 }
 
-func NewGatekeeper(modes Modes, wfClient versioned.Interface, kubeClient kubernetes.Interface, restConfig *rest.Config, ssoIf sso.Interface) (Gatekeeper, error) {
+func NewGatekeeper(modes Modes, wfClient versioned.Interface, kubeClient kubernetes.Interface, restConfig *rest.Config, ssoIf sso.Interface, namespace string) (Gatekeeper, error) {
 	if len(modes) == 0 {
 		return nil, fmt.Errorf("must specify at least one auth mode")
 	}
-	return &gatekeeper{modes, wfClient, kubeClient, restConfig, ssoIf}, nil
+	return &gatekeeper{modes, wfClient, kubeClient, restConfig, ssoIf, namespace, cache.NewExpiring()}, nil
 }
 
 func (s *gatekeeper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -145,8 +161,51 @@ func (s gatekeeper) getClients(ctx context.Context) (versioned.Interface, kubern
 		if err != nil {
 			return nil, nil, nil, status.Error(codes.Unauthenticated, err.Error())
 		}
+		if claimSet.ServiceAccountName != "" {
+			wfClient, kubeClient, err := s.getServiceAccountClients(claimSet.ServiceAccountName)
+			if err != nil {
+				return nil, nil, nil, status.Errorf(codes.Internal, "failed to create clients for service account %s: %v", claimSet.ServiceAccountName, err)
+			}
+			return wfClient, kubeClient, claimSet, nil
+		}
 		return s.wfClient, s.kubeClient, claimSet, nil
 	default:
 		panic("this should never happen")
 	}
 }
+
+// getServiceAccountClients returns clients that use the service account's token, rather than the server's credentials.
+// The clients are cached, so we do not get the service account and its secret on every request.
+func (s gatekeeper) getServiceAccountClients(serviceAccountName string) (versioned.Interface, kubernetes.Interface, error) {
+	if v, ok := s.serviceAccountClients.Get(serviceAccountName); ok {
+		clients := v.(*serviceAccountClients)
+		return clients.wfClient, clients.kubeClient, nil
+	}
+	serviceAccount, err := s.kubeClient.CoreV1().ServiceAccounts(s.namespace).Get(serviceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get service account: %w", err)
+	}
+	if len(serviceAccount.Secrets) == 0 {
+		return nil, nil, fmt.Errorf("service account has no secrets")
+	}
+	secret, err := s.kubeClient.CoreV1().Secrets(s.namespace).Get(serviceAccount.Secrets[0].Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get service account secret: %w", err)
+	}
+	token, ok := secret.Data[apiv1.ServiceAccountTokenKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("secret %s has no %s", secret.Name, apiv1.ServiceAccountTokenKey)
+	}
+	restConfig := rest.AnonymousClientConfig(s.restConfig)
+	restConfig.BearerToken = string(token)
+	wfClient, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure to create wfClientset: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failure to create kubeClientset: %w", err)
+	}
+	s.serviceAccountClients.Set(serviceAccountName, &serviceAccountClients{wfClient, kubeClient}, serviceAccountClientsTTL)
+	return wfClient, kubeClient, nil
+}
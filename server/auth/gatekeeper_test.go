@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 
@@ -19,18 +21,18 @@ func TestServer_GetWFClient(t *testing.T) {
 	wfClient := &fakewfclientset.Clientset{}
 	kubeClient := &fake.Clientset{}
 	t.Run("None", func(t *testing.T) {
-		_, err := NewGatekeeper(Modes{}, wfClient, kubeClient, nil, nil)
+		_, err := NewGatekeeper(Modes{}, wfClient, kubeClient, nil, nil, "")
 		assert.Error(t, err)
 	})
 	t.Run("Invalid", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{Client: true}, wfClient, kubeClient, nil, nil)
+		g, err := NewGatekeeper(Modes{Client: true}, wfClient, kubeClient, nil, nil, "")
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("invalid"))
 			assert.Error(t, err)
 		}
 	})
 	t.Run("NotAllowed", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, kubeClient, nil, nil)
+		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, kubeClient, nil, nil, "")
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("Bearer "))
 			assert.Error(t, err)
@@ -38,7 +40,7 @@ func TestServer_GetWFClient(t *testing.T) {
 	})
 	// not possible to unit test client auth today
 	t.Run("Server", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{Server: true}, wfClient, kubeClient, &rest.Config{Username: "my-username"}, nil)
+		g, err := NewGatekeeper(Modes{Server: true}, wfClient, kubeClient, &rest.Config{Username: "my-username"}, nil, "")
 		assert.NoError(t, err)
 		ctx, err := g.Context(x(""))
 		if assert.NoError(t, err) {
@@ -50,7 +52,7 @@ func TestServer_GetWFClient(t *testing.T) {
 	t.Run("SSO", func(t *testing.T) {
 		ssoIf := &mocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&jws.ClaimSet{}, nil)
-		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, kubeClient, nil, ssoIf)
+		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, kubeClient, nil, ssoIf, "")
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer id_token:whatever"))
 			if assert.NoError(t, err) {
@@ -60,6 +62,46 @@ func TestServer_GetWFClient(t *testing.T) {
 			}
 		}
 	})
+	t.Run("SSO+RBAC", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(
+			&corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-sa", Namespace: "my-ns"},
+				Secrets:    []corev1.ObjectReference{{Name: "my-sa-token"}},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-sa-token", Namespace: "my-ns"},
+				Data:       map[string][]byte{"token": []byte("my-token")},
+			},
+		)
+		ssoIf := &mocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&jws.ClaimSet{ServiceAccountName: "my-sa"}, nil)
+		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, kubeClient, &rest.Config{Host: "https://my-host"}, ssoIf, "my-ns")
+		if assert.NoError(t, err) {
+			ctx, err := g.Context(x("Bearer id_token:whatever"))
+			if assert.NoError(t, err) {
+				assert.NotEqual(t, wfClient, GetWfClient(ctx))
+				assert.NotEqual(t, kubeClient, GetKubeClient(ctx))
+				assert.Equal(t, "my-sa", GetClaimSet(ctx).ServiceAccountName)
+			}
+			// the clients are cached, so the service account and secret are only got once
+			gets := len(kubeClient.Actions())
+			cached, err := g.Context(x("Bearer id_token:whatever"))
+			if assert.NoError(t, err) {
+				assert.Len(t, kubeClient.Actions(), gets)
+				assert.Equal(t, GetWfClient(ctx), GetWfClient(cached))
+				assert.Equal(t, GetKubeClient(ctx), GetKubeClient(cached))
+			}
+		}
+	})
+	t.Run("SSO+RBACMissingServiceAccount", func(t *testing.T) {
+		ssoIf := &mocks.Interface{}
+		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&jws.ClaimSet{ServiceAccountName: "my-sa"}, nil)
+		g, err := NewGatekeeper(Modes{SSO: true}, wfClient, fake.NewSimpleClientset(), &rest.Config{}, ssoIf, "my-ns")
+		if assert.NoError(t, err) {
+			_, err := g.Context(x("Bearer id_token:whatever"))
+			assert.Error(t, err)
+		}
+	})
 }
 
 func x(authorization string) context.Context {
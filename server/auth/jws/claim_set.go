@@ -1,6 +1,10 @@
 package jws
 
 type ClaimSet struct {
-	Iss string `json:"iss"`
-	Sub string `json:"sub,omitempty"`
+	Iss    string   `json:"iss"`
+	Sub    string   `json:"sub,omitempty"`
+	Email  string   `json:"email,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+	// ServiceAccountName is the service account resolved by SSO RBAC, it is never read from the token
+	ServiceAccountName string `json:"-"`
 }
@@ -0,0 +1,81 @@
+package sso
+
+import (
+	"fmt"
+
+	"github.com/antonmedv/expr"
+	log "github.com/sirupsen/logrus"
+)
+
+// RBACRule maps users to a service account. A rule matches if the user is a member of any of the groups, or the
+// expression evaluates to true. If both are specified, both must match.
+type RBACRule struct {
+	// Groups is a list of OIDC groups, e.g. `["admins"]`
+	Groups []string `json:"groups,omitempty"`
+	// Expression is evaluated against the ID token's claims, e.g. `email endsWith "@example.com"`
+	Expression string `json:"expression,omitempty"`
+	// ServiceAccountName is the name of the service account, in the Argo Server's namespace, to use for matching users
+	ServiceAccountName string `json:"serviceAccountName"`
+}
+
+func (r RBACRule) validate() error {
+	if r.ServiceAccountName == "" {
+		return fmt.Errorf("serviceAccountName empty")
+	}
+	if len(r.Groups) == 0 && r.Expression == "" {
+		return fmt.Errorf("groups or expression must be specified for service account %s", r.ServiceAccountName)
+	}
+	if r.Expression != "" {
+		if _, err := expr.Compile(r.Expression); err != nil {
+			return fmt.Errorf("failed to compile expression for service account %s: %w", r.ServiceAccountName, err)
+		}
+	}
+	return nil
+}
+
+func (r RBACRule) matches(groups []string, claims map[string]interface{}) (bool, error) {
+	if len(r.Groups) > 0 && !anyGroup(r.Groups, groups) {
+		return false, nil
+	}
+	if r.Expression != "" {
+		result, err := expr.Eval(r.Expression, claims)
+		if err != nil {
+			return false, fmt.Errorf("failed to evaluate expression for service account %s: %w", r.ServiceAccountName, err)
+		}
+		matched, ok := result.(bool)
+		if !ok {
+			return false, fmt.Errorf("expression for service account %s did not evaluate to boolean", r.ServiceAccountName)
+		}
+		return matched, nil
+	}
+	return true, nil
+}
+
+func anyGroup(want, have []string) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if w == h {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type RBACRules []RBACRule
+
+// serviceAccountFor returns the service account of the first matching rule. A rule whose expression cannot be evaluated
+// for the user, e.g. because it refers to a claim the user does not have, does not match.
+func (rs RBACRules) serviceAccountFor(groups []string, claims map[string]interface{}) (string, error) {
+	for _, r := range rs {
+		matched, err := r.matches(groups, claims)
+		if err != nil {
+			log.WithError(err).Warn("RBAC rule did not match")
+			continue
+		}
+		if matched {
+			return r.ServiceAccountName, nil
+		}
+	}
+	return "", fmt.Errorf("no RBAC rule matched")
+}
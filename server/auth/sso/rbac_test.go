@@ -0,0 +1,59 @@
+package sso
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRBACRule_validate(t *testing.T) {
+	assert.EqualError(t, RBACRule{Groups: []string{"admins"}}.validate(), "serviceAccountName empty")
+	assert.Error(t, RBACRule{ServiceAccountName: "admin"}.validate())
+	assert.Error(t, RBACRule{ServiceAccountName: "admin", Expression: "!!!"}.validate())
+	assert.NoError(t, RBACRule{ServiceAccountName: "admin", Groups: []string{"admins"}}.validate())
+	assert.NoError(t, RBACRule{ServiceAccountName: "admin", Expression: `email endsWith "@example.com"`}.validate())
+}
+
+func TestRBACRules_serviceAccountFor(t *testing.T) {
+	rules := RBACRules{
+		{Groups: []string{"admins"}, ServiceAccountName: "admin"},
+		{Groups: []string{"data-scientists"}, Expression: `email endsWith "@example.com"`, ServiceAccountName: "read-only"},
+		{Expression: `email == "ops@example.com"`, ServiceAccountName: "ops"},
+	}
+	t.Run("Group", func(t *testing.T) {
+		serviceAccountName, err := rules.serviceAccountFor([]string{"admins", "data-scientists"}, map[string]interface{}{"email": "admin@example.com"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "admin", serviceAccountName)
+		}
+	})
+	t.Run("GroupAndExpression", func(t *testing.T) {
+		serviceAccountName, err := rules.serviceAccountFor([]string{"data-scientists"}, map[string]interface{}{"email": "ds@example.com"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "read-only", serviceAccountName)
+		}
+	})
+	t.Run("Expression", func(t *testing.T) {
+		serviceAccountName, err := rules.serviceAccountFor(nil, map[string]interface{}{"email": "ops@example.com"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "ops", serviceAccountName)
+		}
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		_, err := rules.serviceAccountFor([]string{"data-scientists"}, map[string]interface{}{"email": "ds@other.com"})
+		assert.EqualError(t, err, "no RBAC rule matched")
+	})
+	t.Run("NotBoolean", func(t *testing.T) {
+		_, err := RBACRules{{Expression: `email`, ServiceAccountName: "x"}}.serviceAccountFor(nil, map[string]interface{}{"email": "ds@other.com"})
+		assert.EqualError(t, err, "no RBAC rule matched")
+	})
+	t.Run("MissingClaim", func(t *testing.T) {
+		// the first rule cannot be evaluated without the profile claim, so the next rule is tried
+		serviceAccountName, err := RBACRules{
+			{Expression: `profile.team == "ops"`, ServiceAccountName: "ops"},
+			{Expression: `email endsWith "@example.com"`, ServiceAccountName: "read-only"},
+		}.serviceAccountFor(nil, map[string]interface{}{"email": "ds@example.com"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "read-only", serviceAccountName)
+		}
+	})
+}
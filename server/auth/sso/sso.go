@@ -34,6 +34,7 @@ type sso struct {
 	idTokenVerifier *oidc.IDTokenVerifier
 	baseHRef        string
 	secure          bool
+	rbac            RBACRules
 }
 
 type Config struct {
@@ -41,6 +42,11 @@ type Config struct {
 	ClientID     apiv1.SecretKeySelector `json:"clientId"`
 	ClientSecret apiv1.SecretKeySelector `json:"clientSecret"`
 	RedirectURL  string                  `json:"redirectUrl"`
+	// Scopes are additional scopes to request, e.g. `groups`
+	Scopes []string `json:"scopes,omitempty"`
+	// RBAC maps users to service accounts, the first matching rule wins.
+	// If empty, all users act as the Argo Server's service account.
+	RBAC RBACRules `json:"rbac,omitempty"`
 }
 
 // Abtsract methods of oidc.Provider that our code uses into an interface. That
@@ -81,6 +87,11 @@ func newSso(
 	if c.RedirectURL == "" {
 		return nil, fmt.Errorf("redirectUrl empty")
 	}
+	for _, r := range c.RBAC {
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("invalid RBAC rule: %w", err)
+		}
+	}
 	clientSecretObj, err := secretsIf.Get(c.ClientSecret.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -113,11 +124,11 @@ func newSso(
 		ClientSecret: string(clientSecret),
 		RedirectURL:  c.RedirectURL,
 		Endpoint:     provider.Endpoint(),
-		Scopes:       []string{oidc.ScopeOpenID},
+		Scopes:       append([]string{oidc.ScopeOpenID}, c.Scopes...),
 	}
 	idTokenVerifier := provider.Verifier(&oidc.Config{ClientID: config.ClientID})
-	log.WithFields(log.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "clientId": c.ClientID}).Info("SSO configuration")
-	return &sso{config, idTokenVerifier, baseHRef, secure}, nil
+	log.WithFields(log.Fields{"redirectUrl": config.RedirectURL, "issuer": c.Issuer, "clientId": c.ClientID, "scopes": config.Scopes, "rbacRules": len(c.RBAC)}).Info("SSO configuration")
+	return &sso{config, idTokenVerifier, baseHRef, secure, c.RBAC}, nil
 }
 
 const stateCookieName = "oauthState"
@@ -207,5 +218,15 @@ func (s *sso) Authorize(ctx context.Context, authorization string) (*jws.ClaimSe
 	if err := idToken.Claims(c); err != nil {
 		return nil, fmt.Errorf("failed to parse claims: %v", err)
 	}
+	if len(s.rbac) > 0 {
+		claims := map[string]interface{}{}
+		if err := idToken.Claims(&claims); err != nil {
+			return nil, fmt.Errorf("failed to parse claims: %v", err)
+		}
+		c.ServiceAccountName, err = s.rbac.serviceAccountFor(c.Groups, claims)
+		if err != nil {
+			return nil, fmt.Errorf("not authorized: %v", err)
+		}
+	}
 	return c, nil
 }
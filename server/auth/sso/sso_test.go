@@ -102,3 +102,16 @@ func TestLoadSsoClientIdFromSecretNoKeyFails(t *testing.T) {
 	require.Error(t, err)
 	assert.Regexp(t, "key nonexistent missing in secret argo-sso-secret", err.Error())
 }
+
+func TestInvalidRBACRuleFails(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(ssoConfigSecret).CoreV1().Secrets(testNamespace)
+	config := Config{
+		Issuer:       "https://test-issuer",
+		ClientID:     getSecretKeySelector("argo-sso-secret", "client-id"),
+		ClientSecret: getSecretKeySelector("argo-sso-secret", "client-secret"),
+		RedirectURL:  "https://dummy",
+		RBAC:         RBACRules{{Groups: []string{"admins"}}},
+	}
+	_, err := newSso(fakeOidcFactory, config, fakeClient, "/", false)
+	assert.EqualError(t, err, "invalid RBAC rule: serviceAccountName empty")
+}
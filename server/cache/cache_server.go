@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo/config"
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+	"github.com/argoproj/argo/server/auth"
+	controllercache "github.com/argoproj/argo/workflow/controller/cache"
+)
+
+type cacheServer struct {
+	session            sqlbuilder.Database
+	clusterName        string
+	artifactRepository *config.ArtifactRepository
+}
+
+// NewCacheServer returns a server for the memoization caches. SQL caches are only available if there is a session, and
+// artifact caches if there is an artifact repository.
+func NewCacheServer(session sqlbuilder.Database, clusterName string, artifactRepository *config.ArtifactRepository) cachepkg.CacheServiceServer {
+	return &cacheServer{session, clusterName, artifactRepository}
+}
+
+var cacheTypes = map[string]controllercache.CacheType{
+	"configmap": controllercache.ConfigMapCache,
+	"sql":       controllercache.SQLCache,
+	"artifact":  controllercache.ArtifactCache,
+}
+
+// getCache returns the named cache, if the user can perform the verb on the config maps of the namespace, as they can
+// then read or write the entries of config map caches anyway
+func (s *cacheServer) getCache(ctx context.Context, verb, namespace, cacheType, name string) (controllercache.MemoizationCache, error) {
+	ct, ok := cacheTypes[cacheType]
+	if !ok {
+		return nil, fmt.Errorf("unknown cache type %q, must be one of configmap, sql or artifact", cacheType)
+	}
+	allowed, err := auth.CanI(ctx, verb, "configmaps", namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	var opts []controllercache.CacheFactoryOption
+	if s.session != nil {
+		opts = append(opts, controllercache.WithSession(s.session, s.clusterName))
+	}
+	if s.artifactRepository != nil {
+		opts = append(opts, controllercache.WithArtifactRepository(s.artifactRepository))
+	}
+	c := controllercache.NewCacheFactory(auth.GetKubeClient(ctx), namespace, opts...).GetCache(ct, name)
+	if c == nil {
+		return nil, fmt.Errorf("%s caches are not available, as they are not configured", cacheType)
+	}
+	return *c, nil
+}
+
+func (s *cacheServer) ListCacheEntries(ctx context.Context, req *cachepkg.CacheListRequest) (*cachepkg.CacheListResponse, error) {
+	c, err := s.getCache(ctx, "get", req.Namespace, req.Type, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	resp := &cachepkg.CacheListResponse{}
+	for key, entry := range entries {
+		creationTimestamp := entry.CreationTimestamp
+		resp.Entries = append(resp.Entries, &cachepkg.CacheEntry{
+			Key:               key,
+			NodeID:            entry.NodeID,
+			Outputs:           entry.Outputs,
+			CreationTimestamp: &creationTimestamp,
+		})
+	}
+	sort.Slice(resp.Entries, func(i, j int) bool { return resp.Entries[i].Key < resp.Entries[j].Key })
+	return resp, nil
+}
+
+func (s *cacheServer) DeleteCacheEntry(ctx context.Context, req *cachepkg.CacheDeleteRequest) (*cachepkg.CacheDeleteResponse, error) {
+	c, err := s.getCache(ctx, "update", req.Namespace, req.Type, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Delete(req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &cachepkg.CacheDeleteResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	cachepkg "github.com/argoproj/argo/pkg/apiclient/cache"
+	"github.com/argoproj/argo/server/auth"
+)
+
+func getCacheServer(allowed bool) (cachepkg.CacheServiceServer, context.Context) {
+	kubeClient := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "my-ns"},
+		Data: map[string]string{
+			"hi-there-world": `{"nodeID":"my-node-1","outputs":{},"creationTimestamp":"2020-10-01T00:00:00Z"}`,
+			"hello":          `{"nodeID":"my-node-2","outputs":{}}`,
+		},
+	})
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+	return NewCacheServer(nil, "", nil), ctx
+}
+
+func TestCacheServer_ListCacheEntries(t *testing.T) {
+	server, ctx := getCacheServer(true)
+	t.Run("ConfigMap", func(t *testing.T) {
+		resp, err := server.ListCacheEntries(ctx, &cachepkg.CacheListRequest{Namespace: "my-ns", Type: "configmap", Name: "my-cache"})
+		if assert.NoError(t, err) && assert.Len(t, resp.Entries, 2) {
+			assert.Equal(t, "hello", resp.Entries[0].Key)
+			assert.Equal(t, "hi-there-world", resp.Entries[1].Key)
+			assert.Equal(t, "my-node-1", resp.Entries[1].NodeID)
+			assert.Equal(t, 2020, resp.Entries[1].CreationTimestamp.Year())
+		}
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, err := server.ListCacheEntries(ctx, &cachepkg.CacheListRequest{Namespace: "my-ns", Type: "sql", Name: "my-cache"})
+		assert.EqualError(t, err, "sql caches are not available, as they are not configured")
+	})
+	t.Run("UnknownType", func(t *testing.T) {
+		_, err := server.ListCacheEntries(ctx, &cachepkg.CacheListRequest{Namespace: "my-ns", Type: "redis", Name: "my-cache"})
+		assert.Error(t, err)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		server, ctx := getCacheServer(false)
+		_, err := server.ListCacheEntries(ctx, &cachepkg.CacheListRequest{Namespace: "my-ns", Type: "configmap", Name: "my-cache"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestCacheServer_DeleteCacheEntry(t *testing.T) {
+	t.Run("PermissionDenied", func(t *testing.T) {
+		server, ctx := getCacheServer(false)
+		_, err := server.DeleteCacheEntry(ctx, &cachepkg.CacheDeleteRequest{Namespace: "my-ns", Type: "configmap", Name: "my-cache", Key: "hello"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	server, ctx := getCacheServer(true)
+	_, err := server.DeleteCacheEntry(ctx, &cachepkg.CacheDeleteRequest{Namespace: "my-ns", Type: "configmap", Name: "my-cache", Key: "hello"})
+	if assert.NoError(t, err) {
+		resp, err := server.ListCacheEntries(ctx, &cachepkg.CacheListRequest{Namespace: "my-ns", Type: "configmap", Name: "my-cache"})
+		if assert.NoError(t, err) && assert.Len(t, resp.Entries, 1) {
+			assert.Equal(t, "hi-there-world", resp.Entries[0].Key)
+		}
+	}
+}
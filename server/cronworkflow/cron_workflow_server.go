@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cronworkflowpkg "github.com/argoproj/argo/pkg/apiclient/cronworkflow"
@@ -11,6 +13,7 @@ import (
 	"github.com/argoproj/argo/server/auth"
 	"github.com/argoproj/argo/util/instanceid"
 	"github.com/argoproj/argo/workflow/creator"
+	"github.com/argoproj/argo/workflow/cron"
 	"github.com/argoproj/argo/workflow/templateresolution"
 	"github.com/argoproj/argo/workflow/validate"
 )
@@ -90,6 +93,21 @@ func (c *cronWorkflowServiceServer) DeleteCronWorkflow(ctx context.Context, req
 	return &cronworkflowpkg.CronWorkflowDeletedResponse{}, nil
 }
 
+func (c *cronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest) (*v1alpha1.WorkflowList, error) {
+	if req.From == nil || req.To == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	cronWf, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	wfs, err := cron.Backfill(auth.GetWfClient(ctx), cronWf, req.From.Time, req.To.Time)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.WorkflowList{Items: wfs}, nil
+}
+
 func (c *cronWorkflowServiceServer) getCronWorkflowAndValidate(ctx context.Context, namespace string, name string, options metav1.GetOptions) (*v1alpha1.CronWorkflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	cronWf, err := wfClient.ArgoprojV1alpha1().CronWorkflows(namespace).Get(name, options)
@@ -3,8 +3,10 @@ package cronworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cronworkflowpkg "github.com/argoproj/argo/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -106,6 +108,32 @@ metadata:
 			assert.Error(t, err)
 		})
 	})
+	t.Run("BackfillCronWorkflow", func(t *testing.T) {
+		from := metav1.NewTime(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC))
+		to := metav1.NewTime(time.Date(2020, 1, 1, 10, 2, 0, 0, time.UTC))
+		t.Run("Labelled", func(t *testing.T) {
+			wfs, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Namespace: "my-ns", Name: "my-name", From: &from, To: &to})
+			if assert.NoError(t, err) && assert.Len(t, wfs.Items, 3) {
+				assert.Equal(t, "my-name-1577872800", wfs.Items[0].Name)
+				scheduledTime, err := time.Parse(time.RFC3339, wfs.Items[0].Annotations[common.AnnotationKeyCronWfScheduledTime])
+				if assert.NoError(t, err) {
+					assert.True(t, from.Time.Equal(scheduledTime))
+				}
+			}
+			wfs, err = server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Namespace: "my-ns", Name: "my-name", From: &from, To: &to})
+			if assert.NoError(t, err) {
+				assert.Empty(t, wfs.Items)
+			}
+		})
+		t.Run("Unlabelled", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Namespace: "my-ns", Name: "unlabelled", From: &from, To: &to})
+			assert.Error(t, err)
+		})
+		t.Run("MissingWindow", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Namespace: "my-ns", Name: "my-name"})
+			assert.Error(t, err)
+		})
+	})
 	t.Run("DeleteCronWorkflow", func(t *testing.T) {
 		t.Run("Labelled", func(t *testing.T) {
 			_, err := server.DeleteCronWorkflow(ctx, &cronworkflowpkg.DeleteCronWorkflowRequest{Name: "my-name", Namespace: "my-ns"})
@@ -70,17 +70,12 @@ func (o *Operation) Dispatch() {
 }
 
 func (o *Operation) dispatch(wfeb wfv1.WorkflowEventBinding, nameSuffix string) (*wfv1.Workflow, error) {
-	selector := wfeb.Spec.Event.Selector
-	result, err := expr.Eval(selector, o.env)
+	matched, params, err := o.Evaluate(wfeb)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
+		return nil, err
 	}
-	matched, boolExpr := result.(bool)
-	log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "selector": selector, "matched": matched, "boolExpr": boolExpr}).Debug("Selector evaluation")
 	submit := wfeb.Spec.Submit
-	if !boolExpr {
-		return nil, errors.New("malformed workflow template expression: did not evaluate to boolean")
-	} else if matched && submit != nil {
+	if matched && submit != nil {
 		client := auth.GetWfClient(o.ctx)
 		ref := wfeb.Spec.Submit.WorkflowTemplateRef
 		var tmpl wfv1.WorkflowSpecHolder
@@ -105,19 +100,7 @@ func (o *Operation) dispatch(wfeb wfv1.WorkflowEventBinding, nameSuffix string)
 		// so we label with creator (which is a standard) and the name of the triggering event
 		creator.Label(o.ctx, wf)
 		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
-		if submit.Arguments != nil {
-			for _, p := range submit.Arguments.Parameters {
-				if p.ValueFrom == nil {
-					return nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
-				}
-				result, err := expr.Eval(p.ValueFrom.Event, o.env)
-				if err != nil {
-					return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
-				}
-				intOrString := intstr.Parse(fmt.Sprintf("%v", result))
-				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: &intOrString})
-			}
-		}
+		wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, params...)
 		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(wf)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create workflow: %w", err)
@@ -127,6 +110,38 @@ func (o *Operation) dispatch(wfeb wfv1.WorkflowEventBinding, nameSuffix string)
 	return nil, nil
 }
 
+// Evaluate evaluates the selector of the binding against the event and, if it matched, the parameters the binding
+// would submit the workflow template with. Nothing is submitted, so this can be used to test bindings.
+func (o *Operation) Evaluate(wfeb wfv1.WorkflowEventBinding) (bool, []wfv1.Parameter, error) {
+	selector := wfeb.Spec.Event.Selector
+	result, err := expr.Eval(selector, o.env)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
+	}
+	matched, boolExpr := result.(bool)
+	log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "selector": selector, "matched": matched, "boolExpr": boolExpr}).Debug("Selector evaluation")
+	if !boolExpr {
+		return false, nil, errors.New("malformed workflow template expression: did not evaluate to boolean")
+	}
+	submit := wfeb.Spec.Submit
+	if !matched || submit == nil || submit.Arguments == nil {
+		return matched, nil, nil
+	}
+	var params []wfv1.Parameter
+	for _, p := range submit.Arguments.Parameters {
+		if p.ValueFrom == nil {
+			return matched, nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
+		}
+		result, err := expr.Eval(p.ValueFrom.Event, o.env)
+		if err != nil {
+			return matched, nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
+		}
+		intOrString := intstr.Parse(fmt.Sprintf("%v", result))
+		params = append(params, wfv1.Parameter{Name: p.Name, Value: &intOrString})
+	}
+	return matched, params, nil
+}
+
 func expressionEnvironment(ctx context.Context, namespace, discriminator string, payload *wfv1.Item) (map[string]interface{}, error) {
 	src := map[string]interface{}{
 		"namespace":     namespace,
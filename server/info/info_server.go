@@ -17,7 +17,13 @@ type infoServer struct {
 func (i *infoServer) GetUserInfo(ctx context.Context, _ *infopkg.GetUserInfoRequest) (*infopkg.GetUserInfoResponse, error) {
 	claims := auth.GetClaimSet(ctx)
 	if claims != nil {
-		return &infopkg.GetUserInfoResponse{Subject: claims.Sub, Issuer: claims.Iss}, nil
+		return &infopkg.GetUserInfoResponse{
+			Subject:            claims.Sub,
+			Issuer:             claims.Iss,
+			Groups:             claims.Groups,
+			Email:              claims.Email,
+			ServiceAccountName: claims.ServiceAccountName,
+		}, nil
 	}
 	return &infopkg.GetUserInfoResponse{}, nil
 }
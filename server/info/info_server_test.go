@@ -12,10 +12,13 @@ import (
 
 func Test_infoServer_GetUserInfo(t *testing.T) {
 	i := &infoServer{}
-	ctx := context.WithValue(context.TODO(), auth.ClaimSetKey, &jws.ClaimSet{Iss: "my-iss", Sub: "my-sub"})
+	ctx := context.WithValue(context.TODO(), auth.ClaimSetKey, &jws.ClaimSet{Iss: "my-iss", Sub: "my-sub", Groups: []string{"my-group"}, Email: "my@email", ServiceAccountName: "my-sa"})
 	info, err := i.GetUserInfo(ctx, nil)
 	if assert.NoError(t, err) {
 		assert.Equal(t, "my-iss", info.Issuer)
 		assert.Equal(t, "my-sub", info.Subject)
+		assert.Equal(t, []string{"my-group"}, info.Groups)
+		assert.Equal(t, "my@email", info.Email)
+		assert.Equal(t, "my-sa", info.ServiceAccountName)
 	}
 }
@@ -0,0 +1,257 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// differences is a list of differences which only records the fields whose values are different
+type differences []*workflowpkg.WorkflowDifference
+
+func (d *differences) add(field, value, otherValue string) {
+	if value != otherValue {
+		*d = append(*d, &workflowpkg.WorkflowDifference{Field: field, Value: value, OtherValue: otherValue})
+	}
+}
+
+func (d *differences) addResourcesDurations(value, otherValue wfv1.ResourcesDuration) {
+	names := map[string]bool{}
+	for name := range value {
+		names[string(name)] = true
+	}
+	for name := range otherValue {
+		names[string(name)] = true
+	}
+	for _, name := range sortedKeys(names) {
+		d.add("resourcesDuration."+name, formatResourceDuration(value, name), formatResourceDuration(otherValue, name))
+	}
+}
+
+func (d *differences) addParameters(prefix string, value, otherValue []wfv1.Parameter) {
+	values := parameterValues(value)
+	otherValues := parameterValues(otherValue)
+	names := map[string]bool{}
+	for name := range values {
+		names[name] = true
+	}
+	for name := range otherValues {
+		names[name] = true
+	}
+	for _, name := range sortedKeys(names) {
+		d.add(prefix+"."+name, values[name], otherValues[name])
+	}
+}
+
+// compareWorkflows returns the differences between the workflows, and between their nodes. Nodes are aligned by
+// display name and template, as the IDs of the nodes of different workflows are always different.
+func compareWorkflows(wf, other *wfv1.Workflow) *workflowpkg.WorkflowCompareResponse {
+	var d differences
+	d.add("phase", string(wf.Status.Phase), string(other.Status.Phase))
+	d.add("message", wf.Status.Message, other.Status.Message)
+	d.add("duration", formatDuration(wf.Status.StartedAt, wf.Status.FinishedAt), formatDuration(other.Status.StartedAt, other.Status.FinishedAt))
+	d.addResourcesDurations(wf.Status.ResourcesDuration, other.Status.ResourcesDuration)
+	d.addParameters("outputs.parameters", outputParameters(wf.Status.Outputs), outputParameters(other.Status.Outputs))
+	specValues := flattenSpec(wf)
+	otherSpecValues := flattenSpec(other)
+	fields := map[string]bool{}
+	for field := range specValues {
+		fields[field] = true
+	}
+	for field := range otherSpecValues {
+		fields[field] = true
+	}
+	for _, field := range sortedKeys(fields) {
+		d.add(field, specValues[field], otherSpecValues[field])
+	}
+
+	resp := &workflowpkg.WorkflowCompareResponse{Differences: d}
+	nodes, keys := nodesByKey(wf)
+	otherNodes, otherKeys := nodesByKey(other)
+	for _, key := range otherKeys {
+		if _, ok := nodes[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		node, ok := nodes[key]
+		otherNode, otherOk := otherNodes[key]
+		var nd differences
+		switch {
+		case !otherOk:
+			nd.add("phase", string(node.Phase), "")
+		case !ok:
+			nd.add("phase", "", string(otherNode.Phase))
+			node = otherNode
+		default:
+			nd = compareNodes(node, otherNode)
+		}
+		if len(nd) > 0 {
+			resp.Nodes = append(resp.Nodes, &workflowpkg.NodeComparison{DisplayName: node.DisplayName, TemplateName: key.templateName, Differences: nd})
+		}
+	}
+	return resp
+}
+
+func compareNodes(node, other wfv1.NodeStatus) differences {
+	var d differences
+	d.add("phase", string(node.Phase), string(other.Phase))
+	d.add("message", node.Message, other.Message)
+	d.add("duration", formatDuration(node.StartedAt, node.FinishedAt), formatDuration(other.StartedAt, other.FinishedAt))
+	d.addResourcesDurations(node.ResourcesDuration, other.ResourcesDuration)
+	var inputs, otherInputs []wfv1.Parameter
+	if node.Inputs != nil {
+		inputs = node.Inputs.Parameters
+	}
+	if other.Inputs != nil {
+		otherInputs = other.Inputs.Parameters
+	}
+	d.addParameters("inputs.parameters", inputs, otherInputs)
+	d.addParameters("outputs.parameters", outputParameters(node.Outputs), outputParameters(other.Outputs))
+	d.add("outputs.result", outputResult(node.Outputs), outputResult(other.Outputs))
+	return d
+}
+
+// nodeKey identifies the node of a workflow by its display name and template. The nodes with the same display name
+// and template, e.g. step groups, are told apart by the order they were started in.
+type nodeKey struct {
+	displayName  string
+	templateName string
+	n            int
+}
+
+// nodesByKey returns the nodes of the workflow by their keys, and the keys in the order the nodes were started in
+func nodesByKey(wf *wfv1.Workflow) (map[nodeKey]wfv1.NodeStatus, []nodeKey) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	byKey := make(map[nodeKey]wfv1.NodeStatus)
+	var keys []nodeKey
+	counts := make(map[nodeKey]int)
+	for _, node := range nodes {
+		first := nodeKey{displayName: node.DisplayName, templateName: node.TemplateName}
+		if node.TemplateRef != nil {
+			first.templateName = node.TemplateRef.Name + "/" + node.TemplateRef.Template
+		}
+		// the display names of the root and exit handler nodes start with the name of the workflow, which is
+		// different for each workflow
+		if node.DisplayName == wf.Name || strings.HasPrefix(node.DisplayName, wf.Name+".") {
+			first.displayName = strings.TrimPrefix(node.DisplayName, wf.Name)
+		}
+		key := first
+		key.n = counts[first]
+		counts[first]++
+		byKey[key] = node
+		keys = append(keys, key)
+	}
+	return byKey, keys
+}
+
+// flattenSpec returns the values of the fields of the workflow's spec by their paths, e.g. "spec.templates[main].container.image".
+// Items of lists which have a name are identified by their name rather than their index.
+func flattenSpec(wf *wfv1.Workflow) map[string]string {
+	spec := wf.Spec
+	if wf.Status.StoredWorkflowSpec != nil {
+		spec = *wf.Status.StoredWorkflowSpec
+	}
+	values := make(map[string]string)
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return values
+	}
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return values
+	}
+	flatten("spec", obj, values)
+	return values
+}
+
+func flatten(path string, obj interface{}, values map[string]string) {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			flatten(path+"."+key, value, values)
+		}
+	case []interface{}:
+		for i, item := range v {
+			key := strconv.Itoa(i)
+			if m, ok := item.(map[string]interface{}); ok {
+				if name, ok := m["name"].(string); ok && name != "" {
+					key = name
+				}
+			}
+			flatten(fmt.Sprintf("%s[%s]", path, key), item, values)
+		}
+	case string:
+		values[path] = v
+	default:
+		data, _ := json.Marshal(v)
+		values[path] = string(data)
+	}
+}
+
+func formatDuration(startedAt, finishedAt metav1.Time) string {
+	if startedAt.IsZero() || finishedAt.IsZero() {
+		return ""
+	}
+	return finishedAt.Sub(startedAt.Time).Round(time.Second).String()
+}
+
+func formatResourceDuration(resourcesDuration wfv1.ResourcesDuration, name string) string {
+	if d, ok := resourcesDuration[corev1.ResourceName(name)]; ok {
+		return d.String()
+	}
+	return ""
+}
+
+func parameterValues(parameters []wfv1.Parameter) map[string]string {
+	values := make(map[string]string)
+	for _, p := range parameters {
+		if p.Value != nil {
+			values[p.Name] = p.Value.String()
+		} else {
+			values[p.Name] = ""
+		}
+	}
+	return values
+}
+
+func outputParameters(outputs *wfv1.Outputs) []wfv1.Parameter {
+	if outputs == nil {
+		return nil
+	}
+	return outputs.Parameters
+}
+
+func outputResult(outputs *wfv1.Outputs) string {
+	if outputs == nil || outputs.Result == nil {
+		return ""
+	}
+	return *outputs.Result
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,157 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+
+	workflowpkg "github.com/argoproj/argo/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var lastNightWf = `
+metadata:
+  name: nightly-abcde
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: date
+      value: "2020-01-01"
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+  - name: build
+    container:
+      image: golang:1.14
+status:
+  phase: Succeeded
+  startedAt: "2020-01-01T00:00:00Z"
+  finishedAt: "2020-01-01T00:01:00Z"
+  resourcesDuration:
+    cpu: 30
+  nodes:
+    nightly-abcde:
+      id: nightly-abcde
+      name: nightly-abcde
+      displayName: nightly-abcde
+      templateName: main
+      phase: Succeeded
+      startedAt: "2020-01-01T00:00:00Z"
+      finishedAt: "2020-01-01T00:01:00Z"
+    nightly-abcde-1:
+      id: nightly-abcde-1
+      name: nightly-abcde[0].build
+      displayName: build
+      templateName: build
+      phase: Succeeded
+      startedAt: "2020-01-01T00:00:01Z"
+      finishedAt: "2020-01-01T00:00:59Z"
+      resourcesDuration:
+        cpu: 30
+      inputs:
+        parameters:
+        - name: date
+          value: "2020-01-01"
+`
+
+var tonightWf = `
+metadata:
+  name: nightly-fghij
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: date
+      value: "2020-01-02"
+  templates:
+  - name: main
+    steps:
+    - - name: build
+        template: build
+  - name: build
+    container:
+      image: golang:1.15
+status:
+  phase: Failed
+  message: child 'nightly-fghij-1' failed
+  startedAt: "2020-01-02T00:00:00Z"
+  finishedAt: "2020-01-02T00:02:00Z"
+  resourcesDuration:
+    cpu: 90
+  nodes:
+    nightly-fghij:
+      id: nightly-fghij
+      name: nightly-fghij
+      displayName: nightly-fghij
+      templateName: main
+      phase: Failed
+      startedAt: "2020-01-02T00:00:00Z"
+      finishedAt: "2020-01-02T00:01:00Z"
+    nightly-fghij-1:
+      id: nightly-fghij-1
+      name: nightly-fghij[0].build
+      displayName: build
+      templateName: build
+      phase: Failed
+      message: failed with exit code 1
+      startedAt: "2020-01-02T00:00:01Z"
+      finishedAt: "2020-01-02T00:00:59Z"
+      resourcesDuration:
+        cpu: 90
+      inputs:
+        parameters:
+        - name: date
+          value: "2020-01-02"
+    nightly-fghij-2:
+      id: nightly-fghij-2
+      name: nightly-fghij.onExit
+      displayName: nightly-fghij.onExit
+      templateName: notify
+      phase: Succeeded
+      startedAt: "2020-01-02T00:01:00Z"
+      finishedAt: "2020-01-02T00:02:00Z"
+`
+
+func Test_compareWorkflows(t *testing.T) {
+	var wf, other wfv1.Workflow
+	err := yaml.Unmarshal([]byte(lastNightWf), &wf)
+	assert.NoError(t, err)
+	err = yaml.Unmarshal([]byte(tonightWf), &other)
+	assert.NoError(t, err)
+
+	comparison := compareWorkflows(&wf, &other)
+	assert.Equal(t, []*workflowpkg.WorkflowDifference{
+		{Field: "phase", Value: "Succeeded", OtherValue: "Failed"},
+		{Field: "message", OtherValue: "child 'nightly-fghij-1' failed"},
+		{Field: "duration", Value: "1m0s", OtherValue: "2m0s"},
+		{Field: "resourcesDuration.cpu", Value: "30s", OtherValue: "1m30s"},
+		{Field: "spec.arguments.parameters[date].value", Value: "2020-01-01", OtherValue: "2020-01-02"},
+		{Field: "spec.templates[build].container.image", Value: "golang:1.14", OtherValue: "golang:1.15"},
+	}, comparison.Differences)
+	if assert.Len(t, comparison.Nodes, 3) {
+		assert.Equal(t, &workflowpkg.NodeComparison{
+			DisplayName:  "nightly-abcde",
+			TemplateName: "main",
+			Differences:  []*workflowpkg.WorkflowDifference{{Field: "phase", Value: "Succeeded", OtherValue: "Failed"}},
+		}, comparison.Nodes[0])
+		assert.Equal(t, &workflowpkg.NodeComparison{
+			DisplayName:  "build",
+			TemplateName: "build",
+			Differences: []*workflowpkg.WorkflowDifference{
+				{Field: "phase", Value: "Succeeded", OtherValue: "Failed"},
+				{Field: "message", OtherValue: "failed with exit code 1"},
+				{Field: "resourcesDuration.cpu", Value: "30s", OtherValue: "1m30s"},
+				{Field: "inputs.parameters.date", Value: "2020-01-01", OtherValue: "2020-01-02"},
+			},
+		}, comparison.Nodes[1])
+		assert.Equal(t, &workflowpkg.NodeComparison{
+			DisplayName:  "nightly-fghij.onExit",
+			TemplateName: "notify",
+			Differences:  []*workflowpkg.WorkflowDifference{{Field: "phase", OtherValue: "Succeeded"}},
+		}, comparison.Nodes[2])
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,13 +36,14 @@ type workflowServer struct {
 	instanceIDService     instanceid.Service
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	wfArchive             sqldb.WorkflowArchive
 }
 
 const latestAlias = "@latest"
 
 // NewWorkflowServer returns a new workflowServer
-func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowpkg.WorkflowServiceServer {
-	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo)}
+func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive) workflowpkg.WorkflowServiceServer {
+	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), wfArchive}
 }
 
 func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.WorkflowCreateRequest) (*wfv1.Workflow, error) {
@@ -505,6 +509,41 @@ func (s *workflowServer) getWorkflow(wfClient versioned.Interface, namespace str
 	return wf, nil
 }
 
+// getWorkflowOrArchivedWorkflow returns the workflow with the name, or, if there is no such workflow, the archived
+// workflow with the name as its UID
+func (s *workflowServer) getWorkflowOrArchivedWorkflow(ctx context.Context, namespace string, name string) (*wfv1.Workflow, error) {
+	wf, err := s.getWorkflow(auth.GetWfClient(ctx), namespace, name, metav1.GetOptions{})
+	if err == nil {
+		err = s.validateWorkflow(wf)
+		if err != nil {
+			return nil, err
+		}
+		return wf, s.hydrator.Hydrate(wf)
+	}
+	if !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	if s.wfArchive == sqldb.NullWorkflowArchive {
+		// e.g. the CLI is not using the Argo Server, or the server's workflow archive is not enabled
+		return nil, status.Errorf(codes.Unimplemented, "workflow %s not found, and it cannot be looked for in the workflow archive, which is not supported", name)
+	}
+	archivedWf, archiveErr := s.wfArchive.GetWorkflow(name)
+	if archiveErr != nil {
+		log.WithError(archiveErr).WithField("uid", name).Debug("Failed to get archived workflow")
+	}
+	if archivedWf == nil || archivedWf.Namespace != namespace {
+		return nil, err
+	}
+	allowed, err := auth.CanI(ctx, "get", workflow.WorkflowPlural, namespace, archivedWf.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return archivedWf, nil
+}
+
 func (s *workflowServer) validateWorkflow(wf *wfv1.Workflow) error {
 	return s.instanceIDService.Validate(wf)
 }
@@ -535,7 +574,7 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 		if err != nil {
 			return nil, err
 		}
-		wf = common.ConvertCronWorkflowToWorkflow(cronWf)
+		wf = common.ConvertCronWorkflowToWorkflowWithScheduledTime(cronWf, time.Now())
 	case workflow.WorkflowTemplateKind, workflow.WorkflowTemplateSingular, workflow.WorkflowTemplatePlural, workflow.WorkflowTemplateShortName:
 		wfTmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Get(req.ResourceName, metav1.GetOptions{})
 		if err != nil {
@@ -569,3 +608,18 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 	return wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(wf)
 
 }
+
+func (s *workflowServer) CompareWorkflows(ctx context.Context, req *workflowpkg.WorkflowCompareRequest) (*workflowpkg.WorkflowCompareResponse, error) {
+	if req.Name == "" || req.OtherName == "" {
+		return nil, status.Error(codes.InvalidArgument, "the names of both workflows are required")
+	}
+	wf, err := s.getWorkflowOrArchivedWorkflow(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	other, err := s.getWorkflowOrArchivedWorkflow(ctx, req.Namespace, req.OtherName)
+	if err != nil {
+		return nil, err
+	}
+	return compareWorkflows(wf, other), nil
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -581,7 +583,9 @@ func getWorkflowServer() (workflowpkg.WorkflowServiceServer, context.Context) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo)
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("GetWorkflow", mock.Anything).Return(nil, nil)
+	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo, wfArchive)
 	kubeClientSet := fake.NewSimpleClientset()
 	wfClientset := v1alpha.NewSimpleClientset(&unlabelledObj, &wfObj1, &wfObj2, &wfObj3, &wfObj4, &wfObj5, &failedWfObj, &wftmpl, &cronwfObj, &cwfTmpl)
 	wfClientset.PrependReactor("create", "workflows", generateNameReactor)
@@ -918,3 +922,26 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 		}
 	})
 }
+
+func TestCompareWorkflows(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	t.Run("Compare", func(t *testing.T) {
+		comparison, err := server.CompareWorkflows(ctx, &workflowpkg.WorkflowCompareRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "hello-world-9tql2-run"})
+		if assert.NoError(t, err) && assert.NotEmpty(t, comparison.Differences) {
+			assert.Equal(t, &workflowpkg.WorkflowDifference{Field: "phase", Value: "Succeeded", OtherValue: "Running"}, comparison.Differences[0])
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := server.CompareWorkflows(ctx, &workflowpkg.WorkflowCompareRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "not-found"})
+		assert.Error(t, err)
+	})
+	t.Run("MissingName", func(t *testing.T) {
+		_, err := server.CompareWorkflows(ctx, &workflowpkg.WorkflowCompareRequest{Namespace: "workflows", Name: "hello-world-9tql2"})
+		assert.Error(t, err)
+	})
+	t.Run("ArchiveNotSupported", func(t *testing.T) {
+		server := NewWorkflowServer(instanceid.NewService("my-instanceid"), sqldb.ExplosiveOffloadNodeStatusRepo, sqldb.NullWorkflowArchive)
+		_, err := server.CompareWorkflows(ctx, &workflowpkg.WorkflowCompareRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "not-found"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
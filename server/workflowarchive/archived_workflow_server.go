@@ -2,6 +2,7 @@ package workflowarchive
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"sort"
 	"strconv"
@@ -18,6 +19,8 @@ import (
 	"github.com/argoproj/argo/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/server/auth"
+	"github.com/argoproj/argo/workflow/packer"
+	"github.com/argoproj/argo/workflow/util"
 )
 
 type archivedWorkflowServer struct {
@@ -34,37 +37,55 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 	if options == nil {
 		options = &metav1.ListOptions{}
 	}
-	if options.Continue == "" {
-		options.Continue = "0"
-	}
 	limit := int(options.Limit)
 	if limit == 0 {
 		limit = 10
 	}
+	listOptions := sqldb.ListWorkflowsOptions{
+		NamePrefix:       req.NamePrefix,
+		WorkflowTemplate: req.WorkflowTemplate,
+		// list one more than the limit, to know if there are more
+		Limit: limit + 1,
+	}
+	// the continue token used to be an offset, which older clients still send
 	offset, err := strconv.Atoi(options.Continue)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must be int")
+	if err == nil {
+		if offset < 0 {
+			return nil, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0")
+		}
+		listOptions.Offset = offset
+	} else {
+		listOptions.Cursor, err = parseCursor(options.Continue)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "listOptions.continue is invalid")
+		}
 	}
-	if offset < 0 {
-		return nil, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0")
+	for _, phase := range req.Phases {
+		listOptions.Phases = append(listOptions.Phases, wfv1.NodePhase(phase))
 	}
-
-	namespace := ""
-	minStartedAt := time.Time{}
-	maxStartedAt := time.Time{}
 	for _, selector := range strings.Split(options.FieldSelector, ",") {
 		if len(selector) == 0 {
 			continue
 		}
 		if strings.HasPrefix(selector, "metadata.namespace=") {
-			namespace = strings.TrimPrefix(selector, "metadata.namespace=")
+			listOptions.Namespace = strings.TrimPrefix(selector, "metadata.namespace=")
 		} else if strings.HasPrefix(selector, "spec.startedAt>") {
-			minStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt>"))
+			listOptions.MinStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt>"))
 			if err != nil {
 				return nil, err
 			}
 		} else if strings.HasPrefix(selector, "spec.startedAt<") {
-			maxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt<"))
+			listOptions.MaxStartedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.startedAt<"))
+			if err != nil {
+				return nil, err
+			}
+		} else if strings.HasPrefix(selector, "spec.finishedAt>") {
+			listOptions.MinFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.finishedAt>"))
+			if err != nil {
+				return nil, err
+			}
+		} else if strings.HasPrefix(selector, "spec.finishedAt<") {
+			listOptions.MaxFinishedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(selector, "spec.finishedAt<"))
 			if err != nil {
 				return nil, err
 			}
@@ -72,46 +93,61 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 			return nil, fmt.Errorf("unsupported requirement %s", selector)
 		}
 	}
-	requirements, err := labels.ParseToRequirements(options.LabelSelector)
+	listOptions.LabelRequirements, err = labels.ParseToRequirements(options.LabelSelector)
 	if err != nil {
 		return nil, err
 	}
 
-	items := make(wfv1.Workflows, 0)
-	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, listOptions.Namespace, "")
 	if err != nil {
 		return nil, err
 	}
 	if !allowed {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
-	hasMore := true
-	// keep trying until we have enough
-	for len(items) < limit {
-		moreItems, err := w.wfArchive.ListWorkflows(namespace, minStartedAt, maxStartedAt, requirements, limit+1, offset)
-		if err != nil {
-			return nil, err
-		}
-		for index, wf := range moreItems {
-			if index == limit {
-				break
-			}
-			items = append(items, wf)
-		}
-		if len(moreItems) < limit+1 {
-			hasMore = false
-			break
-		}
-		offset = offset + limit
+	items, err := w.wfArchive.ListWorkflows(listOptions)
+	if err != nil {
+		return nil, err
 	}
 	meta := metav1.ListMeta{}
-	if hasMore {
-		meta.Continue = fmt.Sprintf("%v", offset)
+	if len(items) > limit {
+		items = items[:limit]
+		last := items[limit-1]
+		if listOptions.Cursor == nil && options.Continue != "" {
+			meta.Continue = strconv.Itoa(offset + limit)
+		} else {
+			meta.Continue = formatCursor(sqldb.Cursor{StartedAt: last.Status.StartedAt.Time, UID: string(last.UID)})
+		}
 	}
 	sort.Sort(items)
 	return &wfv1.WorkflowList{ListMeta: meta, Items: items}, nil
 }
 
+// formatCursor formats the cursor as an opaque continue token
+func formatCursor(cursor sqldb.Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor.StartedAt.Format(time.RFC3339Nano) + "/" + cursor.UID))
+}
+
+// parseCursor parses a continue token, returning nil for the empty token
+func parseCursor(continueToken string) (*sqldb.Cursor, error) {
+	if continueToken == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(continueToken)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(data), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("malformed continue token")
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	return &sqldb.Cursor{StartedAt: startedAt, UID: parts[1]}, nil
+}
+
 func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.GetArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wf, err := w.wfArchive.GetWorkflow(req.Uid)
 	if err != nil {
@@ -148,3 +184,66 @@ func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req
 	}
 	return &workflowarchivepkg.ArchivedWorkflowDeletedResponse{}, nil
 }
+
+func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := w.getArchivedWorkflowToCreate(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized)
+	if err != nil {
+		return nil, err
+	}
+	return util.SubmitWorkflow(wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wfClient, wf.Namespace, newWF, &wfv1.SubmitOpts{})
+}
+
+func (w *archivedWorkflowServer) RetryArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.RetryArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	kubeClient := auth.GetKubeClient(ctx)
+	wf, err := w.getArchivedWorkflowToCreate(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	err = packer.DecompressWorkflow(wf)
+	if err != nil {
+		return nil, err
+	}
+	newWF, podsToDelete, err := util.FormulateRetryWorkflow(wf, req.RestartSuccessful, req.NodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	// the pods have normally been garbage collected along with the workflow, but we make sure
+	err = util.DeletePods(kubeClient, wf.Namespace, podsToDelete)
+	if err != nil {
+		return nil, err
+	}
+	// the workflow is re-created with the same name, so it must be a new object
+	newWF.ObjectMeta.ResourceVersion = ""
+	newWF.ObjectMeta.UID = ""
+	newWF.ObjectMeta.SelfLink = ""
+	newWF.ObjectMeta.Generation = 0
+	newWF.ObjectMeta.CreationTimestamp = metav1.Time{}
+	// archived workflows are hydrated, and their node status cannot be offloaded as the offload is keyed by UID
+	err = packer.CompressWorkflowIfNeeded(newWF)
+	if err != nil {
+		return nil, err
+	}
+	return wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(newWF)
+}
+
+// getArchivedWorkflowToCreate gets the archived workflow, checking the user may create workflows from it
+func (w *archivedWorkflowServer) getArchivedWorkflowToCreate(ctx context.Context, uid string) (*wfv1.Workflow, error) {
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return wf, nil
+}
@@ -11,11 +11,11 @@ import (
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo/persist/sqldb"
 	"github.com/argoproj/argo/persist/sqldb/mocks"
 	workflowarchivepkg "github.com/argoproj/argo/pkg/apiclient/workflowarchive"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -46,20 +46,48 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		}, nil
 	})
 	// two pages of results for limit 1
-	repo.On("ListWorkflows", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}, {}}, nil)
-	repo.On("ListWorkflows", "", time.Time{}, time.Time{}, labels.Requirements(nil), 2, 1).Return(wfv1.Workflows{{}}, nil)
+	startedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo.On("ListWorkflows", sqldb.ListWorkflowsOptions{Limit: 2}).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{UID: "uid-1"}, Status: wfv1.WorkflowStatus{StartedAt: metav1.Time{Time: startedAt}}},
+		{ObjectMeta: metav1.ObjectMeta{UID: "uid-2"}, Status: wfv1.WorkflowStatus{StartedAt: metav1.Time{Time: startedAt}}},
+	}, nil)
+	repo.On("ListWorkflows", sqldb.ListWorkflowsOptions{Limit: 2, Cursor: &sqldb.Cursor{StartedAt: startedAt, UID: "uid-1"}}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sqldb.ListWorkflowsOptions{Limit: 2, Offset: 1}).Return(wfv1.Workflows{{}, {}}, nil)
 	minStartAt, _ := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
 	maxStartAt, _ := time.Parse(time.RFC3339, "2020-01-02T00:00:00Z")
-	repo.On("ListWorkflows", "", minStartAt, maxStartAt, labels.Requirements(nil), 2, 0).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sqldb.ListWorkflowsOptions{MinStartedAt: minStartAt, MaxStartedAt: maxStartAt, Limit: 2}).Return(wfv1.Workflows{{}}, nil)
+	repo.On("ListWorkflows", sqldb.ListWorkflowsOptions{
+		NamePrefix:       "my-",
+		Phases:           []wfv1.NodePhase{wfv1.NodeFailed},
+		WorkflowTemplate: "my-wftmpl",
+		MinFinishedAt:    minStartAt,
+		MaxFinishedAt:    maxStartAt,
+		Limit:            2,
+	}).Return(wfv1.Workflows{{}}, nil)
 	repo.On("GetWorkflow", "").Return(nil, nil)
 	repo.On("GetWorkflow", "my-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-name"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "my-entrypoint",
+			Templates: []wfv1.Template{
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "docker/whalesay:latest"}},
+			},
+		},
+	}, nil)
+	repo.On("GetWorkflow", "my-failed-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-failed", Namespace: "my-ns", UID: "my-failed-uid", Labels: map[string]string{}},
 		Spec: wfv1.WorkflowSpec{
 			Entrypoint: "my-entrypoint",
 			Templates: []wfv1.Template{
 				{Name: "my-entrypoint", Container: &apiv1.Container{}},
 			},
 		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.NodeFailed,
+			Nodes: wfv1.Nodes{
+				"my-failed": {ID: "my-failed", Name: "my-failed", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed},
+			},
+		},
 	}, nil)
 	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &wfv1.Workflow{
@@ -77,18 +105,37 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		resp, err := w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
-			assert.Equal(t, "1", resp.Continue)
+			assert.NotEmpty(t, resp.Continue)
+			resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: resp.Continue, Limit: 1}})
+			if assert.NoError(t, err) {
+				assert.Len(t, resp.Items, 1)
+				assert.Empty(t, resp.Continue)
+			}
 		}
+		// older clients page with offsets
 		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "1", Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
-			assert.Empty(t, resp.Continue)
+			assert.Equal(t, "2", resp.Continue)
 		}
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "-1", Limit: 1}})
+		assert.Equal(t, err, status.Error(codes.InvalidArgument, "listOptions.continue must >= 0"))
+		_, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{Continue: "!", Limit: 1}})
+		assert.Equal(t, err, status.Error(codes.InvalidArgument, "listOptions.continue is invalid"))
 		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: &metav1.ListOptions{FieldSelector: "spec.startedAt>2020-01-01T00:00:00Z,spec.startedAt<2020-01-02T00:00:00Z", Limit: 1}})
 		if assert.NoError(t, err) {
 			assert.Len(t, resp.Items, 1)
 			assert.Empty(t, resp.Continue)
 		}
+		resp, err = w.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{
+			ListOptions:      &metav1.ListOptions{FieldSelector: "spec.finishedAt>2020-01-01T00:00:00Z,spec.finishedAt<2020-01-02T00:00:00Z", Limit: 1},
+			NamePrefix:       "my-",
+			Phases:           []string{"Failed"},
+			WorkflowTemplate: "my-wftmpl",
+		})
+		if assert.NoError(t, err) {
+			assert.Len(t, resp.Items, 1)
+		}
 	})
 	t.Run("GetArchivedWorkflow", func(t *testing.T) {
 		allowed = false
@@ -109,4 +156,30 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("ResubmitArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		wf, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-name-resubmitted", wf.Name)
+		}
+	})
+	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-failed-uid"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.EqualError(t, err, "workflow must be Failed/Error to retry")
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-failed-uid"})
+		if assert.NoError(t, err) {
+			created := wfClient.Actions()[len(wfClient.Actions())-1].(k8stesting.CreateAction).GetObject().(*wfv1.Workflow)
+			assert.Equal(t, "my-failed", created.Name)
+			assert.Empty(t, created.UID)
+			assert.Equal(t, wfv1.NodeRunning, created.Status.Phase)
+			assert.Empty(t, created.Status.Nodes)
+		}
+	})
 }
@@ -0,0 +1,123 @@
+package workfloweventbinding
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/server/auth"
+	"github.com/argoproj/argo/server/event/dispatch"
+	"github.com/argoproj/argo/util/instanceid"
+	"github.com/argoproj/argo/workflow/creator"
+)
+
+type WorkflowEventBindingServer struct {
+	instanceIDService instanceid.Service
+}
+
+func NewWorkflowEventBindingServer(instanceIDService instanceid.Service) workfloweventbindingpkg.WorkflowEventBindingServiceServer {
+	return &WorkflowEventBindingServer{instanceIDService}
+}
+
+func (s *WorkflowEventBindingServer) CreateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingCreateRequest) (*v1alpha1.WorkflowEventBinding, error) {
+	if req.Binding == nil {
+		return nil, fmt.Errorf("workflow event binding was not found in the request body")
+	}
+	s.instanceIDService.Label(req.Binding)
+	creator.Label(ctx, req.Binding)
+	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).Create(req.Binding)
+}
+
+func (s *WorkflowEventBindingServer) GetWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingGetRequest) (*v1alpha1.WorkflowEventBinding, error) {
+	return s.getBindingAndValidate(ctx, req.Namespace, req.Name)
+}
+
+func (s *WorkflowEventBindingServer) getBindingAndValidate(ctx context.Context, namespace string, name string) (*v1alpha1.WorkflowEventBinding, error) {
+	wfeb, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(namespace).Get(name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	err = s.instanceIDService.Validate(wfeb)
+	if err != nil {
+		return nil, err
+	}
+	return wfeb, nil
+}
+
+func (s *WorkflowEventBindingServer) ListWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingListRequest) (*v1alpha1.WorkflowEventBindingList, error) {
+	options := &v1.ListOptions{}
+	if req.ListOptions != nil {
+		options = req.ListOptions
+	}
+	s.instanceIDService.With(options)
+	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).List(*options)
+}
+
+func (s *WorkflowEventBindingServer) UpdateWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingUpdateRequest) (*v1alpha1.WorkflowEventBinding, error) {
+	if req.Binding == nil {
+		return nil, fmt.Errorf("workflow event binding was not found in the request body")
+	}
+	// the name in the URL is the binding to update, the name in the body must not contradict it
+	if req.Binding.Name == "" {
+		req.Binding.Name = req.Name
+	}
+	if req.Name != "" && req.Name != req.Binding.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "name %q does not match the binding's name %q", req.Name, req.Binding.Name)
+	}
+	_, err := s.getBindingAndValidate(ctx, req.Namespace, req.Binding.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = s.instanceIDService.Validate(req.Binding)
+	if err != nil {
+		return nil, err
+	}
+	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).Update(req.Binding)
+}
+
+func (s *WorkflowEventBindingServer) DeleteWorkflowEventBinding(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingDeleteRequest) (*workfloweventbindingpkg.WorkflowEventBindingDeleteResponse, error) {
+	_, err := s.getBindingAndValidate(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).Delete(req.Name, &v1.DeleteOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &workfloweventbindingpkg.WorkflowEventBindingDeleteResponse{}, nil
+}
+
+// TestWorkflowEventBindings evaluates the event against the bindings in the namespace, exactly as a received event
+// would be, but reports the result for each binding rather than submitting any workflows.
+func (s *WorkflowEventBindingServer) TestWorkflowEventBindings(ctx context.Context, req *workfloweventbindingpkg.WorkflowEventBindingTestRequest) (*workfloweventbindingpkg.WorkflowEventBindingTestResponse, error) {
+	list, err := s.ListWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingListRequest{Namespace: req.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, nil, list.Items, req.Namespace, req.Discriminator, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*workfloweventbindingpkg.WorkflowEventBindingTestResult, 0, len(list.Items))
+	for _, wfeb := range list.Items {
+		result := &workfloweventbindingpkg.WorkflowEventBindingTestResult{Name: wfeb.Name}
+		matched, params, err := operation.Evaluate(wfeb)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Matched = matched
+		if submit := wfeb.Spec.Submit; submit != nil {
+			result.WorkflowTemplateRef = submit.WorkflowTemplateRef.DeepCopy()
+			if matched && err == nil {
+				result.Arguments = &v1alpha1.Arguments{Parameters: params}
+			}
+		}
+		results = append(results, result)
+	}
+	return &workfloweventbindingpkg.WorkflowEventBindingTestResponse{Results: results}, nil
+}
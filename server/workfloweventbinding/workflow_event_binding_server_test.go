@@ -0,0 +1,172 @@
+package workfloweventbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	workfloweventbindingpkg "github.com/argoproj/argo/pkg/apiclient/workfloweventbinding"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	wfFake "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/server/auth"
+	"github.com/argoproj/argo/server/auth/jws"
+	"github.com/argoproj/argo/util/instanceid"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+func newBinding(name, selector string, params ...wfv1.Parameter) *wfv1.WorkflowEventBinding {
+	return &wfv1.WorkflowEventBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		Spec: wfv1.WorkflowEventBindingSpec{
+			Event: wfv1.Event{Selector: selector},
+			Submit: &wfv1.Submit{
+				WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+				Arguments:           &wfv1.Arguments{Parameters: params},
+			},
+		},
+	}
+}
+
+func getWorkflowEventBindingServer() (workfloweventbindingpkg.WorkflowEventBindingServiceServer, context.Context) {
+	unlabelled := &wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "my-ns"}}
+	wfClientset := wfFake.NewSimpleClientset(
+		unlabelled,
+		newBinding("my-wfeb-1", `payload.message == "hello"`, wfv1.Parameter{Name: "my-param", ValueFrom: &wfv1.ValueFrom{Event: "payload.message"}}),
+		newBinding("my-wfeb-2", `payload.message == "goodbye"`),
+		newBinding("malformed", `"not a bool"`),
+	)
+	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, fake.NewSimpleClientset()), auth.ClaimSetKey, &jws.ClaimSet{Sub: "my-sub"})
+	return NewWorkflowEventBindingServer(instanceid.NewService("my-instanceid")), ctx
+}
+
+func TestWorkflowEventBindingServer_CreateWorkflowEventBinding(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	t.Run("Empty", func(t *testing.T) {
+		_, err := server.CreateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingCreateRequest{Namespace: "my-ns"})
+		assert.Error(t, err)
+	})
+	t.Run("Unlabelled", func(t *testing.T) {
+		wfeb, err := server.CreateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingCreateRequest{
+			Namespace: "my-ns",
+			Binding:   &wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "foo"}},
+		})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wfeb.Labels, common.LabelKeyControllerInstanceID)
+			assert.Contains(t, wfeb.Labels, common.LabelKeyCreator)
+		}
+	})
+}
+
+func TestWorkflowEventBindingServer_GetWorkflowEventBinding(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	t.Run("Labelled", func(t *testing.T) {
+		wfeb, err := server.GetWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingGetRequest{Name: "my-wfeb-1", Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-wfeb-1", wfeb.Name)
+		}
+	})
+	t.Run("Unlabelled", func(t *testing.T) {
+		_, err := server.GetWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingGetRequest{Name: "unlabelled", Namespace: "my-ns"})
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkflowEventBindingServer_ListWorkflowEventBindings(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	list, err := server.ListWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingListRequest{Namespace: "my-ns"})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.Items, 3)
+	}
+	list, err = server.ListWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingListRequest{Namespace: "other-ns"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.Items)
+	}
+}
+
+func TestWorkflowEventBindingServer_UpdateWorkflowEventBinding(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	t.Run("Labelled", func(t *testing.T) {
+		wfeb, err := server.UpdateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingUpdateRequest{
+			Namespace: "my-ns",
+			Binding:   newBinding("my-wfeb-2", "true"),
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "true", wfeb.Spec.Event.Selector)
+		}
+	})
+	t.Run("NameFromURL", func(t *testing.T) {
+		binding := newBinding("", "true")
+		wfeb, err := server.UpdateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingUpdateRequest{
+			Name:      "my-wfeb-2",
+			Namespace: "my-ns",
+			Binding:   binding,
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-wfeb-2", wfeb.Name)
+		}
+	})
+	t.Run("NameMismatch", func(t *testing.T) {
+		_, err := server.UpdateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingUpdateRequest{
+			Name:      "my-wfeb-1",
+			Namespace: "my-ns",
+			Binding:   newBinding("my-wfeb-2", "true"),
+		})
+		assert.Error(t, err)
+	})
+	t.Run("Unlabelled", func(t *testing.T) {
+		_, err := server.UpdateWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingUpdateRequest{
+			Namespace: "my-ns",
+			Binding:   &wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "my-ns"}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkflowEventBindingServer_DeleteWorkflowEventBinding(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	t.Run("Labelled", func(t *testing.T) {
+		_, err := server.DeleteWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingDeleteRequest{Name: "my-wfeb-1", Namespace: "my-ns"})
+		assert.NoError(t, err)
+	})
+	t.Run("Unlabelled", func(t *testing.T) {
+		_, err := server.DeleteWorkflowEventBinding(ctx, &workfloweventbindingpkg.WorkflowEventBindingDeleteRequest{Name: "unlabelled", Namespace: "my-ns"})
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkflowEventBindingServer_TestWorkflowEventBindings(t *testing.T) {
+	server, ctx := getWorkflowEventBindingServer()
+	rsp, err := server.TestWorkflowEventBindings(ctx, &workfloweventbindingpkg.WorkflowEventBindingTestRequest{
+		Namespace: "my-ns",
+		Payload:   &wfv1.Item{Value: []byte(`{"message": "hello"}`)},
+	})
+	if assert.NoError(t, err) && assert.Len(t, rsp.Results, 3) {
+		results := make(map[string]*workfloweventbindingpkg.WorkflowEventBindingTestResult)
+		for _, result := range rsp.Results {
+			results[result.Name] = result
+		}
+		if result := results["my-wfeb-1"]; assert.NotNil(t, result) {
+			assert.True(t, result.Matched)
+			assert.Empty(t, result.Error)
+			assert.Equal(t, "my-wft", result.WorkflowTemplateRef.Name)
+			if assert.NotNil(t, result.Arguments) && assert.Len(t, result.Arguments.Parameters, 1) {
+				assert.Equal(t, "hello", result.Arguments.Parameters[0].Value.String())
+			}
+		}
+		if result := results["my-wfeb-2"]; assert.NotNil(t, result) {
+			assert.False(t, result.Matched)
+			assert.Nil(t, result.Arguments)
+		}
+		if result := results["malformed"]; assert.NotNil(t, result) {
+			assert.False(t, result.Matched)
+			assert.Equal(t, "malformed workflow template expression: did not evaluate to boolean", result.Error)
+		}
+	}
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("my-ns").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, list.Items, "no workflows are submitted")
+	}
+}
@@ -1191,12 +1191,11 @@ spec:
 		})
 	}
 
-	s.Run("ListWithLimitAndOffset", func() {
+	s.Run("ListWithLimitAndContinue", func() {
 		j := s.e().GET("/api/v1/archived-workflows").
 			WithQuery("listOptions.labelSelector", "argo-e2e").
 			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
 			WithQuery("listOptions.limit", 1).
-			WithQuery("listOptions.offset", 1).
 			Expect().
 			Status(200).
 			JSON()
@@ -1205,9 +1204,54 @@ spec:
 			Array().
 			Length().
 			Equal(1)
-		j.
+		continueToken := j.
 			Path("$.metadata.continue").
-			Equal("1")
+			String().
+			NotEmpty().
+			Raw()
+		j = s.e().GET("/api/v1/archived-workflows").
+			WithQuery("listOptions.labelSelector", "argo-e2e").
+			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
+			WithQuery("listOptions.limit", 1).
+			WithQuery("listOptions.continue", continueToken).
+			Expect().
+			Status(200).
+			JSON()
+		j.
+			Path("$.items").
+			Array().
+			Length().
+			Equal(1)
+		j.
+			Path("$.metadata").
+			Object().
+			NotContainsKey("continue")
+	})
+
+	s.Run("ListWithPhases", func() {
+		s.e().GET("/api/v1/archived-workflows").
+			WithQuery("listOptions.labelSelector", "argo-e2e").
+			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
+			WithQuery("phases", "Error").
+			Expect().
+			Status(200).
+			JSON().
+			Path("$.items").
+			Null()
+	})
+
+	s.Run("ListWithNamePrefix", func() {
+		s.e().GET("/api/v1/archived-workflows").
+			WithQuery("listOptions.labelSelector", "argo-e2e").
+			WithQuery("listOptions.fieldSelector", "metadata.namespace=argo").
+			WithQuery("namePrefix", "arch").
+			Expect().
+			Status(200).
+			JSON().
+			Path("$.items").
+			Array().
+			Length().
+			Equal(1)
 	})
 
 	s.Run("ListWithMinStartedAtGood", func() {
@@ -139,7 +139,7 @@ export class CronWorkflowList extends BasePage<RouteComponentProps<any>, State>
                             </div>
                             <div className='columns small-3'>{w.metadata.name}</div>
                             <div className='columns small-3'>{w.metadata.namespace}</div>
-                            <div className='columns small-2'>{w.spec.schedule}</div>
+                            <div className='columns small-2'>{models.getCronWorkflowSchedules(w).join(', ')}</div>
                             <div className='columns small-3'>
                                 <Timestamp date={w.metadata.creationTimestamp} />
                             </div>
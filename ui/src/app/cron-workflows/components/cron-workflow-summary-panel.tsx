@@ -1,7 +1,7 @@
 import * as React from 'react';
 
 import * as kubernetes from 'argo-ui/src/models/kubernetes';
-import {CronWorkflow} from '../../../models';
+import {CronWorkflow, getCronWorkflowSchedules} from '../../../models';
 import {ResourceEditor} from '../../shared/components/resource-editor/resource-editor';
 import {Timestamp} from '../../shared/components/timestamp';
 import {ConditionsPanel} from '../../shared/conditions-panel';
@@ -20,7 +20,8 @@ export const CronWorkflowSummaryPanel = (props: Props) => {
     const specAttributes = [
         {title: 'Name', value: props.cronWorkflow.metadata.name},
         {title: 'Namespace', value: props.cronWorkflow.metadata.namespace},
-        {title: 'Schedule', value: props.cronWorkflow.spec.schedule},
+        {title: 'Schedule', value: getCronWorkflowSchedules(props.cronWorkflow).join(', ')},
+        {title: 'Exclusions', value: (props.cronWorkflow.spec.exclusions || []).join(', ')},
         {title: 'Timezone', value: props.cronWorkflow.spec.timezone},
         {
             title: 'Concurrency Policy',
@@ -35,7 +36,7 @@ export const CronWorkflowSummaryPanel = (props: Props) => {
     const statusAttributes = [
         {title: 'Active', value: props.cronWorkflow.status.active ? getCronWorkflowActiveWorkflowList(props.cronWorkflow.status.active) : <i>No Workflows Active</i>},
         {title: 'Last Scheduled Time', value: props.cronWorkflow.status.lastScheduledTime},
-        {title: 'Next Scheduled Time', value: getNextScheduledTime(props.cronWorkflow.spec.schedule, props.cronWorkflow.spec.timezone)},
+        {title: 'Next Scheduled Time', value: getNextScheduledTime(getCronWorkflowSchedules(props.cronWorkflow), props.cronWorkflow.spec.timezone)},
         {title: 'Conditions', value: <ConditionsPanel conditions={props.cronWorkflow.status.conditions} />}
     ];
     return (
@@ -88,15 +89,21 @@ function getCronWorkflowActiveWorkflowList(active: kubernetes.ObjectReference[])
     return active.reverse().map(activeWf => <WorkflowLink key={activeWf.uid} namespace={activeWf.namespace} name={activeWf.name} />);
 }
 
-function getNextScheduledTime(schedule: string, tz: string): string {
+// getNextScheduledTime returns the earliest next time of the schedules, exclusions are not taken into account
+function getNextScheduledTime(schedules: string[], tz: string): string {
     let out = '';
-    try {
-        out = parser
-            .parseExpression(schedule, {tz})
-            .next()
-            .toISOString();
-    } catch (e) {
-        // Do nothing
-    }
+    schedules.forEach(schedule => {
+        try {
+            const next = parser
+                .parseExpression(schedule, {tz})
+                .next()
+                .toISOString();
+            if (!out || next < out) {
+                out = next;
+            }
+        } catch (e) {
+            // Do nothing
+        }
+    });
     return out;
 }
@@ -27,6 +27,9 @@ export class WorkflowDagRenderOptionsPanel extends React.Component<WorkflowDagRe
                 {value: 'type:Retry', label: 'Retry'},
                 {value: 'type:Skipped', label: 'Skipped'},
                 {value: 'type:Suspend', label: 'Suspend'},
+                {value: 'type:HTTP', label: 'HTTP'},
+                {value: 'type:Container', label: 'Container'},
+                {value: 'type:Workflow', label: 'Workflow'},
                 {value: 'type:TaskGroup', label: 'TaskGroup'},
                 {value: 'type:StepGroup', label: 'StepGroup'}
             ],
@@ -280,7 +280,10 @@ export class WorkflowDag extends React.Component<WorkflowDagProps, WorkflowDagRe
                 'type:DAG',
                 'type:Retry',
                 'type:Skipped',
-                'type:Suspend'
+                'type:Suspend',
+                'type:HTTP',
+                'type:Container',
+                'type:Workflow'
             ]
         } as WorkflowDagRenderOptions;
     }
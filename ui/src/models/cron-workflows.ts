@@ -12,7 +12,9 @@ export interface CronWorkflow {
 export interface CronWorkflowSpec {
     workflowSpec: WorkflowSpec;
     workflowMetadata?: kubernetes.ObjectMeta;
-    schedule: string;
+    schedule?: string;
+    schedules?: string[];
+    exclusions?: string[];
     concurrencyPolicy?: string;
     suspend?: boolean;
     startingDeadlineSeconds?: number;
@@ -21,6 +23,10 @@ export interface CronWorkflowSpec {
     timezone?: string;
 }
 
+export function getCronWorkflowSchedules(cronWorkflow: CronWorkflow): string[] {
+    return [cronWorkflow.spec.schedule, ...(cronWorkflow.spec.schedules || [])].filter(schedule => !!schedule);
+}
+
 export interface CronWorkflowStatus {
     active: kubernetes.ObjectReference[];
     lastScheduledTime: kubernetes.Time;
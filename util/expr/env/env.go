@@ -0,0 +1,207 @@
+package env
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Expand turns a map of dotted variable names, such as "inputs.parameters.n", into nested maps, so that the variables
+// can be referenced in an expression as they would be in a tag. The values are usually strings, but may be any value,
+// e.g. the artifacts of a template's scope. When a name is both a variable and the prefix of other
+// variables (e.g. "inputs.parameters" is the JSON list of all the parameters), the nested variables take precedence.
+func Expand(m map[string]interface{}) map[string]interface{} {
+	env := make(map[string]interface{})
+	for key, value := range m {
+		parts := strings.Split(key, ".")
+		parent := env
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := parent[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[part] = child
+			}
+			parent = child
+		}
+		last := parts[len(parts)-1]
+		if _, ok := parent[last].(map[string]interface{}); !ok {
+			parent[last] = value
+		}
+	}
+	return env
+}
+
+// GetFuncMap returns an expression environment made from the variables, with the helper functions added
+func GetFuncMap(m map[string]interface{}) map[string]interface{} {
+	env := Expand(m)
+	for name, f := range funcMap {
+		env[name] = f
+	}
+	return env
+}
+
+// funcMap are the helper functions available in expressions. The string functions are named after, and take their
+// arguments in the same order as, the Sprig functions (http://masterminds.github.io/sprig/strings.html). Names which
+// are operators in the expression language (e.g. contains) are omitted.
+var funcMap = map[string]interface{}{
+	// type conversion
+	"asInt":    asInt,
+	"asFloat":  asFloat,
+	"string":   toString,
+	"toJson":   toJSON,
+	"jsonpath": jsonPath,
+	// strings
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"title":      strings.Title,
+	"trim":       strings.TrimSpace,
+	"trimAll":    func(cutset, s string) string { return strings.Trim(s, cutset) },
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"repeat":     repeat,
+	"substr":     substr,
+	"splitList":  func(sep, s string) []string { return strings.Split(s, sep) },
+	"join":       join,
+	"default":    defaultValue,
+	"b64enc":     func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"b64dec":     b64dec,
+}
+
+func asInt(v interface{}) int64 {
+	switch x := v.(type) {
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("cannot convert %q to int: %w", x, err))
+		}
+		return i
+	case float32:
+		return int64(x)
+	case float64:
+		return int64(x)
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint())
+	}
+	panic(fmt.Errorf("cannot convert %v to int", v))
+}
+
+func asFloat(v interface{}) float64 {
+	switch x := v.(type) {
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		if err != nil {
+			panic(fmt.Errorf("cannot convert %q to float: %w", x, err))
+		}
+		return f
+	case float32:
+		return float64(x)
+	case float64:
+		return x
+	}
+	return float64(asInt(v))
+}
+
+func toString(v interface{}) string {
+	if v == nil {
+		panic(fmt.Errorf("cannot convert nil to string"))
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+// jsonPath returns the first value at the JSONPath (e.g. "$.items[0].name") in the JSON document
+func jsonPath(jsonStr, path string) interface{} {
+	var data interface{}
+	err := json.Unmarshal([]byte(jsonStr), &data)
+	if err != nil {
+		panic(fmt.Errorf("cannot parse JSON: %w", err))
+	}
+	j := jsonpath.New("")
+	err = j.Parse("{" + path + "}")
+	if err != nil {
+		panic(err)
+	}
+	results, err := j.FindResults(data)
+	if err != nil {
+		panic(err)
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return nil
+	}
+	return results[0][0].Interface()
+}
+
+// maxRepeatSize is the largest string repeat may return, so that an expression cannot exhaust the memory of the
+// controller
+const maxRepeatSize = 256 * 1024
+
+func repeat(count int, s string) string {
+	if count < 0 {
+		panic(fmt.Errorf("cannot repeat a string %d times", count))
+	}
+	if len(s) > 0 && count > maxRepeatSize/len(s) {
+		panic(fmt.Errorf("cannot repeat a string of %d bytes %d times, the result must be at most %d bytes", len(s), count, maxRepeatSize))
+	}
+	return strings.Repeat(s, count)
+}
+
+func substr(start, end int, s string) string {
+	if start < 0 {
+		start = 0
+	}
+	if end < 0 || end > len(s) {
+		end = len(s)
+	}
+	if start > end {
+		return ""
+	}
+	return s[start:end]
+}
+
+func join(sep string, v interface{}) string {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return toString(v)
+	}
+	items := make([]string, value.Len())
+	for i := range items {
+		items[i] = toString(value.Index(i).Interface())
+	}
+	return strings.Join(items, sep)
+}
+
+func defaultValue(def, v interface{}) interface{} {
+	if v == nil || v == "" {
+		return def
+	}
+	return v
+}
+
+func b64dec(s string) string {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,59 @@
+package env
+
+import (
+	"testing"
+
+	"github.com/antonmedv/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	env := Expand(map[string]interface{}{
+		"inputs.parameters":   `[{"name": "x"}]`,
+		"inputs.parameters.x": "1",
+		"workflow.name":       "my-wf",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"inputs":   map[string]interface{}{"parameters": map[string]interface{}{"x": "1"}},
+		"workflow": map[string]interface{}{"name": "my-wf"},
+	}, env)
+}
+
+func TestGetFuncMap(t *testing.T) {
+	env := GetFuncMap(map[string]interface{}{
+		"inputs.parameters.n":    "3",
+		"inputs.parameters.f":    "1.5",
+		"steps.a.outputs.result": `{"items": [{"name": "foo"}, {"name": "bar"}]}`,
+	})
+	for expression, expected := range map[string]interface{}{
+		"asInt(inputs.parameters.n) * 2":                         int64(6),
+		"asFloat(inputs.parameters.f) * 2":                       3.0,
+		"string(asInt(inputs.parameters.n))":                     "3",
+		"jsonpath(steps.a.outputs.result, '$.items[1].name')":    "bar",
+		"toJson(jsonpath(steps.a.outputs.result, '$.items[0]'))": `{"name":"foo"}`,
+		"upper(trim(' foo '))":                                   "FOO",
+		"trimPrefix('my-', 'my-wf')":                             "wf",
+		"replace('-', '_', 'my-wf')":                             "my_wf",
+		"substr(0, 2, 'foo')":                                    "fo",
+		"repeat(3, 'ab')":                                        "ababab",
+		"join(',', splitList('-', 'a-b-c'))":                     "a,b,c",
+		"default('x', '')":                                       "x",
+		"b64dec(b64enc('foo'))":                                  "foo",
+		"inputs.parameters.n == '3' ? 'three' : 'other'":         "three",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			result, err := expr.Eval(expression, env)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, result)
+			}
+		})
+	}
+	t.Run("Error", func(t *testing.T) {
+		_, err := expr.Eval("asInt('foo')", env)
+		assert.Error(t, err)
+	})
+	t.Run("RepeatTooLarge", func(t *testing.T) {
+		_, err := expr.Eval("repeat(1000000, 'foo')", env)
+		assert.Error(t, err)
+	})
+}
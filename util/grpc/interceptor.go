@@ -3,11 +3,16 @@ package grpc
 import (
 	"runtime/debug"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo/util/tracing"
 )
 
 // PanicLoggerUnaryServerInterceptor returns a new unary server interceptor for recovering from panics and returning error
@@ -45,3 +50,40 @@ var (
 		return TranslateError(handler(srv, ss))
 	}
 )
+
+// TracingUnaryServerInterceptor returns a new unary server interceptor which records a span for each request, as part
+// of the trace of the caller if the request has a traceparent
+func TracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer func() {
+			tracing.RecordError(span, err)
+			span.End()
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// TracingStreamServerInterceptor returns a new streaming server interceptor which records a span for each request, as
+// part of the trace of the caller if the request has a traceparent
+func TracingStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, span := startServerSpan(stream.Context(), info.FullMethod)
+		defer func() {
+			tracing.RecordError(span, err)
+			span.End()
+		}()
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("traceparent"); len(values) > 0 {
+			ctx = tracing.Extract(ctx, values[0])
+		}
+	}
+	return tracing.Tracer().Start(ctx, fullMethod, trace.WithSpanKind(trace.SpanKindServer))
+}
@@ -0,0 +1,150 @@
+// Package tracing exports OpenTelemetry traces of the controller, executor and server over OTLP. Tracing is disabled,
+// and spans are not recorded, unless the OTEL_EXPORTER_OTLP_ENDPOINT environment variable is set.
+package tracing
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvVarOTLPEndpoint is the endpoint of the OTLP collector, e.g. http://localhost:4317
+	EnvVarOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	instrumentationName = "github.com/argoproj/argo"
+	traceParentKey      = "traceparent"
+)
+
+// propagator passes the trace context in the W3C Trace Context format
+var propagator = propagation.TraceContext{}
+
+// enabled is whether the traces are exported
+var enabled bool
+
+var ids = newIDGenerator()
+
+// Init starts exporting the traces of the service, if the OTLP endpoint is set. The returned function flushes the
+// spans which have not been exported yet, and must be called before the process exits.
+func Init(serviceName string) (func(), error) {
+	if os.Getenv(EnvVarOTLPEndpoint) == "" {
+		return func() {}, nil
+	}
+	exporter, err := otlptracegrpc.New(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithIDGenerator(ids),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	enabled = true
+	log.WithFields(log.Fields{"serviceName": serviceName, "endpoint": os.Getenv(EnvVarOTLPEndpoint)}).Info("Exporting traces")
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			log.WithError(err).Warn("Failed to flush traces")
+		}
+	}, nil
+}
+
+// Tracer returns the tracer to start spans with
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Inject returns the trace context of the context, as a W3C traceparent, to be passed to another process in an
+// annotation. It returns "" if the context has no trace, e.g. when tracing is disabled.
+func Inject(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get(traceParentKey)
+}
+
+// Extract returns the context with the trace context of a W3C traceparent, so that the spans started with it are part
+// of the trace
+func Extract(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{traceParentKey: traceParent})
+}
+
+// RecordError marks the span as failed with the error, if there is one
+func RecordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// NewSpanContext returns the context of a span which is not started yet, e.g. a span which lasts longer than the
+// process, so that other spans can be started as its children before it is recorded with StartSpanWithContext. It
+// returns an invalid span context if tracing is disabled.
+func NewSpanContext() trace.SpanContext {
+	if !enabled {
+		return trace.SpanContext{}
+	}
+	traceID, spanID := ids.NewIDs(context.Background())
+	return trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+}
+
+// StartSpanWithContext starts a root span with the trace and span IDs of the span context returned by NewSpanContext
+func StartSpanWithContext(spanCtx trace.SpanContext, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(context.WithValue(context.Background(), spanContextKey{}, spanCtx), name, opts...)
+}
+
+type spanContextKey struct{}
+
+// idGenerator generates random IDs, unless a root span is started by StartSpanWithContext, in which case it returns
+// the IDs of its span context
+type idGenerator struct {
+	sync.Mutex
+	random *rand.Rand
+}
+
+func newIDGenerator() *idGenerator {
+	var seed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &seed)
+	return &idGenerator{random: rand.New(rand.NewSource(seed))}
+}
+
+func (g *idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if spanCtx, ok := ctx.Value(spanContextKey{}).(trace.SpanContext); ok && spanCtx.IsValid() {
+		return spanCtx.TraceID(), spanCtx.SpanID()
+	}
+	g.Lock()
+	defer g.Unlock()
+	traceID := trace.TraceID{}
+	_, _ = g.random.Read(traceID[:])
+	spanID := trace.SpanID{}
+	_, _ = g.random.Read(spanID[:])
+	return traceID, spanID
+}
+
+func (g *idGenerator) NewSpanID(_ context.Context, _ trace.TraceID) trace.SpanID {
+	g.Lock()
+	defer g.Unlock()
+	spanID := trace.SpanID{}
+	_, _ = g.random.Read(spanID[:])
+	return spanID
+}
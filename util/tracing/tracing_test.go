@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectExtract(t *testing.T) {
+	t.Run("NoTrace", func(t *testing.T) {
+		assert.Empty(t, Inject(context.Background()))
+		assert.Equal(t, context.Background(), Extract(context.Background(), ""))
+	})
+	t.Run("Trace", func(t *testing.T) {
+		traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		ctx := Extract(context.Background(), traceParent)
+		spanCtx := trace.SpanContextFromContext(ctx)
+		assert.True(t, spanCtx.IsRemote())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanCtx.TraceID().String())
+		assert.Equal(t, traceParent, Inject(ctx))
+	})
+}
+
+func TestNewSpanContext(t *testing.T) {
+	assert.False(t, NewSpanContext().IsValid())
+}
+
+func TestIDGenerator(t *testing.T) {
+	g := newIDGenerator()
+	traceID, spanID := g.NewIDs(context.Background())
+	assert.True(t, traceID.IsValid())
+	assert.True(t, spanID.IsValid())
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+	fixedTraceID, fixedSpanID := g.NewIDs(context.WithValue(context.Background(), spanContextKey{}, spanCtx))
+	assert.Equal(t, traceID, fixedTraceID)
+	assert.Equal(t, spanID, fixedSpanID)
+	assert.NotEqual(t, spanID, g.NewSpanID(context.Background(), traceID))
+}
@@ -1,13 +1,16 @@
 package executor
 
 import (
+	"context"
 	"fmt"
+	"io"
 
 	"github.com/argoproj/argo/workflow/artifacts/gcs"
 	"github.com/argoproj/argo/workflow/artifacts/oss"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/workflow/artifacts/artifactory"
+	"github.com/argoproj/argo/workflow/artifacts/azure"
 	"github.com/argoproj/argo/workflow/artifacts/git"
 	"github.com/argoproj/argo/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo/workflow/artifacts/http"
@@ -25,6 +28,13 @@ type ArtifactDriver interface {
 	Save(path string, outputArtifact *wfv1.Artifact) error
 }
 
+// ArtifactStreamer is implemented by the artifact drivers which can open an artifact for reading, so that it can be
+// streamed without first being loaded into a file
+type ArtifactStreamer interface {
+	// OpenStream opens the artifact for reading, the caller must close it. Reading stops once the context is done.
+	OpenStream(ctx context.Context, a *wfv1.Artifact) (io.ReadCloser, error)
+}
+
 var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
 
 // NewDriver initializes an instance of an artifact driver
@@ -150,5 +160,27 @@ func NewDriver(art *wfv1.Artifact, ri resource.Interface) (ArtifactDriver, error
 		return &driver, nil
 	}
 
+	if art.Azure != nil {
+		driver := azure.ArtifactDriver{
+			Endpoint:  art.Azure.Endpoint,
+			Container: art.Azure.Container,
+		}
+		if art.Azure.AccountKeySecret != nil {
+			accountKey, err := ri.GetSecret(art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.AccountKey = accountKey
+		}
+		if art.Azure.SASTokenSecret != nil {
+			sasToken, err := ri.GetSecret(art.Azure.SASTokenSecret.Name, art.Azure.SASTokenSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SASToken = sasToken
+		}
+		return &driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
@@ -0,0 +1,242 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/pkg/file"
+
+	argoerrs "github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	errorsutil "github.com/argoproj/argo/util/errors"
+)
+
+// ArtifactDriver is a driver for Azure Blob Storage
+type ArtifactDriver struct {
+	Endpoint   string
+	Container  string
+	AccountKey string
+	SASToken   string
+}
+
+// accountName returns the storage account name from the endpoint. Azure uses the first label of the host
+// (e.g. https://myaccount.blob.core.windows.net), whereas Azurite uses the first segment of the path
+// (e.g. http://127.0.0.1:10000/devstoreaccount1).
+func accountName(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint %s: %w", endpoint, err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("failed to determine account name from endpoint %s", endpoint)
+	}
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		return strings.Split(p, "/")[0], nil
+	}
+	return strings.Split(u.Hostname(), ".")[0], nil
+}
+
+func (azblobDriver *ArtifactDriver) newContainerURL() (azblob.ContainerURL, error) {
+	u, err := url.Parse(strings.TrimSuffix(azblobDriver.Endpoint, "/") + "/" + azblobDriver.Container)
+	if err != nil {
+		return azblob.ContainerURL{}, fmt.Errorf("failed to parse container url: %w", err)
+	}
+	var credential azblob.Credential
+	if azblobDriver.AccountKey != "" {
+		name, err := accountName(azblobDriver.Endpoint)
+		if err != nil {
+			return azblob.ContainerURL{}, err
+		}
+		credential, err = azblob.NewSharedKeyCredential(name, azblobDriver.AccountKey)
+		if err != nil {
+			return azblob.ContainerURL{}, fmt.Errorf("failed to create shared key credential: %w", err)
+		}
+	} else {
+		// the SAS token is appended to every request, so no further credential is needed
+		u.RawQuery = strings.TrimPrefix(azblobDriver.SASToken, "?")
+		credential = azblob.NewAnonymousCredential()
+	}
+	return azblob.NewContainerURL(*u, azblob.NewPipeline(credential, azblob.PipelineOptions{})), nil
+}
+
+func isNotFound(err error) bool {
+	var storageErr azblob.StorageError
+	if errors.As(err, &storageErr) {
+		return storageErr.ServiceCode() == azblob.ServiceCodeBlobNotFound
+	}
+	return false
+}
+
+// isTransientErr returns whether the request may succeed if retried: server errors, throttling and network errors are
+// transient, whereas any other response of the storage (e.g. an authentication failure) is not
+func isTransientErr(err error) bool {
+	var storageErr azblob.StorageError
+	if errors.As(err, &storageErr) {
+		if resp := storageErr.Response(); resp != nil {
+			return resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests
+		}
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && (netErr.Temporary() || netErr.Timeout()) {
+		return true
+	}
+	return errorsutil.IsTransientErr(err)
+}
+
+// Load downloads a blob, or all the blobs of a directory, from Azure Blob Storage
+func (azblobDriver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	var lastErr error
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Blob Load path: %s, blob: %s", path, inputArtifact.Azure.Blob)
+			containerURL, err := azblobDriver.newContainerURL()
+			if err != nil {
+				return false, err
+			}
+			err = downloadBlob(containerURL, inputArtifact.Azure.Blob, path)
+			if isNotFound(err) {
+				err = downloadBlobs(containerURL, inputArtifact.Azure.Blob, path)
+			}
+			if err != nil {
+				if isTransientErr(err) {
+					log.Warnf("Transient error downloading blob from Azure: %v", err)
+					lastErr = err
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		})
+	if err == wait.ErrWaitTimeout && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+// downloadBlobs downloads all the blobs prefixed by the directory into the local path
+func downloadBlobs(containerURL azblob.ContainerURL, dir, localPath string) error {
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	blobNames, err := listByPrefix(containerURL, prefix)
+	if err != nil {
+		return err
+	}
+	if len(blobNames) == 0 {
+		return argoerrs.Errorf(argoerrs.CodeNotFound, "blob %s not found", dir)
+	}
+	for _, blobName := range blobNames {
+		err := downloadBlob(containerURL, blobName, filepath.Join(localPath, strings.TrimPrefix(blobName, prefix)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downloadBlob(containerURL azblob.ContainerURL, blobName, localPath string) error {
+	ctx := context.Background()
+	blobURL := containerURL.NewBlobURL(blobName)
+	// check the blob exists before we create any local files
+	if _, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{}); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(localPath), 0700); err != nil {
+		return fmt.Errorf("mkdir %s: %w", filepath.Dir(localPath), err)
+	}
+	f, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("os create %s: %w", localPath, err)
+	}
+	defer func() { _ = f.Close() }()
+	return azblob.DownloadBlobToFile(ctx, blobURL, 0, azblob.CountToEnd, f, azblob.DownloadFromBlobOptions{})
+}
+
+// listByPrefix lists the names of all the blobs with the prefix
+func listByPrefix(containerURL azblob.ContainerURL, prefix string) ([]string, error) {
+	ctx := context.Background()
+	var blobNames []string
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		resp, err := containerURL.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{Prefix: prefix})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list blobs: %w", err)
+		}
+		for _, item := range resp.Segment.BlobItems {
+			blobNames = append(blobNames, item.Name)
+		}
+		marker = resp.NextMarker
+	}
+	return blobNames, nil
+}
+
+// Save uploads a file, or all the files of a directory, to Azure Blob Storage
+func (azblobDriver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	var lastErr error
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Blob Save path: %s, blob: %s", path, outputArtifact.Azure.Blob)
+			containerURL, err := azblobDriver.newContainerURL()
+			if err != nil {
+				return false, err
+			}
+			isDir, err := file.IsDirectory(path)
+			if err != nil {
+				return false, fmt.Errorf("test if %s is a dir: %w", path, err)
+			}
+			if isDir {
+				err = uploadDir(containerURL, path, outputArtifact.Azure.Blob)
+			} else {
+				err = uploadFile(containerURL, path, outputArtifact.Azure.Blob)
+			}
+			if err != nil {
+				if isTransientErr(err) {
+					log.Warnf("Transient error uploading to Azure: %v", err)
+					lastErr = err
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		})
+	if err == wait.ErrWaitTimeout && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+func uploadDir(containerURL azblob.ContainerURL, dir, blobPrefix string) error {
+	return filepath.Walk(dir, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, localPath)
+		if err != nil {
+			return err
+		}
+		return uploadFile(containerURL, localPath, path.Join(blobPrefix, filepath.ToSlash(relPath)))
+	})
+}
+
+func uploadFile(containerURL azblob.ContainerURL, localPath, blobName string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("os open: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	_, err = azblob.UploadFileToBlockBlob(context.Background(), f, containerURL.NewBlockBlobURL(blobName), azblob.UploadToBlockBlobOptions{})
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", localPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	argoerrs "github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func TestAccountName(t *testing.T) {
+	for endpoint, want := range map[string]string{
+		"https://myaccount.blob.core.windows.net":   "myaccount",
+		"https://myaccount.blob.core.windows.net/":  "myaccount",
+		"http://127.0.0.1:10000/devstoreaccount1":   "devstoreaccount1",
+		"http://azurite:10000/devstoreaccount1/foo": "devstoreaccount1",
+	} {
+		t.Run(endpoint, func(t *testing.T) {
+			got, err := accountName(endpoint)
+			if assert.NoError(t, err) {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+	_, err := accountName("not-a-url")
+	assert.Error(t, err)
+}
+
+// fakeBlobStorage serves the subset of the Blob service REST API used by the driver
+type fakeBlobStorage struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+	// errorCode, if set, is returned with a 403 for every request
+	errorCode string
+}
+
+func (s *fakeBlobStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.errorCode != "" {
+		w.Header().Set("x-ms-error-code", s.errorCode)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/devstoreaccount1/my-container")
+	name = strings.TrimPrefix(name, "/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list":
+		prefix := r.URL.Query().Get("prefix")
+		var names []string
+		for n := range s.blobs {
+			if strings.HasPrefix(n, prefix) {
+				names = append(names, n)
+			}
+		}
+		sort.Strings(names)
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults ContainerName="my-container"><Blobs>`)
+		for _, n := range names {
+			_, _ = fmt.Fprintf(w, "<Blob><Name>%s</Name></Blob>", n)
+		}
+		_, _ = fmt.Fprint(w, "</Blobs><NextMarker /></EnumerationResults>")
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := s.blobs[name]
+		if !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		if r.Method == http.MethodHead {
+			return
+		}
+		var start, end int
+		if _, err := fmt.Sscanf(r.Header.Get("x-ms-range"), "bytes=%d-%d", &start, &end); err == nil {
+			data = data[start : end+1]
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		_, _ = w.Write(data)
+	case r.Method == http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestDriver(storage *fakeBlobStorage) (*ArtifactDriver, func()) {
+	server := httptest.NewServer(storage)
+	return &ArtifactDriver{Endpoint: server.URL + "/devstoreaccount1", Container: "my-container", SASToken: "sig=my-sig"}, server.Close
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "azure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() { _ = os.RemoveAll(dir) }
+}
+
+func azureArtifact(blob string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{Blob: blob}}}
+}
+
+func TestArtifactDriver_Load(t *testing.T) {
+	storage := &fakeBlobStorage{blobs: map[string][]byte{
+		"my-file":       []byte("my-content"),
+		"my-dir/a":      []byte("a"),
+		"my-dir/sub/b":  []byte("b"),
+		"my-dir-suffix": []byte("not in the dir"),
+	}}
+	driver, closeServer := newTestDriver(storage)
+	defer closeServer()
+	t.Run("File", func(t *testing.T) {
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		path := filepath.Join(dir, "my-file")
+		if assert.NoError(t, driver.Load(azureArtifact("my-file"), path)) {
+			data, err := ioutil.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, "my-content", string(data))
+		}
+	})
+	t.Run("Directory", func(t *testing.T) {
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		if assert.NoError(t, driver.Load(azureArtifact("my-dir"), dir)) {
+			data, err := ioutil.ReadFile(filepath.Join(dir, "a"))
+			assert.NoError(t, err)
+			assert.Equal(t, "a", string(data))
+			data, err = ioutil.ReadFile(filepath.Join(dir, "sub", "b"))
+			assert.NoError(t, err)
+			assert.Equal(t, "b", string(data))
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		err := driver.Load(azureArtifact("not-found"), filepath.Join(dir, "not-found"))
+		assert.True(t, argoerrs.IsCode(argoerrs.CodeNotFound, err), "%v", err)
+	})
+	t.Run("Forbidden", func(t *testing.T) {
+		driver, closeServer := newTestDriver(&fakeBlobStorage{errorCode: "AuthenticationFailed"})
+		defer closeServer()
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		err := driver.Load(azureArtifact("my-file"), filepath.Join(dir, "my-file"))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "AuthenticationFailed")
+		}
+	})
+}
+
+func TestArtifactDriver_Save(t *testing.T) {
+	storage := &fakeBlobStorage{blobs: map[string][]byte{}}
+	driver, closeServer := newTestDriver(storage)
+	defer closeServer()
+	t.Run("File", func(t *testing.T) {
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		path := filepath.Join(dir, "my-file")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("my-content"), 0600))
+		if assert.NoError(t, driver.Save(path, azureArtifact("my-file"))) {
+			assert.Equal(t, "my-content", string(storage.blobs["my-file"]))
+		}
+	})
+	t.Run("Directory", func(t *testing.T) {
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0700))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a"), []byte("a"), 0600))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "sub", "b"), []byte("b"), 0600))
+		if assert.NoError(t, driver.Save(dir, azureArtifact("my-dir"))) {
+			assert.Equal(t, "a", string(storage.blobs["my-dir/a"]))
+			assert.Equal(t, "b", string(storage.blobs["my-dir/sub/b"]))
+		}
+	})
+	t.Run("Forbidden", func(t *testing.T) {
+		driver, closeServer := newTestDriver(&fakeBlobStorage{errorCode: "AuthenticationFailed"})
+		defer closeServer()
+		dir, cleanup := tempDir(t)
+		defer cleanup()
+		path := filepath.Join(dir, "my-file")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("my-content"), 0600))
+		err := driver.Save(path, azureArtifact("my-file"))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "AuthenticationFailed")
+		}
+	})
+}
+
+func TestIsTransientErr(t *testing.T) {
+	assert.False(t, isTransientErr(nil))
+	assert.False(t, isTransientErr(fmt.Errorf("not transient")))
+	assert.False(t, isTransientErr(argoerrs.Errorf(argoerrs.CodeNotFound, "blob not found")))
+}
@@ -79,6 +79,34 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	return err
 }
 
+// OpenStream opens the object of the key for reading. Unlike Load, it cannot open keys with more than one object.
+func (g *ArtifactDriver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	gcsClient, err := g.newGCSClient()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := gcsClient.Bucket(inputArtifact.GCS.Bucket).Object(inputArtifact.GCS.Key).NewReader(ctx)
+	if err != nil {
+		_ = gcsClient.Close()
+		if err == storage.ErrObjectNotExist {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("new bucket reader: %v", err)
+	}
+	return &objectReader{Reader: rc, client: gcsClient}, nil
+}
+
+// objectReader closes the client along with the reader of the object
+type objectReader struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *objectReader) Close() error {
+	_ = r.Reader.Close()
+	return r.client.Close()
+}
+
 // download all the objects of a key from the bucket
 func downloadObjects(client *storage.Client, bucket, key, path string) error {
 	objNames, err := listByPrefix(client, bucket, key, "")
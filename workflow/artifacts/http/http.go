@@ -1,6 +1,9 @@
 package http
 
 import (
+	"context"
+	"io"
+	nethttp "net/http"
 	"os/exec"
 	"strings"
 
@@ -35,6 +38,26 @@ func (h *HTTPArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) err
 	return nil
 }
 
+// OpenStream opens the HTTP URL for reading
+func (h *HTTPArtifactDriver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, inputArtifact.HTTP.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nethttp.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		_ = resp.Body.Close()
+		if resp.StatusCode == nethttp.StatusNotFound {
+			return nil, errors.Errorf(errors.CodeNotFound, "%s not found", inputArtifact.HTTP.URL)
+		}
+		return nil, errors.InternalErrorf("failed to get %s: %s", inputArtifact.HTTP.URL, resp.Status)
+	}
+	return resp.Body, nil
+}
+
 func (h *HTTPArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "HTTP output artifacts unsupported")
 }
@@ -1,6 +1,10 @@
 package http
 
 import (
+	"context"
+	"io/ioutil"
+	nethttp "net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -38,6 +42,48 @@ func TestHTTPArtifactDriver_Load(t *testing.T) {
 	})
 }
 
+func TestHTTPArtifactDriver_OpenStream(t *testing.T) {
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path != "/found" {
+			w.WriteHeader(nethttp.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("my-content"))
+	}))
+	defer server.Close()
+	driver := &HTTPArtifactDriver{}
+	open := func(ctx context.Context, path string) (string, error) {
+		rc, err := driver.OpenStream(ctx, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + path}}})
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = rc.Close() }()
+		data, err := ioutil.ReadAll(rc)
+		return string(data), err
+	}
+	t.Run("Found", func(t *testing.T) {
+		data, err := open(context.Background(), "/found")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-content", data)
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := open(context.Background(), "/not-found")
+		if assert.Error(t, err) {
+			argoError, ok := err.(errors.ArgoError)
+			if assert.True(t, ok) {
+				assert.Equal(t, errors.CodeNotFound, argoError.Code())
+			}
+		}
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := open(ctx, "/found")
+		assert.Error(t, err)
+	})
+}
+
 func TestHTTPArtifactDriver_Save(t *testing.T) {
 	driver := &HTTPArtifactDriver{}
 	assert.Error(t, driver.Save("", nil))
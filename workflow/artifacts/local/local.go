@@ -0,0 +1,88 @@
+package local
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// ArtifactDriver is a driver which keeps artifacts on the local file system. It is used by the local workflow runner
+// in place of an object store: S3 locations are mapped to the file <RootDir>/<bucket>/<key>.
+type ArtifactDriver struct {
+	RootDir string
+}
+
+// Load copies the artifact from the local file system to path
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	src, err := d.filePath(inputArtifact)
+	if err != nil {
+		return err
+	}
+	log.Infof("Local Load path: %s, file: %s", path, src)
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return errors.Errorf(errors.CodeNotFound, "artifact %s not found at %s", inputArtifact.Name, src)
+	}
+	return copyPath(src, path)
+}
+
+// Save copies path to the local file system
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	dest, err := d.filePath(outputArtifact)
+	if err != nil {
+		return err
+	}
+	log.Infof("Local Save path: %s, file: %s", path, dest)
+	return copyPath(path, dest)
+}
+
+func (d *ArtifactDriver) filePath(art *wfv1.Artifact) (string, error) {
+	if art.S3 == nil {
+		return "", errors.Errorf(errors.CodeBadRequest, "artifact %s does not have a local location", art.Name)
+	}
+	return filepath.Join(d.RootDir, art.S3.Bucket, filepath.FromSlash(art.S3.Key)), nil
+}
+
+// copyPath copies a file, or a directory recursively, from src to dest
+func copyPath(src, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		target := filepath.Join(dest, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode()|0700)
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dest string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(dest), 0700)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = out.Close() }()
+	_, err = io.Copy(out, in)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return out.Close()
+}
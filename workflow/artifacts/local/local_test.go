@@ -0,0 +1,45 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+func TestArtifactDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	driver := &ArtifactDriver{RootDir: filepath.Join(dir, "artifacts")}
+	art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod/my-art.tgz"}}}
+
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(art, filepath.Join(dir, "not-found"))
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+	t.Run("SaveAndLoad", func(t *testing.T) {
+		src := filepath.Join(dir, "src")
+		if assert.NoError(t, ioutil.WriteFile(src, []byte("my-content"), 0644)) {
+			assert.NoError(t, driver.Save(src, art))
+			assert.FileExists(t, filepath.Join(dir, "artifacts", "my-bucket", "my-wf", "my-pod", "my-art.tgz"))
+			dest := filepath.Join(dir, "dest")
+			if assert.NoError(t, driver.Load(art, dest)) {
+				data, err := ioutil.ReadFile(dest)
+				assert.NoError(t, err)
+				assert.Equal(t, "my-content", string(data))
+			}
+		}
+	})
+	t.Run("NoLocation", func(t *testing.T) {
+		err := driver.Save(filepath.Join(dir, "src"), &wfv1.Artifact{Name: "my-art"})
+		assert.Error(t, err)
+	})
+}
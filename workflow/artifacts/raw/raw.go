@@ -1,7 +1,11 @@
 package raw
 
 import (
+	"context"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/argoproj/argo/errors"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -24,6 +28,11 @@ func (a *RawArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
 	return err
 }
 
+// OpenStream opens the raw content for reading
+func (a *RawArtifactDriver) OpenStream(_ context.Context, artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(artifact.Raw.Data)), nil
+}
+
 // Save is unsupported for raw output artifacts
 func (g *RawArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "Raw output artifacts unsupported")
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"io"
 	"os"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/argoproj/pkg/file"
 	argos3 "github.com/argoproj/pkg/s3"
+	"github.com/minio/minio-go/v7"
 
 	"github.com/argoproj/argo/errors"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -28,9 +30,9 @@ type S3ArtifactDriver struct {
 	Context     context.Context
 }
 
-// newMinioClient instantiates a new minio client object.
-func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
-	opts := argos3.S3ClientOpts{
+// clientOpts returns the options of the S3 client of the driver
+func (s3Driver *S3ArtifactDriver) clientOpts() argos3.S3ClientOpts {
+	return argos3.S3ClientOpts{
 		Endpoint:    s3Driver.Endpoint,
 		Region:      s3Driver.Region,
 		Secure:      s3Driver.Secure,
@@ -40,7 +42,28 @@ func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Cli
 		Trace:       os.Getenv(common.EnvVarArgoTrace) == "1",
 		UseSDKCreds: s3Driver.UseSDKCreds,
 	}
-	return argos3.NewS3Client(ctx, opts)
+}
+
+// newS3Client instantiates a new S3 client object.
+func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
+	return argos3.NewS3Client(ctx, s3Driver.clientOpts())
+}
+
+// newMinioClient returns a minio client, configured as the S3 client is, for what the S3 client cannot do
+func (s3Driver *S3ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	opts := s3Driver.clientOpts()
+	creds, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{Creds: creds, Secure: opts.Secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Trace {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return minioClient, nil
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -118,3 +141,26 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 		})
 	return err
 }
+
+// OpenStream opens the object of the key for reading. Unlike Load, it cannot open keys which are directories.
+func (s3Driver *S3ArtifactDriver) OpenStream(ctx context.Context, inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("S3 OpenStream key: %s", inputArtifact.S3.Key)
+	minioClient, err := s3Driver.newMinioClient()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := minioClient.GetObject(ctx, inputArtifact.S3.Bucket, inputArtifact.S3.Key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the object is only requested once it is read or stat'ed, so that a missing object is an error now, not once read
+	_, err = obj.Stat()
+	if err != nil {
+		_ = obj.Close()
+		if argos3.IsS3ErrCode(err, "NoSuchKey") {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return obj, nil
+}
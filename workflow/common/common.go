@@ -42,10 +42,19 @@ const (
 	AnnotationKeyTemplate = workflow.WorkflowFullName + "/template"
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyContainerOutputs is the pod metadata annotation key containing the outputs of each container of a
+	// container set, by container name
+	AnnotationKeyContainerOutputs = workflow.WorkflowFullName + "/container-outputs"
 	// AnnotationKeyExecutionControl is the pod metadata annotation key containing execution control parameters
 	// set by the controller and obeyed by the executor. For example, the controller will use this annotation to
 	// signal the executors of daemoned containers that it should terminate.
 	AnnotationKeyExecutionControl = workflow.WorkflowFullName + "/execution"
+	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time, in RFC3339 format,
+	// at which the workflow was scheduled by its cron workflow
+	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
+	// AnnotationKeyTraceParent is the workflow and pod metadata annotation key containing the W3C traceparent of the
+	// trace of the workflow, so that its operations and pods are part of the same trace
+	AnnotationKeyTraceParent = workflow.WorkflowFullName + "/traceparent"
 
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
@@ -71,6 +80,12 @@ const (
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
+	// LabelKeyParallelismLimit is the label, or annotation, of a namespace limiting the number of workflows of the
+	// namespace the controller runs at the same time
+	LabelKeyParallelismLimit = workflow.WorkflowFullName + "/parallelism-limit"
+	// LabelKeyPodParallelismLimit is the label, or annotation, of a namespace limiting the number of workflow pods of
+	// the namespace which are pending or running at the same time
+	LabelKeyPodParallelismLimit = workflow.WorkflowFullName + "/pod-parallelism-limit"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -89,6 +104,18 @@ const (
 	// ExecutorResourceManifestPath is the path which init will write the a manifest file to for resource templates
 	ExecutorResourceManifestPath = "/tmp/manifest.yaml"
 
+	// VarRunArgoVolumeName is the name of the emptydir shared by the init container and the containers which run their
+	// commands through the executor, i.e. the containers of a container set, or all containers with the emissary executor
+	VarRunArgoVolumeName = "var-run-argo"
+	// VarRunArgoPath is the path the var-run-argo volume is mounted at, which holds the executor binary, and the exit
+	// codes, logs and outputs of the containers
+	VarRunArgoPath = "/var/run/argo"
+	// VarRunArgoExecutorPath is the path which init will copy the executor binary to
+	VarRunArgoExecutorPath = VarRunArgoPath + "/argoexec"
+	// ContainerOmittedMessagePrefix prefixes the termination message of a container of a container set which was not
+	// run because a container it depends on did not succeed
+	ContainerOmittedMessagePrefix = "omitted"
+
 	// Various environment variables containing pod information exposed to the executor container(s)
 
 	// EnvVarPodName contains the name of the pod (currently unused)
@@ -101,6 +128,8 @@ const (
 	EnvVarKubeletPort = "ARGO_KUBELET_PORT"
 	// EnvVarKubeletInsecure is used to disable the TLS verification
 	EnvVarKubeletInsecure = "ARGO_KUBELET_INSECURE"
+	// EnvVarContainerName contains the name of the container the emissary runs the command of
+	EnvVarContainerName = "ARGO_CONTAINER_NAME"
 	// EnvVarArgoTrace is used enable tracing statements in Argo components
 	EnvVarArgoTrace = "ARGO_TRACE"
 
@@ -116,6 +145,10 @@ const (
 	// ContainerRuntimeExecutorPNS indicates to use process namespace sharing as the container runtime executor
 	ContainerRuntimeExecutorPNS = "pns"
 
+	// ContainerRuntimeExecutorEmissary indicates to run the commands of the containers through the executor binary,
+	// which records their outputs in a shared volume
+	ContainerRuntimeExecutorEmissary = "emissary"
+
 	// Variables that are added to the scope during template execution and can be referenced using {{}} syntax
 
 	// GlobalVarWorkflowName is a global workflow variable referencing the workflow's metadata.name field
@@ -138,6 +171,8 @@ const (
 	GlobalVarWorkflowDuration = "workflow.duration"
 	// GlobalVarWorkflowParameters is a JSON string containing all workflow parameters
 	GlobalVarWorkflowParameters = "workflow.parameters"
+	// GlobalVarWorkflowScheduledTime is the time, in RFC3339 format, at which a cron workflow scheduled the workflow
+	GlobalVarWorkflowScheduledTime = "workflow.scheduledTime"
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
 	// LocalVarRetries is a step level variable that references the retries number if retryStrategy is specified
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo/pkg/apis/workflow"
@@ -26,6 +28,14 @@ func ConvertCronWorkflowToWorkflow(cronWf *wfv1.CronWorkflow) *wfv1.Workflow {
 	return wf
 }
 
+// ConvertCronWorkflowToWorkflowWithScheduledTime returns the workflow of the cron workflow for the time it was
+// scheduled at, which is made available to the workflow as {{workflow.scheduledTime}}
+func ConvertCronWorkflowToWorkflowWithScheduledTime(cronWf *wfv1.CronWorkflow, scheduledTime time.Time) *wfv1.Workflow {
+	wf := ConvertCronWorkflowToWorkflow(cronWf)
+	wf.Annotations[AnnotationKeyCronWfScheduledTime] = scheduledTime.Format(time.RFC3339)
+	return wf
+}
+
 func NewWorkflowFromWorkflowTemplate(templateName string, workflowMetadata *metav1.ObjectMeta, clusterScope bool) *wfv1.Workflow {
 
 	wf := &wfv1.Workflow{
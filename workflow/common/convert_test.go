@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -109,6 +110,13 @@ spec:
 	}
 }
 
+func TestConvertCronWorkflowToWorkflowWithScheduledTime(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Name: "hello-world"}}
+	wf := ConvertCronWorkflowToWorkflowWithScheduledTime(cronWf, time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, "hello-world-", wf.GenerateName)
+	assert.Equal(t, "2020-01-01T10:00:00Z", wf.Annotations[AnnotationKeyCronWfScheduledTime])
+}
+
 const workflowTmpl = `
 apiVersion: argoproj.io/v1alpha1
 kind: WorkflowTemplate
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/antonmedv/expr"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/util/expr/env"
+)
+
+// ExpressionTagPrefix is the prefix of a tag which is an expression rather than a variable, e.g. {{=asInt(inputs.parameters.n) * 2}}
+const ExpressionTagPrefix = "="
+
+// IsExpressionTag returns whether the tag (without the braces) is an expression
+func IsExpressionTag(tag string) bool {
+	return strings.HasPrefix(tag, ExpressionTagPrefix)
+}
+
+// UnmarshalExpressionTag returns the expression of the tag. Templates are JSON-marshalled before substitution, so this
+// undoes any escaping of quotes and other characters within the expression.
+func UnmarshalExpressionTag(tag string) (string, error) {
+	var expression string
+	err := json.Unmarshal([]byte(`"`+strings.TrimPrefix(tag, ExpressionTagPrefix)+`"`), &expression)
+	if err != nil {
+		return "", errors.Errorf(errors.CodeBadRequest, "invalid expression {{%s}}: %v", tag, err)
+	}
+	return expression, nil
+}
+
+// EvaluateExpression evaluates the expression with the variables in the replace map, e.g. "inputs.parameters.n" can be
+// referenced as inputs.parameters.n, and returns the result as a string
+func EvaluateExpression(expression string, replaceMap map[string]string) (string, error) {
+	vars := make(map[string]interface{}, len(replaceMap))
+	for key, value := range replaceMap {
+		vars[key] = value
+	}
+	result, err := expr.Eval(expression, env.GetFuncMap(vars))
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", fmt.Errorf("expression %q evaluated to nil", expression)
+	}
+	if s, ok := result.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprintf("%v", result), nil
+}
+
+// evaluateExpressionTag evaluates the expression tag with the variables in the replace map
+func evaluateExpressionTag(tag string, replaceMap map[string]string) (string, error) {
+	expression, err := UnmarshalExpressionTag(tag)
+	if err != nil {
+		return "", err
+	}
+	return EvaluateExpression(expression, replaceMap)
+}
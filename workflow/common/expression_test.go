@@ -0,0 +1,47 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valyala/fasttemplate"
+)
+
+func TestReplaceExpression(t *testing.T) {
+	replace := func(tmpl string, replaceMap map[string]string, allowUnresolved bool) (string, error) {
+		fstTmpl, err := fasttemplate.NewTemplate(tmpl, "{{", "}}")
+		if err != nil {
+			return "", err
+		}
+		return Replace(fstTmpl, replaceMap, allowUnresolved)
+	}
+	t.Run("Arithmetic", func(t *testing.T) {
+		s, err := replace(`{{=asInt(inputs.parameters.n) * 2}}`, map[string]string{"inputs.parameters.n": "3"}, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "6", s)
+		}
+	})
+	t.Run("JSONPath", func(t *testing.T) {
+		s, err := replace(`{{=jsonpath(steps.a.outputs.result, '$.name')}}`, map[string]string{"steps.a.outputs.result": `{"name": "foo"}`}, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "foo", s)
+		}
+	})
+	t.Run("Escaped", func(t *testing.T) {
+		// templates are JSON-marshalled before substitution, so quotes in expressions are escaped
+		s, err := replace(`{"args": ["{{=upper(\"hello\") + \"\\n\"}}"]}`, nil, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"args": ["HELLO\n"]}`, s)
+		}
+	})
+	t.Run("Unresolved", func(t *testing.T) {
+		s, err := replace(`{{=asInt(inputs.parameters.n) * 2}}`, map[string]string{}, true)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{{=asInt(inputs.parameters.n) * 2}}`, s)
+		}
+		_, err = replace(`{{=asInt(inputs.parameters.n) * 2}}`, map[string]string{}, false)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "failed to evaluate {{=asInt(inputs.parameters.n) * 2}}")
+		}
+	})
+}
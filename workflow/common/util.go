@@ -39,6 +39,8 @@ func FindOverlappingVolume(tmpl *wfv1.Template, path string) *apiv1.VolumeMount
 		volMounts = tmpl.Container.VolumeMounts
 	} else if tmpl.Script != nil {
 		volMounts = tmpl.Script.VolumeMounts
+	} else if tmpl.ContainerSet != nil {
+		volMounts = tmpl.ContainerSet.VolumeMounts
 	} else {
 		return nil
 	}
@@ -372,6 +374,20 @@ func SubstituteParams(tmpl *wfv1.Template, globalParams, localParams Parameters)
 func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allowUnresolved bool) (string, error) {
 	var unresolvedErr error
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		if IsExpressionTag(tag) {
+			replacement, err := evaluateExpressionTag(tag, replaceMap)
+			if err != nil {
+				// the expression may reference variables which are not yet in scope
+				if allowUnresolved {
+					return w.Write([]byte(fmt.Sprintf("{{%s}}", tag)))
+				}
+				unresolvedErr = errors.Errorf(errors.CodeBadRequest, "failed to evaluate {{%s}}: %v", tag, err)
+				return 0, nil
+			}
+			replacement = strconv.Quote(replacement)
+			replacement = replacement[1 : len(replacement)-1]
+			return w.Write([]byte(replacement))
+		}
 		replacement, ok := replaceMap[tag]
 		if !ok {
 			// Attempt to resolve nested tags, if possible
@@ -573,6 +589,38 @@ func SplitCronWorkflowYAMLFile(body []byte, strict bool) ([]wfv1.CronWorkflow, e
 	return manifests, nil
 }
 
+// SplitWorkflowEventBindingYAMLFile is a helper to split a body into multiple workflow event binding objects
+func SplitWorkflowEventBindingYAMLFile(body []byte, strict bool) ([]wfv1.WorkflowEventBinding, error) {
+	manifestsStrings := yamlSeparator.Split(string(body), -1)
+	manifests := make([]wfv1.WorkflowEventBinding, 0)
+	for _, manifestStr := range manifestsStrings {
+		if strings.TrimSpace(manifestStr) == "" {
+			continue
+		}
+		var wfeb wfv1.WorkflowEventBinding
+		var opts []yaml.JSONOpt
+		if strict {
+			opts = append(opts, yaml.DisallowUnknownFields) // nolint
+		}
+		err := yaml.Unmarshal([]byte(manifestStr), &wfeb, opts...)
+		if wfeb.Kind != "" && wfeb.Kind != workflow.WorkflowEventBindingKind {
+			name := wfeb.Kind
+			if wfeb.Name != "" {
+				name = fmt.Sprintf("%s '%s'", name, wfeb.Name)
+			}
+			log.Warnf("%s is not of kind WorkflowEventBinding. Ignoring...", name)
+			// If we get here, it was a k8s manifest which was not of type 'WorkflowEventBinding'
+			// We ignore these since we only care about WorkflowEventBinding manifests.
+			continue
+		}
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		manifests = append(manifests, wfeb)
+	}
+	return manifests, nil
+}
+
 // MergeReferredTemplate merges a referred template to the receiver template.
 func MergeReferredTemplate(tmpl *wfv1.Template, referred *wfv1.Template) (*wfv1.Template, error) {
 	// Copy the referred template to deep copy template types.
@@ -714,3 +762,11 @@ func SplitClusterWorkflowTemplateYAMLFile(body []byte, strict bool) ([]wfv1.Clus
 func GenerateOnExitNodeName(parentDisplayName string) string {
 	return fmt.Sprintf("%s.onExit", parentDisplayName)
 }
+
+func GenerateLifecycleHookNodeName(parentNodeName string, hookName string) string {
+	return fmt.Sprintf("%s.hooks.%s", parentNodeName, hookName)
+}
+
+func GenerateContainerNodeName(podNodeName string, containerName string) string {
+	return fmt.Sprintf("%s.%s", podNodeName, containerName)
+}
@@ -27,6 +27,14 @@ func TestFindOverlappingVolume(t *testing.T) {
 	assert.Equal(t, &volMnt, FindOverlappingVolume(templateWithVolMount, "/user-mount"))
 	assert.Equal(t, &volMnt, FindOverlappingVolume(templateWithVolMount, "/user-mount/subdir"))
 	assert.Nil(t, FindOverlappingVolume(templateWithVolMount, "/user-mount-coincidental-prefix"))
+
+	containerSetWithVolMount := &wfv1.Template{
+		ContainerSet: &wfv1.ContainerSetTemplate{
+			VolumeMounts: []corev1.VolumeMount{volMnt},
+		},
+	}
+	assert.Equal(t, &volMnt, FindOverlappingVolume(containerSetWithVolMount, "/user-mount/subdir"))
+	assert.Nil(t, FindOverlappingVolume(containerSetWithVolMount, "/tmp"))
 }
 
 func TestUnknownFieldEnforcerForWorkflowStep(t *testing.T) {
@@ -0,0 +1,272 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo/config"
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo/workflow/artifacts"
+)
+
+// Artifact cache
+
+// artifactCache stores each entry of the cache as its own JSON object in the artifact repository, under a key prefix
+// for the cache, so that saving an entry does not rewrite the others. The cache is listed by loading the prefix as
+// a directory.
+type artifactCache struct {
+	namespace string
+	name      string
+	location  *wfv1.ArtifactLocation
+	newDriver func(art *wfv1.Artifact) (artifact.ArtifactDriver, error)
+}
+
+// NewArtifactCache returns a cache stored in the artifact repository, whose credentials are read from the secrets of
+// the namespace
+func NewArtifactCache(ns string, ki kubernetes.Interface, repo *config.ArtifactRepository, n string) MemoizationCache {
+	return &artifactCache{
+		namespace: ns,
+		name:      n,
+		location:  cacheLocation(repo, n),
+		newDriver: func(art *wfv1.Artifact) (artifact.ArtifactDriver, error) {
+			return artifact.NewDriver(art, resources{ki, ns})
+		},
+	}
+}
+
+// cacheLocation returns the location of the directory the named cache is stored in, nil if the repository does not
+// support caches
+func cacheLocation(repo *config.ArtifactRepository, name string) *wfv1.ArtifactLocation {
+	key := path.Join("memoization", name)
+	switch {
+	case repo.S3 != nil:
+		return &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: repo.S3.S3Bucket, Key: path.Join(repo.S3.KeyPrefix, key)}}
+	case repo.GCS != nil:
+		return &wfv1.ArtifactLocation{GCS: &wfv1.GCSArtifact{GCSBucket: repo.GCS.GCSBucket, Key: key}}
+	case repo.Azure != nil:
+		return &wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{AzureBlobContainer: repo.Azure.AzureBlobContainer, Blob: key}}
+	case repo.Artifactory != nil:
+		return &wfv1.ArtifactLocation{Artifactory: &wfv1.ArtifactoryArtifact{ArtifactoryAuth: repo.Artifactory.ArtifactoryAuth, URL: strings.TrimSuffix(repo.Artifactory.RepoURL, "/") + "/" + key}}
+	default:
+		return nil
+	}
+}
+
+// entryLocation returns the location of the object the entry is stored in, nil if the cache's location is
+func entryLocation(dir *wfv1.ArtifactLocation, key string) *wfv1.ArtifactLocation {
+	if dir == nil {
+		return nil
+	}
+	l := dir.DeepCopy()
+	name := key + ".json"
+	switch {
+	case l.S3 != nil:
+		l.S3.Key = path.Join(l.S3.Key, name)
+	case l.GCS != nil:
+		l.GCS.Key = path.Join(l.GCS.Key, name)
+	case l.Azure != nil:
+		l.Azure.Blob = path.Join(l.Azure.Blob, name)
+	case l.Artifactory != nil:
+		l.Artifactory.URL = l.Artifactory.URL + "/" + name
+	}
+	return l
+}
+
+func (c *artifactCache) log() *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name})
+}
+
+func (c *artifactCache) Load(key string) (*CacheEntry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("Invalid cache key %s", key)
+	}
+	entry, err := c.load(key)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		c.log().WithField("key", key).Info("artifact cache miss: entry does not exist")
+		return nil, nil
+	}
+	c.log().WithField("key", key).Info("artifact cache hit")
+	return entry, nil
+}
+
+func (c *artifactCache) Save(key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("Invalid cache key")
+	}
+	c.log().WithFields(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving artifact cache entry")
+	return c.save(key, &CacheEntry{
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: metav1.Now(),
+	})
+}
+
+// List loads the cache's directory, which the Artifactory driver cannot do, so Artifactory caches cannot be listed
+func (c *artifactCache) List() (map[string]*CacheEntry, error) {
+	if c.location != nil && c.location.Artifactory != nil {
+		return nil, fmt.Errorf("artifactory memoization caches cannot be listed")
+	}
+	art, driver, err := c.artifactAndDriver(c.location)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	localPath := filepath.Join(dir, "entries")
+	err = driver.Load(art, localPath)
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return map[string]*CacheEntry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache: %w", err)
+	}
+	files, err := ioutil.ReadDir(localPath)
+	if os.IsNotExist(err) {
+		return map[string]*CacheEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]*CacheEntry, len(files))
+	for _, f := range files {
+		key := strings.TrimSuffix(f.Name(), ".json")
+		if f.IsDir() || !cacheKeyRegex.MatchString(key) || key+".json" != f.Name() {
+			continue
+		}
+		entry, err := readEntry(filepath.Join(localPath, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("malformed artifact cache entry %s: %w", key, err)
+		}
+		if entry != nil {
+			entries[key] = entry
+		}
+	}
+	return entries, nil
+}
+
+// Delete overwrites the entry's object with null, as the artifact drivers cannot delete objects
+func (c *artifactCache) Delete(key string) error {
+	entry, err := c.load(key)
+	if err != nil || entry == nil {
+		return err
+	}
+	c.log().WithField("key", key).Info("Deleting artifact cache entry")
+	return c.save(key, nil)
+}
+
+func (c *artifactCache) artifactAndDriver(location *wfv1.ArtifactLocation) (*wfv1.Artifact, artifact.ArtifactDriver, error) {
+	if location == nil {
+		return nil, nil, fmt.Errorf("the artifact repository does not support memoization caches")
+	}
+	art := &wfv1.Artifact{Name: c.name, ArtifactLocation: *location}
+	driver, err := c.newDriver(art)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create artifact driver: %w", err)
+	}
+	return art, driver, nil
+}
+
+// load returns the entry of the key, nil if it does not exist or was deleted
+func (c *artifactCache) load(key string) (*CacheEntry, error) {
+	art, driver, err := c.artifactAndDriver(entryLocation(c.location, key))
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	localPath := filepath.Join(dir, key+".json")
+	err = driver.Load(art, localPath)
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache entry: %w", err)
+	}
+	entry, err := readEntry(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("malformed artifact cache entry %s: %w", key, err)
+	}
+	return entry, nil
+}
+
+// readEntry reads the entry in the file, nil if the file does not exist or holds a deleted entry
+func readEntry(localPath string) (*CacheEntry, error) {
+	data, err := ioutil.ReadFile(localPath)
+	// some drivers load nothing rather than returning an error when the object does not exist
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry *CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return entry, nil
+}
+
+func (c *artifactCache) save(key string, entry *CacheEntry) error {
+	art, driver, err := c.artifactAndDriver(entryLocation(c.location, key))
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	localPath := filepath.Join(dir, key+".json")
+	if err := ioutil.WriteFile(localPath, data, 0600); err != nil {
+		return err
+	}
+	if err := driver.Save(localPath, art); err != nil {
+		return fmt.Errorf("could not save artifact cache entry: %w", err)
+	}
+	return nil
+}
+
+// resources reads the secrets of the artifact repository from the namespace of the cache
+type resources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r resources) GetSecret(name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r resources) GetConfigMapKey(name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
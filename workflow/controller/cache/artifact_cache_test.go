@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo/config"
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo/util/intstr"
+	artifact "github.com/argoproj/argo/workflow/artifacts"
+)
+
+// memoryDriver keeps the saved artifacts in memory by key, and loads keys with more than one object as directories
+type memoryDriver map[string][]byte
+
+func (d memoryDriver) Load(art *wfv1.Artifact, path string) error {
+	if data, ok := d[art.S3.Key]; ok {
+		return ioutil.WriteFile(path, data, 0600)
+	}
+	found := false
+	for key, data := range d {
+		if strings.HasPrefix(key, art.S3.Key+"/") {
+			found = true
+			name := filepath.Join(path, strings.TrimPrefix(key, art.S3.Key+"/"))
+			if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(name, data, 0600); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		return errors.Errorf(errors.CodeNotFound, "%s not found", art.S3.Key)
+	}
+	return nil
+}
+
+func (d memoryDriver) Save(path string, art *wfv1.Artifact) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d[art.S3.Key] = data
+	return nil
+}
+
+func newTestArtifactCache(driver memoryDriver) MemoizationCache {
+	repo := &config.ArtifactRepository{S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, KeyPrefix: "prefix"}}
+	return &artifactCache{
+		namespace: "default",
+		name:      "whalesay-cache",
+		location:  cacheLocation(repo, "whalesay-cache"),
+		newDriver: func(*wfv1.Artifact) (artifact.ArtifactDriver, error) { return driver, nil },
+	}
+}
+
+func TestArtifactCache(t *testing.T) {
+	driver := memoryDriver{}
+	c := newTestArtifactCache(driver)
+
+	entry, err := c.Load("hi-there-world")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: intstrutil.ParsePtr("Hello world")}}}
+	err = c.Save("hi-there-world", "my-node", outputs)
+	assert.NoError(t, err)
+	assert.Contains(t, driver, "prefix/memoization/whalesay-cache/hi-there-world.json")
+	err = c.Save("hello-world", "my-other-node", outputs)
+	assert.NoError(t, err)
+	assert.Len(t, driver, 2, "each entry is its own object")
+
+	entry, err = c.Load("hi-there-world")
+	assert.NoError(t, err)
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, "my-node", entry.NodeID)
+		assert.Equal(t, outputs, entry.Outputs)
+		assert.False(t, entry.CreationTimestamp.IsZero())
+	}
+
+	entries, err := c.List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "my-other-node", entries["hello-world"].NodeID)
+	}
+
+	err = c.Delete("hi-there-world")
+	assert.NoError(t, err)
+	entry, err = c.Load("hi-there-world")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+	entries, err = c.List()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries, "hello-world")
+}
+
+func TestArtifactCacheListEmpty(t *testing.T) {
+	entries, err := newTestArtifactCache(memoryDriver{}).List()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestArtifactCacheUnsupportedRepository(t *testing.T) {
+	c := NewArtifactCache("default", nil, &config.ArtifactRepository{}, "whalesay-cache")
+	_, err := c.Load("hi-there-world")
+	assert.EqualError(t, err, "the artifact repository does not support memoization caches")
+}
+
+func TestCacheFactoryConfigure(t *testing.T) {
+	cf := NewCacheFactory(nil, "default")
+	assert.Nil(t, cf.GetCache(ArtifactCache, "whalesay-cache"), "not configured")
+	cf.Configure(WithArtifactRepository(&config.ArtifactRepository{S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}))
+	assert.NotNil(t, cf.GetCache(ArtifactCache, "whalesay-cache"))
+	cf.Configure()
+	assert.Nil(t, cf.GetCache(ArtifactCache, "whalesay-cache"), "no longer configured")
+}
+
+func TestArtifactCacheArtifactory(t *testing.T) {
+	repo := &config.ArtifactRepository{Artifactory: &config.ArtifactoryArtifactRepository{RepoURL: "https://my-artifactory/my-repo/"}}
+	location := entryLocation(cacheLocation(repo, "whalesay-cache"), "hi-there-world")
+	if assert.NotNil(t, location.Artifactory) {
+		assert.Equal(t, "https://my-artifactory/my-repo/memoization/whalesay-cache/hi-there-world.json", location.Artifactory.URL)
+	}
+	_, err := NewArtifactCache("default", nil, repo, "whalesay-cache").List()
+	assert.EqualError(t, err, "artifactory memoization caches cannot be listed")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"sync"
+	"time"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 
@@ -12,7 +13,9 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"upper.io/db.v3/lib/sqlbuilder"
 
+	"github.com/argoproj/argo/config"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 )
 
@@ -21,52 +24,140 @@ var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 type MemoizationCache interface {
 	Load(key string) (*CacheEntry, error)
 	Save(key string, nodeId string, value *wfv1.Outputs) error
+	// List returns all entries of the cache by key
+	List() (map[string]*CacheEntry, error)
+	// Delete deletes the entry with the key, if it exists
+	Delete(key string) error
 }
 
 type CacheEntry struct {
 	NodeID  string        `json:"nodeID"`
 	Outputs *wfv1.Outputs `json:"outputs"`
+	// CreationTimestamp is when the entry was saved, zero for entries saved before it was recorded
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty"`
+}
+
+// IsExpired tests if the entry is older than the max age. Entries never expire if the max age is zero, nor if their
+// creation timestamp is unknown, as they were saved before it was recorded.
+func (e *CacheEntry) IsExpired(maxAge time.Duration) bool {
+	return maxAge > 0 && !e.CreationTimestamp.IsZero() && time.Since(e.CreationTimestamp.Time) > maxAge
 }
 
 type cacheFactory struct {
-	caches     map[string]*MemoizationCache
-	kubeclient kubernetes.Interface
-	namespace  string
+	caches             map[string]*MemoizationCache
+	kubeclient         kubernetes.Interface
+	namespace          string
+	session            sqlbuilder.Database
+	clusterName        string
+	artifactRepository *config.ArtifactRepository
+	lock               sync.Mutex
 }
 
 type CacheFactory interface {
 	GetCache(ct CacheType, name string) *MemoizationCache
+	// Configure replaces the options of the factory, the caches it returns from then on use the new options
+	Configure(opts ...CacheFactoryOption)
+}
+
+// CacheFactoryOption configures the storage of the caches beyond ConfigMaps
+type CacheFactoryOption func(*cacheFactory)
+
+// WithSession stores SQL caches in the database of the session
+func WithSession(session sqlbuilder.Database, clusterName string) CacheFactoryOption {
+	return func(cf *cacheFactory) {
+		cf.session = session
+		cf.clusterName = clusterName
+	}
+}
+
+// WithArtifactRepository stores artifact caches in the artifact repository
+func WithArtifactRepository(artifactRepository *config.ArtifactRepository) CacheFactoryOption {
+	return func(cf *cacheFactory) {
+		cf.artifactRepository = artifactRepository
+	}
+}
+
+func NewCacheFactory(ki kubernetes.Interface, ns string, opts ...CacheFactoryOption) CacheFactory {
+	cf := &cacheFactory{
+		kubeclient: ki,
+		namespace:  ns,
+	}
+	cf.Configure(opts...)
+	return cf
 }
 
-func NewCacheFactory(ki kubernetes.Interface, ns string) CacheFactory {
-	return &cacheFactory{
-		make(map[string]*MemoizationCache),
-		ki,
-		ns,
+func (cf *cacheFactory) Configure(opts ...CacheFactoryOption) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	cf.session = nil
+	cf.clusterName = ""
+	cf.artifactRepository = nil
+	for _, opt := range opts {
+		opt(cf)
 	}
+	// the caches created so far use the old session and artifact repository
+	cf.caches = make(map[string]*MemoizationCache)
 }
 
 type CacheType string
 
 const (
-	// Only config maps are currently supported for caching
+	// ConfigMapCache stores entries in a ConfigMap
 	ConfigMapCache CacheType = "ConfigMapCache"
+	// SQLCache stores entries in a table of the database configured for persistence
+	SQLCache CacheType = "SQLCache"
+	// ArtifactCache stores entries in an object in the default artifact repository
+	ArtifactCache CacheType = "ArtifactCache"
 )
 
-// Returns a cache if it exists and creates it otherwise
+// GetCacheTypeAndName returns the type and name of the cache the memoization cache refers to
+func GetCacheTypeAndName(c *wfv1.Cache) (CacheType, string, error) {
+	switch {
+	case c == nil:
+		return "", "", fmt.Errorf("cache must be set")
+	case c.ConfigMap != nil:
+		return ConfigMapCache, c.ConfigMap.Name, nil
+	case c.SQL != nil:
+		return SQLCache, c.SQL.Name, nil
+	case c.Artifact != nil:
+		return ArtifactCache, c.Artifact.Name, nil
+	default:
+		return "", "", fmt.Errorf("one of configMap, sql or artifact must be set")
+	}
+}
+
+// Returns a cache if it exists and creates it otherwise, nil if the type of cache is not configured
 func (cf *cacheFactory) GetCache(ct CacheType, name string) *MemoizationCache {
+	if ct == "" {
+		ct = ConfigMapCache
+	}
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
 	idx := string(ct) + "." + name
 	if c := cf.caches[idx]; c != nil {
 		return c
 	}
+	var c MemoizationCache
 	switch ct {
 	case ConfigMapCache:
-		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
-		cf.caches[idx] = &c
-		return &c
+		c = NewConfigMapCache(cf.namespace, cf.kubeclient, name)
+	case SQLCache:
+		if cf.session == nil {
+			log.WithField("name", name).Error("SQL caches need persistence to be configured")
+			return nil
+		}
+		c = NewSQLCache(cf.session, cf.clusterName, cf.namespace, name)
+	case ArtifactCache:
+		if cf.artifactRepository == nil {
+			log.WithField("name", name).Error("Artifact caches need an artifact repository to be configured")
+			return nil
+		}
+		c = NewArtifactCache(cf.namespace, cf.kubeclient, cf.artifactRepository, name)
 	default:
 		return nil
 	}
+	cf.caches[idx] = &c
+	return &c
 }
 
 // ConfigMap cache
@@ -121,11 +212,8 @@ func (c *configMapCache) Load(key string) (*CacheEntry, error) {
 	if err != nil {
 		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
 	}
-	outputs := entry.Outputs
 	c.logInfo(log.Fields{"key": key}, "config map cache hit")
-	return &CacheEntry{
-		Outputs: outputs,
-	}, nil
+	return &entry, nil
 }
 
 func (c *configMapCache) Save(key string, nodeId string, value *wfv1.Outputs) error {
@@ -151,8 +239,9 @@ func (c *configMapCache) Save(key string, nodeId string, value *wfv1.Outputs) er
 	}
 
 	newEntry := CacheEntry{
-		NodeID:  nodeId,
-		Outputs: value,
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: metav1.Now(),
 	}
 
 	entryJSON, err := json.Marshal(newEntry)
@@ -173,3 +262,46 @@ func (c *configMapCache) Save(key string, nodeId string, value *wfv1.Outputs) er
 	}
 	return nil
 }
+
+func (c *configMapCache) List() (map[string]*CacheEntry, error) {
+	c.locked.Lock()
+	defer c.locked.Unlock()
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return map[string]*CacheEntry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	entries := make(map[string]*CacheEntry, len(cm.Data))
+	for key, rawEntry := range cm.Data {
+		var entry CacheEntry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(key string) error {
+	c.locked.Lock()
+	defer c.locked.Unlock()
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	if _, ok := cm.Data[key]; !ok {
+		return nil
+	}
+	c.logInfo(log.Fields{"key": key}, "Deleting ConfigMap cache entry")
+	delete(cm.Data, key)
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(cm)
+	if err != nil {
+		return fmt.Errorf("error deleting cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// SQL cache
+
+type sqlCacheRecord struct {
+	ClusterName string `db:"clustername"`
+	Namespace   string `db:"namespace"`
+	CacheName   string `db:"cachename"`
+	// Why is this called "cachekey" not "key"? Key is an SQL reserved word.
+	Key       string    `db:"cachekey"`
+	NodeID    string    `db:"nodeid"`
+	Outputs   string    `db:"outputs"`
+	CreatedAt time.Time `db:"createdat"`
+}
+
+type sqlCache struct {
+	session     sqlbuilder.Database
+	clusterName string
+	namespace   string
+	name        string
+}
+
+// NewSQLCache returns a cache stored in the memoization cache table of the database of the session, which the
+// migration of the database creates
+func NewSQLCache(session sqlbuilder.Database, clusterName, ns, n string) MemoizationCache {
+	return &sqlCache{
+		session:     session,
+		clusterName: clusterName,
+		namespace:   ns,
+		name:        n,
+	}
+}
+
+func (c *sqlCache) log() *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name})
+}
+
+func (c *sqlCache) cacheCond() db.Cond {
+	return db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "cachename": c.name}
+}
+
+func (c *sqlCache) Load(key string) (*CacheEntry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("Invalid cache key %s", key)
+	}
+	var records []sqlCacheRecord
+	err := c.session.
+		Select("cachekey", "nodeid", "outputs", "createdat").
+		From(sqldb.MemoizationCacheTableName).
+		Where(c.cacheCond()).
+		And(db.Cond{"cachekey": key}).
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache: %w", err)
+	}
+	if len(records) == 0 {
+		c.log().WithField("key", key).Info("SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+	entry, err := records[0].toEntry()
+	if err != nil {
+		return nil, err
+	}
+	c.log().WithField("key", key).Info("SQL cache hit")
+	return entry, nil
+}
+
+func (c *sqlCache) Save(key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("Invalid cache key")
+	}
+	outputs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	c.log().WithFields(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving SQL cache entry")
+	return c.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(sqldb.MemoizationCacheTableName).
+			Where(c.cacheCond()).
+			And(db.Cond{"cachekey": key}).
+			Exec()
+		if err != nil {
+			return fmt.Errorf("error replacing cache entry: %w", err)
+		}
+		_, err = sess.Collection(sqldb.MemoizationCacheTableName).
+			Insert(&sqlCacheRecord{
+				ClusterName: c.clusterName,
+				Namespace:   c.namespace,
+				CacheName:   c.name,
+				Key:         key,
+				NodeID:      nodeId,
+				Outputs:     string(outputs),
+				CreatedAt:   time.Now().UTC(),
+			})
+		if err != nil {
+			return fmt.Errorf("error creating cache entry: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c *sqlCache) List() (map[string]*CacheEntry, error) {
+	var records []sqlCacheRecord
+	err := c.session.
+		Select("cachekey", "nodeid", "outputs", "createdat").
+		From(sqldb.MemoizationCacheTableName).
+		Where(c.cacheCond()).
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache: %w", err)
+	}
+	entries := make(map[string]*CacheEntry, len(records))
+	for _, record := range records {
+		entry, err := record.toEntry()
+		if err != nil {
+			return nil, err
+		}
+		entries[record.Key] = entry
+	}
+	return entries, nil
+}
+
+func (c *sqlCache) Delete(key string) error {
+	c.log().WithField("key", key).Info("Deleting SQL cache entry")
+	_, err := c.session.
+		DeleteFrom(sqldb.MemoizationCacheTableName).
+		Where(c.cacheCond()).
+		And(db.Cond{"cachekey": key}).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting cache entry: %w", err)
+	}
+	return nil
+}
+
+func (r sqlCacheRecord) toEntry() (*CacheEntry, error) {
+	var outputs wfv1.Outputs
+	if err := json.Unmarshal([]byte(r.Outputs), &outputs); err != nil {
+		return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", r.Key, err)
+	}
+	return &CacheEntry{
+		NodeID:            r.NodeID,
+		Outputs:           &outputs,
+		CreationTimestamp: metav1.NewTime(r.CreatedAt),
+	}, nil
+}
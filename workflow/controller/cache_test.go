@@ -2,6 +2,7 @@ package controller
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
@@ -82,3 +83,29 @@ func TestConfigMapCacheSave(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, cm)
 }
+
+func TestConfigMapCacheListAndDelete(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(&sampleConfigMapCacheEntry)
+	assert.NoError(t, err)
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+	entries, err := c.List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "memoize-abx4124-123129321123", entries["hi-there-world"].NodeID)
+	}
+	err = c.Delete("hi-there-world")
+	assert.NoError(t, err)
+	entries, err = c.List()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestCacheEntryIsExpired(t *testing.T) {
+	entry := &cache.CacheEntry{CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour))}
+	assert.False(t, entry.IsExpired(0))
+	assert.False(t, entry.IsExpired(2*time.Hour))
+	assert.True(t, entry.IsExpired(time.Minute))
+	assert.False(t, (&cache.CacheEntry{}).IsExpired(time.Minute))
+}
@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	"github.com/argoproj/argo/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// executeWorkflow creates the child workflow of a workflow template, and completes the node once the child workflow
+// has completed, with the child's outputs and phase. The child workflow is named <parent>-<fnv32a(node ID)>, see
+// childWorkflowName, so that it is only ever created once, and is owned by the parent workflow, so that it is deleted
+// with it. Once created, its name is recorded in the node, so that a child workflow which has since been deleted is not
+// created again.
+func (woc *wfOperationCtx) executeWorkflow(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+
+	if node.ChildWorkflow == "" {
+		child, err := woc.newChildWorkflow(childWorkflowName(woc.wf, node), tmpl)
+		if err != nil {
+			return node, err
+		}
+		_, err = woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace).Create(child)
+		if err != nil && !apierr.IsAlreadyExists(err) {
+			return woc.requeueIfTransientErr(err, nodeName)
+		}
+		if err == nil {
+			woc.log.Infof("Created child workflow %s for node %s", child.Name, node.ID)
+		}
+		node.ChildWorkflow = child.Name
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+	}
+
+	child, err := woc.getChildWorkflow(node.ChildWorkflow)
+	if err != nil {
+		return node, err
+	}
+	if child == nil {
+		child, err = woc.getDeletedChildWorkflow(node.ChildWorkflow)
+		if err != nil {
+			return woc.requeueIfTransientErr(err, nodeName)
+		}
+		if child == nil {
+			return woc.markNodePhase(nodeName, wfv1.NodeError, fmt.Sprintf("child workflow %s was deleted before it completed", node.ChildWorkflow)), nil
+		}
+	}
+	if !child.Status.Fulfilled() {
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+	}
+
+	if child.Status.Outputs != nil {
+		node.Outputs = child.Status.Outputs.DeepCopy()
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
+	}
+	message := child.Status.Message
+	if child.Status.Phase != wfv1.NodeSucceeded && message == "" {
+		message = fmt.Sprintf("child workflow %s %s", child.Name, child.Status.Phase)
+	}
+	return woc.markNodePhase(nodeName, child.Status.Phase, message), nil
+}
+
+// childWorkflowName returns the name of the child workflow of the node. It is not the node's ID, because the ID of the
+// root node is the name of the parent workflow.
+func childWorkflowName(wf *wfv1.Workflow, node *wfv1.NodeStatus) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(node.ID))
+	return fmt.Sprintf("%s-%v", wf.Name, h.Sum32())
+}
+
+// newChildWorkflow returns the child workflow of a workflow template. Input artifacts of the template may be passed
+// to the child workflow using `from`.
+func (woc *wfOperationCtx) newChildWorkflow(name string, tmpl *wfv1.Template) (*wfv1.Workflow, error) {
+	arguments := tmpl.Workflow.Arguments.DeepCopy()
+	scope := &wfScope{tmpl: tmpl, scope: make(map[string]interface{})}
+	for i, art := range arguments.Artifacts {
+		if art.From == "" {
+			continue
+		}
+		resolvedArt, err := scope.resolveArtifact(art.From, art.SubPath)
+		if err != nil {
+			return nil, err
+		}
+		resolvedArt.Name = art.Name
+		arguments.Artifacts[i] = *resolvedArt
+	}
+	child := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: woc.wf.Namespace,
+			Labels:    map[string]string{},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: wfv1.WorkflowSpec{
+			WorkflowTemplateRef: tmpl.Workflow.WorkflowTemplateRef.DeepCopy(),
+			Arguments:           *arguments,
+		},
+	}
+	if instanceID := woc.controller.Config.InstanceID; instanceID != "" {
+		child.Labels[common.LabelKeyControllerInstanceID] = instanceID
+	}
+	return child, nil
+}
+
+// getChildWorkflow returns the child workflow with the name from the informer, or nil if it does not exist (yet)
+func (woc *wfOperationCtx) getChildWorkflow(name string) (*wfv1.Workflow, error) {
+	key, err := cache.MetaNamespaceKeyFunc(&metav1.ObjectMeta{Namespace: woc.wf.Namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	obj, exists, err := woc.controller.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("child workflow %s is not an unstructured", name)
+	}
+	return util.FromUnstructured(un)
+}
+
+// getDeletedChildWorkflow returns a child workflow which was created, but is not in the informer. The informer may not
+// have seen it yet, otherwise it has been deleted, e.g. garbage collected, and is looked for in the archive. It returns
+// nil if the child workflow cannot be found.
+func (woc *wfOperationCtx) getDeletedChildWorkflow(name string) (*wfv1.Workflow, error) {
+	child, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace).Get(name, metav1.GetOptions{})
+	if err == nil {
+		return child, nil
+	}
+	if !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	return woc.controller.getArchivedWorkflow(woc.wf.Namespace, name)
+}
+
+// getArchivedWorkflow returns the most recently archived workflow with the name, or nil if none was archived
+func (wfc *WorkflowController) getArchivedWorkflow(namespace, name string) (*wfv1.Workflow, error) {
+	// the archive is listed newest first
+	wfs, err := wfc.wfArchive.ListWorkflows(sqldb.ListWorkflowsOptions{Namespace: namespace, Name: name, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(wfs) == 0 {
+		return nil, nil
+	}
+	return wfc.wfArchive.GetWorkflow(string(wfs[0].UID))
+}
+
+// shutdownChildWorkflows propagates the shutdown of the workflow, or the passing of its deadline, to the child
+// workflows which are still running. Their nodes complete once the child workflows have stopped.
+func (woc *wfOperationCtx) shutdownChildWorkflows() {
+	strategy := woc.execWf.Spec.Shutdown
+	if strategy == "" && woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
+		strategy = wfv1.ShutdownStrategyTerminate
+	}
+	if strategy == "" {
+		return
+	}
+	wfClient := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypeWorkflow || node.Fulfilled() {
+			continue
+		}
+		if node.ChildWorkflow == "" {
+			continue
+		}
+		child, err := woc.getChildWorkflow(node.ChildWorkflow)
+		if err != nil || child == nil || child.Spec.Shutdown != "" || child.Status.Fulfilled() {
+			continue
+		}
+		woc.log.Infof("Shutting down child workflow %s with strategy: %s", child.Name, strategy)
+		switch strategy {
+		case wfv1.ShutdownStrategyStop:
+			err = util.StopWorkflow(wfClient, woc.controller.hydrator, child.Name, "", "")
+		default:
+			err = util.TerminateWorkflow(wfClient, child.Name)
+		}
+		if err != nil {
+			woc.log.WithError(err).Warnf("Failed to shut down child workflow %s", child.Name)
+		}
+	}
+}
+
+// enqueueParentWorkflow queues the parent of a child workflow, so that the parent's node is updated when the child
+// workflow changes
+func (wfc *WorkflowController) enqueueParentWorkflow(obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	owner := metav1.GetControllerOf(un)
+	if owner == nil || owner.Kind != workflow.WorkflowKind {
+		return
+	}
+	wfc.wfQueue.Add(un.GetNamespace() + "/" + owner.Name)
+}
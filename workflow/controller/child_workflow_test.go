@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo/persist/sqldb/mocks"
+	"github.com/argoproj/argo/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/intstr"
+)
+
+var childWorkflowWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: child-workflow
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: message
+      value: hello
+  templates:
+  - name: main
+    workflow:
+      workflowTemplateRef:
+        name: my-wftmpl
+      arguments:
+        parameters:
+        - name: message
+          value: "{{workflow.parameters.message}}"
+    outputs:
+      parameters:
+      - name: result
+`
+
+// setChildWorkflow adds the child workflow to the informer, as the controller would see it
+func setChildWorkflow(t *testing.T, controller *WorkflowController, child *wfv1.Workflow) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(child)
+	if assert.NoError(t, err) {
+		err = controller.wfInformer.GetIndexer().Add(&unstructured.Unstructured{Object: obj})
+		assert.NoError(t, err)
+	}
+}
+
+func TestChildWorkflow(t *testing.T) {
+	startChild := func(t *testing.T) (*WorkflowController, *wfOperationCtx, *wfv1.Workflow, func()) {
+		cancel, controller := newController()
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Create(unmarshalWF(childWorkflowWf))
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(wf.Name)]
+		assert.Equal(t, wfv1.NodeTypeWorkflow, node.Type)
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+
+		assert.NotEqual(t, wf.Name, node.ChildWorkflow)
+
+		child, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(node.ChildWorkflow, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-wftmpl", child.Spec.WorkflowTemplateRef.Name)
+			assert.Equal(t, "hello", child.Spec.Arguments.GetParameterByName("message").Value.String())
+			if owner := metav1.GetControllerOf(child); assert.NotNil(t, owner) {
+				assert.Equal(t, workflow.WorkflowKind, owner.Kind)
+				assert.Equal(t, wf.Name, owner.Name)
+			}
+		}
+		return controller, woc, child, cancel
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+		child.Status.Phase = wfv1.NodeSucceeded
+		child.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: intstr.ParsePtr("done")}}}
+		setChildWorkflow(t, controller, child)
+
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		if assert.NotNil(t, node.Outputs) && assert.Len(t, node.Outputs.Parameters, 1) {
+			assert.Equal(t, "done", node.Outputs.Parameters[0].Value.String())
+		}
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+		child.Status.Phase = wfv1.NodeFailed
+		child.Status.Message = "child failed"
+		setChildWorkflow(t, controller, child)
+
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "child failed", node.Message)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+
+		// the informer has not seen the child workflow yet, which must not be created again
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.Equal(t, child.Name, node.ChildWorkflow)
+	})
+
+	t.Run("Deleted", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+		err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Delete(child.Name, nil)
+		assert.NoError(t, err)
+
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "child workflow "+child.Name+" was deleted before it completed", node.Message)
+		_, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(child.Name, metav1.GetOptions{})
+		assert.True(t, apierr.IsNotFound(err))
+	})
+
+	t.Run("Archived", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+		err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Delete(child.Name, nil)
+		assert.NoError(t, err)
+		child.UID = "my-uid"
+		child.Status.Phase = wfv1.NodeSucceeded
+		child.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: intstr.ParsePtr("done")}}}
+		wfArchive := &sqldbmocks.WorkflowArchive{}
+		wfArchive.On("ListWorkflows", sqldb.ListWorkflowsOptions{Name: child.Name, Limit: 1}).Return(wfv1.Workflows{{ObjectMeta: metav1.ObjectMeta{Name: child.Name, UID: "my-uid"}}}, nil)
+		wfArchive.On("GetWorkflow", "my-uid").Return(child, nil)
+		wfArchive.On("ArchiveWorkflow", mock.Anything).Return(nil)
+		controller.wfArchive = wfArchive
+
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		node := woc.wf.Status.Nodes[woc.wf.NodeID(woc.wf.Name)]
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		if assert.NotNil(t, node.Outputs) && assert.Len(t, node.Outputs.Parameters, 1) {
+			assert.Equal(t, "done", node.Outputs.Parameters[0].Value.String())
+		}
+	})
+
+	t.Run("Terminate", func(t *testing.T) {
+		controller, woc, child, cancel := startChild(t)
+		defer cancel()
+		child.Status.Phase = wfv1.NodeRunning
+		setChildWorkflow(t, controller, child)
+
+		woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate()
+		child, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(child.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.ShutdownStrategyTerminate, child.Spec.Shutdown)
+		}
+	})
+}
+
+var childWorkflowFromWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: child-workflow-from
+spec:
+  entrypoint: main
+  arguments:
+    artifacts:
+    - name: data
+      s3:
+        bucket: my-bucket
+        endpoint: minio:9000
+        key: my-key
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: data
+    workflow:
+      workflowTemplateRef:
+        name: my-wftmpl
+      arguments:
+        artifacts:
+        - name: input
+          from: "{{inputs.artifacts.data}}"
+          subPath: my-sub-path
+`
+
+func TestChildWorkflowFromArtifact(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Create(unmarshalWF(childWorkflowFromWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes[woc.wf.NodeID(wf.Name)]
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+
+	child, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(node.ChildWorkflow, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		art := child.Spec.Arguments.GetArtifactByName("input")
+		if assert.NotNil(t, art) && assert.NotNil(t, art.S3) {
+			assert.Empty(t, art.From)
+			assert.Equal(t, "my-bucket", art.S3.Bucket)
+			assert.Equal(t, "my-key/my-sub-path", art.S3.Key)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo/errors"
 	"github.com/argoproj/argo/persist/sqldb"
 	"github.com/argoproj/argo/util/instanceid"
+	controllercache "github.com/argoproj/argo/workflow/controller/cache"
 	"github.com/argoproj/argo/workflow/hydrator"
 )
 
@@ -25,6 +26,15 @@ func (wfc *WorkflowController) updateConfig(config config.Config) error {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap does not have executorImage")
 	}
 	wfc.Config = config
+	wfc.podCreationRateLimiter = nil
+	if podCreation := config.PodCreation; podCreation != nil && podCreation.QPS > 0 {
+		wfc.podCreationRateLimiter = newPodCreationRateLimiter(podCreation.QPS, podCreation.GetBurst())
+		log.WithFields(log.Fields{"qps": podCreation.QPS, "burst": podCreation.GetBurst()}).Info("Pod creation is rate limited")
+	}
+	if wfc.throttler != nil {
+		wfc.throttler.SetParallelism(wfc.getParallelism())
+		wfc.throttler.ParallelismChanged()
+	}
 	if wfc.session != nil {
 		err := wfc.session.Close()
 		if err != nil {
@@ -34,7 +44,10 @@ func (wfc *WorkflowController) updateConfig(config config.Config) error {
 	wfc.session = nil
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	wfc.syncLockRepo = sqldb.NullSyncLockRepo
 	wfc.archiveLabelSelector = labels.Everything()
+	artifactRepository := config.ArtifactRepository
+	cacheFactoryOptions := []controllercache.CacheFactoryOption{controllercache.WithArtifactRepository(&artifactRepository)}
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
 		log.Info("Persistence configuration enabled")
@@ -49,6 +62,7 @@ func (wfc *WorkflowController) updateConfig(config config.Config) error {
 		}
 
 		wfc.session = session
+		cacheFactoryOptions = append(cacheFactoryOptions, controllercache.WithSession(session, persistence.GetClusterName()))
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -70,9 +84,24 @@ func (wfc *WorkflowController) updateConfig(config config.Config) error {
 		} else {
 			log.Info("Workflow archiving is disabled")
 		}
+		if persistence.Synchronization != nil {
+			controllerName := persistence.Synchronization.ControllerName
+			if controllerName == "" {
+				return errors.Errorf(errors.CodeBadRequest, "persistence.synchronization.controllerName must be set")
+			}
+			syncLockRepo := sqldb.NewSyncLockRepo(session, controllerName, persistence.Synchronization.GetLease())
+			_, err = syncLockRepo.Heartbeat()
+			if err != nil {
+				return err
+			}
+			wfc.syncLockRepo = syncLockRepo
+			log.WithField("controllerName", controllerName).Info("Database synchronization is enabled")
+		}
 	} else {
 		log.Info("Persistence configuration disabled")
 	}
+	// the operators hold on to the cache factory, so it is re-configured rather than replaced
+	wfc.cacheFactory.Configure(cacheFactoryOptions...)
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	return nil
 }
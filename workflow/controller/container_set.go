@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// executeContainerSet runs the containers of a container set in a single pod. Each container is reported as a child
+// node of the pod node, or of the containers it depends on.
+func (woc *wfOperationCtx) executeContainerSet(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	} else if tmpl.IsResubmitPendingPods() && !node.Pending() {
+		return node, nil
+	}
+
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		ctrNodeName := common.GenerateContainerNodeName(nodeName, ctr.Name)
+		if woc.wf.GetNodeByName(ctrNodeName) == nil {
+			woc.initializeNode(ctrNodeName, wfv1.NodeTypeContainer, templateScope, &wfv1.WorkflowStep{}, node.ID, wfv1.NodePending)
+		}
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		ctrNodeName := common.GenerateContainerNodeName(nodeName, ctr.Name)
+		if len(ctr.Dependencies) == 0 {
+			woc.addChildNode(nodeName, ctrNodeName)
+		}
+		for _, dep := range ctr.Dependencies {
+			woc.addChildNode(common.GenerateContainerNodeName(nodeName, dep), ctrNodeName)
+		}
+	}
+	node = woc.wf.GetNodeByName(nodeName)
+
+	woc.log.Debugf("Executing node %s with container set template: %v\n", nodeName, tmpl)
+	_, err := woc.createWorkflowPod(nodeName, newContainerSetContainers(tmpl), tmpl, &createWorkflowPodOpts{
+		onExitPod:         opts.onExitTemplate,
+		executionDeadline: opts.executionDeadline,
+	})
+	if err != nil {
+		return woc.requeueIfTransientErr(err, node.Name)
+	}
+	return node, nil
+}
+
+// newContainerSetContainers returns the containers of the pod of a container set. The command of each container is
+// wrapped by the executor, which runs it once the containers it depends on have succeeded.
+func newContainerSetContainers(tmpl *wfv1.Template) []apiv1.Container {
+	ctrs := make([]apiv1.Container, 0, len(tmpl.ContainerSet.Containers))
+	for _, ctrNode := range tmpl.ContainerSet.Containers {
+		ctr := *ctrNode.Container.DeepCopy()
+		command := []string{common.VarRunArgoExecutorPath, "container-set-run", "--name", ctr.Name}
+		if len(ctrNode.Dependencies) > 0 {
+			command = append(command, "--dependencies", strings.Join(ctrNode.Dependencies, ","))
+		}
+		command = append(command, "--")
+		command = append(command, ctr.Command...)
+		ctr.Command = append(command, ctr.Args...)
+		ctr.Args = nil
+		ctr.VolumeMounts = append(ctr.VolumeMounts, tmpl.ContainerSet.VolumeMounts...)
+		ctrs = append(ctrs, ctr)
+	}
+	return ctrs
+}
+
+// containerSetCacheTTL is how long the container set of a pod is cached for after it is decoded from its annotation
+const containerSetCacheTTL = 10 * time.Minute
+
+// getContainerSet returns the container set of a pod, which is decoded from the template annotation of the pod and
+// cached, as the pods of container sets are reconciled by every operation of their workflow
+func (wfc *WorkflowController) getContainerSet(pod *apiv1.Pod) *wfv1.ContainerSetTemplate {
+	key := pod.Namespace + "/" + pod.Name + "/" + string(pod.UID)
+	if value, ok := wfc.containerSets.Get(key); ok {
+		return value.(*wfv1.ContainerSetTemplate)
+	}
+	tmplStr, ok := pod.Annotations[common.AnnotationKeyTemplate]
+	if !ok {
+		return nil
+	}
+	var tmpl wfv1.Template
+	if err := json.Unmarshal([]byte(tmplStr), &tmpl); err != nil || tmpl.ContainerSet == nil {
+		return nil
+	}
+	wfc.containerSets.Set(key, tmpl.ContainerSet, containerSetCacheTTL)
+	return tmpl.ContainerSet
+}
+
+// hasContainerNodes returns whether the node is the pod node of a container set, whose containers without
+// dependencies are its children
+func (woc *wfOperationCtx) hasContainerNodes(node wfv1.NodeStatus) bool {
+	for _, childID := range node.Children {
+		if woc.wf.Status.Nodes[childID].Type == wfv1.NodeTypeContainer {
+			return true
+		}
+	}
+	return false
+}
+
+// updateContainerSetNodes updates the container nodes of a container set from the statuses of the containers of its
+// pod, and the outputs the wait container collected for them. Once the pod node is fulfilled, any container node which
+// did not complete is completed with it.
+func (woc *wfOperationCtx) updateContainerSetNodes(pod *apiv1.Pod, nodeID string) {
+	node, ok := woc.wf.Status.Nodes[nodeID]
+	if !ok || node.Type != wfv1.NodeTypePod || !woc.hasContainerNodes(node) {
+		return
+	}
+	containerSet := woc.controller.getContainerSet(pod)
+	if containerSet == nil {
+		return
+	}
+
+	ctrStatuses := make(map[string]apiv1.ContainerStatus)
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		ctrStatuses[ctrStatus.Name] = ctrStatus
+	}
+	for _, ctr := range containerSet.Containers {
+		ctrNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(node.Name, ctr.Name))
+		if ctrNode == nil || ctrNode.Fulfilled() {
+			continue
+		}
+		ctrStatus, ok := ctrStatuses[ctr.Name]
+		if !ok {
+			continue
+		}
+		phase, message := containerNodePhase(ctrStatus)
+		if phase == wfv1.NodeRunning && !woc.containerDependenciesSucceeded(node.Name, ctr.Dependencies) {
+			// the container is running, but it is still waiting for the containers it depends on
+			phase = wfv1.NodePending
+		}
+		woc.markNodePhase(ctrNode.Name, phase, message)
+	}
+	woc.addContainerOutputs(pod, node.Name, containerSet)
+
+	node = woc.wf.Status.Nodes[nodeID]
+	if !node.Fulfilled() {
+		return
+	}
+	woc.failStrandedContainerNodes(node.Name, containerSet)
+	if node.Phase == wfv1.NodeSucceeded || node.Phase == wfv1.NodeFailed {
+		// report the container which failed, rather than the message inferred from the pod, which treats the
+		// containers of the set as sidecars, e.g. ignoring them when they are killed
+		for _, ctr := range containerSet.Containers {
+			ctrNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(node.Name, ctr.Name))
+			if ctrNode != nil && ctrNode.Phase == wfv1.NodeFailed {
+				node.Phase = wfv1.NodeFailed
+				node.Message = pod.Annotations[common.AnnotationKeyNodeMessage]
+				if node.Message == "" {
+					node.Message = fmt.Sprintf("container '%s' %s", ctr.Name, ctrNode.Message)
+				}
+				woc.wf.Status.Nodes[nodeID] = node
+				woc.updated = true
+				break
+			}
+		}
+	}
+	woc.completeContainerSetNodes(node)
+}
+
+// addContainerOutputs sets the outputs of the succeeded container nodes from the outputs which the wait container
+// collected. The annotation is only decoded once a container which has outputs is waiting for them.
+func (woc *wfOperationCtx) addContainerOutputs(pod *apiv1.Pod, podNodeName string, containerSet *wfv1.ContainerSetTemplate) {
+	outputsStr, ok := pod.Annotations[common.AnnotationKeyContainerOutputs]
+	if !ok {
+		return
+	}
+	var outputs map[string]wfv1.Outputs
+	for _, ctr := range containerSet.Containers {
+		ctrNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(podNodeName, ctr.Name))
+		if ctrNode == nil || ctrNode.Phase != wfv1.NodeSucceeded || ctrNode.Outputs != nil || !ctr.Outputs.HasOutputs() {
+			continue
+		}
+		if outputs == nil {
+			if err := json.Unmarshal([]byte(outputsStr), &outputs); err != nil {
+				woc.log.Warnf("Failed to decode the container outputs of pod %s: %v", pod.Name, err)
+				return
+			}
+		}
+		ctrOutputs, ok := outputs[ctr.Name]
+		if !ok {
+			continue
+		}
+		ctrNode.Outputs = &ctrOutputs
+		woc.wf.Status.Nodes[ctrNode.ID] = *ctrNode
+		woc.updated = true
+	}
+}
+
+// failStrandedContainerNodes fails the container nodes which did not complete when their pod terminated, because a
+// container they depend on failed without recording its exit code, e.g. because it was killed, so they were never run.
+// The nodes which depend on a failed node are failed in turn.
+func (woc *wfOperationCtx) failStrandedContainerNodes(podNodeName string, containerSet *wfv1.ContainerSetTemplate) {
+	for failed := true; failed; {
+		failed = false
+		for _, ctr := range containerSet.Containers {
+			ctrNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(podNodeName, ctr.Name))
+			if ctrNode == nil || ctrNode.Fulfilled() {
+				continue
+			}
+			for _, dep := range ctr.Dependencies {
+				depNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(podNodeName, dep))
+				if depNode != nil && depNode.FailedOrError() {
+					woc.markNodePhase(ctrNode.Name, wfv1.NodeFailed, fmt.Sprintf("dependency '%s' failed without recording its exit code", dep))
+					failed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// containerDependenciesSucceeded returns whether all of the given containers of a container set have succeeded
+func (woc *wfOperationCtx) containerDependenciesSucceeded(nodeName string, dependencies []string) bool {
+	for _, dep := range dependencies {
+		depNode := woc.wf.GetNodeByName(common.GenerateContainerNodeName(nodeName, dep))
+		if depNode == nil || depNode.Phase != wfv1.NodeSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// containerNodePhase returns the phase and message of a container node from the status of its container
+func containerNodePhase(ctrStatus apiv1.ContainerStatus) (wfv1.NodePhase, string) {
+	switch {
+	case ctrStatus.State.Terminated != nil:
+		terminated := ctrStatus.State.Terminated
+		if terminated.ExitCode == 0 {
+			// the executor exits with zero and a message when a container is not run because a dependency failed
+			if strings.HasPrefix(terminated.Message, common.ContainerOmittedMessagePrefix) {
+				return wfv1.NodeOmitted, terminated.Message
+			}
+			return wfv1.NodeSucceeded, ""
+		}
+		if terminated.Message != "" {
+			return wfv1.NodeFailed, terminated.Message
+		}
+		if terminated.Reason == "OOMKilled" {
+			return wfv1.NodeFailed, terminated.Reason
+		}
+		return wfv1.NodeFailed, fmt.Sprintf("failed with exit code %d", terminated.ExitCode)
+	case ctrStatus.State.Running != nil:
+		return wfv1.NodeRunning, ""
+	default:
+		return wfv1.NodePending, ""
+	}
+}
+
+// completeContainerSetNodes completes the container nodes of a fulfilled pod node which are not fulfilled, e.g.
+// because the pod was deleted or exceeded its deadline
+func (woc *wfOperationCtx) completeContainerSetNodes(podNode wfv1.NodeStatus) {
+	if !podNode.Fulfilled() {
+		return
+	}
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypeContainer || node.BoundaryID != podNode.ID || node.Fulfilled() {
+			continue
+		}
+		phase := podNode.Phase
+		if phase == wfv1.NodeSucceeded {
+			phase = wfv1.NodeOmitted
+		}
+		woc.markNodePhase(node.Name, phase, podNode.Message)
+	}
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var containerSetWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: container-set
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    volumes:
+    - name: workspace
+      emptyDir: {}
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: a
+        image: argoproj/argosay:v2
+        command: [echo]
+        args: [a]
+      - name: b
+        image: argoproj/argosay:v2
+        command: [sh, -c]
+        args: ["echo b > /workspace/message"]
+        dependencies: [a]
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /workspace/message
+`
+
+// setContainerStatuses acts like the kubelet and sets the phase and container statuses of the workflow's only pod
+func setContainerStatuses(t *testing.T, controller *WorkflowController, phase apiv1.PodPhase, ctrStatuses ...apiv1.ContainerStatus) {
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		pod.Status.Phase = phase
+		pod.Status.ContainerStatuses = ctrStatuses
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+}
+
+func runningContainer(name string) apiv1.ContainerStatus {
+	return apiv1.ContainerStatus{Name: name, State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}}}
+}
+
+func terminatedContainer(name string, exitCode int32, message string) apiv1.ContainerStatus {
+	return apiv1.ContainerStatus{Name: name, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: exitCode, Message: message}}}
+}
+
+func TestContainerSetPod(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	pods, err := controller.kubeclientset.CoreV1().Pods("").List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		if assert.Len(t, pod.Spec.InitContainers, 1) {
+			assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.VarRunArgoPath})
+		}
+		var names []string
+		for _, ctr := range pod.Spec.Containers {
+			names = append(names, ctr.Name)
+			switch ctr.Name {
+			case "a":
+				assert.Equal(t, []string{common.VarRunArgoExecutorPath, "container-set-run", "--name", "a", "--", "echo", "a"}, ctr.Command)
+				assert.Empty(t, ctr.Args)
+				assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: "workspace", MountPath: "/workspace"})
+				assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.VarRunArgoPath})
+			case "b":
+				assert.Equal(t, []string{common.VarRunArgoExecutorPath, "container-set-run", "--name", "b", "--dependencies", "a", "--", "sh", "-c", "echo b > /workspace/message"}, ctr.Command)
+			case common.WaitContainerName:
+				assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: "workspace", MountPath: "/mainctrfs/workspace"})
+				assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.VarRunArgoPath})
+			}
+		}
+		assert.ElementsMatch(t, []string{common.WaitContainerName, "a", "b"}, names)
+	}
+}
+
+func TestContainerSetNodes(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podNode := woc.wf.GetNodeByName("container-set")
+	nodeA := woc.wf.GetNodeByName("container-set.a")
+	nodeB := woc.wf.GetNodeByName("container-set.b")
+	if assert.NotNil(t, podNode) && assert.NotNil(t, nodeA) && assert.NotNil(t, nodeB) {
+		assert.Equal(t, wfv1.NodeTypeContainer, nodeA.Type)
+		assert.Equal(t, "a", nodeA.DisplayName)
+		assert.Equal(t, []string{nodeA.ID}, podNode.Children)
+		assert.Equal(t, []string{nodeB.ID}, nodeA.Children)
+	}
+
+	setContainerStatuses(t, controller, apiv1.PodRunning, runningContainer("a"), runningContainer("b"))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("container-set.a").Phase)
+	// b is waiting for a
+	assert.Equal(t, wfv1.NodePending, woc.wf.GetNodeByName("container-set.b").Phase)
+
+	setContainerStatuses(t, controller, apiv1.PodRunning, terminatedContainer("a", 0, ""), runningContainer("b"))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("container-set.a").Phase)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("container-set.b").Phase)
+
+	setContainerStatuses(t, controller, apiv1.PodFailed, terminatedContainer("a", 0, ""), terminatedContainer("b", 2, ""))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("container-set.b").Phase)
+	podNode = woc.wf.GetNodeByName("container-set")
+	assert.Equal(t, wfv1.NodeFailed, podNode.Phase)
+	assert.Equal(t, "container 'b' failed with exit code 2", podNode.Message)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+}
+
+func TestContainerSetOmittedNodes(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	setContainerStatuses(t, controller, apiv1.PodFailed, terminatedContainer("a", 1, ""), terminatedContainer("b", 0, "omitted: dependency 'a' did not succeed"))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("container-set.a").Phase)
+	nodeB := woc.wf.GetNodeByName("container-set.b")
+	assert.Equal(t, wfv1.NodeOmitted, nodeB.Phase)
+	assert.Equal(t, "omitted: dependency 'a' did not succeed", nodeB.Message)
+	assert.Equal(t, "container 'a' failed with exit code 1", woc.wf.GetNodeByName("container-set").Message)
+}
+
+func TestContainerSetPodDeleted(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	setContainerStatuses(t, controller, apiv1.PodRunning, runningContainer("a"), runningContainer("b"))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		assert.NoError(t, podcs.Delete(pod.Name, &metav1.DeleteOptions{}))
+	}
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeError, woc.wf.GetNodeByName("container-set").Phase)
+	assert.Equal(t, wfv1.NodeError, woc.wf.GetNodeByName("container-set.a").Phase)
+	assert.Equal(t, wfv1.NodeError, woc.wf.GetNodeByName("container-set.b").Phase)
+}
+
+func TestContainerSetOutputs(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf.Spec.Templates[0].ContainerSet.Containers[1].Outputs = wfv1.Outputs{
+		Parameters: []wfv1.Parameter{{Name: "message", ValueFrom: &wfv1.ValueFrom{Path: "/workspace/message"}}},
+	}
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods("")
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		pod.Annotations[common.AnnotationKeyContainerOutputs] = `{"b":{"parameters":[{"name":"message","value":"b","valueFrom":{"path":"/workspace/message"}}]}}`
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+	setContainerStatuses(t, controller, apiv1.PodSucceeded, terminatedContainer("a", 0, ""), terminatedContainer("b", 0, ""))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Nil(t, woc.wf.GetNodeByName("container-set.a").Outputs)
+	nodeB := woc.wf.GetNodeByName("container-set.b")
+	if assert.Equal(t, wfv1.NodeSucceeded, nodeB.Phase) && assert.NotNil(t, nodeB.Outputs) && assert.Len(t, nodeB.Outputs.Parameters, 1) {
+		assert.Equal(t, "b", nodeB.Outputs.Parameters[0].Value.String())
+	}
+}
+
+func TestContainerSetStrandedNodes(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(containerSetWf)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	// a is killed before it records its exit code, so b is still waiting for it when the pod terminates
+	setContainerStatuses(t, controller, apiv1.PodFailed, terminatedContainer("a", 137, ""))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("container-set.a").Phase)
+	nodeB := woc.wf.GetNodeByName("container-set.b")
+	assert.Equal(t, wfv1.NodeFailed, nodeB.Phase)
+	assert.Equal(t, "dependency 'a' failed without recording its exit code", nodeB.Message)
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.GetNodeByName("container-set").Phase)
+}
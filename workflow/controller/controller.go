@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
@@ -79,6 +80,7 @@ type WorkflowController struct {
 	wftmplInformer        wfextvv1alpha1.WorkflowTemplateInformer
 	cwftmplInformer       wfextvv1alpha1.ClusterWorkflowTemplateInformer
 	podInformer           cache.SharedIndexInformer
+	nsInformer            cache.SharedIndexInformer
 	wfQueue               workqueue.RateLimitingInterface
 	podQueue              workqueue.RateLimitingInterface
 	completedPods         chan string
@@ -89,10 +91,21 @@ type WorkflowController struct {
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
 	syncManager           *sync.SyncManager
+	syncLockRepo          sqldb.SyncLockRepo
 	metrics               *metrics.Metrics
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.CacheFactory
+	httpRequests          *httpRequests
+
+	// containerSets caches the container sets decoded from the template annotations of pods
+	containerSets *utilcache.Expiring
+	// archivedDependencies caches the phases of the archived workflows that waiting workflows depend on, so that the
+	// archive is not queried every time they are operated on
+	archivedDependencies *utilcache.Expiring
+
+	// podCreationRateLimiter limits the rate the workflow pods are created at, or is nil if there is no limit
+	podCreationRateLimiter *podCreationRateLimiter
 }
 
 const (
@@ -100,43 +113,66 @@ const (
 	workflowTemplateResyncPeriod        = 20 * time.Minute
 	podResyncPeriod                     = 30 * time.Minute
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
+	// the lease of the database locks must be several times longer than this
+	syncLockLeaseRenewalPeriod = 10 * time.Second
 )
 
+// the indexes of the workflow informer
+var workflowIndexers = cache.Indexers{
+	cache.NamespaceIndex:        cache.MetaNamespaceIndexFunc,
+	waitingForDependenciesIndex: waitingForDependenciesIndexFunc,
+}
+
 // NewWorkflowController instantiates a new WorkflowController
 func NewWorkflowController(restConfig *rest.Config, kubeclientset kubernetes.Interface, wfclientset wfclientset.Interface, namespace, managedNamespace, executorImage, executorImagePullPolicy, containerRuntimeExecutor, configMap string) (*WorkflowController, error) {
 	dynamicInterface, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
 	}
+	return newWorkflowController(restConfig, kubeclientset, dynamicInterface, wfclientset, namespace, managedNamespace, func(wfc *WorkflowController) error {
+		wfc.cliExecutorImage = executorImage
+		wfc.cliExecutorImagePullPolicy = executorImagePullPolicy
+		wfc.containerRuntimeExecutor = containerRuntimeExecutor
+		wfc.configController = config.NewController(namespace, configMap, kubeclientset)
+		wfc.UpdateConfig()
+		return nil
+	})
+}
 
-	wfc := WorkflowController{
-		restConfig:                 restConfig,
-		kubeclientset:              kubeclientset,
-		dynamicInterface:           dynamicInterface,
-		wfclientset:                wfclientset,
-		namespace:                  namespace,
-		managedNamespace:           managedNamespace,
-		cliExecutorImage:           executorImage,
-		cliExecutorImagePullPolicy: executorImagePullPolicy,
-		containerRuntimeExecutor:   containerRuntimeExecutor,
-		configController:           config.NewController(namespace, configMap, kubeclientset),
-		completedPods:              make(chan string, 512),
-		gcPods:                     make(chan string, 512),
-		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
-		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
+// newWorkflowController creates the controller the cluster and local controllers have in common. The configure func
+// is called once the clients are set, and must configure the controller, as the metrics and queues need the config.
+func newWorkflowController(restConfig *rest.Config, kubeclientset kubernetes.Interface, dynamicInterface dynamic.Interface, wfclientset wfclientset.Interface, namespace, managedNamespace string, configure func(wfc *WorkflowController) error) (*WorkflowController, error) {
+	wfc := &WorkflowController{
+		restConfig:           restConfig,
+		kubeclientset:        kubeclientset,
+		dynamicInterface:     dynamicInterface,
+		wfclientset:          wfclientset,
+		namespace:            namespace,
+		managedNamespace:     managedNamespace,
+		completedPods:        make(chan string, 512),
+		gcPods:               make(chan string, 512),
+		cacheFactory:         controllercache.NewCacheFactory(kubeclientset, namespace),
+		eventRecorderManager: events.NewEventRecorderManager(kubeclientset),
+	}
+
+	// configures the cache factory, amongst others, before anything can use it
+	err := configure(wfc)
+	if err != nil {
+		return nil, err
 	}
 
-	wfc.UpdateConfig()
-
 	wfc.metrics = metrics.New(wfc.getMetricsServerConfig())
 
 	workqueue.SetProvider(wfc.metrics)
 	wfc.wfQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "workflow_queue")
-	wfc.throttler = sync.NewThrottler(0, wfc.wfQueue)
+	wfc.throttler = sync.NewNamespaceThrottler(0, wfc.getNamespaceParallelism, wfc.metrics.NamespaceWorkflows, wfc.wfQueue)
 	wfc.throttler.SetParallelism(wfc.getParallelism())
 	wfc.podQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "pod_queue")
+	wfc.httpRequests = newHTTPRequests(func(wfKey string) { wfc.wfQueue.Add(wfKey) })
+	wfc.containerSets = utilcache.NewExpiring()
+	wfc.archivedDependencies = utilcache.NewExpiring()
 
-	return &wfc, nil
+	return wfc, nil
 }
 
 // RunTTLController runs the workflow TTL controller
@@ -161,27 +197,36 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, podWorkers in
 	log.WithField("version", argo.GetVersion().Version).Info("Starting Workflow Controller")
 	log.Infof("Workers: workflow: %d, pod: %d", wfWorkers, podWorkers)
 
-	wfc.wfInformer = util.NewWorkflowInformer(wfc.restConfig, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions)
+	wfc.wfInformer = util.NewWorkflowInformer(wfc.restConfig, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions, workflowIndexers)
 	wfc.wftmplInformer = informer.NewTolerantWorkflowTemplateInformer(wfc.dynamicInterface, workflowTemplateResyncPeriod, wfc.managedNamespace)
 
 	wfc.addWorkflowInformerHandlers()
 	wfc.podInformer = wfc.newPodInformer()
+	wfc.nsInformer = wfc.newNamespaceInformer()
 
 	go wfc.configController.Run(ctx.Done(), wfc.updateConfig)
 	go wfc.wfInformer.Run(ctx.Done())
 	go wfc.wftmplInformer.Informer().Run(ctx.Done())
 	go wfc.podInformer.Run(ctx.Done())
+	if wfc.nsInformer != nil {
+		go wfc.nsInformer.Run(ctx.Done())
+	}
 	go wfc.podLabeler(ctx.Done())
 	go wfc.podGarbageCollector(ctx.Done())
 	go wfc.workflowGarbageCollector(ctx.Done())
 	go wfc.archivedWorkflowGarbageCollector(ctx.Done())
+	go wfc.syncLockLeaseRenewer(ctx.Done())
 
 	go wfc.runTTLController(ctx)
 	go wfc.runCronController(ctx)
 	go wfc.metrics.RunServer(ctx)
 
 	// Wait for all involved caches to be synced, before processing items from the queue is started
-	for _, informer := range []cache.SharedIndexInformer{wfc.wfInformer, wfc.wftmplInformer.Informer(), wfc.podInformer} {
+	informers := []cache.SharedIndexInformer{wfc.wfInformer, wfc.wftmplInformer.Informer(), wfc.podInformer}
+	if wfc.nsInformer != nil {
+		informers = append(informers, wfc.nsInformer)
+	}
+	for _, informer := range informers {
 		if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
 			log.Error("Timed out waiting for caches to sync")
 			return
@@ -227,6 +272,13 @@ func (wfc *WorkflowController) createSynchronizationManager() error {
 	wfc.syncManager = sync.NewLockManager(syncLimitConfig, func(key string) {
 		wfc.wfQueue.AddAfter(key, enoughTimeForInformerSync)
 	})
+	wfc.syncManager.SetDatabase(func() sqldb.SyncLockRepo { return wfc.syncLockRepo }, func(key string) {
+		pollInterval := config.SyncConfig{}.GetPollInterval()
+		if wfc.Config.Persistence != nil && wfc.Config.Persistence.Synchronization != nil {
+			pollInterval = wfc.Config.Persistence.Synchronization.GetPollInterval()
+		}
+		wfc.wfQueue.AddAfter(key, pollInterval)
+	})
 
 	labelSelector := v1Label.NewSelector()
 	req, _ := v1Label.NewRequirement(common.LabelKeyPhase, selection.Equals, []string{string(wfv1.NodeRunning)})
@@ -422,6 +474,46 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 	}
 }
 
+// syncLockLeaseRenewer renews the lease of the database locks held by the controller, so that the other controllers
+// of the database do not release them
+func (wfc *WorkflowController) syncLockLeaseRenewer(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(syncLockLeaseRenewalPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			expired, err := wfc.syncLockRepo.Heartbeat()
+			if err != nil {
+				log.WithError(err).Error("Failed to renew the lease of the database locks")
+			}
+			if expired {
+				log.Warn("The lease of the database locks had expired, the workflows holding locks check they still hold them")
+				wfc.queueSynchronizedWorkflows()
+			}
+		}
+	}
+}
+
+// queueSynchronizedWorkflows queues the workflows which hold, or wait for, synchronization locks. They acquire them
+// again, or wait for them, when they are processed.
+func (wfc *WorkflowController) queueSynchronizedWorkflows() {
+	for _, obj := range wfc.wfInformer.GetIndexer().List() {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if _, ok, _ := unstructured.NestedMap(un.Object, "status", "synchronization"); !ok {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(un)
+		if err == nil {
+			wfc.wfQueue.Add(key)
+		}
+	}
+}
+
 func (wfc *WorkflowController) runWorker() {
 	for wfc.processNextItem() {
 	}
@@ -453,9 +545,12 @@ func (wfc *WorkflowController) processNextItem() bool {
 		return true
 	}
 
-	if key, ok = wfc.throttler.Next(key); !ok {
-		log.WithFields(log.Fields{"key": key}).Warn("Workflow processing has been postponed due to max parallelism limit")
-		return true
+	// workflows with dependencies are only admitted by the throttler once they are met, see below
+	if !hasDependencies(un) {
+		if key, ok = wfc.throttler.Next(key); !ok {
+			log.WithFields(log.Fields{"key": key}).Warn("Workflow processing has been postponed due to max parallelism limit")
+			return true
+		}
 	}
 
 	wf, err := util.FromUnstructured(un)
@@ -477,6 +572,24 @@ func (wfc *WorkflowController) processNextItem() bool {
 
 	woc := newWorkflowOperationCtx(wf, wfc)
 
+	// workflows wait for their dependencies before being admitted by the throttler or acquiring any lock, so that
+	// they neither use up the parallelism nor hold a lock a workflow they depend on needs. A waiting workflow has no
+	// nodes yet, so it is not hydrated for the check.
+	if wf.Spec.DependsOn != nil {
+		if woc.waitForDependencies() {
+			woc.persistUpdates()
+			wfc.throttler.Remove(key)
+			return true
+		}
+		// the workflow was removed from the throttler while it waited
+		priority, creationTime := getWfPriority(un)
+		wfc.throttler.Add(key, priority, creationTime)
+		if key, ok = wfc.throttler.Next(key); !ok {
+			log.WithFields(log.Fields{"key": key}).Warn("Workflow processing has been postponed due to max parallelism limit")
+			return true
+		}
+	}
+
 	err = wfc.hydrator.Hydrate(woc.wf)
 	if err != nil {
 		woc.log.Errorf("hydration failed: %v", err)
@@ -622,6 +735,17 @@ func getWfPhase(obj interface{}) wfv1.NodePhase {
 	return wfv1.NodePhase(phase)
 }
 
+// addToThrottler adds the workflow to the throttler, unless it is waiting for its dependencies, which it does before
+// it is admitted
+func (wfc *WorkflowController) addToThrottler(key string, obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if ok && isWaitingForDependencies(un) {
+		return
+	}
+	priority, creation := getWfPriority(obj)
+	wfc.throttler.Add(key, priority, creation)
+}
+
 func (wfc *WorkflowController) addWorkflowInformerHandlers() {
 	wfc.wfInformer.AddEventHandler(
 		cache.FilteringResourceEventHandler{
@@ -633,8 +757,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers() {
 					key, err := cache.MetaNamespaceKeyFunc(obj)
 					if err == nil {
 						wfc.wfQueue.Add(key)
-						priority, creation := getWfPriority(obj)
-						wfc.throttler.Add(key, priority, creation)
+						wfc.addToThrottler(key, obj)
 					}
 				},
 				UpdateFunc: func(old, new interface{}) {
@@ -646,8 +769,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers() {
 					key, err := cache.MetaNamespaceKeyFunc(new)
 					if err == nil {
 						wfc.wfQueue.Add(key)
-						priority, creation := getWfPriority(new)
-						wfc.throttler.Add(key, priority, creation)
+						wfc.addToThrottler(key, new)
 					}
 				},
 				DeleteFunc: func(obj interface{}) {
@@ -656,6 +778,7 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers() {
 					key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 					if err == nil {
 						wfc.releaseAllWorkflowLocks(obj)
+						wfc.httpRequests.forgetWorkflow(key)
 						wfc.wfQueue.Add(key)
 						wfc.throttler.Remove(key)
 					}
@@ -663,6 +786,23 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers() {
 			},
 		},
 	)
+	// workflows waiting for their dependencies are queued whenever another workflow is created or changes phase
+	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: wfc.enqueueDependentWorkflows,
+		UpdateFunc: func(old, new interface{}) {
+			if getWfPhase(old) != getWfPhase(new) {
+				wfc.enqueueDependentWorkflows(new)
+			}
+		},
+	})
+	// child workflows, created by workflow templates, are watched regardless of whether they have completed
+	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: wfc.enqueueParentWorkflow,
+		UpdateFunc: func(_, new interface{}) {
+			wfc.enqueueParentWorkflow(new)
+		},
+		DeleteFunc: wfc.enqueueParentWorkflow,
+	})
 	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			key, err := cache.MetaNamespaceKeyFunc(obj)
@@ -717,7 +857,7 @@ func (wfc *WorkflowController) newWorkflowPodWatch() *cache.ListWatch {
 
 func (wfc *WorkflowController) newPodInformer() cache.SharedIndexInformer {
 	source := wfc.newWorkflowPodWatch()
-	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{})
+	informer := cache.NewSharedIndexInformer(source, &apiv1.Pod{}, podResyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
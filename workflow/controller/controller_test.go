@@ -15,6 +15,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
@@ -121,7 +122,7 @@ var _ events.EventRecorderManager = &testEventRecorderManager{}
 func newController(objects ...runtime.Object) (context.CancelFunc, *WorkflowController) {
 	wfclientset := fakewfclientset.NewSimpleClientset(objects...)
 	informerFactory := wfextv.NewSharedInformerFactory(wfclientset, 10*time.Minute)
-	wfInformer := cache.NewSharedIndexInformer(nil, nil, 0, nil)
+	wfInformer := cache.NewSharedIndexInformer(nil, nil, 0, workflowIndexers)
 	wftmplInformer := informerFactory.Argoproj().V1alpha1().WorkflowTemplates()
 	cwftmplInformer := informerFactory.Argoproj().V1alpha1().ClusterWorkflowTemplates()
 	ctx, cancel := context.WithCancel(context.Background())
@@ -148,6 +149,7 @@ func newController(objects ...runtime.Object) (context.CancelFunc, *WorkflowCont
 		wfQueue:              workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		podQueue:             workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		wfArchive:            sqldb.NullWorkflowArchive,
+		syncLockRepo:         sqldb.NullSyncLockRepo,
 		hydrator:             hydratorfake.Noop,
 		metrics:              metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
 		eventRecorderManager: &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(16)},
@@ -155,6 +157,9 @@ func newController(objects ...runtime.Object) (context.CancelFunc, *WorkflowCont
 		cacheFactory:         controllercache.NewCacheFactory(kube, "default"),
 	}
 	controller.podInformer = controller.newPodInformer()
+	controller.httpRequests = newHTTPRequests(func(wfKey string) { controller.wfQueue.Add(wfKey) })
+	controller.containerSets = utilcache.NewExpiring()
+	controller.archivedDependencies = utilcache.NewExpiring()
 	return cancel, controller
 }
 
@@ -443,3 +448,17 @@ func TestReleaseAllWorkflowLocks(t *testing.T) {
 		controller.releaseAllWorkflowLocks(un)
 	})
 }
+
+func TestQueueSynchronizedWorkflows(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	holding := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "holding", Namespace: "default"}}
+	holding.Status.Synchronization = &wfv1.SynchronizationStatus{Semaphore: &wfv1.SemaphoreStatus{Holding: []wfv1.SemaphoreHolding{{Semaphore: "default/ConfigMap/my-config/workflow", Holders: []string{"default/holding"}}}}}
+	setChildWorkflow(t, controller, holding)
+	setChildWorkflow(t, controller, &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}})
+	controller.queueSynchronizedWorkflows()
+	if assert.Equal(t, 1, controller.wfQueue.Len()) {
+		key, _ := controller.wfQueue.Get()
+		assert.Equal(t, "default/holding", key)
+	}
+}
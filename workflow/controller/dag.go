@@ -164,6 +164,9 @@ func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes) wfv1
 			if childNode := getChildNodeIndex(&node, nodes, -1); childNode != nil {
 				uniqueQueue.add(generatePhaseNodes(childNode.Children, branchPhase)...)
 			}
+			// The lifecycle hooks of the task are children of the Retry node rather than of an attempt, so they need to be
+			// fulfilled as well
+			uniqueQueue.add(generatePhaseNodes(lifecycleHookNodeIDs(&node, nodes), branchPhase)...)
 		} else {
 			uniqueQueue.add(generatePhaseNodes(node.Children, branchPhase)...)
 		}
@@ -246,6 +249,12 @@ func (woc *wfOperationCtx) executeDAG(nodeName string, tmplCtx *templateresoluti
 		}
 	}
 
+	// run the lifecycle hooks of the tasks, which are then considered by assessDAGPhase
+	err := woc.executeDAGLifecycleHooks(dagCtx)
+	if err != nil {
+		return node, err
+	}
+
 	// check if we are still running any tasks in this dag and return early if we do
 	dagPhase := dagCtx.assessDAGPhase(targetTasks, woc.wf.Status.Nodes)
 	switch dagPhase {
@@ -0,0 +1,292 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// the index of the workflow informer of the workflows waiting for their dependencies
+const waitingForDependenciesIndex = "waitingForDependencies"
+
+const (
+	// maxArchivedDependencies is the maximum number of archived workflows a dependency is checked against
+	maxArchivedDependencies = 100
+	// archivedDependenciesTTL is how long the phases of the archived workflows a dependency selects are cached for
+	archivedDependenciesTTL = time.Minute
+)
+
+// waitForDependencies returns true if the workflow has not started yet and must keep waiting for the workflows it
+// depends on. While it waits the workflow is Pending with the DependenciesNotMet condition. It fails once its
+// dependencies time out, or can no longer be met because a workflow it depends on completed in another phase. A
+// waiting workflow which is stopped, terminated or passes its deadline fails without waiting any longer.
+func (woc *wfOperationCtx) waitForDependencies() bool {
+	waiting := woc.isWaitingForDependencies()
+	if woc.wf.Spec.DependsOn == nil || (woc.wf.Status.Phase != "" && !waiting) {
+		return false
+	}
+	if woc.wf.Spec.Shutdown != "" {
+		woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeDependenciesNotMet)
+		woc.markWorkflowFailed(fmt.Sprintf("Stopped with strategy '%s'", woc.wf.Spec.Shutdown))
+		return true
+	}
+	if deadline := woc.getWorkflowDeadline(); deadline != nil {
+		if !time.Now().Before(*deadline) {
+			woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeDependenciesNotMet)
+			woc.markWorkflowFailed(fmt.Sprintf("dependencies not met within the deadline of %ds", *woc.execWf.Spec.ActiveDeadlineSeconds))
+			return true
+		}
+		woc.requeue(time.Until(*deadline))
+	}
+	unmet, err := woc.unmetDependencies()
+	if err != nil {
+		woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeDependenciesNotMet)
+		woc.markWorkflowFailed(fmt.Sprintf("dependencies cannot be met: %s", err))
+		return true
+	}
+	if len(unmet) == 0 {
+		if waiting {
+			woc.log.Infof("Dependencies met, starting workflow")
+			woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeDependenciesNotMet)
+			// the workflow starts now, rather than when it started waiting
+			woc.wf.Status.Phase = ""
+			woc.wf.Status.StartedAt = metav1.Time{}
+			woc.updated = true
+		}
+		return false
+	}
+	message := fmt.Sprintf("waiting for %s", strings.Join(unmet, ", "))
+	if timeoutSeconds := woc.wf.Spec.DependsOn.TimeoutSeconds; timeoutSeconds != nil {
+		deadline := woc.wf.CreationTimestamp.Add(time.Duration(*timeoutSeconds) * time.Second)
+		if !time.Now().Before(deadline) {
+			woc.wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeDependenciesNotMet)
+			woc.markWorkflowFailed(fmt.Sprintf("dependencies not met within %ds, %s", *timeoutSeconds, message))
+			return true
+		}
+		woc.requeue(time.Until(deadline))
+	}
+	condition := wfv1.Condition{Type: wfv1.ConditionTypeDependenciesNotMet, Status: metav1.ConditionTrue, Message: message}
+	if !hasCondition(woc.wf.Status.Conditions, condition) {
+		woc.wf.Status.Conditions.UpsertCondition(condition)
+		woc.updated = true
+	}
+	woc.markWorkflowPhase(wfv1.NodePending, false, message)
+	return true
+}
+
+func (woc *wfOperationCtx) isWaitingForDependencies() bool {
+	for _, condition := range woc.wf.Status.Conditions {
+		if condition.Type == wfv1.ConditionTypeDependenciesNotMet && condition.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func hasCondition(conditions wfv1.Conditions, condition wfv1.Condition) bool {
+	for _, c := range conditions {
+		if c == condition {
+			return true
+		}
+	}
+	return false
+}
+
+// unmetDependencies returns a description of each of the dependencies of the workflow which have not been met yet,
+// or an error if one of them cannot be met anymore
+func (woc *wfOperationCtx) unmetDependencies() ([]string, error) {
+	objs, err := woc.controller.wfInformer.GetIndexer().ByIndex(cache.NamespaceIndex, woc.wf.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var workflows []*unstructured.Unstructured
+	for _, obj := range objs {
+		un, ok := obj.(*unstructured.Unstructured)
+		if ok && un.GetName() != woc.wf.Name {
+			workflows = append(workflows, un)
+		}
+	}
+	// creation timestamps only have a precision of a second
+	createdAt := woc.wf.CreationTimestamp.Truncate(time.Second)
+	var unmet []string
+	for i, dependency := range woc.wf.Spec.DependsOn.Workflows {
+		phase := dependency.GetPhase()
+		candidates := workflows
+		if dependency.IgnoreOlder {
+			candidates = nil
+			for _, un := range workflows {
+				if !un.GetCreationTimestamp().Time.Before(createdAt) {
+					candidates = append(candidates, un)
+				}
+			}
+		}
+		// workflow name -> phase
+		phases := make(map[string]wfv1.NodePhase)
+		var selector labels.Selector
+		var description string
+		if dependency.Selector != nil {
+			selector, err = metav1.LabelSelectorAsSelector(dependency.Selector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector: %w", err)
+			}
+			for _, un := range candidates {
+				if selector.Matches(labels.Set(un.GetLabels())) {
+					phases[un.GetName()] = getWfPhase(un)
+				}
+			}
+			description = fmt.Sprintf("workflows matching '%s' to be %s", selector, phase)
+		} else {
+			for _, un := range candidates {
+				if un.GetName() == dependency.Name {
+					phases[un.GetName()] = getWfPhase(un)
+				}
+			}
+			description = fmt.Sprintf("workflow %s to be %s", dependency.Name, phase)
+		}
+		if len(phases) == 0 {
+			// the workflows may have completed, and been garbage collected since
+			phases, err = woc.archivedDependencyPhases(i, dependency, selector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get archived workflows: %w", err)
+			}
+		}
+		met := len(phases) > 0
+		for name, p := range phases {
+			reached, possible := phaseReached(p, phase)
+			if !possible {
+				return nil, fmt.Errorf("workflow %s is %s rather than %s", name, p, phase)
+			}
+			met = met && reached
+		}
+		if !met {
+			unmet = append(unmet, description)
+		}
+	}
+	return unmet, nil
+}
+
+// archivedDependencyPhases returns the phases of the archived workflows the dependency, the index-th of the workflow,
+// selects, by their names. If the dependency ignores older workflows, only the workflows which started after this
+// workflow was created are considered. Of the workflows archived with the same name, it returns the phase of the latest
+// one. The phases are cached for a while, as the archive may be large.
+func (woc *wfOperationCtx) archivedDependencyPhases(index int, dependency wfv1.WorkflowDependency, selector labels.Selector) (map[string]wfv1.NodePhase, error) {
+	key := fmt.Sprintf("%s/%d", woc.wf.UID, index)
+	if phases, ok := woc.controller.archivedDependencies.Get(key); ok {
+		return phases.(map[string]wfv1.NodePhase), nil
+	}
+	options := sqldb.ListWorkflowsOptions{
+		Namespace: woc.wf.Namespace,
+		Name:      dependency.Name,
+		Limit:     maxArchivedDependencies,
+	}
+	if dependency.IgnoreOlder {
+		options.MinStartedAt = woc.wf.CreationTimestamp.Time
+	}
+	if selector != nil {
+		options.Name = ""
+		options.LabelRequirements, _ = selector.Requirements()
+	}
+	wfs, err := woc.controller.wfArchive.ListWorkflows(options)
+	if err != nil {
+		return nil, err
+	}
+	phases := make(map[string]wfv1.NodePhase)
+	// the archived workflows are listed newest first
+	for _, wf := range wfs {
+		if _, ok := phases[wf.Name]; ok {
+			continue
+		}
+		phases[wf.Name] = wf.Status.Phase
+	}
+	woc.controller.archivedDependencies.Set(key, phases, archivedDependenciesTTL)
+	return phases, nil
+}
+
+// dependsOn returns whether one of the dependencies selects the workflow
+func dependsOn(dependencies *wfv1.WorkflowDependencies, un *unstructured.Unstructured) bool {
+	for _, dependency := range dependencies.Workflows {
+		if dependency.Selector == nil {
+			if dependency.Name == un.GetName() {
+				return true
+			}
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(dependency.Selector)
+		if err == nil && selector.Matches(labels.Set(un.GetLabels())) {
+			return true
+		}
+	}
+	return false
+}
+
+// phaseReached returns whether a workflow in the phase has reached the required phase, and whether it still can
+func phaseReached(phase, required wfv1.NodePhase) (bool, bool) {
+	if required == wfv1.NodeRunning {
+		return phase == wfv1.NodeRunning || phase.Fulfilled(), true
+	}
+	return phase == required, !phase.Fulfilled() || phase == required
+}
+
+// enqueueDependentWorkflows queues the workflows in the same namespace which are waiting for their dependencies and
+// depend on the workflow, so that they start as soon as the workflow they wait for reaches its phase
+func (wfc *WorkflowController) enqueueDependentWorkflows(obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	objs, err := wfc.wfInformer.GetIndexer().ByIndex(waitingForDependenciesIndex, un.GetNamespace())
+	if err != nil {
+		log.WithError(err).Error("Failed to get the workflows waiting for their dependencies")
+		return
+	}
+	for _, item := range objs {
+		other, ok := item.(*unstructured.Unstructured)
+		if !ok || other.GetName() == un.GetName() {
+			continue
+		}
+		wf, err := util.FromUnstructured(other)
+		if err != nil || wf.Spec.DependsOn == nil || !dependsOn(wf.Spec.DependsOn, un) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(other)
+		if err == nil {
+			wfc.wfQueue.Add(key)
+		}
+	}
+}
+
+// waitingForDependenciesIndexFunc indexes the workflows which are waiting for their dependencies by their namespace
+func waitingForDependenciesIndexFunc(obj interface{}) ([]string, error) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok || !isWaitingForDependencies(un) {
+		return nil, nil
+	}
+	return []string{un.GetNamespace()}, nil
+}
+
+// hasDependencies returns whether the workflow depends on other workflows, without unmarshalling it
+func hasDependencies(un *unstructured.Unstructured) bool {
+	dependsOn, ok, _ := unstructured.NestedFieldNoCopy(un.Object, "spec", "dependsOn")
+	return ok && dependsOn != nil
+}
+
+func isWaitingForDependencies(un *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(un.Object, "status", "conditions")
+	for _, obj := range conditions {
+		condition, ok := obj.(map[string]interface{})
+		if ok && condition["type"] == string(wfv1.ConditionTypeDependenciesNotMet) && condition["status"] == string(metav1.ConditionTrue) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/sync"
+)
+
+var dependsOnWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: downstream
+spec:
+  entrypoint: main
+  dependsOn:
+    timeoutSeconds: 3600
+    workflows:
+    - name: upstream
+    - selector:
+        matchLabels:
+          team: data
+      phase: Running
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+`
+
+func newDependencyWorkflow(name string, phase wfv1.NodePhase, labels map[string]string) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, CreationTimestamp: metav1.Now()},
+		Status:     wfv1.WorkflowStatus{Phase: phase},
+	}
+}
+
+func TestWaitForDependencies(t *testing.T) {
+	start := func(t *testing.T) (*WorkflowController, *wfOperationCtx, func()) {
+		cancel, controller := newController()
+		wf := unmarshalWF(dependsOnWf)
+		wf.CreationTimestamp = metav1.Now()
+		woc := newWorkflowOperationCtx(wf, controller)
+		return controller, woc, cancel
+	}
+
+	t.Run("NotMet", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		setChildWorkflow(t, controller, newDependencyWorkflow("upstream", wfv1.NodeRunning, nil))
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodePending, woc.wf.Status.Phase)
+		assert.Equal(t, "waiting for workflow upstream to be Succeeded, workflows matching 'team=data' to be Running", woc.wf.Status.Message)
+		assert.True(t, woc.isWaitingForDependencies())
+	})
+	t.Run("Met", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		assert.True(t, woc.waitForDependencies())
+		setChildWorkflow(t, controller, newDependencyWorkflow("upstream", wfv1.NodeSucceeded, nil))
+		setChildWorkflow(t, controller, newDependencyWorkflow("ingest", wfv1.NodeFailed, map[string]string{"team": "data"}))
+		assert.False(t, woc.waitForDependencies())
+		assert.False(t, woc.isWaitingForDependencies())
+		assert.Empty(t, woc.wf.Status.Phase)
+		assert.True(t, woc.wf.Status.StartedAt.IsZero())
+		woc.operate()
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+		assert.Empty(t, woc.wf.Status.Message)
+	})
+	t.Run("CannotBeMet", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		setChildWorkflow(t, controller, newDependencyWorkflow("upstream", wfv1.NodeFailed, nil))
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "dependencies cannot be met: workflow upstream is Failed rather than Succeeded", woc.wf.Status.Message)
+		assert.False(t, woc.isWaitingForDependencies())
+	})
+	t.Run("AlreadySucceeded", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		// the workflows completed before the dependent was created
+		created := metav1.NewTime(woc.wf.CreationTimestamp.Add(-time.Hour))
+		upstream := newDependencyWorkflow("upstream", wfv1.NodeSucceeded, nil)
+		upstream.CreationTimestamp = created
+		setChildWorkflow(t, controller, upstream)
+		ingest := newDependencyWorkflow("ingest", wfv1.NodeSucceeded, map[string]string{"team": "data"})
+		ingest.CreationTimestamp = created
+		setChildWorkflow(t, controller, ingest)
+		assert.False(t, woc.waitForDependencies())
+		assert.False(t, woc.isWaitingForDependencies())
+	})
+	t.Run("IgnoreOlder", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		woc.wf.Spec.DependsOn.Workflows[1].IgnoreOlder = true
+		setChildWorkflow(t, controller, newDependencyWorkflow("upstream", wfv1.NodeSucceeded, nil))
+		// this workflow ran for an earlier dependent
+		failed := newDependencyWorkflow("old-ingest", wfv1.NodeFailed, map[string]string{"team": "data"})
+		failed.CreationTimestamp = metav1.NewTime(woc.wf.CreationTimestamp.Add(-time.Hour))
+		setChildWorkflow(t, controller, failed)
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodePending, woc.wf.Status.Phase)
+		assert.Equal(t, "waiting for workflows matching 'team=data' to be Running", woc.wf.Status.Message)
+
+		setChildWorkflow(t, controller, newDependencyWorkflow("ingest", wfv1.NodeRunning, map[string]string{"team": "data"}))
+		assert.False(t, woc.waitForDependencies())
+	})
+	t.Run("Archived", func(t *testing.T) {
+		controller, woc, cancel := start(t)
+		defer cancel()
+		woc.wf.Spec.DependsOn.Workflows[1].IgnoreOlder = true
+		wfArchive := &sqldbmocks.WorkflowArchive{}
+		wfArchive.On("ListWorkflows", sqldb.ListWorkflowsOptions{Name: "upstream", Limit: maxArchivedDependencies}).Return(wfv1.Workflows{
+			*newDependencyWorkflow("upstream", wfv1.NodeSucceeded, nil),
+			*newDependencyWorkflow("upstream", wfv1.NodeFailed, nil),
+		}, nil)
+		wfArchive.On("ListWorkflows", mock.MatchedBy(func(options sqldb.ListWorkflowsOptions) bool {
+			// only the workflows which started after the dependent was created are considered
+			return len(options.LabelRequirements) == 1 && options.MinStartedAt.Equal(woc.wf.CreationTimestamp.Time)
+		})).Return(wfv1.Workflows{*newDependencyWorkflow("ingest", wfv1.NodeSucceeded, map[string]string{"team": "data"})}, nil)
+		controller.wfArchive = wfArchive
+		assert.False(t, woc.waitForDependencies())
+		// the phases are cached rather than listed again
+		assert.False(t, woc.waitForDependencies())
+		wfArchive.AssertNumberOfCalls(t, "ListWorkflows", 2)
+	})
+	t.Run("TimedOut", func(t *testing.T) {
+		_, woc, cancel := start(t)
+		defer cancel()
+		woc.wf.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, "dependencies not met within 3600s")
+	})
+	t.Run("Terminated", func(t *testing.T) {
+		_, woc, cancel := start(t)
+		defer cancel()
+		assert.True(t, woc.waitForDependencies())
+		woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "Stopped with strategy 'Terminate'", woc.wf.Status.Message)
+		assert.False(t, woc.isWaitingForDependencies())
+	})
+	t.Run("DeadlineExceeded", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		wf := unmarshalWF(dependsOnWf)
+		wf.CreationTimestamp = metav1.Now()
+		activeDeadlineSeconds := int64(60)
+		wf.Spec.ActiveDeadlineSeconds = &activeDeadlineSeconds
+		woc := newWorkflowOperationCtx(wf, controller)
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodePending, woc.wf.Status.Phase)
+		woc.wf.Status.StartedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		assert.True(t, woc.waitForDependencies())
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "dependencies not met within the deadline of 60s", woc.wf.Status.Message)
+		assert.False(t, woc.isWaitingForDependencies())
+	})
+}
+
+func TestEnqueueDependentWorkflows(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(dependsOnWf), controller)
+	woc.wf.CreationTimestamp = metav1.Now()
+	assert.True(t, woc.waitForDependencies())
+	setChildWorkflow(t, controller, woc.wf)
+	setChildWorkflow(t, controller, newDependencyWorkflow("other", wfv1.NodeRunning, nil))
+	// a workflow waiting for another workflow is not queued
+	unrelated := newWorkflowOperationCtx(unmarshalWF(dependsOnWf), controller)
+	unrelated.wf.Name = "unrelated"
+	unrelated.wf.CreationTimestamp = metav1.Now()
+	unrelated.wf.Spec.DependsOn = &wfv1.WorkflowDependencies{Workflows: []wfv1.WorkflowDependency{{Name: "other"}}}
+	assert.True(t, unrelated.waitForDependencies())
+	setChildWorkflow(t, controller, unrelated.wf)
+
+	upstream := newDependencyWorkflow("upstream", wfv1.NodeSucceeded, nil)
+	setChildWorkflow(t, controller, upstream)
+	obj, exists, err := controller.wfInformer.GetIndexer().GetByKey("upstream")
+	if assert.NoError(t, err) && assert.True(t, exists) {
+		controller.enqueueDependentWorkflows(obj)
+		if assert.Equal(t, 1, controller.wfQueue.Len()) {
+			key, _ := controller.wfQueue.Get()
+			assert.Equal(t, "downstream", key)
+		}
+	}
+}
+
+func TestProcessNextItemWaitingForDependencies(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.throttler = sync.NewThrottler(1, workqueue.NewNamedRateLimitingQueue(nil, ""))
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Create(unmarshalWF(dependsOnWf))
+	assert.NoError(t, err)
+	wf.CreationTimestamp = metav1.Now()
+	setChildWorkflow(t, controller, wf)
+	controller.throttler.Add("downstream", 0, time.Now())
+	controller.wfQueue.Add("downstream")
+	assert.True(t, controller.processNextItem())
+
+	// the waiting workflow does not use up the parallelism
+	controller.throttler.Add("other", 0, time.Now())
+	_, ok := controller.throttler.Next("other")
+	assert.True(t, ok)
+}
@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antonmedv/expr"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/templateresolution"
+)
+
+// executeLifecycleHooks starts each hook whose expression evaluates to true, unless it has been started before, and
+// continues to execute the hooks which have been started. Each hook runs at most once, as its node name is derived
+// from its parent node and the name of the hook. It returns whether all of the started hooks are fulfilled.
+func (woc *wfOperationCtx) executeLifecycleHooks(hooks wfv1.LifecycleHooks, parentNodeName string, env map[string]interface{}, tmplCtx *templateresolution.Context, opts *executeTemplateOpts) (bool, error) {
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fulfilled := true
+	for _, name := range names {
+		hook := hooks[name]
+		hookNodeName := common.GenerateLifecycleHookNodeName(parentNodeName, name)
+		if woc.wf.GetNodeByName(hookNodeName) == nil {
+			if !woc.wf.Spec.Shutdown.ShouldExecute(opts.onExitTemplate) {
+				continue
+			}
+			run, err := evaluateLifecycleHookExpression(hook.Expression, env)
+			if err != nil {
+				return false, fmt.Errorf("hook '%s' of '%s': %w", name, parentNodeName, err)
+			}
+			if !run {
+				continue
+			}
+			woc.log.Infof("Running lifecycle hook %s of %s", name, parentNodeName)
+		}
+		hookNode, err := woc.executeTemplate(hookNodeName, &hook, tmplCtx, hook.Arguments, opts)
+		if err != nil && err != ErrParallelismReached {
+			return false, err
+		}
+		if hookNode == nil || !hookNode.Fulfilled() {
+			fulfilled = false
+		}
+		if hookNode != nil && woc.wf.GetNodeByName(parentNodeName) != nil {
+			woc.addChildNode(parentNodeName, hookNodeName)
+		}
+	}
+	return fulfilled, nil
+}
+
+// evaluateLifecycleHookExpression evaluates the expression of a lifecycle hook, which must result in a boolean
+func evaluateLifecycleHookExpression(expression string, env map[string]interface{}) (bool, error) {
+	result, err := expr.Eval(expression, env)
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate expression '%s': %s", expression, err)
+	}
+	ok, isBool := result.(bool)
+	if !isBool {
+		return false, fmt.Errorf("expression '%s' did not evaluate to a boolean", expression)
+	}
+	return ok, nil
+}
+
+// lifecycleHookEnv returns the variables of the workflow which are available to the expressions of lifecycle hooks
+func (woc *wfOperationCtx) lifecycleHookEnv(workflowStatus wfv1.NodePhase) map[string]interface{} {
+	parameters := make(map[string]interface{})
+	for key, value := range woc.globalParams {
+		if strings.HasPrefix(key, "workflow.parameters.") {
+			parameters[strings.TrimPrefix(key, "workflow.parameters.")] = value
+		}
+	}
+	return map[string]interface{}{
+		"workflow": map[string]interface{}{
+			"name":        woc.wf.Name,
+			"namespace":   woc.wf.Namespace,
+			"status":      string(workflowStatus),
+			"parameters":  parameters,
+			"labels":      woc.wf.Labels,
+			"annotations": woc.wf.Annotations,
+		},
+	}
+}
+
+// executeWorkflowLifecycleHooks executes the lifecycle hooks of the workflow. Until the entrypoint node is fulfilled,
+// `workflow.status` is the phase of the workflow; afterwards it is the final phase of the entrypoint node.
+func (woc *wfOperationCtx) executeWorkflowLifecycleHooks(tmplCtx *templateresolution.Context, node *wfv1.NodeStatus) (bool, error) {
+	if len(woc.execWf.Spec.Hooks) == 0 {
+		return true, nil
+	}
+	workflowStatus := woc.wf.Status.Phase
+	if node != nil && node.Fulfilled() {
+		workflowStatus = node.Phase
+		if workflowStatus == wfv1.NodeSkipped {
+			// treat skipped the same as Succeeded for workflow.status
+			workflowStatus = wfv1.NodeSucceeded
+		}
+	}
+	return woc.executeLifecycleHooks(woc.execWf.Spec.Hooks, woc.wf.Name, woc.lifecycleHookEnv(workflowStatus), tmplCtx, &executeTemplateOpts{})
+}
+
+// executeStepGroupLifecycleHooks executes the lifecycle hooks of the steps of a step group. The expressions may refer to
+// the status of any step of the template, e.g. `steps.build.status == "Running"`. The steps which have not started yet
+// are Pending.
+func (woc *wfOperationCtx) executeStepGroupLifecycleHooks(sgNode *wfv1.NodeStatus, nodeSteps map[string]wfv1.WorkflowStep, stepsCtx *stepsContext) (bool, error) {
+	env := woc.lifecycleHookEnv(woc.wf.Status.Phase)
+	steps := make(map[string]interface{})
+	stepsNodeName := woc.wf.Status.Nodes[stepsCtx.boundaryID].Name
+	for i, stepGroup := range stepsCtx.scope.tmpl.Steps {
+		for _, step := range stepGroup.Steps {
+			status := wfv1.NodePending
+			if stepNode := woc.wf.GetNodeByName(fmt.Sprintf("%s[%d].%s", stepsNodeName, i, step.Name)); stepNode != nil {
+				status = stepNode.Phase
+			}
+			steps[step.Name] = map[string]interface{}{"status": string(status)}
+		}
+	}
+	for _, childNodeID := range sgNode.Children {
+		childNode := woc.wf.Status.Nodes[childNodeID]
+		steps[childNode.DisplayName] = map[string]interface{}{"status": string(childNode.Phase)}
+	}
+	env["steps"] = steps
+
+	fulfilled := true
+	for _, childNodeID := range sgNode.Children {
+		childNode := woc.wf.Status.Nodes[childNodeID]
+		step, ok := nodeSteps[childNode.Name]
+		if !ok || len(step.Hooks) == 0 {
+			continue
+		}
+		hooksFulfilled, err := woc.executeLifecycleHooks(step.Hooks, childNode.Name, env, stepsCtx.tmplCtx, &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate})
+		if err != nil {
+			return false, err
+		}
+		if !hooksFulfilled {
+			fulfilled = false
+		}
+	}
+	return fulfilled, nil
+}
+
+// executeDAGLifecycleHooks executes the lifecycle hooks of the tasks of a DAG, and of each of their expansions. The
+// expressions may refer to the status of any task in the DAG, e.g. `tasks.build.status == "Running"`, the tasks which
+// have not started yet being Pending. The hook nodes are children of the task nodes, so the DAG is not fulfilled until
+// all of the started hooks are.
+func (woc *wfOperationCtx) executeDAGLifecycleHooks(dagCtx *dagContext) error {
+	env := woc.lifecycleHookEnv(woc.wf.Status.Phase)
+	tasks := make(map[string]interface{})
+	for _, task := range dagCtx.tasks {
+		status := wfv1.NodePending
+		if taskNode := dagCtx.getTaskNode(task.Name); taskNode != nil {
+			status = taskNode.Phase
+		}
+		tasks[task.Name] = map[string]interface{}{"status": string(status)}
+	}
+	env["tasks"] = tasks
+
+	for _, task := range dagCtx.tasks {
+		if len(task.Hooks) == 0 {
+			continue
+		}
+		taskNode := dagCtx.getTaskNode(task.Name)
+		if taskNode == nil {
+			continue
+		}
+		nodeNames := []string{taskNode.Name}
+		if taskNode.Type == wfv1.NodeTypeTaskGroup {
+			nodeNames = nil
+			for _, childNodeID := range taskNode.Children {
+				nodeNames = append(nodeNames, woc.wf.Status.Nodes[childNodeID].Name)
+			}
+		}
+		for _, nodeName := range nodeNames {
+			_, err := woc.executeLifecycleHooks(task.Hooks, nodeName, env, dagCtx.tmplCtx, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isLifecycleHookNode returns whether the child is one of the lifecycle hooks of the node
+func isLifecycleHookNode(node *wfv1.NodeStatus, child wfv1.NodeStatus) bool {
+	return strings.HasPrefix(child.Name, common.GenerateLifecycleHookNodeName(node.Name, ""))
+}
+
+// lifecycleHookNodeIDs returns the IDs of the children of the node which are its lifecycle hooks
+func lifecycleHookNodeIDs(node *wfv1.NodeStatus, nodes wfv1.Nodes) []string {
+	var ids []string
+	for _, childID := range node.Children {
+		if isLifecycleHookNode(node, nodes[childID]) {
+			ids = append(ids, childID)
+		}
+	}
+	return ids
+}
+
+// retryAttemptIDs returns the IDs of the attempts of a Retry node, i.e. its children other than the lifecycle hooks of
+// the step or task, which hang off the Retry node
+func retryAttemptIDs(node *wfv1.NodeStatus, nodes wfv1.Nodes) []string {
+	ids := make([]string, 0, len(node.Children))
+	for _, childID := range node.Children {
+		if !isLifecycleHookNode(node, nodes[childID]) {
+			ids = append(ids, childID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,343 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var stepsLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        template: whalesay
+        hooks:
+          running:
+            expression: steps.hello.status == "Running"
+            template: notify
+          failed:
+            expression: steps.hello.status == "Failed"
+            template: notify
+  - name: whalesay
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestStepsLifecycleHooks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(stepsLifecycleHooks)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Nil(t, woc.wf.GetNodeByName("steps-hooks[0].hello.hooks.running"))
+
+	makePodsPhase(t, apiv1.PodRunning, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	hookNode := woc.wf.GetNodeByName("steps-hooks[0].hello.hooks.running")
+	if assert.NotNil(t, hookNode) {
+		assert.Contains(t, woc.wf.GetNodeByName("steps-hooks[0].hello").Children, hookNode.ID)
+	}
+	assert.Nil(t, woc.wf.GetNodeByName("steps-hooks[0].hello.hooks.failed"))
+
+	// the hook is only started once
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	pods, err := controller.kubeclientset.CoreV1().Pods(wf.Namespace).List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pods.Items, 2)
+
+	makePodsPhaseAll(t, apiv1.PodSucceeded, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("steps-hooks[0].hello.hooks.running").Phase)
+	assert.Nil(t, woc.wf.GetNodeByName("steps-hooks[0].hello.hooks.failed"))
+}
+
+var dagLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: hello
+        template: whalesay
+        hooks:
+          running:
+            expression: tasks.hello.status == "Running"
+            template: notify
+  - name: whalesay
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestDAGLifecycleHooks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(dagLifecycleHooks)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Nil(t, woc.wf.GetNodeByName("dag-hooks.hello.hooks.running"))
+
+	makePodsPhase(t, apiv1.PodRunning, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.NotNil(t, woc.wf.GetNodeByName("dag-hooks.hello.hooks.running"))
+
+	makePodsPhaseAll(t, apiv1.PodSucceeded, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("dag-hooks.hello.hooks.running").Phase)
+}
+
+var dagRetriedTaskLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-retried-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: hello
+        template: whalesay
+        hooks:
+          running:
+            expression: tasks.hello.status == "Running"
+            template: notify
+  - name: whalesay
+    retryStrategy:
+      limit: 1
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestDAGRetriedTaskLifecycleHooks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(dagRetriedTaskLifecycleHooks)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeTypeRetry, woc.wf.GetNodeByName("dag-retried-hooks.hello").Type)
+
+	makePodsPhase(t, apiv1.PodRunning, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	hookNode := woc.wf.GetNodeByName("dag-retried-hooks.hello.hooks.running")
+	if assert.NotNil(t, hookNode) {
+		assert.Contains(t, woc.wf.GetNodeByName("dag-retried-hooks.hello").Children, hookNode.ID)
+	}
+
+	// the task succeeds while its hook, which hangs off the Retry node, is still running
+	podcs := controller.kubeclientset.CoreV1().Pods(wf.Namespace)
+	pods, err := podcs.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, pod := range pods.Items {
+		if pod.Annotations[common.AnnotationKeyNodeName] == "dag-retried-hooks.hello(0)" {
+			pod.Status.Phase = apiv1.PodSucceeded
+			_, err = podcs.Update(&pod)
+			assert.NoError(t, err)
+		}
+	}
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("dag-retried-hooks.hello").Phase)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+
+	makePodsPhaseAll(t, apiv1.PodSucceeded, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("dag-retried-hooks.hello.hooks.running").Phase)
+}
+
+var unstartedLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: unstarted-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: hello
+        template: steps
+        hooks:
+          waiting:
+            expression: tasks.deploy.status == "Pending"
+            template: notify
+      - name: deploy
+        template: whalesay
+        dependencies: [hello]
+  - name: steps
+    steps:
+    - - name: build
+        template: whalesay
+        hooks:
+          waiting:
+            expression: steps.test.status == "Pending"
+            template: notify
+    - - name: test
+        template: whalesay
+  - name: whalesay
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestUnstartedLifecycleHooks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(unstartedLifecycleHooks)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	// the hooks refer to a task and a step which have not started yet
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	assert.Nil(t, woc.wf.GetNodeByName("unstarted-hooks.deploy"))
+	assert.NotNil(t, woc.wf.GetNodeByName("unstarted-hooks.hello.hooks.waiting"))
+	assert.Nil(t, woc.wf.GetNodeByName("unstarted-hooks.hello[1].test"))
+	assert.NotNil(t, woc.wf.GetNodeByName("unstarted-hooks.hello[0].build.hooks.waiting"))
+}
+
+var workflowLifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: workflow-hooks
+spec:
+  entrypoint: main
+  hooks:
+    failed:
+      expression: workflow.status == "Failed"
+      template: notify
+    succeeded:
+      expression: workflow.status == "Succeeded"
+      template: notify
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestWorkflowLifecycleHooks(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(workflowLifecycleHooks)
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Nil(t, woc.wf.GetNodeByName("workflow-hooks.hooks.failed"))
+
+	makePodsPhase(t, apiv1.PodFailed, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.NotNil(t, woc.wf.GetNodeByName("workflow-hooks.hooks.failed"))
+	assert.Nil(t, woc.wf.GetNodeByName("workflow-hooks.hooks.succeeded"))
+	// the workflow is not completed until the hook is
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+
+	makePodsPhase(t, apiv1.PodSucceeded, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+}
+
+var workflowLifecycleHookNotBoolean = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: workflow-hook-not-boolean
+spec:
+  entrypoint: main
+  hooks:
+    status:
+      expression: workflow.status
+      template: notify
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestWorkflowLifecycleHookNotBoolean(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Create(unmarshalWF(workflowLifecycleHookNotBoolean))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	// the workflow errors, rather than running forever
+	assert.Equal(t, wfv1.NodeError, woc.wf.Status.Phase)
+	assert.Equal(t, "hook 'status' of 'workflow-hook-not-boolean': expression 'workflow.status' did not evaluate to a boolean", woc.wf.Status.Message)
+}
+
+func TestEvaluateLifecycleHookExpression(t *testing.T) {
+	env := map[string]interface{}{"workflow": map[string]interface{}{"status": "Running"}}
+	run, err := evaluateLifecycleHookExpression(`workflow.status == "Running"`, env)
+	assert.NoError(t, err)
+	assert.True(t, run)
+	run, err = evaluateLifecycleHookExpression(`workflow.status == "Failed"`, env)
+	assert.NoError(t, err)
+	assert.False(t, run)
+	_, err = evaluateLifecycleHookExpression(`workflow.status`, env)
+	assert.Error(t, err)
+}
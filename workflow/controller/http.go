@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antonmedv/expr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/intstr"
+)
+
+// maxHTTPResponseSize is the largest response body an HTTP template may return, as it is stored in the node status
+const maxHTTPResponseSize = 256 * 1024
+
+// executeHTTP makes the request of an HTTP template from the controller. The request is made in the background, and
+// the node is completed by a later operation of the workflow, once the response has been received.
+func (woc *wfOperationCtx) executeHTTP(nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+	wfKey := woc.wf.Namespace + "/" + woc.wf.Name
+
+	deadline, err := getHTTPDeadline(node, tmpl, opts)
+	if err != nil {
+		return node, err
+	}
+	if deadline != nil {
+		if time.Now().UTC().After(*deadline) {
+			woc.completedHTTPRequests[node.ID] = true
+			return woc.markNodePhase(nodeName, wfv1.NodeFailed, "Step exceeded its deadline"), nil
+		}
+		woc.requeue(time.Until(*deadline))
+	}
+
+	response, started := woc.controller.httpRequests.get(wfKey, node.ID)
+	if !started {
+		req, err := woc.newHTTPRequest(tmpl.HTTP)
+		if err != nil {
+			return node, err
+		}
+		woc.log.Infof("Making HTTP request %s %s for node %s", req.Method, req.URL, node.ID)
+		woc.controller.httpRequests.start(wfKey, node.ID, req, tmpl.HTTP.GetTimeout())
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+	}
+	if response == nil {
+		// the request is still in flight
+		return node, nil
+	}
+	woc.completedHTTPRequests[node.ID] = true
+	if response.err != nil {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, response.err.Error()), nil
+	}
+
+	node.Outputs = &wfv1.Outputs{Result: &response.body}
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+	succeeded, err := response.succeeded(tmpl.HTTP.SuccessCondition)
+	if err != nil {
+		return node, err
+	}
+	if !succeeded {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("HTTP request did not meet the success condition, status code %d", response.statusCode)), nil
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+}
+
+// getHTTPDeadline returns the earliest of the template's activeDeadlineSeconds and the execution deadline, if any
+func getHTTPDeadline(node *wfv1.NodeStatus, tmpl *wfv1.Template, opts *executeTemplateOpts) (*time.Time, error) {
+	var deadline *time.Time
+	activeDeadlineSeconds, err := intstr.Int64(tmpl.ActiveDeadlineSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if activeDeadlineSeconds != nil {
+		d := node.StartedAt.Add(time.Duration(*activeDeadlineSeconds) * time.Second).UTC()
+		deadline = &d
+	}
+	if !opts.executionDeadline.IsZero() && (deadline == nil || opts.executionDeadline.Before(*deadline)) {
+		deadline = &opts.executionDeadline
+	}
+	return deadline, nil
+}
+
+// newHTTPRequest creates the request of an HTTP template, reading header values from secrets as needed
+func (woc *wfOperationCtx) newHTTPRequest(h *wfv1.HTTP) (*http.Request, error) {
+	method := h.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequest(method, h.URL, strings.NewReader(h.Body))
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range h.Headers {
+		value := header.Value
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+			selector := header.ValueFrom.SecretKeyRef
+			secret, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Get(selector.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			data, ok := secret.Data[selector.Key]
+			if !ok {
+				return nil, fmt.Errorf("secret '%s' does not have the key '%s'", selector.Name, selector.Key)
+			}
+			value = string(data)
+		}
+		req.Header.Add(header.Name, value)
+	}
+	return req, nil
+}
+
+// httpResponse is the outcome of an HTTP template request
+type httpResponse struct {
+	statusCode int
+	body       string
+	err        error
+}
+
+// succeeded evaluates the success condition against the response. An empty condition requires a 2xx status code.
+func (r *httpResponse) succeeded(condition string) (bool, error) {
+	if condition == "" {
+		return r.statusCode >= 200 && r.statusCode < 300, nil
+	}
+	env := map[string]interface{}{
+		"response": map[string]interface{}{
+			"statusCode": r.statusCode,
+			"body":       r.body,
+		},
+	}
+	result, err := expr.Eval(condition, env)
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate successCondition '%s': %s", condition, err)
+	}
+	ok, isBool := result.(bool)
+	if !isBool {
+		return false, fmt.Errorf("successCondition '%s' did not evaluate to a boolean", condition)
+	}
+	return ok, nil
+}
+
+type httpRequestKey struct {
+	wfKey  string
+	nodeID string
+}
+
+type httpRequest struct {
+	cancel context.CancelFunc
+	// response is nil while the request is in flight
+	response *httpResponse
+}
+
+// httpRequests makes the requests of HTTP templates in the background, so they do not block the workflow operation.
+// Requests are not persisted, so if the controller restarts while a request is in flight, it will be made again.
+type httpRequests struct {
+	client   *http.Client
+	mutex    sync.Mutex
+	requests map[httpRequestKey]*httpRequest
+	// onComplete is called with the workflow key when one of its requests completes
+	onComplete func(wfKey string)
+}
+
+func newHTTPRequests(onComplete func(wfKey string)) *httpRequests {
+	return &httpRequests{
+		client:     &http.Client{},
+		requests:   make(map[httpRequestKey]*httpRequest),
+		onComplete: onComplete,
+	}
+}
+
+// start makes the request in the background, unless it has already been started
+func (r *httpRequests) start(wfKey, nodeID string, req *http.Request, timeout time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := httpRequestKey{wfKey, nodeID}
+	if _, ok := r.requests[key]; ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	r.requests[key] = &httpRequest{cancel: cancel}
+	go func() {
+		defer cancel()
+		response := r.do(req.WithContext(ctx))
+		r.mutex.Lock()
+		request, ok := r.requests[key]
+		if ok {
+			request.response = response
+		}
+		r.mutex.Unlock()
+		if ok {
+			r.onComplete(wfKey)
+		}
+	}()
+}
+
+func (r *httpRequests) do(req *http.Request) *httpResponse {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return &httpResponse{err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseSize+1))
+	if err != nil {
+		return &httpResponse{err: err}
+	}
+	if len(body) > maxHTTPResponseSize {
+		return &httpResponse{err: fmt.Errorf("response body exceeds %d bytes", maxHTTPResponseSize)}
+	}
+	return &httpResponse{statusCode: resp.StatusCode, body: string(body)}
+}
+
+// get returns the response of the request (nil if it is in flight), and whether the request has been started
+func (r *httpRequests) get(wfKey, nodeID string) (*httpResponse, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	request, ok := r.requests[httpRequestKey{wfKey, nodeID}]
+	if !ok {
+		return nil, false
+	}
+	return request.response, true
+}
+
+// forget cancels the request if it is in flight, and forgets it
+func (r *httpRequests) forget(wfKey, nodeID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := httpRequestKey{wfKey, nodeID}
+	if request, ok := r.requests[key]; ok {
+		request.cancel()
+		delete(r.requests, key)
+	}
+}
+
+// forgetWorkflow cancels and forgets all of the requests of the workflow
+func (r *httpRequests) forgetWorkflow(wfKey string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for key, request := range r.requests {
+		if key.wfKey == wfKey {
+			request.cancel()
+			delete(r.requests, key)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	fakewfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+)
+
+var httpTemplateWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http-template
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      method: POST
+      body: hello
+      headers:
+      - name: X-Argo
+        value: argo
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+`
+
+// waitForHTTPResponse waits for the background request of the workflow's only node to complete
+func waitForHTTPResponse(t *testing.T, controller *WorkflowController, wf *wfv1.Workflow) {
+	for i := 0; i < 100; i++ {
+		if response, _ := controller.httpRequests.get(wf.Namespace+"/"+wf.Name, wf.NodeID(wf.Name)); response != nil {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatal("timed out waiting for HTTP response")
+}
+
+func runHTTPTemplate(t *testing.T, handler http.HandlerFunc, successCondition string) *wfv1.NodeStatus {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cancel, controller := newController()
+	defer cancel()
+	_, err := controller.kubeclientset.CoreV1().Secrets("").Create(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+		Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+	})
+	assert.NoError(t, err)
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(httpTemplateWf)
+	wf.Spec.Templates[0].HTTP.URL = server.URL
+	wf.Spec.Templates[0].HTTP.SuccessCondition = successCondition
+	wf, err = wfcset.Create(wf)
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes[woc.wf.NodeID(wf.Name)]
+	assert.Equal(t, wfv1.NodeTypeHTTP, node.Type)
+	assert.Equal(t, wfv1.NodeRunning, node.Phase)
+
+	waitForHTTPResponse(t, controller, woc.wf)
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node = woc.wf.Status.Nodes[woc.wf.NodeID(wf.Name)]
+	return &node
+}
+
+func TestHTTPTemplate(t *testing.T) {
+	t.Run("Succeeded", func(t *testing.T) {
+		node := runHTTPTemplate(t, func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "hello", string(body))
+			assert.Equal(t, "argo", r.Header.Get("X-Argo"))
+			assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+			_, _ = fmt.Fprint(w, "ok")
+		}, "")
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		if assert.NotNil(t, node.Outputs) && assert.NotNil(t, node.Outputs.Result) {
+			assert.Equal(t, "ok", *node.Outputs.Result)
+		}
+	})
+	t.Run("FailedStatusCode", func(t *testing.T) {
+		node := runHTTPTemplate(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}, "")
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Contains(t, node.Message, "status code 500")
+	})
+	t.Run("SuccessCondition", func(t *testing.T) {
+		node := runHTTPTemplate(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprint(w, "queued")
+		}, `response.statusCode == 202 && response.body == "queued"`)
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+	})
+	t.Run("SuccessConditionNotMet", func(t *testing.T) {
+		node := runHTTPTemplate(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, "error")
+		}, `response.body contains "ok"`)
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+	})
+}
+
+func TestHTTPResponseForgottenOncePersisted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	cancel, controller := newController()
+	defer cancel()
+	failUpdates := false
+	controller.wfclientset.(*fakewfclientset.Clientset).PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return failUpdates, nil, fmt.Errorf("update failed")
+	})
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+
+	wf := unmarshalWF(httpTemplateWf)
+	wf.Spec.Templates[0].HTTP.URL = server.URL
+	wf.Spec.Templates[0].HTTP.Headers = nil
+	wf, err := wfcset.Create(wf)
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	waitForHTTPResponse(t, controller, woc.wf)
+	wfKey, nodeID := wf.Namespace+"/"+wf.Name, woc.wf.NodeID(wf.Name)
+
+	// the node completes, but is not persisted, so the next operation needs the response again
+	failUpdates = true
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes[nodeID].Phase)
+	response, started := controller.httpRequests.get(wfKey, nodeID)
+	assert.True(t, started)
+	assert.NotNil(t, response)
+
+	failUpdates = false
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes[nodeID].Phase)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Nodes[nodeID].Phase)
+	_, started = controller.httpRequests.get(wfKey, nodeID)
+	assert.False(t, started)
+}
+
+func TestHTTPResponseSucceeded(t *testing.T) {
+	ok, err := (&httpResponse{statusCode: 204}).succeeded("")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = (&httpResponse{statusCode: 404}).succeeded("")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = (&httpResponse{statusCode: 404}).succeeded("response.statusCode == 404")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	_, err = (&httpResponse{statusCode: 200}).succeeded("response.statusCode")
+	assert.Error(t, err)
+}
+
+func TestHTTPRequestsForget(t *testing.T) {
+	requests := newHTTPRequests(func(string) {})
+	requests.requests[httpRequestKey{"ns/my-wf", "my-node"}] = &httpRequest{cancel: func() {}}
+	requests.requests[httpRequestKey{"ns/other-wf", "other-node"}] = &httpRequest{cancel: func() {}}
+	requests.forgetWorkflow("ns/my-wf")
+	_, started := requests.get("ns/my-wf", "my-node")
+	assert.False(t, started)
+	_, started = requests.get("ns/other-wf", "other-node")
+	assert.True(t, started)
+}
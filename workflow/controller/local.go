@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo/config"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
+	wfextv "github.com/argoproj/argo/pkg/client/informers/externalversions"
+)
+
+// NewLocalWorkflowController returns a workflow controller which is not backed by a Kubernetes cluster. It is intended
+// to be used with fake clientsets by a local runner, which operates workflows one at a time using OperateWorkflow and
+// plays the part of the kubelet by running the pods the controller creates.
+func NewLocalWorkflowController(ctx context.Context, kubeclientset kubernetes.Interface, wfclientset wfclientset.Interface, namespace string, config config.Config) (*WorkflowController, error) {
+	informerFactory := wfextv.NewSharedInformerFactoryWithOptions(wfclientset, workflowTemplateResyncPeriod, wfextv.WithNamespace(namespace))
+	wftmplInformer := informerFactory.Argoproj().V1alpha1().WorkflowTemplates()
+	cwftmplInformer := informerFactory.Argoproj().V1alpha1().ClusterWorkflowTemplates()
+	go wftmplInformer.Informer().Run(ctx.Done())
+	go cwftmplInformer.Informer().Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), wftmplInformer.Informer().HasSynced, cwftmplInformer.Informer().HasSynced) {
+		return nil, fmt.Errorf("timed out waiting for caches to sync")
+	}
+	// there is no cluster to exec into, so signalling pods fails rather than panics
+	wfc, err := newWorkflowController(&rest.Config{}, kubeclientset, nil, wfclientset, namespace, namespace, func(wfc *WorkflowController) error {
+		// the executor never runs locally, but the pods must have an image for it
+		wfc.cliExecutorImage = "argoproj/argoexec:latest"
+		return wfc.updateConfig(config)
+	})
+	if err != nil {
+		return nil, err
+	}
+	wfc.wfInformer = cache.NewSharedIndexInformer(nil, nil, 0, workflowIndexers)
+	wfc.wftmplInformer = wftmplInformer
+	wfc.cwftmplInformer = cwftmplInformer
+	// the pod informer is never started, so the operator always falls through to creating pods
+	wfc.podInformer = cache.NewSharedIndexInformer(nil, &apiv1.Pod{}, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	err = wfc.createSynchronizationManager()
+	if err != nil {
+		return nil, err
+	}
+	// nothing labels or garbage collects pods locally, so we just discard them
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-wfc.completedPods:
+			case <-wfc.gcPods:
+			}
+		}
+	}()
+	return wfc, nil
+}
+
+// OperateWorkflow reconciles the workflow once, and returns the workflow as persisted by the operator.
+func (wfc *WorkflowController) OperateWorkflow(wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+	woc := newWorkflowOperationCtx(wf, wfc)
+	woc.operate()
+	return wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(wf.Name, metav1.GetOptions{})
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	authutil "github.com/argoproj/argo/util/auth"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+const namespaceResyncPeriod = 20 * time.Minute
+
+// newNamespaceInformer returns the informer of the namespaces, whose labels and annotations set their parallelism
+// limits, or nil if the controller is not allowed to watch namespaces
+func (wfc *WorkflowController) newNamespaceInformer() cache.SharedIndexInformer {
+	for _, verb := range []string{"list", "watch"} {
+		allowed, err := authutil.CanI(wfc.kubeclientset, verb, "namespaces", "", "")
+		if err != nil || !allowed {
+			log.WithError(err).Warn("Controller doesn't have RBAC access for namespaces, namespace parallelism limits must be set in the configmap")
+			return nil
+		}
+	}
+	informer := coreinformers.NewFilteredNamespaceInformer(wfc.kubeclientset, namespaceResyncPeriod, cache.Indexers{}, func(options *metav1.ListOptions) {
+		if wfc.managedNamespace != "" {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", wfc.managedNamespace).String()
+		}
+	})
+	// the workflows of a namespace may no longer be throttled once its labels or annotations change
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) {
+			wfc.throttler.ParallelismChanged()
+		},
+		UpdateFunc: func(interface{}, interface{}) {
+			wfc.throttler.ParallelismChanged()
+		},
+	})
+	return informer
+}
+
+// getNamespaceParallelism returns the max number of workflows of the namespace running at the same time, or 0 if
+// there is no limit
+func (wfc *WorkflowController) getNamespaceParallelism(namespace string) int {
+	return wfc.getNamespaceLimit(namespace, common.LabelKeyParallelismLimit, wfc.Config.NamespaceParallelism)
+}
+
+// getNamespacePodParallelism returns the max number of pending or running workflow pods of the namespace, or 0 if
+// there is no limit
+func (wfc *WorkflowController) getNamespacePodParallelism(namespace string) int {
+	return wfc.getNamespaceLimit(namespace, common.LabelKeyPodParallelismLimit, wfc.Config.NamespacePodParallelism)
+}
+
+// getNamespaceLimit returns the limit in the label or annotation of the namespace, or the default limit of the
+// controller if it has none
+func (wfc *WorkflowController) getNamespaceLimit(namespace, key string, defaultLimit int) int {
+	if wfc.nsInformer == nil {
+		return defaultLimit
+	}
+	obj, exists, err := wfc.nsInformer.GetStore().GetByKey(namespace)
+	if err != nil || !exists {
+		return defaultLimit
+	}
+	ns, ok := obj.(*apiv1.Namespace)
+	if !ok {
+		return defaultLimit
+	}
+	limit, err := getParallelismLimit(ns, key)
+	if err != nil {
+		log.WithField("namespace", namespace).Warn(err)
+		return defaultLimit
+	}
+	if limit == nil {
+		return defaultLimit
+	}
+	return *limit
+}
+
+// getParallelismLimit returns the limit in the label, or else the annotation, of the namespace, or nil if it has none
+func getParallelismLimit(ns *apiv1.Namespace, key string) (*int, error) {
+	value, ok := ns.Labels[key]
+	if !ok {
+		value, ok = ns.Annotations[key]
+	}
+	if !ok {
+		return nil, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s '%s': %w", key, value, err)
+	}
+	return &limit, nil
+}
+
+// checkNamespacePodParallelism returns a podThrottledError if the namespace of the workflow has as many pending or
+// running workflow pods as its limit
+func (woc *wfOperationCtx) checkNamespacePodParallelism() error {
+	namespace := woc.wf.Namespace
+	limit := woc.controller.getNamespacePodParallelism(namespace)
+	if limit <= 0 {
+		return nil
+	}
+	active, err := woc.getInFlightPods(namespace)
+	if err != nil {
+		return err
+	}
+	if active >= limit {
+		woc.log.Infof("namespace pod parallelism reached %d/%d", active, limit)
+		return podThrottledError{
+			message:      fmt.Sprintf("waiting for namespace %s to run fewer than %d pods", namespace, limit),
+			requeueAfter: podThrottledRequeuePeriod,
+		}
+	}
+	return nil
+}
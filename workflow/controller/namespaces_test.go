@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var namespacePodParallelismWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: hello-world
+  namespace: team-a
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestGetNamespaceParallelism(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.Config.NamespaceParallelism = 10
+	controller.Config.NamespacePodParallelism = 100
+	assert.Equal(t, 10, controller.getNamespaceParallelism("team-a"))
+
+	controller.nsInformer = cache.NewSharedIndexInformer(nil, &apiv1.Namespace{}, 0, cache.Indexers{})
+	for _, ns := range []*apiv1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{common.LabelKeyParallelismLimit: "2"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Annotations: map[string]string{common.LabelKeyPodParallelismLimit: "20"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-c", Labels: map[string]string{common.LabelKeyParallelismLimit: "many"}}},
+	} {
+		assert.NoError(t, controller.nsInformer.GetStore().Add(ns))
+	}
+	assert.Equal(t, 2, controller.getNamespaceParallelism("team-a"))
+	assert.Equal(t, 100, controller.getNamespacePodParallelism("team-a"))
+	assert.Equal(t, 10, controller.getNamespaceParallelism("team-b"))
+	assert.Equal(t, 20, controller.getNamespacePodParallelism("team-b"))
+	assert.Equal(t, 10, controller.getNamespaceParallelism("team-c"))
+	assert.Equal(t, 10, controller.getNamespaceParallelism("team-d"))
+}
+
+func TestNamespacePodParallelism(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.Config.NamespacePodParallelism = 1
+	running := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "team-a"},
+		Status:     apiv1.PodStatus{Phase: apiv1.PodRunning},
+	}
+	assert.NoError(t, controller.podInformer.GetIndexer().Add(running))
+
+	woc := newWorkflowOperationCtx(unmarshalWF(namespacePodParallelismWf), controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("hello-world")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, "waiting for namespace team-a to run fewer than 1 pods", node.Message)
+	}
+	pods, err := controller.kubeclientset.CoreV1().Pods("team-a").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, pods.Items)
+	}
+
+	running.Status.Phase = apiv1.PodSucceeded
+	assert.NoError(t, controller.podInformer.GetIndexer().Update(running))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	pods, err = controller.kubeclientset.CoreV1().Pods("team-a").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 1)
+	}
+}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -19,6 +20,8 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apiv1 "k8s.io/api/core/v1"
 	policyv1beta "k8s.io/api/policy/v1beta1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +43,7 @@ import (
 	"github.com/argoproj/argo/util/intstr"
 	"github.com/argoproj/argo/util/resource"
 	"github.com/argoproj/argo/util/retry"
+	"github.com/argoproj/argo/util/tracing"
 	"github.com/argoproj/argo/workflow/common"
 	controllercache "github.com/argoproj/argo/workflow/controller/cache"
 	"github.com/argoproj/argo/workflow/metrics"
@@ -73,6 +77,13 @@ type wfOperationCtx struct {
 	completedPods map[string]bool
 	// map of pods which is identified as succeeded=true
 	succeededPods map[string]bool
+	// map of pods created by this operation, which the pod informer may not have received yet
+	createdPods map[string]bool
+	// inFlightPods is the number of pending or running workflow pods by namespace, "" for every namespace, counted the
+	// first time the operation needs it
+	inFlightPods map[string]int
+	// map of HTTP nodes whose requests are done with, which are forgotten once the workflow is updated
+	completedHTTPRequests map[string]bool
 	// deadline is the dealine time in which this operation should relinquish
 	// its hold on the workflow so that an operation does not run for too long
 	// and starve other workqueue items. It also enables workflow progress to
@@ -96,6 +107,10 @@ type wfOperationCtx struct {
 	// 1. `wf.Spec.Suspend`
 	// 2. `wf.Spec.Shutdown`
 	execWf *wfv1.Workflow
+
+	// spanCtx is the context of the span of the operation, which the spans of its steps, such as the creation of
+	// pods, are children of
+	spanCtx context.Context
 }
 
 var (
@@ -138,10 +153,14 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 		volumes:                wf.Spec.DeepCopy().Volumes,
 		artifactRepository:     &wfc.Config.ArtifactRepository,
 		completedPods:          make(map[string]bool),
+		createdPods:            make(map[string]bool),
+		inFlightPods:           make(map[string]int),
+		completedHTTPRequests:  make(map[string]bool),
 		succeededPods:          make(map[string]bool),
 		deadline:               time.Now().UTC().Add(maxOperationTime),
 		eventRecorder:          wfc.eventRecorderManager.Get(wf.Namespace),
 		preExecutionNodePhases: make(map[string]wfv1.NodePhase),
+		spanCtx:                context.Background(),
 	}
 
 	if woc.wf.Status.Nodes == nil {
@@ -159,6 +178,12 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 // TODO: an error returned by this method should result in requeuing the workflow to be retried at a
 // later time
 func (woc *wfOperationCtx) operate() {
+	ctx, span := tracing.Tracer().Start(woc.traceContext(), "operate", trace.WithAttributes(woc.spanAttributes()...))
+	defer span.End()
+	woc.spanCtx = ctx
+	defer func() {
+		span.SetAttributes(attribute.String("phase", string(woc.wf.Status.Phase)))
+	}()
 	defer func() {
 		if woc.wf.Status.Fulfilled() {
 			_ = woc.killDaemonedChildren("")
@@ -251,6 +276,7 @@ func (woc *wfOperationCtx) operate() {
 			// TODO: we need to re-add to the workqueue, but should happen in caller
 			return
 		}
+		woc.shutdownChildWorkflows()
 	}
 
 	if woc.wf.Spec.Suspend != nil && *woc.wf.Spec.Suspend {
@@ -325,7 +351,16 @@ func (woc *wfOperationCtx) operate() {
 		return
 	}
 
-	if node == nil || !node.Fulfilled() {
+	hooksFulfilled, err := woc.executeWorkflowLifecycleHooks(tmplCtx, node)
+	if err != nil {
+		msg := "error in lifecycle hook execution"
+		woc.log.WithError(err).Error(msg)
+		woc.markWorkflowError(err, true)
+		woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "WorkflowFailed", fmt.Sprintf("%s %s: %+v", woc.wf.Name, msg, err))
+		return
+	}
+
+	if node == nil || !node.Fulfilled() || !hooksFulfilled {
 		// node can be nil if a workflow created immediately in a parallelism == 0 state
 		return
 	}
@@ -452,6 +487,9 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 	if woc.execWf.Spec.Priority != nil {
 		woc.globalParams[common.GlobalVarWorkflowPriority] = strconv.Itoa(int(*woc.execWf.Spec.Priority))
 	}
+	if scheduledTime, ok := woc.wf.ObjectMeta.Annotations[common.AnnotationKeyCronWfScheduledTime]; ok {
+		woc.globalParams[common.GlobalVarWorkflowScheduledTime] = scheduledTime
+	}
 	for char := range strftime.FormatChars {
 		cTimeVar := fmt.Sprintf("%s.%s", common.GlobalVarWorkflowCreationTimestamp, string(char))
 		woc.globalParams[cTimeVar] = strftime.Format("%"+string(char), woc.wf.ObjectMeta.CreationTimestamp.Time)
@@ -528,6 +566,12 @@ func (woc *wfOperationCtx) persistUpdates() {
 
 	woc.log.WithFields(log.Fields{"resourceVersion": woc.wf.ResourceVersion, "phase": woc.wf.Status.Phase}).Info("Workflow update successful")
 
+	// Responses are only forgotten once the nodes they completed are persisted, as otherwise a failed update would
+	// make the next operation repeat the request
+	for nodeID := range woc.completedHTTPRequests {
+		woc.controller.httpRequests.forget(woc.wf.Namespace+"/"+woc.wf.Name, nodeID)
+	}
+
 	// HACK(jessesuen) after we successfully persist an update to the workflow, the informer's
 	// cache is now invalid. It's very common that we will need to immediately re-operate on a
 	// workflow due to queuing by the pod workers. The following sleep gives a *chance* for the
@@ -688,7 +732,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	if retryStrategy.Backoff != nil {
 		maxDurationDeadline := time.Time{}
 		// Process max duration limit
-		if retryStrategy.Backoff.MaxDuration != "" && len(node.Children) > 0 {
+		if retryStrategy.Backoff.MaxDuration != "" && len(retryAttemptIDs(node, woc.wf.Status.Nodes)) > 0 {
 			maxDuration, err := parseStringToDuration(retryStrategy.Backoff.MaxDuration)
 			if err != nil {
 				return nil, false, err
@@ -719,7 +763,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		if *retryStrategyBackoffFactor > 0 {
 			// Formula: timeToWait = duration * factor^retry_number
 			// Note that timeToWait should equal to duration for the first retry attempt.
-			timeToWait = baseDuration * time.Duration(math.Pow(float64(*retryStrategyBackoffFactor), float64(len(node.Children)-1)))
+			timeToWait = baseDuration * time.Duration(math.Pow(float64(*retryStrategyBackoffFactor), float64(len(retryAttemptIDs(node, woc.wf.Status.Nodes))-1)))
 		}
 		waitingDeadline := lastChildNode.FinishedAt.Add(timeToWait)
 
@@ -763,6 +807,15 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
 	}
 
+	retry, err := shouldRetry(retryStrategy.Expression, lastChildNode)
+	if err != nil {
+		return nil, false, err
+	}
+	if !retry {
+		woc.log.Infof("Node not set to be retried after evaluating expression: %s", retryStrategy.Expression)
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+	}
+
 	if !lastChildNode.CanRetry() {
 		woc.log.Infof("Node cannot be retried. Marking it failed")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
@@ -772,12 +825,13 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	if err != nil {
 		return nil, false, err
 	}
-	if retryStrategy.Limit != nil && int32(len(node.Children)) > *limit {
+	attempts := len(retryAttemptIDs(node, woc.wf.Status.Nodes))
+	if retryStrategy.Limit != nil && int32(attempts) > *limit {
 		woc.log.Infoln("No more retries left. Failing...")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, "No more retries left"), true, nil
 	}
 
-	woc.log.Infof("%d child nodes of %s failed. Trying again...", len(node.Children), node.Name)
+	woc.log.Infof("%d child nodes of %s failed. Trying again...", attempts, node.Name)
 	return node, true, nil
 }
 
@@ -785,11 +839,17 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 // pods and update the node state before continuing the evaluation of the workflow.
 // Records all pods which were observed completed, which will be labeled completed=true
 // after successful persist of the workflow.
-func (woc *wfOperationCtx) podReconciliation() error {
+func (woc *wfOperationCtx) podReconciliation() (err error) {
+	_, span := woc.startSpan("podReconciliation")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 	podList, err := woc.getAllWorkflowPods()
 	if err != nil {
 		return err
 	}
+	span.SetAttributes(attribute.Int("pods", len(podList.Items)))
 	seenPods := make(map[string]*apiv1.Pod)
 	seenPodLock := &sync.Mutex{}
 	wfNodesLock := &sync.RWMutex{}
@@ -807,12 +867,21 @@ func (woc *wfOperationCtx) podReconciliation() error {
 		wfNodesLock.Lock()
 		defer wfNodesLock.Unlock()
 		if node, ok := woc.wf.Status.Nodes[nodeID]; ok {
+			if node.Throttled {
+				// the pod has been created
+				node.Throttled = false
+				woc.wf.Status.Nodes[nodeID] = node
+				woc.updated = true
+			}
 			if newState := woc.assessNodeStatus(pod, &node); newState != nil {
 				woc.wf.Status.Nodes[nodeID] = *newState
 				woc.addOutputsToGlobalScope(node.Outputs)
 				if node.MemoizationStatus != nil {
-					c := *woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-					err := c.Save(node.MemoizationStatus.Key, node.ID, node.Outputs)
+					c := woc.controller.cacheFactory.GetCache(controllercache.CacheType(node.MemoizationStatus.CacheType), node.MemoizationStatus.CacheName)
+					err := fmt.Errorf("cache could not be found or created")
+					if c != nil {
+						err = (*c).Save(node.MemoizationStatus.Key, node.ID, node.Outputs)
+					}
 					if err != nil {
 						woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
 						node.Phase = wfv1.NodeError
@@ -820,6 +889,7 @@ func (woc *wfOperationCtx) podReconciliation() error {
 				}
 				woc.updated = true
 			}
+			woc.updateContainerSetNodes(pod, nodeID)
 			node := woc.wf.Status.Nodes[pod.ObjectMeta.Name]
 			if node.Fulfilled() && !node.IsDaemoned() {
 				if tmpVal, tmpOk := pod.Labels[common.LabelKeyCompleted]; tmpOk {
@@ -874,6 +944,10 @@ func (woc *wfOperationCtx) podReconciliation() error {
 			// If the node is pending and the pod does not exist, it could be the case that we want to try to submit it
 			// again instead of marking it as an error. Check if that's the case.
 			if node.Pending() {
+				if isPodThrottled(node) {
+					// The pod is created once the controller is no longer throttling the creation of pods
+					continue
+				}
 				tmplCtx, err := woc.createTemplateContext(node.GetTemplateScope())
 				if err != nil {
 					return err
@@ -901,6 +975,7 @@ func (woc *wfOperationCtx) podReconciliation() error {
 			// See processNodeRetries for more details.
 			node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
 			woc.wf.Status.Nodes[nodeID] = node
+			woc.completeContainerSetNodes(node)
 			woc.log.Warnf("pod %s deleted", nodeID)
 			woc.updated = true
 		} else {
@@ -922,12 +997,16 @@ func (woc *wfOperationCtx) shouldPrintPodSpec(node wfv1.NodeStatus) bool {
 		(woc.controller.Config.PodSpecLogStrategy.FailedPod && node.FailedOrError())
 }
 
-//fails any suspended and pending nodes if the workflow deadline has passed
+//fails any suspended, pending and HTTP nodes if the workflow deadline has passed
 func (woc *wfOperationCtx) failSuspendedAndPendingNodesAfterDeadlineOrShutdown() error {
 	deadlineExceeded := woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)
 	if woc.execWf.Spec.Shutdown != "" || deadlineExceeded {
 		for _, node := range woc.wf.Status.Nodes {
-			if node.IsActiveSuspendNode() || (node.Phase == wfv1.NodePending && deadlineExceeded) {
+			isActiveHTTPNode := node.Type == wfv1.NodeTypeHTTP && !node.Fulfilled()
+			if isActiveHTTPNode {
+				woc.completedHTTPRequests[node.ID] = true
+			}
+			if node.IsActiveSuspendNode() || isActiveHTTPNode || (node.Phase == wfv1.NodePending && deadlineExceeded) {
 				var message string
 				if woc.execWf.Spec.Shutdown != "" {
 					message = fmt.Sprintf("Stopped with strategy '%s'", woc.execWf.Spec.Shutdown)
@@ -1115,6 +1194,12 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		updated = true
 		node.Message = message
 	}
+	// record the Kubernetes node of pods which fail before they are seen running as well, for the node anti-affinity
+	// of their retries
+	if pod.Spec.NodeName != "" && node.HostNodeName != pod.Spec.NodeName {
+		updated = true
+		node.HostNodeName = pod.Spec.NodeName
+	}
 
 	if node.Fulfilled() && node.FinishedAt.IsZero() {
 		updated = true
@@ -1406,19 +1491,20 @@ func (woc *wfOperationCtx) deletePVCs() error {
 }
 
 func getChildNodeIndex(node *wfv1.NodeStatus, nodes wfv1.Nodes, index int) *wfv1.NodeStatus {
-	if len(node.Children) <= 0 {
+	children := retryAttemptIDs(node, nodes)
+	if len(children) <= 0 {
 		return nil
 	}
 
 	nodeIndex := index
 	if index < 0 {
-		nodeIndex = len(node.Children) + index // This actually subtracts, since index is negative
+		nodeIndex = len(children) + index // This actually subtracts, since index is negative
 		if nodeIndex < 0 {
 			panic(fmt.Sprintf("child index '%d' out of bounds", index))
 		}
 	}
 
-	lastChildNodeName := node.Children[nodeIndex]
+	lastChildNodeName := children[nodeIndex]
 	lastChildNode, ok := nodes[lastChildNodeName]
 	if !ok {
 		panic("could not find child node")
@@ -1473,10 +1559,18 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 	// If memoization is on, check if node output exists in cache
 	if resolvedTmpl.Memoize != nil && node == nil {
 		// return cacheEntry struct instead of wfv1.Outputs
-		c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+		cacheType, cacheName, err := controllercache.GetCacheTypeAndName(processedTmpl.Memoize.Cache)
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+		maxAge, err := processedTmpl.Memoize.GetMaxAge()
+		if err != nil {
+			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+		}
+		c := woc.controller.cacheFactory.GetCache(cacheType, cacheName)
 		if c == nil {
 			err := fmt.Errorf("Cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheName": cacheName, "cacheType": cacheType}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 		entry, err := (*c).Load(processedTmpl.Memoize.Key)
@@ -1485,14 +1579,17 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 		}
 		hit := false
 		outputs := &wfv1.Outputs{}
-		if entry != nil {
+		if entry != nil && entry.IsExpired(maxAge) {
+			woc.log.WithFields(log.Fields{"cacheName": cacheName, "key": processedTmpl.Memoize.Key, "maxAge": maxAge}).Info("Ignoring expired cache entry")
+		} else if entry != nil {
 			hit = true
 			outputs = entry.Outputs
 		}
 		memStat := &wfv1.MemoizationStatus{
 			Hit:       hit,
 			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: cacheName,
+			CacheType: string(cacheType),
 		}
 		node = woc.initializeCacheNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memStat)
 		woc.wf.Status.Nodes[node.ID] = *node
@@ -1599,7 +1696,8 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 			node = lastChildNode
 		} else {
 			// Create a new child node and append it to the retry node.
-			nodeName = fmt.Sprintf("%s(%d)", retryNodeName, len(retryParentNode.Children))
+			attempts := len(retryAttemptIDs(retryParentNode, woc.wf.Status.Nodes))
+			nodeName = fmt.Sprintf("%s(%d)", retryNodeName, attempts)
 			woc.addChildNode(retryNodeName, nodeName)
 			node = nil
 
@@ -1609,13 +1707,24 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 				localParams[common.LocalVarPodName] = woc.wf.NodeID(nodeName)
 			}
 			// Inject the retryAttempt number
-			localParams[common.LocalVarRetries] = strconv.Itoa(len(retryParentNode.Children))
+			localParams[common.LocalVarRetries] = strconv.Itoa(attempts)
 
 			processedTmpl, err = common.SubstituteParams(processedTmpl, map[string]string{}, localParams)
 			if err != nil {
 				return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 			}
 		}
+		// Schedule the pod of the attempt away from the Kubernetes nodes where the previous attempts failed
+		if affinity := processedTmpl.RetryStrategy.Affinity; processedTmpl.IsPodType() && affinity != nil && affinity.NodeAntiAffinity != nil {
+			if hostNodeNames := getFailedHostNodeNames(retryParentNode, woc.wf.Status.Nodes); len(hostNodeNames) > 0 {
+				podAffinity := processedTmpl.Affinity
+				if podAffinity == nil {
+					podAffinity = woc.execWf.Spec.Affinity
+				}
+				processedTmpl = processedTmpl.DeepCopy()
+				processedTmpl.Affinity = addNodeAntiAffinity(podAffinity, hostNodeNames)
+			}
+		}
 	}
 
 	switch processedTmpl.GetType() {
@@ -1631,6 +1740,12 @@ func (woc *wfOperationCtx) executeTemplate(nodeName string, orgTmpl wfv1.Templat
 		node, err = woc.executeDAG(nodeName, newTmplCtx, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypeSuspend:
 		node, err = woc.executeSuspend(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeHTTP:
+		node, err = woc.executeHTTP(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeContainerSet:
+		node, err = woc.executeContainerSet(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeWorkflow:
+		node, err = woc.executeWorkflow(nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeError, err.Error()), err
@@ -1746,6 +1861,7 @@ func (woc *wfOperationCtx) markWorkflowPhase(phase wfv1.NodePhase, markCompleted
 				woc.updated = true
 				woc.wf.Status.Message = err.Error()
 			}
+			woc.recordWorkflowSpan()
 
 			if woc.controller.isArchivable(woc.wf) {
 				err := wait.ExponentialBackoff(retry.DefaultRetry, func() (bool, error) {
@@ -2026,7 +2142,7 @@ func (woc *wfOperationCtx) executeContainer(nodeName string, templateScope strin
 	}
 
 	woc.log.Debugf("Executing node %s with container template: %v\n", nodeName, tmpl)
-	_, err = woc.createWorkflowPod(nodeName, *tmpl.Container, tmpl, &createWorkflowPodOpts{
+	_, err = woc.createWorkflowPod(nodeName, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
 		executionDeadline:   opts.executionDeadline,
@@ -2054,9 +2170,9 @@ func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 		}
 		return outboundNodes
 	case wfv1.NodeTypeRetry:
-		numChildren := len(node.Children)
-		if numChildren > 0 {
-			return []string{node.Children[numChildren-1]}
+		attempts := retryAttemptIDs(&node, woc.wf.Status.Nodes)
+		if len(attempts) > 0 {
+			return []string{attempts[len(attempts)-1]}
 		}
 	}
 	outbound := make([]string, 0)
@@ -2084,7 +2200,13 @@ func getTemplateOutputsFromScope(tmpl *wfv1.Template, scope *wfScope) (*wfv1.Out
 			if param.ValueFrom == nil {
 				return nil, fmt.Errorf("output parameters must have a valueFrom specified")
 			}
-			val, err := scope.resolveParameter(param.ValueFrom.Parameter)
+			var val string
+			var err error
+			if param.ValueFrom.Expression != "" {
+				val, err = common.EvaluateExpression(param.ValueFrom.Expression, scope.getParameters())
+			} else {
+				val, err = scope.resolveParameter(param.ValueFrom.Parameter)
+			}
 			if err != nil {
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default != nil {
@@ -2101,7 +2223,13 @@ func getTemplateOutputsFromScope(tmpl *wfv1.Template, scope *wfScope) (*wfv1.Out
 	if len(tmpl.Outputs.Artifacts) > 0 {
 		outputs.Artifacts = make([]wfv1.Artifact, 0)
 		for _, art := range tmpl.Outputs.Artifacts {
-			resolvedArt, err := scope.resolveArtifact(art.From, art.SubPath)
+			var resolvedArt *wfv1.Artifact
+			var err error
+			if art.FromExpression != "" {
+				resolvedArt, err = scope.resolveArtifactFromExpression(art.FromExpression, art.SubPath)
+			} else {
+				resolvedArt, err = scope.resolveArtifact(art.From, art.SubPath)
+			}
 			if err != nil {
 				// If the artifact was not found and is optional, don't mark an error
 				if strings.Contains(err.Error(), "Unable to resolve") && art.Optional {
@@ -2165,7 +2293,7 @@ func (woc *wfOperationCtx) executeScript(nodeName string, templateScope string,
 
 	mainCtr := tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
-	_, err = woc.createWorkflowPod(nodeName, mainCtr, tmpl, &createWorkflowPodOpts{
+	_, err = woc.createWorkflowPod(nodeName, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
 		executionDeadline:   opts.executionDeadline,
@@ -2177,6 +2305,11 @@ func (woc *wfOperationCtx) executeScript(nodeName string, templateScope string,
 }
 
 func (woc *wfOperationCtx) requeueIfTransientErr(err error, nodeName string) (*wfv1.NodeStatus, error) {
+	if throttled, ok := err.(podThrottledError); ok {
+		// the pod is created once the controller is no longer throttling the creation of pods
+		woc.requeue(throttled.requeueAfter)
+		return woc.markNodeThrottled(nodeName, throttled.message), nil
+	}
 	if errorsutil.IsTransientErr(err) {
 		// Our error was most likely caused by a lack of resources.
 		woc.requeue(10 * time.Second)
@@ -2450,7 +2583,7 @@ func (woc *wfOperationCtx) executeResource(nodeName string, templateScope string
 
 	mainCtr := woc.newExecContainer(common.MainContainerName, tmpl)
 	mainCtr.Command = []string{"argoexec", "resource", tmpl.Resource.Action}
-	_, err = woc.createWorkflowPod(nodeName, *mainCtr, tmpl, &createWorkflowPodOpts{onExitPod: opts.onExitTemplate, executionDeadline: opts.executionDeadline})
+	_, err = woc.createWorkflowPod(nodeName, []apiv1.Container{*mainCtr}, tmpl, &createWorkflowPodOpts{onExitPod: opts.onExitTemplate, executionDeadline: opts.executionDeadline})
 	if err != nil {
 		return woc.requeueIfTransientErr(err, node.Name)
 	}
@@ -4100,6 +4100,33 @@ func TestConfigMapCacheSaveOperate(t *testing.T) {
 	assert.Equal(t, sampleOutputs, *entry.Outputs)
 }
 
+func TestConfigMapCacheLoadExpired(t *testing.T) {
+	var sampleConfigMapCacheEntry = apiv1.ConfigMap{
+		Data: map[string]string{
+			"hi-there-world": `{"nodeID":"memoize-abx4124-123129321123","outputs":{"parameters":[{"name":"hello","value":"hello"}]},"creationTimestamp":"2020-06-18T17:11:05Z"}`,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "whalesay-cache",
+		},
+	}
+	wf := unmarshalWF(workflowCached)
+	cancel, controller := newController()
+	defer cancel()
+
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(&sampleConfigMapCacheEntry)
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	node := woc.wf.GetNodeByName("")
+	if assert.NotNil(t, node) && assert.NotNil(t, node.MemoizationStatus) {
+		assert.False(t, node.MemoizationStatus.Hit)
+		assert.Equal(t, string(cache.ConfigMapCache), node.MemoizationStatus.CacheType)
+		assert.Nil(t, node.Outputs)
+	}
+}
+
 var propagate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+const (
+	// podThrottledRequeuePeriod is how long a workflow waits before it tries to create a throttled pod again
+	podThrottledRequeuePeriod = 10 * time.Second
+	// podRateLimitedRequeuePeriod is how long a workflow waits before it tries to create a rate limited pod again
+	podRateLimitedRequeuePeriod = time.Second
+)
+
+// podThrottledError is returned when a pod is not created yet, because the controller is throttling the creation of
+// pods. The node of the pod stays Pending and throttled, with the message of the error.
+type podThrottledError struct {
+	message      string
+	requeueAfter time.Duration
+}
+
+func (e podThrottledError) Error() string {
+	return e.message
+}
+
+// podCreationCapacityMessage starts the message of the nodes held back by the max pods in flight, or the pod creation
+// rate limit, of the controller
+const podCreationCapacityMessage = "waiting for pod creation capacity"
+
+// isPodThrottled returns whether the node is Pending because the creation of its pod is throttled, rather than because
+// its pod was deleted
+func isPodThrottled(node wfv1.NodeStatus) bool {
+	return node.Pending() && node.Throttled
+}
+
+// markNodeThrottled marks the node Pending and throttled with the message, as the creation of its pod is throttled
+func (woc *wfOperationCtx) markNodeThrottled(nodeName string, message string) *wfv1.NodeStatus {
+	node := woc.markNodePhase(nodeName, wfv1.NodePending, message)
+	if !node.Throttled {
+		node.Throttled = true
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
+	}
+	return node
+}
+
+// podCreationRateLimiter limits the rate workflow pods are created at. The token of an attempt which creates no pod,
+// because the create call fails, is refunded, so that failed attempts do not use up the rate of the other pods.
+type podCreationRateLimiter struct {
+	limiter flowcontrol.RateLimiter
+	burst   int
+	mutex   sync.Mutex
+	// refunded is the number of refunded tokens, which are taken before the tokens of the limiter
+	refunded int
+}
+
+func newPodCreationRateLimiter(qps float32, burst int) *podCreationRateLimiter {
+	return &podCreationRateLimiter{limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst), burst: burst}
+}
+
+// tryAccept takes a token, if there is one, to create a pod
+func (l *podCreationRateLimiter) tryAccept() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.refunded > 0 {
+		l.refunded--
+		return true
+	}
+	return l.limiter.TryAccept()
+}
+
+// refund gives back the token of an attempt which created no pod. There are never more tokens than the burst.
+func (l *podCreationRateLimiter) refund() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.refunded < l.burst {
+		l.refunded++
+	}
+}
+
+// checkPodCreationCapacity returns a podThrottledError if the controller has as many workflow pods in flight as its
+// limit, or is creating pods faster than its rate limit. Otherwise it takes a token of the rate limit, which must be
+// refunded if the pod is not created.
+func (woc *wfOperationCtx) checkPodCreationCapacity() error {
+	if podCreation := woc.controller.Config.PodCreation; podCreation != nil && podCreation.MaxInFlight > 0 {
+		inFlight, err := woc.getInFlightPods("")
+		if err != nil {
+			return err
+		}
+		if inFlight >= podCreation.MaxInFlight {
+			woc.log.Infof("max pods in flight reached %d/%d", inFlight, podCreation.MaxInFlight)
+			return podThrottledError{
+				message:      fmt.Sprintf("%s, %d/%d pods in flight", podCreationCapacityMessage, inFlight, podCreation.MaxInFlight),
+				requeueAfter: podThrottledRequeuePeriod,
+			}
+		}
+	}
+	if limiter := woc.controller.podCreationRateLimiter; limiter != nil && !limiter.tryAccept() {
+		woc.log.Debug("pod creation rate limit reached")
+		return podThrottledError{message: podCreationCapacityMessage, requeueAfter: podRateLimitedRequeuePeriod}
+	}
+	return nil
+}
+
+// getInFlightPods returns the number of pending or running workflow pods of the namespace, or of every namespace if the
+// namespace is "". They are counted the first time the operation needs them, and then kept up to date as the operation
+// creates pods, rather than counted again for every pod.
+func (woc *wfOperationCtx) getInFlightPods(namespace string) (int, error) {
+	if inFlight, ok := woc.inFlightPods[namespace]; ok {
+		return inFlight, nil
+	}
+	var objs []interface{}
+	if namespace == "" {
+		objs = woc.controller.podInformer.GetStore().List()
+	} else {
+		var err error
+		objs, err = woc.controller.podInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list pods of namespace %s: %w", namespace, err)
+		}
+	}
+	inFlight := woc.countInFlightPods(objs)
+	woc.inFlightPods[namespace] = inFlight
+	return inFlight, nil
+}
+
+// addInFlightPod adds a pod created by the operation to the counts of in-flight pods
+func (woc *wfOperationCtx) addInFlightPod() {
+	for namespace := range woc.inFlightPods {
+		if namespace == "" || namespace == woc.wf.Namespace {
+			woc.inFlightPods[namespace]++
+		}
+	}
+}
+
+// countInFlightPods returns the number of pending or running workflow pods amongst the pods, and the pods created by
+// this operation which the pod informer has not received yet
+func (woc *wfOperationCtx) countInFlightPods(objs []interface{}) int {
+	inFlight := 0
+	seen := make(map[string]bool)
+	for _, obj := range objs {
+		pod, ok := obj.(*apiv1.Pod)
+		if !ok {
+			continue
+		}
+		seen[pod.Namespace+"/"+pod.Name] = true
+		if pod.Labels[common.LabelKeyCompleted] != "true" && (pod.Status.Phase == "" || pod.Status.Phase == apiv1.PodPending || pod.Status.Phase == apiv1.PodRunning) {
+			inFlight++
+		}
+	}
+	for podName := range woc.createdPods {
+		if !seen[woc.wf.Namespace+"/"+podName] {
+			inFlight++
+		}
+	}
+	return inFlight
+}
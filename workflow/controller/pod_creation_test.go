@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo/config"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+var podCreationWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: %s
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestPodCreationMaxInFlight(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.Config.PodCreation = &config.PodCreationConfig{MaxInFlight: 1}
+	running := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Status:     apiv1.PodStatus{Phase: apiv1.PodRunning},
+	}
+	assert.NoError(t, controller.podInformer.GetIndexer().Add(running))
+
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(unmarshalWF(fmt.Sprintf(podCreationWf, "max-in-flight")))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("max-in-flight")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.True(t, node.Throttled)
+		assert.Equal(t, "waiting for pod creation capacity, 1/1 pods in flight", node.Message)
+	}
+	pods, err := controller.kubeclientset.CoreV1().Pods("default").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, pods.Items)
+	}
+
+	// the node is still waiting, rather than its pod being deleted
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	node = woc.wf.Status.Nodes.FindByDisplayName("max-in-flight")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+	}
+
+	running.Status.Phase = apiv1.PodSucceeded
+	assert.NoError(t, controller.podInformer.GetIndexer().Update(running))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	pods, err = controller.kubeclientset.CoreV1().Pods("default").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 1)
+	}
+	// the node is no longer throttled once its pod is seen
+	makePodsPhase(t, apiv1.PodRunning, controller.kubeclientset, wf.Namespace)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	node = woc.wf.Status.Nodes.FindByDisplayName("max-in-flight")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		assert.False(t, node.Throttled)
+	}
+}
+
+func TestPodCreationRateLimit(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.podCreationRateLimiter = newPodCreationRateLimiter(0.001, 1)
+
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("default")
+	wf, err := wfcset.Create(unmarshalWF(fmt.Sprintf(podCreationWf, "first")))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node := woc.wf.Status.Nodes.FindByDisplayName("first")
+	if assert.NotNil(t, node) {
+		assert.NotEqual(t, "waiting for pod creation capacity", node.Message)
+	}
+
+	wf, err = wfcset.Create(unmarshalWF(fmt.Sprintf(podCreationWf, "second")))
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	node = woc.wf.Status.Nodes.FindByDisplayName("second")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, "waiting for pod creation capacity", node.Message)
+	}
+	pods, err := controller.kubeclientset.CoreV1().Pods("default").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 1)
+	}
+}
+
+var podCreationResubmitWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: failed-create
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    resubmitPendingPods: true
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestPodCreationRateLimitFailedCreate(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.podCreationRateLimiter = newPodCreationRateLimiter(0.001, 1)
+	failed := false
+	controller.kubeclientset.(*fake.Clientset).PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if failed {
+			return false, nil, nil
+		}
+		failed = true
+		return true, nil, apierr.NewTooManyRequests("quota exceeded", 1)
+	})
+
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(unmarshalWF(podCreationResubmitWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+	assert.True(t, failed)
+	node := woc.wf.Status.Nodes.FindByDisplayName("failed-create")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.False(t, node.Throttled)
+	}
+
+	// the failed attempt did not use up the token of the next one
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate()
+	pods, err := controller.kubeclientset.CoreV1().Pods("default").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 1)
+	}
+}
+
+var podCreationFanOutWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: fan-out
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: echo
+        template: echo
+        withItems: [1, 2, 3]
+  - name: echo
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestPodCreationMaxInFlightFanOut(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.Config.PodCreation = &config.PodCreationConfig{MaxInFlight: 2}
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Create(unmarshalWF(podCreationFanOutWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	// the pods created by the operation count against the limit
+	pods, err := controller.kubeclientset.CoreV1().Pods("default").List(metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 2)
+	}
+	assert.Equal(t, 2, woc.inFlightPods[""])
+	node := woc.wf.Status.Nodes.FindByDisplayName("echo(2:3)")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.Equal(t, "waiting for pod creation capacity, 2/2 pods in flight", node.Message)
+	}
+}
+
+func TestPodReconciliationThrottledNode(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(fmt.Sprintf(podCreationWf, "throttled")), controller)
+	node := woc.initializeNode("throttled", wfv1.NodeTypePod, "", &wfv1.WorkflowStep{Template: "main"}, "", wfv1.NodePending)
+	woc.markNodeThrottled(node.Name, "waiting for pod creation capacity")
+	// the message of a node does not make it throttled
+	node = woc.initializeNode("deleted", wfv1.NodeTypePod, "", &wfv1.WorkflowStep{Template: "main"}, "", wfv1.NodePending)
+	woc.markNodePhase(node.Name, wfv1.NodePending, "waiting for pod creation capacity")
+
+	assert.NoError(t, woc.podReconciliation())
+	node = woc.wf.Status.Nodes.FindByDisplayName("throttled")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodePending, node.Phase)
+		assert.True(t, node.Throttled)
+		assert.Equal(t, "waiting for pod creation capacity", node.Message)
+	}
+	node = woc.wf.Status.Nodes.FindByDisplayName("deleted")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeError, node.Phase)
+		assert.Equal(t, "pod deleted", node.Message)
+	}
+}
+
+func TestPodCreationConfigGetBurst(t *testing.T) {
+	assert.Equal(t, 3, (&config.PodCreationConfig{QPS: 2.5}).GetBurst())
+	assert.Equal(t, 10, (&config.PodCreationConfig{QPS: 2.5, Burst: 10}).GetBurst())
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/antonmedv/expr"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/expr/env"
+)
+
+// unknownExitCode is the lastRetry.exitCode of an attempt which did not record the exit code of its main container,
+// e.g. because its pod was deleted
+const unknownExitCode = "-1"
+
+// shouldRetry evaluates the expression of the retry strategy against the last attempt of the node. An empty expression
+// always retries.
+func shouldRetry(expression string, lastChildNode *wfv1.NodeStatus) (bool, error) {
+	if expression == "" {
+		return true, nil
+	}
+	exitCode := unknownExitCode
+	if lastChildNode.Outputs != nil && lastChildNode.Outputs.ExitCode != nil {
+		exitCode = *lastChildNode.Outputs.ExitCode
+	}
+	vars := map[string]interface{}{
+		"lastRetry.exitCode": exitCode,
+		"lastRetry.status":   string(lastChildNode.Phase),
+		"lastRetry.message":  lastChildNode.Message,
+	}
+	result, err := expr.Eval(expression, env.GetFuncMap(vars))
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate retryStrategy.expression '%s': %s", expression, err)
+	}
+	ok, isBool := result.(bool)
+	if !isBool {
+		return false, fmt.Errorf("retryStrategy.expression '%s' did not evaluate to a boolean", expression)
+	}
+	return ok, nil
+}
+
+// getFailedHostNodeNames returns the Kubernetes nodes on which the failed attempts of the retry node ran
+func getFailedHostNodeNames(retryNode *wfv1.NodeStatus, nodes wfv1.Nodes) []string {
+	var hostNodeNames []string
+	seen := make(map[string]bool)
+	for _, childID := range retryNode.Children {
+		child, ok := nodes[childID]
+		if !ok || !child.FailedOrError() || child.HostNodeName == "" || seen[child.HostNodeName] {
+			continue
+		}
+		seen[child.HostNodeName] = true
+		hostNodeNames = append(hostNodeNames, child.HostNodeName)
+	}
+	return hostNodeNames
+}
+
+// addNodeAntiAffinity returns a copy of the affinity which also requires the pod not to be scheduled on any of the
+// Kubernetes nodes. The requirement is added to every node selector term, as the terms are ORed.
+func addNodeAntiAffinity(affinity *apiv1.Affinity, hostNodeNames []string) *apiv1.Affinity {
+	requirement := apiv1.NodeSelectorRequirement{
+		Key:      "metadata.name",
+		Operator: apiv1.NodeSelectorOpNotIn,
+		Values:   hostNodeNames,
+	}
+	affinity = affinity.DeepCopy()
+	if affinity == nil {
+		affinity = &apiv1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &apiv1.NodeAffinity{}
+	}
+	nodeSelector := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if nodeSelector == nil || len(nodeSelector.NodeSelectorTerms) == 0 {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &apiv1.NodeSelector{
+			NodeSelectorTerms: []apiv1.NodeSelectorTerm{{MatchFields: []apiv1.NodeSelectorRequirement{requirement}}},
+		}
+		return affinity
+	}
+	for i := range nodeSelector.NodeSelectorTerms {
+		term := &nodeSelector.NodeSelectorTerms[i]
+		term.MatchFields = append(term.MatchFields, requirement)
+	}
+	return affinity
+}
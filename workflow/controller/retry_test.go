@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo/util/intstr"
+)
+
+func TestShouldRetry(t *testing.T) {
+	exitCode := "137"
+	oomKilled := &wfv1.NodeStatus{Phase: wfv1.NodeFailed, Message: "OOMKilled", Outputs: &wfv1.Outputs{ExitCode: &exitCode}}
+	deleted := &wfv1.NodeStatus{Phase: wfv1.NodeError, Message: "pod deleted"}
+	for _, tt := range []struct {
+		expression string
+		node       *wfv1.NodeStatus
+		retry      bool
+	}{
+		{"", oomKilled, true},
+		{`lastRetry.exitCode == "137"`, oomKilled, true},
+		{`asInt(lastRetry.exitCode) == 1`, oomKilled, false},
+		{`lastRetry.status == "Error"`, deleted, true},
+		{`lastRetry.message matches "OOM"`, oomKilled, true},
+		{`lastRetry.exitCode == "-1"`, deleted, true},
+	} {
+		retry, err := shouldRetry(tt.expression, tt.node)
+		if assert.NoError(t, err, tt.expression) {
+			assert.Equal(t, tt.retry, retry, tt.expression)
+		}
+	}
+	_, err := shouldRetry(`lastRetry.exitCode`, oomKilled)
+	assert.EqualError(t, err, "retryStrategy.expression 'lastRetry.exitCode' did not evaluate to a boolean")
+}
+
+func TestProcessNodeRetriesWithExpression(t *testing.T) {
+	for _, tt := range []struct {
+		exitCode string
+		phase    wfv1.NodePhase
+	}{
+		{"137", wfv1.NodeRunning},
+		{"1", wfv1.NodeFailed},
+	} {
+		t.Run(tt.exitCode, func(t *testing.T) {
+			cancel, controller := newController()
+			defer cancel()
+			woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+			nodeName := "test-node"
+			woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.Template{}, "", wfv1.NodeRunning)
+			childNode := woc.initializeNode("test-node(0)", wfv1.NodeTypePod, "", &wfv1.Template{}, "", wfv1.NodeFailed)
+			exitCode := tt.exitCode
+			childNode.Outputs = &wfv1.Outputs{ExitCode: &exitCode}
+			woc.wf.Status.Nodes[childNode.ID] = *childNode
+			woc.addChildNode(nodeName, childNode.Name)
+
+			retryStrategy := wfv1.RetryStrategy{Limit: intstrutil.ParsePtr("2"), Expression: `lastRetry.exitCode == "137"`}
+			n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retryStrategy, &executeTemplateOpts{})
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.phase, n.Phase)
+			}
+		})
+	}
+}
+
+func TestAddNodeAntiAffinity(t *testing.T) {
+	notIn := apiv1.NodeSelectorRequirement{Key: "metadata.name", Operator: apiv1.NodeSelectorOpNotIn, Values: []string{"node-1"}}
+	t.Run("NoAffinity", func(t *testing.T) {
+		affinity := addNodeAntiAffinity(nil, []string{"node-1"})
+		assert.Equal(t, []apiv1.NodeSelectorTerm{{MatchFields: []apiv1.NodeSelectorRequirement{notIn}}},
+			affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	})
+	t.Run("NodeSelectorTerms", func(t *testing.T) {
+		zone := apiv1.NodeSelectorRequirement{Key: "zone", Operator: apiv1.NodeSelectorOpIn, Values: []string{"a"}}
+		gpu := apiv1.NodeSelectorRequirement{Key: "gpu", Operator: apiv1.NodeSelectorOpExists}
+		original := &apiv1.Affinity{NodeAffinity: &apiv1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{
+			NodeSelectorTerms: []apiv1.NodeSelectorTerm{{MatchExpressions: []apiv1.NodeSelectorRequirement{zone}}, {MatchExpressions: []apiv1.NodeSelectorRequirement{gpu}}},
+		}}}
+		affinity := addNodeAntiAffinity(original, []string{"node-1"})
+		assert.Equal(t, []apiv1.NodeSelectorTerm{
+			{MatchExpressions: []apiv1.NodeSelectorRequirement{zone}, MatchFields: []apiv1.NodeSelectorRequirement{notIn}},
+			{MatchExpressions: []apiv1.NodeSelectorRequirement{gpu}, MatchFields: []apiv1.NodeSelectorRequirement{notIn}},
+		}, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+		// the affinity of the template is not modified
+		assert.Empty(t, original.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields)
+	})
+}
+
+var retryNodeAntiAffinityWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: retry-node-anti-affinity
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 2
+      affinity:
+        nodeAntiAffinity: {}
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestRetryNodeAntiAffinity(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfcset := controller.wfclientset.ArgoprojV1alpha1().Workflows("")
+	wf, err := wfcset.Create(unmarshalWF(retryNodeAntiAffinityWf))
+	assert.NoError(t, err)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	podcs := controller.kubeclientset.CoreV1().Pods(wf.Namespace)
+	pods, err := podcs.List(metav1.ListOptions{})
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		assert.Nil(t, pods.Items[0].Spec.Affinity)
+		pod := pods.Items[0]
+		pod.Spec.NodeName = "node-1"
+		pod.Status.Phase = apiv1.PodFailed
+		pod.Status.Message = "Pod failed"
+		_, err = podcs.Update(&pod)
+		assert.NoError(t, err)
+	}
+
+	wf, err = wfcset.Get(wf.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate()
+
+	pods, err = podcs.List(metav1.ListOptions{})
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 2) {
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName == "node-1" {
+				continue
+			}
+			if assert.NotNil(t, pod.Spec.Affinity) {
+				terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+				assert.Equal(t, []apiv1.NodeSelectorTerm{{MatchFields: []apiv1.NodeSelectorRequirement{
+					{Key: "metadata.name", Operator: apiv1.NodeSelectorOpNotIn, Values: []string{"node-1"}},
+				}}}, terms)
+			}
+		}
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"path"
 	"strings"
 
+	"github.com/antonmedv/expr"
 	"github.com/valyala/fasttemplate"
 
 	"github.com/argoproj/argo/errors"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/expr/env"
 	"github.com/argoproj/argo/workflow/common"
 )
 
@@ -82,7 +84,38 @@ func (s *wfScope) resolveArtifact(v string, subPath string) (*wfv1.Artifact, err
 	if !ok {
 		return nil, errors.Errorf(errors.CodeBadRequest, "Variable {{%s}} is not an artifact", v)
 	}
+	return s.resolveArtifactSubPath(valArt, v, subPath)
+}
 
+// resolveArtifactFromExpression evaluates the expression, which references parameters and artifacts as it would in a
+// tag (e.g. steps.a.outputs.artifacts.x), and returns the artifact it results in. An expression which references the
+// outputs of a node which did not produce them, such as a skipped step, cannot be resolved.
+func (s *wfScope) resolveArtifactFromExpression(expression string, subPath string) (*wfv1.Artifact, error) {
+	vars := make(map[string]interface{}, len(s.scope))
+	for key, val := range s.scope {
+		vars[key] = val
+	}
+	if s.tmpl != nil {
+		for _, art := range s.tmpl.Inputs.Artifacts {
+			vars["inputs.artifacts."+art.Name] = art
+		}
+	}
+	result, err := expr.Eval(expression, env.GetFuncMap(vars))
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "Unable to resolve expression %q: %v", expression, err)
+	}
+	if result == nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "Unable to resolve expression %q: evaluated to nil", expression)
+	}
+	valArt, ok := result.(wfv1.Artifact)
+	if !ok {
+		return nil, errors.Errorf(errors.CodeBadRequest, "Expression %q did not evaluate to an artifact", expression)
+	}
+	return s.resolveArtifactSubPath(valArt, expression, subPath)
+}
+
+// resolveArtifactSubPath returns a copy of the artifact, resolved from the reference, with the sub-path added to its location
+func (s *wfScope) resolveArtifactSubPath(valArt wfv1.Artifact, v string, subPath string) (*wfv1.Artifact, error) {
 	if subPath != "" {
 		fstTmpl := fasttemplate.New(subPath, "{{", "}}")
 		resolvedSubPath, err := common.Replace(fstTmpl, s.getParameters(), true)
@@ -112,6 +145,9 @@ func (s *wfScope) resolveArtifact(v string, subPath string) (*wfv1.Artifact, err
 		case wfv1.ArtifactLocationGCS:
 			copyArt.GCS.Key = path.Join(copyArt.GCS.Key, resolvedSubPath)
 
+		case wfv1.ArtifactLocationAzure:
+			copyArt.Azure.Blob = path.Join(copyArt.Azure.Blob, resolvedSubPath)
+
 		case wfv1.ArtifactLocationArtifactory:
 			u, err := url.Parse(copyArt.Artifactory.URL)
 			if err != nil {
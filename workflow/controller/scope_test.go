@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 )
 
 func unsupportedArtifactSubPathResolution(t *testing.T, artifactString string) {
@@ -239,3 +241,67 @@ func TestSubPathResolution(t *testing.T) {
 		unsupportedArtifactSubPathResolution(t, RawArtifact)
 	})
 }
+
+func TestResolveArtifactFromExpression(t *testing.T) {
+	scope := wfScope{
+		tmpl:  &wfv1.Template{},
+		scope: make(map[string]interface{}),
+	}
+	scope.addParamToScope("steps.flip.outputs.result", "tails")
+	scope.addParamToScope("steps.heads.status", string(wfv1.NodeSkipped))
+	scope.addParamToScope("steps.tails.status", string(wfv1.NodeSucceeded))
+	scope.addArtifactToScope("steps.tails.outputs.artifacts.out", wfv1.Artifact{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "tails"}}})
+
+	t.Run("Conditional", func(t *testing.T) {
+		art, err := scope.resolveArtifactFromExpression("steps.flip.outputs.result == 'heads' ? steps.heads.outputs.artifacts.out : steps.tails.outputs.artifacts.out", "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "tails", art.S3.Key)
+		}
+	})
+	t.Run("SubPath", func(t *testing.T) {
+		art, err := scope.resolveArtifactFromExpression("steps.tails.outputs.artifacts.out", "sub")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "tails/sub", art.S3.Key)
+		}
+	})
+	t.Run("Skipped", func(t *testing.T) {
+		_, err := scope.resolveArtifactFromExpression("steps.heads.outputs.artifacts.out", "")
+		assert.Contains(t, err.Error(), "Unable to resolve")
+	})
+	t.Run("NotAnArtifact", func(t *testing.T) {
+		_, err := scope.resolveArtifactFromExpression("steps.flip.outputs.result", "")
+		assert.EqualError(t, err, `Expression "steps.flip.outputs.result" did not evaluate to an artifact`)
+	})
+}
+
+func TestGetTemplateOutputsFromScopeExpression(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Name: "main",
+		Outputs: wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{
+				Name:      "result",
+				ValueFrom: &wfv1.ValueFrom{Expression: "steps.flip.outputs.result == 'heads' ? steps.heads.outputs.result : steps.tails.outputs.result"},
+			}},
+			Artifacts: []wfv1.Artifact{
+				{Name: "out", FromExpression: "steps.flip.outputs.result == 'heads' ? steps.heads.outputs.artifacts.out : steps.tails.outputs.artifacts.out"},
+				{Name: "optional", FromExpression: "steps.heads.outputs.artifacts.out", Optional: true},
+			},
+		},
+	}
+	scope := &wfScope{tmpl: tmpl, scope: make(map[string]interface{})}
+	scope.addParamToScope("steps.flip.outputs.result", "tails")
+	scope.addParamToScope("steps.heads.status", string(wfv1.NodeSkipped))
+	scope.addParamToScope("steps.tails.outputs.result", "it was tails")
+	scope.addArtifactToScope("steps.tails.outputs.artifacts.out", wfv1.Artifact{Name: "tails-out", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "tails"}}})
+
+	outputs, err := getTemplateOutputsFromScope(tmpl, scope)
+	if assert.NoError(t, err) {
+		if assert.Len(t, outputs.Parameters, 1) {
+			assert.Equal(t, "it was tails", outputs.Parameters[0].Value.String())
+		}
+		if assert.Len(t, outputs.Artifacts, 1) {
+			assert.Equal(t, "out", outputs.Artifacts[0].Name)
+			assert.Equal(t, "tails", outputs.Artifacts[0].S3.Key)
+		}
+	}
+}
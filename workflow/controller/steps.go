@@ -250,8 +250,12 @@ func (woc *wfOperationCtx) executeStepGroup(stepGroup []wfv1.WorkflowStep, sgNod
 	}
 
 	node = woc.wf.GetNodeByName(sgNodeName)
+	// Run the lifecycle hooks of the steps. A step group is not completed until all of its started hooks are.
+	completed, err := woc.executeStepGroupLifecycleHooks(node, nodeSteps, stepsCtx)
+	if err != nil {
+		return woc.markNodeError(sgNodeName, err)
+	}
 	// Return if not all children completed
-	completed := true
 	for _, childNodeID := range node.Children {
 		childNode := woc.wf.Status.Nodes[childNodeID]
 		step := nodeSteps[childNode.Name]
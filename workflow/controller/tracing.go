@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/tracing"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// traceContext returns the context of the trace of the workflow. The trace has a "workflow" span, whose context is
+// created by the first operation of the workflow and kept in an annotation, so that every operation and pod of the
+// workflow is its child. The workflow span itself is recorded by recordWorkflowSpan once the workflow completes.
+func (woc *wfOperationCtx) traceContext() context.Context {
+	if traceParent := woc.wf.Annotations[common.AnnotationKeyTraceParent]; traceParent != "" {
+		return tracing.Extract(context.Background(), traceParent)
+	}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), tracing.NewSpanContext())
+	if traceParent := tracing.Inject(ctx); traceParent != "" {
+		if woc.wf.Annotations == nil {
+			woc.wf.Annotations = make(map[string]string)
+		}
+		woc.wf.Annotations[common.AnnotationKeyTraceParent] = traceParent
+		woc.updated = true
+	}
+	return ctx
+}
+
+// recordWorkflowSpan records the "workflow" span of the completed workflow, from when it started until it finished
+func (woc *wfOperationCtx) recordWorkflowSpan() {
+	spanCtx := trace.SpanContextFromContext(tracing.Extract(context.Background(), woc.wf.Annotations[common.AnnotationKeyTraceParent]))
+	if !spanCtx.IsValid() {
+		return
+	}
+	startedAt := woc.wf.Status.StartedAt.Time
+	if startedAt.IsZero() {
+		startedAt = woc.wf.CreationTimestamp.Time
+	}
+	_, span := tracing.StartSpanWithContext(spanCtx, "workflow", trace.WithTimestamp(startedAt),
+		trace.WithAttributes(append(woc.spanAttributes(), attribute.String("phase", string(woc.wf.Status.Phase)))...))
+	if woc.wf.Status.Phase != wfv1.NodeSucceeded {
+		span.SetStatus(codes.Error, woc.wf.Status.Message)
+	}
+	span.End(trace.WithTimestamp(woc.wf.Status.FinishedAt.Time))
+}
+
+// startSpan starts a span of the current operation of the workflow
+func (woc *wfOperationCtx) startSpan(name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(woc.spanCtx, name, trace.WithAttributes(append(woc.spanAttributes(), attributes...)...))
+}
+
+func (woc *wfOperationCtx) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("namespace", woc.wf.Namespace),
+		attribute.String("workflow", woc.wf.Name),
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
+	"go.opentelemetry.io/otel/attribute"
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +24,7 @@ import (
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	errorsutil "github.com/argoproj/argo/util/errors"
 	"github.com/argoproj/argo/util/intstr"
+	"github.com/argoproj/argo/util/tracing"
 	"github.com/argoproj/argo/workflow/common"
 	"github.com/argoproj/argo/workflow/util"
 )
@@ -128,13 +130,23 @@ type createWorkflowPodOpts struct {
 	executionDeadline   time.Time
 }
 
-func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (*apiv1.Pod, error) {
+func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtrs []apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (_ *apiv1.Pod, err error) {
 	nodeID := woc.wf.NodeID(nodeName)
+	ctx, span := woc.startSpan("createWorkflowPod", attribute.String("nodeName", nodeName), attribute.String("nodeID", nodeID))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 	woc.log.Debugf("Creating Pod: %s (%s)", nodeName, nodeID)
 	tmpl = tmpl.DeepCopy()
 	wfSpec := woc.execWf.Spec.DeepCopy()
 
-	mainCtr.Name = common.MainContainerName
+	// the containers of a container set keep their own names
+	if tmpl.GetType() != wfv1.TemplateTypeContainerSet {
+		for i := range mainCtrs {
+			mainCtrs[i].Name = common.MainContainerName
+		}
+	}
 
 	var activeDeadlineSeconds *int64
 	wfDeadline := woc.getWorkflowDeadline()
@@ -226,11 +238,12 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 	// each container sequentially in the order that they appear in this list. For PNS we want the
 	// wait container to start before the main, so that it always has the chance to see the main
 	// container's PID and root filesystem.
-	pod.Spec.Containers = append(pod.Spec.Containers, mainCtr)
+	pod.Spec.Containers = append(pod.Spec.Containers, mainCtrs...)
 
 	// Add init container only if it needs input artifacts. This is also true for
-	// script templates (which needs to populate the script)
-	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript {
+	// script templates (which needs to populate the script), and container sets
+	// and the emissary executor (which need the executor binary)
+	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript || tmpl.GetType() == wfv1.TemplateTypeContainerSet || woc.usesEmissary(tmpl) {
 		initCtr := woc.newInitContainer(tmpl)
 		pod.Spec.InitContainers = []apiv1.Container{initCtr}
 	}
@@ -252,6 +265,10 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		addScriptStagingVolume(pod)
 	}
 
+	if tmpl.GetType() == wfv1.TemplateTypeContainerSet {
+		addContainerSetVolume(pod, tmpl)
+	}
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	err = addInitContainers(pod, tmpl)
@@ -264,6 +281,14 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 	}
 	addOutputArtifactsVolumes(pod, tmpl)
 
+	// the emissary is added last, so that the wait sidecar does not mirror the volume it shares with the containers
+	if woc.usesEmissary(tmpl) {
+		err = addEmissary(pod, tmpl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the container template JSON in pod annotations, which executor examines for things like
 	// artifact location/path.
 	tmplBytes, err := json.Marshal(tmpl)
@@ -271,6 +296,10 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		return nil, err
 	}
 	pod.ObjectMeta.Annotations[common.AnnotationKeyTemplate] = string(tmplBytes)
+	// the executor continues the trace of the workflow
+	if traceParent := tracing.Inject(ctx); traceParent != "" {
+		pod.ObjectMeta.Annotations[common.AnnotationKeyTraceParent] = traceParent
+	}
 
 	// Perform one last variable substitution here. Some variables come from the from workflow
 	// configmap (e.g. archive location) or volumes attribute, and were not substituted
@@ -349,8 +378,21 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 		}
 	}
 
+	err = woc.checkNamespacePodParallelism()
+	if err != nil {
+		return nil, err
+	}
+	err = woc.checkPodCreationCapacity()
+	if err != nil {
+		return nil, err
+	}
+
 	created, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(pod)
 	if err != nil {
+		if limiter := woc.controller.podCreationRateLimiter; limiter != nil {
+			// no pod was created, so the attempt does not count against the rate limit
+			limiter.refund()
+		}
 		if apierr.IsAlreadyExists(err) {
 			// workflow pod names are deterministic. We can get here if the
 			// controller fails to persist the workflow after creating the pod.
@@ -365,6 +407,8 @@ func (woc *wfOperationCtx) createWorkflowPod(nodeName string, mainCtr apiv1.Cont
 	}
 	woc.log.Infof("Created pod: %s (%s)", nodeName, created.Name)
 	woc.activePods++
+	woc.createdPods[created.Name] = true
+	woc.addInFlightPod()
 	return created, nil
 }
 
@@ -426,11 +470,18 @@ func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) (*apiv1.Contain
 	return ctr, nil
 }
 
-// hasPrivilegedContainers tests if the main container or sidecars is privileged
+// hasPrivilegedContainers tests if the main container, the containers of a container set, or sidecars is privileged
 func hasPrivilegedContainers(tmpl *wfv1.Template) bool {
 	if containerIsPrivileged(tmpl.Container) {
 		return true
 	}
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if containerIsPrivileged(&ctr.Container) {
+				return true
+			}
+		}
+	}
 	for _, side := range tmpl.Sidecars {
 		if containerIsPrivileged(&side.Container) {
 			return true
@@ -491,7 +542,7 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 				Value: strconv.FormatBool(woc.controller.Config.KubeletInsecure),
 			},
 		)
-	case common.ContainerRuntimeExecutorPNS:
+	case common.ContainerRuntimeExecutorPNS, common.ContainerRuntimeExecutorEmissary:
 		execEnvVars = append(execEnvVars,
 			apiv1.EnvVar{
 				Name:  common.EnvVarContainerRuntimeExecutor,
@@ -521,7 +572,7 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 		})
 	}
 	switch woc.controller.GetContainerRuntimeExecutor() {
-	case common.ContainerRuntimeExecutorKubelet, common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorPNS:
+	case common.ContainerRuntimeExecutorKubelet, common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorPNS, common.ContainerRuntimeExecutorEmissary:
 		return volumes
 	default:
 		return append(volumes, woc.getVolumeDockerSock(tmpl))
@@ -675,7 +726,7 @@ func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *w
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
 func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 	default:
 		return nil
 	}
@@ -738,6 +789,18 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 			return err
 		}
 	}
+	if tmpl.ContainerSet != nil {
+		err := addVolumeRef(tmpl.ContainerSet.VolumeMounts)
+		if err != nil {
+			return err
+		}
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			err := addVolumeRef(ctr.VolumeMounts)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	for _, container := range tmpl.InitContainers {
 		err := addVolumeRef(container.VolumeMounts)
@@ -815,6 +878,8 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 				tmplVolumeMounts = tmpl.Container.VolumeMounts
 			} else if tmpl.Script != nil {
 				tmplVolumeMounts = tmpl.Script.Container.VolumeMounts
+			} else if tmpl.ContainerSet != nil {
+				tmplVolumeMounts = tmpl.ContainerSet.VolumeMounts
 			}
 			for _, mnt := range tmplVolumeMounts {
 				mnt.MountPath = filepath.Join(common.ExecutorMainFilesystemDir, mnt.MountPath)
@@ -826,6 +891,11 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 		}
 	}
 
+	if tmpl.GetType() == wfv1.TemplateTypeContainerSet {
+		// the input artifacts of a container set must be in its volumes, so init loads them there
+		return nil
+	}
+
 	mainCtrIndex := -1
 	for i, ctr := range pod.Spec.Containers {
 		switch ctr.Name {
@@ -879,21 +949,16 @@ func addOutputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.Template) {
 	if tmpl.GetType() == wfv1.TemplateTypeResource {
 		return
 	}
-	mainCtrIndex := -1
+	mainCtr := findMainContainer(pod, tmpl)
 	waitCtrIndex := -1
-	var mainCtr *apiv1.Container
 	for i, ctr := range pod.Spec.Containers {
-		switch ctr.Name {
-		case common.MainContainerName:
-			mainCtrIndex = i
-		case common.WaitContainerName:
+		if ctr.Name == common.WaitContainerName {
 			waitCtrIndex = i
 		}
 	}
-	if mainCtrIndex == -1 || waitCtrIndex == -1 {
+	if mainCtr == nil || waitCtrIndex == -1 {
 		panic("Could not find main or wait container in pod spec")
 	}
-	mainCtr = &pod.Spec.Containers[mainCtrIndex]
 	waitCtr := &pod.Spec.Containers[waitCtrIndex]
 
 	for _, mnt := range mainCtr.VolumeMounts {
@@ -915,7 +980,7 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) error {
 	var needLocation bool
 
 	if tmpl.ArchiveLocation != nil {
-		if tmpl.ArchiveLocation.S3 != nil || tmpl.ArchiveLocation.Artifactory != nil || tmpl.ArchiveLocation.HDFS != nil || tmpl.ArchiveLocation.OSS != nil || tmpl.ArchiveLocation.GCS != nil {
+		if tmpl.ArchiveLocation.S3 != nil || tmpl.ArchiveLocation.Artifactory != nil || tmpl.ArchiveLocation.HDFS != nil || tmpl.ArchiveLocation.OSS != nil || tmpl.ArchiveLocation.GCS != nil || tmpl.ArchiveLocation.Azure != nil {
 			// User explicitly set the location. nothing else to do.
 			return nil
 		}
@@ -1000,6 +1065,16 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) error {
 			},
 			Key: artLocationKey,
 		}
+	} else if azureLocation := woc.artifactRepository.Azure; azureLocation != nil {
+		woc.log.Debugf("Setting Azure artifact repository information")
+		blobName := azureLocation.BlobNameFormat
+		if blobName == "" {
+			blobName = common.DefaultArchivePattern
+		}
+		tmpl.ArchiveLocation.Azure = &wfv1.AzureArtifact{
+			AzureBlobContainer: azureLocation.AzureBlobContainer,
+			Blob:               blobName,
+		}
 	} else {
 		return errors.Errorf(errors.CodeBadRequest, "controller is not configured with a default archive location")
 	}
@@ -1090,13 +1165,94 @@ func addScriptStagingVolume(pod *apiv1.Pod) {
 	}
 }
 
+// addContainerSetVolume sets up a volume shared by the init, wait and containers of a container set, which holds the
+// executor binary that runs each container once its dependencies have completed, and their exit codes
+func addContainerSetVolume(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: common.VarRunArgoVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{},
+		},
+	})
+	volMount := apiv1.VolumeMount{
+		Name:      common.VarRunArgoVolumeName,
+		MountPath: common.VarRunArgoPath,
+	}
+	for i, initCtr := range pod.Spec.InitContainers {
+		if initCtr.Name == common.InitContainerName {
+			pod.Spec.InitContainers[i].VolumeMounts = append(initCtr.VolumeMounts, volMount)
+			break
+		}
+	}
+	for i, ctr := range pod.Spec.Containers {
+		if ctr.Name == common.WaitContainerName || tmpl.ContainerSet.GetContainer(ctr.Name) != nil {
+			pod.Spec.Containers[i].VolumeMounts = append(ctr.VolumeMounts, volMount)
+		}
+	}
+}
+
+// usesEmissary tests if the commands of the containers of the template are run by the emissary executor. Container
+// sets run their commands through the executor anyway, and resource templates have no containers of their own.
+func (woc *wfOperationCtx) usesEmissary(tmpl *wfv1.Template) bool {
+	if woc.controller.GetContainerRuntimeExecutor() != common.ContainerRuntimeExecutorEmissary {
+		return false
+	}
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+		return true
+	default:
+		return false
+	}
+}
+
+// addEmissary wraps the command of the main container and the sidecars with the executor binary, which the init
+// container copies to a volume shared with all containers of the pod. The binary records the exit code, the output
+// and the outputs of each container in that volume, where the wait sidecar collects them, and delivers the signals the
+// wait sidecar requests. As the image entrypoint cannot be known, the command of each container must be specified.
+func addEmissary(pod *apiv1.Pod, tmpl *wfv1.Template) error {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: common.VarRunArgoVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{},
+		},
+	})
+	volMount := apiv1.VolumeMount{
+		Name:      common.VarRunArgoVolumeName,
+		MountPath: common.VarRunArgoPath,
+	}
+	for i, initCtr := range pod.Spec.InitContainers {
+		if initCtr.Name == common.InitContainerName {
+			pod.Spec.InitContainers[i].VolumeMounts = append(initCtr.VolumeMounts, volMount)
+			break
+		}
+	}
+	for i, ctr := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(ctr.VolumeMounts, volMount)
+		if ctr.Name == common.WaitContainerName {
+			continue
+		}
+		if len(ctr.Command) == 0 {
+			return errors.Errorf(errors.CodeBadRequest, "when using the emissary executor you must specify the command of container '%s'", ctr.Name)
+		}
+		command := append([]string{common.VarRunArgoExecutorPath, "emissary", "--"}, ctr.Command...)
+		pod.Spec.Containers[i].Command = append(command, ctr.Args...)
+		pod.Spec.Containers[i].Args = nil
+		pod.Spec.Containers[i].Env = append(ctr.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: ctr.Name})
+		if ctr.Name == common.MainContainerName {
+			// the emissary reads the template from the pod metadata, to find the outputs to save
+			pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, volumeMountPodMetadata)
+		}
+	}
+	return nil
+}
+
 // addInitContainers adds all init containers to the pod spec of the step
 // Optionally volume mounts from the main container to the init containers
 func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.InitContainers) == 0 {
 		return nil
 	}
-	mainCtr := findMainContainer(pod)
+	mainCtr := findMainContainer(pod, tmpl)
 	if mainCtr == nil {
 		panic("Unable to locate main container")
 	}
@@ -1116,7 +1272,7 @@ func addSidecars(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.Sidecars) == 0 {
 		return nil
 	}
-	mainCtr := findMainContainer(pod)
+	mainCtr := findMainContainer(pod, tmpl)
 	if mainCtr == nil {
 		panic("Unable to locate main container")
 	}
@@ -1198,6 +1354,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 		createSecretVal(volMap, &ossRepo.SecretKeySecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, &gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
+		createSecretVal(volMap, azureRepo.SASTokenSecret, uniqueKeyMap)
 	}
 }
 
@@ -1220,6 +1379,9 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, &art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, &art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
+		createSecretVal(volMap, art.Azure.SASTokenSecret, keyMap)
 	}
 }
 
@@ -1256,8 +1418,12 @@ func createSecretVal(volMap map[string]apiv1.Volume, secret *apiv1.SecretKeySele
 	}
 }
 
-// findMainContainer finds main container
-func findMainContainer(pod *apiv1.Pod) *apiv1.Container {
+// findMainContainer finds main container. For container sets, which have no main container, it returns a container
+// with the volume mounts shared by the containers of the set, which are the ones mirrored to other containers
+func findMainContainer(pod *apiv1.Pod, tmpl *wfv1.Template) *apiv1.Container {
+	if tmpl.ContainerSet != nil {
+		return &apiv1.Container{VolumeMounts: tmpl.ContainerSet.VolumeMounts}
+	}
 	var mainCtr *apiv1.Container
 	for _, ctr := range pod.Spec.Containers {
 		if ctr.Name != common.MainContainerName {
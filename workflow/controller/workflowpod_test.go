@@ -157,7 +157,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	woc := newWoc()
 	mainCtr := tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
-	pod, err := woc.createWorkflowPod(tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{})
+	pod, err := woc.createWorkflowPod(tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{})
 	assert.NoError(t, err)
 	// Note: pod.Spec.Containers[0] is wait
 	assert.Contains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
@@ -172,7 +172,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	woc = newWoc(*wf)
 	mainCtr = tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
-	pod, err = woc.createWorkflowPod(tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{includeScriptOutput: true})
+	pod, err = woc.createWorkflowPod(tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{includeScriptOutput: true})
 	assert.NoError(t, err)
 	assert.NotContains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
 	assert.Contains(t, pod.Spec.Containers[1].VolumeMounts, customVolumeMount)
@@ -184,7 +184,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	mainCtr = tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
 
-	pod, err = woc.createWorkflowPod(tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{})
+	pod, err = woc.createWorkflowPod(tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{})
 	assert.NoError(t, err)
 	assert.NotContains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
 }
@@ -1092,19 +1092,19 @@ func TestPodSpecPatch(t *testing.T) {
 	wf := unmarshalWF(helloWorldWfWithPatch)
 	woc := newWoc(*wf)
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	wf = unmarshalWF(helloWorldWfWithWFPatch)
 	woc = newWoc(*wf)
 	mainCtr = woc.execWf.Spec.Templates[0].Container
-	pod, _ = woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	wf = unmarshalWF(helloWorldWfWithWFYAMLPatch)
 	woc = newWoc(*wf)
 	mainCtr = woc.execWf.Spec.Templates[0].Container
-	pod, _ = woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 	assert.Equal(t, "104857600", pod.Spec.Containers[1].Resources.Limits.Memory().AsDec().String())
@@ -1150,7 +1150,7 @@ func TestHybridWfVolumesWindows(t *testing.T) {
 	woc := newWoc(*wf)
 
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "\\\\.\\pipe\\docker_engine", pod.Spec.Containers[0].VolumeMounts[1].MountPath)
 	assert.Equal(t, false, pod.Spec.Containers[0].VolumeMounts[1].ReadOnly)
 	assert.Equal(t, (*apiv1.HostPathType)(nil), pod.Spec.Volumes[1].HostPath.Type)
@@ -1161,7 +1161,7 @@ func TestHybridWfVolumesLinux(t *testing.T) {
 	woc := newWoc(*wf)
 
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "/var/run/docker.sock", pod.Spec.Containers[0].VolumeMounts[1].MountPath)
 	assert.Equal(t, true, pod.Spec.Containers[0].VolumeMounts[1].ReadOnly)
 	assert.Equal(t, &hostPathSocket, pod.Spec.Volumes[1].HostPath.Type)
@@ -1187,10 +1187,68 @@ func TestPropagateMaxDuration(t *testing.T) {
 	tmpl := unmarshalTemplate(propagateMaxDuration)
 	woc := newWoc()
 	deadline := time.Now()
-	pod, err := woc.createWorkflowPod(tmpl.Name, *tmpl.Container, tmpl, &createWorkflowPodOpts{executionDeadline: deadline})
+	pod, err := woc.createWorkflowPod(tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{executionDeadline: deadline})
 	assert.NoError(t, err)
 	out, err := json.Marshal(map[string]time.Time{"deadline": deadline})
 	if assert.NoError(t, err) {
 		assert.Equal(t, string(out), pod.Annotations[common.AnnotationKeyExecutionControl])
 	}
 }
+
+var emissaryTemplate = `
+name: emissary
+container:
+  image: alpine
+  command: [sh, -c]
+  args: ["echo hello"]
+sidecars:
+- name: side
+  image: nginx
+  command: [nginx]
+`
+
+func TestEmissary(t *testing.T) {
+	t.Run("WrapsCommands", func(t *testing.T) {
+		tmpl := unmarshalTemplate(emissaryTemplate)
+		woc := newWoc()
+		woc.controller.Config.ContainerRuntimeExecutor = common.ContainerRuntimeExecutorEmissary
+		pod, err := woc.createWorkflowPod(tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		if assert.NoError(t, err) {
+			volumeMount := apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.VarRunArgoPath}
+			for _, v := range pod.Spec.Volumes {
+				assert.NotEqual(t, "docker-sock", v.Name)
+			}
+			assert.Contains(t, pod.Spec.Volumes, apiv1.Volume{Name: common.VarRunArgoVolumeName, VolumeSource: apiv1.VolumeSource{EmptyDir: &apiv1.EmptyDirVolumeSource{}}})
+			if assert.Len(t, pod.Spec.InitContainers, 1) {
+				assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, volumeMount)
+			}
+			if assert.Len(t, pod.Spec.Containers, 3) {
+				wait := pod.Spec.Containers[0]
+				assert.Equal(t, []string{"argoexec", "wait"}, wait.Command)
+				assert.Contains(t, wait.VolumeMounts, volumeMount)
+				assert.Contains(t, wait.Env, apiv1.EnvVar{Name: common.EnvVarContainerRuntimeExecutor, Value: common.ContainerRuntimeExecutorEmissary})
+
+				main := pod.Spec.Containers[1]
+				assert.Equal(t, []string{common.VarRunArgoExecutorPath, "emissary", "--", "sh", "-c", "echo hello"}, main.Command)
+				assert.Empty(t, main.Args)
+				assert.Contains(t, main.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: "main"})
+				assert.Contains(t, main.VolumeMounts, volumeMount)
+				assert.Contains(t, main.VolumeMounts, volumeMountPodMetadata)
+
+				side := pod.Spec.Containers[2]
+				assert.Equal(t, []string{common.VarRunArgoExecutorPath, "emissary", "--", "nginx"}, side.Command)
+				assert.Contains(t, side.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: "side"})
+				assert.Contains(t, side.VolumeMounts, volumeMount)
+				assert.NotContains(t, side.VolumeMounts, volumeMountPodMetadata)
+			}
+		}
+	})
+	t.Run("CommandRequired", func(t *testing.T) {
+		tmpl := unmarshalTemplate(emissaryTemplate)
+		tmpl.Sidecars[0].Command = nil
+		woc := newWoc()
+		woc.controller.Config.ContainerRuntimeExecutor = common.ContainerRuntimeExecutorEmissary
+		_, err := woc.createWorkflowPod(tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		assert.EqualError(t, err, "when using the emissary executor you must specify the command of container 'side'")
+	})
+}
@@ -0,0 +1,172 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+// maxBackfillScheduledTimes is the maximum number of schedule times a single backfill may cover
+const maxBackfillScheduledTimes = 1000
+
+// GetScheduledTimes returns the times, in order, at which the cron workflow is scheduled between from and to, inclusive
+func GetScheduledTimes(cronWf *v1alpha1.CronWorkflow, from, to time.Time) ([]time.Time, error) {
+	if to.Before(from) {
+		return nil, errors.Errorf(errors.CodeBadRequest, "to (%s) must not be before from (%s)", to.Format(time.RFC3339), from.Format(time.RFC3339))
+	}
+	cronSchedule, err := cronWf.GetSchedule()
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "invalid schedule: %s", err)
+	}
+	var scheduledTimes []time.Time
+	// the schedule's next time is strictly after the time it is given, so start just before from to include it
+	for t := cronSchedule.Next(from.Add(-time.Nanosecond)); !t.IsZero() && !t.After(to); t = cronSchedule.Next(t) {
+		if len(scheduledTimes) == maxBackfillScheduledTimes {
+			return nil, errors.Errorf(errors.CodeBadRequest, "cannot backfill more than %d scheduled times, use a smaller window", maxBackfillScheduledTimes)
+		}
+		scheduledTimes = append(scheduledTimes, t)
+	}
+	return scheduledTimes, nil
+}
+
+// getBackfillWorkflowName returns the name of the workflow of the scheduled time, so that a scheduled time is not
+// backfilled twice while its workflow exists
+func getBackfillWorkflowName(cronWf *v1alpha1.CronWorkflow, scheduledTime time.Time) string {
+	return fmt.Sprintf("%s-%d", cronWf.Name, scheduledTime.Unix())
+}
+
+// Backfill creates the workflows of the cron workflow's scheduled times between from and to, inclusive, which have not
+// been backfilled yet, and returns the created workflows. Each workflow gets the scheduled time as
+// {{workflow.scheduledTime}}.
+//
+// The backfilled scheduled times are recorded in the cron workflow's status, so that they are not backfilled again
+// once their workflows have been deleted or archived.
+//
+// The cron workflow's concurrency policy is respected. With "Allow", the workflows of all the scheduled times are
+// created at once. With "Forbid" or "Replace", workflows must not run at the same time, so only the workflow of the
+// earliest scheduled time is created, and only if none of the cron workflow's workflows are running. Backfill is
+// then called again once that workflow has completed, until it creates no more workflows.
+func Backfill(wfClientset versioned.Interface, cronWf *v1alpha1.CronWorkflow, from, to time.Time) ([]v1alpha1.Workflow, error) {
+	scheduledTimes, err := GetScheduledTimes(cronWf, from, to)
+	if err != nil {
+		return nil, err
+	}
+	wfClient := wfClientset.ArgoprojV1alpha1().Workflows(cronWf.Namespace)
+	concurrent := true
+	switch cronWf.Spec.ConcurrencyPolicy {
+	case v1alpha1.AllowConcurrent, "":
+	case v1alpha1.ForbidConcurrent, v1alpha1.ReplaceConcurrent:
+		concurrent = false
+		running, err := hasRunningWorkflows(wfClientset, cronWf)
+		if err != nil {
+			return nil, err
+		}
+		if running {
+			log.WithField("cronWorkflow", cronWf.Name).Infof("Not backfilling, there are running workflows and the concurrency policy is %s", cronWf.Spec.ConcurrencyPolicy)
+			return nil, nil
+		}
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "invalid ConcurrencyPolicy: %s", cronWf.Spec.ConcurrencyPolicy)
+	}
+	var created []v1alpha1.Workflow
+	// the indexes of the scheduled times which have been backfilled, by this or an earlier backfill
+	var backfilled []int
+	for i, scheduledTime := range scheduledTimes {
+		if cronWf.Status.HasBackfilled(scheduledTime) {
+			continue
+		}
+		wf := common.ConvertCronWorkflowToWorkflowWithScheduledTime(cronWf, scheduledTime)
+		wf.GenerateName = ""
+		wf.Name = getBackfillWorkflowName(cronWf, scheduledTime)
+		_, err := wfClient.Get(wf.Name, v1.GetOptions{})
+		if err == nil {
+			backfilled = append(backfilled, i)
+			continue
+		}
+		if !apierr.IsNotFound(err) {
+			return nil, err
+		}
+		runWf, err := util.SubmitWorkflow(wfClient, wfClientset, cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
+		if apierr.IsAlreadyExists(err) {
+			backfilled = append(backfilled, i)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		log.WithFields(log.Fields{"cronWorkflow": cronWf.Name, "workflow": runWf.Name}).Infof("Backfilled scheduled time %s", scheduledTime.Format(time.RFC3339))
+		created = append(created, *runWf)
+		backfilled = append(backfilled, i)
+		if !concurrent {
+			break
+		}
+	}
+	if len(backfilled) > 0 {
+		err = recordBackfilled(wfClientset, cronWf, scheduledTimes, backfilled)
+		if err != nil {
+			return created, fmt.Errorf("could not record the backfilled scheduled times: %w", err)
+		}
+	}
+	return created, nil
+}
+
+// recordBackfilled adds the backfilled scheduled times to the status of the cron workflow, retrying if the cron
+// workflow has been updated meanwhile, e.g. by the controller
+func recordBackfilled(wfClientset versioned.Interface, cronWf *v1alpha1.CronWorkflow, scheduledTimes []time.Time, backfilled []int) error {
+	cronWfClient := wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWf.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := cronWfClient.Get(cronWf.Name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, i := range backfilled {
+			var previous time.Time
+			if i > 0 {
+				previous = scheduledTimes[i-1]
+			}
+			latest.Status.AddBackfilled(scheduledTimes[i], previous)
+		}
+		updated, err := cronWfClient.Update(latest)
+		if err != nil {
+			return err
+		}
+		cronWf.Status = updated.Status
+		return nil
+	})
+}
+
+// hasRunningWorkflows returns whether any of the cron workflow's workflows, scheduled or backfilled, have not completed
+func hasRunningWorkflows(wfClientset versioned.Interface, cronWf *v1alpha1.CronWorkflow) (bool, error) {
+	cronWfReq, err := labels.NewRequirement(common.LabelKeyCronWorkflow, selection.Equals, []string{cronWf.Name})
+	if err != nil {
+		return false, err
+	}
+	incompleteReq, err := labels.NewRequirement(common.LabelKeyCompleted, selection.NotEquals, []string{"true"})
+	if err != nil {
+		return false, err
+	}
+	list, err := wfClientset.ArgoprojV1alpha1().Workflows(cronWf.Namespace).List(v1.ListOptions{
+		LabelSelector: labels.NewSelector().Add(*cronWfReq, *incompleteReq).String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, wf := range list.Items {
+		if !wf.Status.Fulfilled() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
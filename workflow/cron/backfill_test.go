@@ -0,0 +1,131 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+var backfillCronWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: my-cron
+  namespace: my-ns
+spec:
+  schedule: "0 * * * *"
+  concurrencyPolicy: Forbid
+  workflowSpec:
+    entrypoint: main
+    templates:
+    - name: main
+      container:
+        image: docker/whalesay:latest
+        args: ["{{workflow.scheduledTime}}"]
+`
+
+func TestGetScheduledTimes(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(backfillCronWf), &cronWf)
+	assert.NoError(t, err)
+	cronWf.Spec.Timezone = "UTC"
+	from := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Inclusive", func(t *testing.T) {
+		scheduledTimes, err := GetScheduledTimes(&cronWf, from, from.Add(2*time.Hour))
+		if assert.NoError(t, err) && assert.Len(t, scheduledTimes, 3) {
+			assert.True(t, from.Equal(scheduledTimes[0]))
+			assert.True(t, from.Add(2*time.Hour).Equal(scheduledTimes[2]))
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		scheduledTimes, err := GetScheduledTimes(&cronWf, from.Add(time.Minute), from.Add(59*time.Minute))
+		if assert.NoError(t, err) {
+			assert.Empty(t, scheduledTimes)
+		}
+	})
+	t.Run("ToBeforeFrom", func(t *testing.T) {
+		_, err := GetScheduledTimes(&cronWf, from, from.Add(-time.Hour))
+		assert.Error(t, err)
+	})
+	t.Run("TooMany", func(t *testing.T) {
+		_, err := GetScheduledTimes(&cronWf, from, from.Add(365*24*time.Hour))
+		assert.EqualError(t, err, "cannot backfill more than 1000 scheduled times, use a smaller window")
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(backfillCronWf), &cronWf)
+	assert.NoError(t, err)
+	from := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	t.Run("Allow", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.ConcurrencyPolicy = v1alpha1.AllowConcurrent
+		cs := fake.NewSimpleClientset(cronWf)
+		wfs, err := Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 3) {
+			assert.Equal(t, "my-cron-1577872800", wfs[0].Name)
+			assert.Equal(t, "my-cron", wfs[0].Labels[common.LabelKeyCronWorkflow])
+			assert.Contains(t, wfs[0].Annotations, common.AnnotationKeyCronWfScheduledTime)
+		}
+		wfs, err = Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) {
+			assert.Empty(t, wfs)
+		}
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.ConcurrencyPolicy = v1alpha1.AllowConcurrent
+		cs := fake.NewSimpleClientset(cronWf)
+		wfs, err := Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 3) {
+			wfClient := cs.ArgoprojV1alpha1().Workflows("my-ns")
+			for _, wf := range wfs {
+				assert.NoError(t, wfClient.Delete(wf.Name, nil))
+			}
+		}
+		stored, err := cs.ArgoprojV1alpha1().CronWorkflows("my-ns").Get("my-cron", v1.GetOptions{})
+		if assert.NoError(t, err) && assert.Len(t, stored.Status.Backfilled, 1) {
+			assert.True(t, from.Equal(stored.Status.Backfilled[0].From.Time))
+			assert.True(t, to.Equal(stored.Status.Backfilled[0].To.Time))
+		}
+		wfs, err = Backfill(cs, stored, from, to.Add(time.Hour))
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1, "only the scheduled time which was not backfilled") {
+			assert.Equal(t, "my-cron-1577883600", wfs[0].Name)
+		}
+	})
+	t.Run("Forbid", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cs := fake.NewSimpleClientset(cronWf)
+		wfs, err := Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "my-cron-1577872800", wfs[0].Name)
+		}
+		// the first workflow is still running
+		wfs, err = Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) {
+			assert.Empty(t, wfs)
+		}
+		wfClient := cs.ArgoprojV1alpha1().Workflows("my-ns")
+		wf, err := wfClient.Get("my-cron-1577872800", v1.GetOptions{})
+		if assert.NoError(t, err) {
+			wf.Status.Phase = v1alpha1.NodeSucceeded
+			_, err = wfClient.Update(wf)
+			assert.NoError(t, err)
+		}
+		wfs, err = Backfill(cs, cronWf, from, to)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "my-cron-1577876400", wfs[0].Name)
+		}
+	})
+}
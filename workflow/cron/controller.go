@@ -93,7 +93,7 @@ func (cc *Controller) Run(ctx context.Context) {
 
 	cc.wfInformer = util.NewWorkflowInformer(cc.restConfig, cc.managedNamespace, cronWorkflowResyncPeriod, func(options *v1.ListOptions) {
 		wfInformerListOptionsFunc(options, cc.instanceId)
-	})
+	}, cache.Indexers{})
 	cc.addWorkflowInformerHandler()
 
 	cc.wfLister = util.NewWorkflowLister(cc.wfInformer)
@@ -178,17 +178,14 @@ func (cc *Controller) processNextCronItem() bool {
 		delete(cc.nameEntryIDMap, key.(string))
 	}
 
-	cronSchedule := cronWf.Spec.Schedule
-	if cronWf.Spec.Timezone != "" {
-		cronSchedule = "CRON_TZ=" + cronWf.Spec.Timezone + " " + cronSchedule
-	}
-
-	entryId, err := cc.cron.AddJob(cronSchedule, cronWorkflowOperationCtx)
+	cronSchedule, err := cronWf.GetSchedule()
 	if err != nil {
 		logCtx.WithError(err).Error("could not schedule CronWorkflow")
 		return true
 	}
-	cc.nameEntryIDMap[key.(string)] = entryId
+
+	// all the schedules are added as one entry, so that the CronWorkflow is only run once if schedules coincide
+	cc.nameEntryIDMap[key.(string)] = cc.cron.Schedule(cronSchedule, cronWorkflowOperationCtx)
 
 	logCtx.Infof("CronWorkflow %s added", key.(string))
 
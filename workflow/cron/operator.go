@@ -24,6 +24,9 @@ import (
 	"github.com/argoproj/argo/workflow/validate"
 )
 
+// how far back getLastScheduledTime looks for a time the schedule was due at
+const maxLastScheduledTimeWindow = 5 * 366 * 24 * time.Hour
+
 type cronWfOperationCtx struct {
 	// CronWorkflow is the CronWorkflow to be run
 	name        string
@@ -76,7 +79,14 @@ func (woc *cronWfOperationCtx) Run() {
 		return
 	}
 
-	wf := common.ConvertCronWorkflowToWorkflow(woc.cronWf)
+	cronSchedule, err := woc.cronWf.GetSchedule()
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, fmt.Sprint(err))
+		return
+	}
+	// the workflow is run for the time it was scheduled at, rather than the time it happens to be run at
+	scheduledTime := getLastScheduledTime(cronSchedule, time.Now())
+	wf := common.ConvertCronWorkflowToWorkflowWithScheduledTime(woc.cronWf, scheduledTime)
 
 	runWf, err := util.SubmitWorkflow(woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
 	if err != nil {
@@ -85,7 +95,7 @@ func (woc *cronWfOperationCtx) Run() {
 	}
 
 	woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
-	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: time.Now()}
+	woc.cronWf.Status.LastScheduledTime = &v1.Time{Time: scheduledTime}
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
 	woc.persistUpdate()
 }
@@ -237,28 +247,11 @@ func (woc *cronWfOperationCtx) runOutstandingWorkflows() error {
 func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (bool, error) {
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
 	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
-		if woc.cronWf.Spec.Timezone != "" {
-			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
-			if err != nil {
-				return false, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
-			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := "CRON_TZ=" + woc.cronWf.Spec.Timezone + " " + woc.cronWf.Spec.Schedule
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return false, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return false, err
-			}
+		cronSchedule, err := woc.cronWf.GetSchedule()
+		if err != nil {
+			return false, err
 		}
+		now := time.Now()
 
 		var missedExecutionTime time.Time
 		nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
@@ -280,6 +273,23 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (bool, error) {
 	return false, nil
 }
 
+// getLastScheduledTime returns the latest time the schedule was due at, at or before now. The cron workflow is run
+// either by its schedule, just after the time it was due at, or because it missed that time.
+func getLastScheduledTime(cronSchedule cron.Schedule, now time.Time) time.Time {
+	// look back over an ever larger window, rather than from a fixed time, so the number of times iterated over is small
+	for window := time.Minute; window <= maxLastScheduledTimeWindow; window *= 2 {
+		scheduledTime := cronSchedule.Next(now.Add(-window))
+		if scheduledTime.After(now) {
+			continue
+		}
+		for next := cronSchedule.Next(scheduledTime); !next.After(now); next = cronSchedule.Next(next) {
+			scheduledTime = next
+		}
+		return scheduledTime
+	}
+	return now
+}
+
 func (woc *cronWfOperationCtx) reconcileDeletedWfs() error {
 	wfList, err := woc.wfLister.List()
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/argoproj/pkg/humanize"
+	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -275,3 +276,21 @@ func TestReapplyUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLastScheduledTime(t *testing.T) {
+	now := time.Date(2020, 10, 18, 12, 0, 30, 0, time.UTC)
+	for schedule, expected := range map[string]time.Time{
+		"* * * * *":   time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC),
+		"*/5 * * * *": time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC),
+		"30 * * * *":  time.Date(2020, 10, 18, 11, 30, 0, 0, time.UTC),
+		"0 0 * * *":   time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC),
+		"0 0 1 1 *":   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		t.Run(schedule, func(t *testing.T) {
+			cronSchedule, err := cron.ParseStandard("CRON_TZ=UTC " + schedule)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, getLastScheduledTime(cronSchedule, now).UTC())
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package containerset
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// omittedExitCode is recorded as the exit code of a container which was not run because a dependency did not succeed
+const omittedExitCode = "omitted"
+
+// Runner runs the command of a container of a container set, once the containers it depends on have succeeded. Each
+// container records its exit code in the directory shared by the containers of the set, which is how the containers
+// which depend on it learn that it has completed.
+type Runner struct {
+	// Dir is the directory shared by the containers of the set
+	Dir string
+	// Name is the name of the container
+	Name string
+	// Dependencies are the names of the containers which must succeed before the command is run
+	Dependencies []string
+	// PollInterval is how often the exit codes of the dependencies are checked
+	PollInterval time.Duration
+	// TerminationMessagePath is the file the reason a container was omitted is written to
+	TerminationMessagePath string
+}
+
+// NewRunner returns a runner for the named container, using the directory mounted into the containers of the set
+func NewRunner(name string, dependencies []string) *Runner {
+	return &Runner{
+		Dir:                    common.VarRunArgoPath,
+		Name:                   name,
+		Dependencies:           dependencies,
+		PollInterval:           time.Second,
+		TerminationMessagePath: "/dev/termination-log",
+	}
+}
+
+// Run waits for the dependencies, then runs the command and returns its exit code. If a dependency did not succeed,
+// the command is not run, and a message prefixed with "omitted" is written as the termination message of the
+// container, so that the controller reports it as omitted.
+func (r *Runner) Run(ctx context.Context, command []string) (int, error) {
+	for _, dep := range r.Dependencies {
+		exitCode, err := r.waitForExitCode(ctx, dep)
+		if err != nil {
+			return 1, err
+		}
+		if exitCode != "0" {
+			log.Infof("Not running %s as dependency %s did not succeed", r.Name, dep)
+			message := fmt.Sprintf("%s: dependency '%s' did not succeed", common.ContainerOmittedMessagePrefix, dep)
+			if err := ioutil.WriteFile(r.TerminationMessagePath, []byte(message), 0644); err != nil {
+				return 1, err
+			}
+			return 0, r.writeExitCode(omittedExitCode)
+		}
+	}
+	exitCode, runErr := r.runCommand(command)
+	if err := r.writeExitCode(strconv.Itoa(exitCode)); err != nil {
+		return 1, err
+	}
+	return exitCode, runErr
+}
+
+// runCommand runs the command, forwarding signals to it, and returns its exit code
+func (r *Runner) runCommand(command []string) (int, error) {
+	if len(command) == 0 {
+		return 1, fmt.Errorf("container %s has no command", r.Name)
+	}
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return 1, err
+	}
+
+	signals := make(chan os.Signal, 1)
+	defer close(signals)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		for s := range signals {
+			log.Infof("Forwarding signal %s to %s", s, r.Name)
+			_ = cmd.Process.Signal(s)
+		}
+	}()
+
+	err := cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+func (r *Runner) exitCodePath(name string) string {
+	return filepath.Join(r.Dir, "ctr", name, "exitcode")
+}
+
+// waitForExitCode waits until the named container has recorded its exit code, and returns it
+func (r *Runner) waitForExitCode(ctx context.Context, name string) (string, error) {
+	ticker := time.NewTicker(r.PollInterval)
+	defer ticker.Stop()
+	for {
+		data, err := ioutil.ReadFile(r.exitCodePath(name))
+		if err == nil {
+			return strings.TrimSpace(string(data)), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordExitCode records the exit code of a container which terminated without recording it itself, e.g. because it was
+// killed, so that the containers which depend on it do not wait for it forever. An exit code which the container
+// recorded is kept.
+func RecordExitCode(dir, name string, exitCode int32) (bool, error) {
+	r := &Runner{Dir: dir, Name: name}
+	if _, err := os.Stat(r.exitCodePath(name)); !os.IsNotExist(err) {
+		return false, err
+	}
+	return true, r.writeExitCode(strconv.Itoa(int(exitCode)))
+}
+
+// writeExitCode records the exit code of the container, by renaming a temporary file so that it is never read partially
+func (r *Runner) writeExitCode(exitCode string) error {
+	path := r.exitCodePath(r.Name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path+".tmp", []byte(exitCode), 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
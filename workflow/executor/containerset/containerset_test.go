@@ -0,0 +1,90 @@
+package containerset
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRunner(dir, name string, dependencies ...string) *Runner {
+	return &Runner{
+		Dir:                    dir,
+		Name:                   name,
+		Dependencies:           dependencies,
+		PollInterval:           10 * time.Millisecond,
+		TerminationMessagePath: filepath.Join(dir, name+"-termination-log"),
+	}
+}
+
+func TestRunner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerset")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	ctx := context.Background()
+
+	exitCode, err := newTestRunner(dir, "a").Run(ctx, []string{"sh", "-c", "exit 0"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+
+	exitCode, err = newTestRunner(dir, "b", "a").Run(ctx, []string{"sh", "-c", "exit 3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, exitCode)
+
+	t.Run("DependencyFailed", func(t *testing.T) {
+		exitCode, err := newTestRunner(dir, "c", "a", "b").Run(ctx, []string{"sh", "-c", "exit 0"})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, exitCode)
+		message, err := ioutil.ReadFile(filepath.Join(dir, "c-termination-log"))
+		assert.NoError(t, err)
+		assert.Equal(t, "omitted: dependency 'b' did not succeed", string(message))
+	})
+	t.Run("DependencyOmitted", func(t *testing.T) {
+		exitCode, err := newTestRunner(dir, "d", "c").Run(ctx, []string{"sh", "-c", "exit 0"})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, exitCode)
+		data, err := ioutil.ReadFile(filepath.Join(dir, "ctr", "d", "exitcode"))
+		assert.NoError(t, err)
+		assert.Equal(t, omittedExitCode, string(data))
+	})
+	t.Run("WaitsForDependency", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err := newTestRunner(dir, "e", "not-completed").Run(ctx, []string{"sh", "-c", "exit 0"})
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+	t.Run("CommandNotFound", func(t *testing.T) {
+		exitCode, err := newTestRunner(dir, "f").Run(ctx, []string{filepath.Join(dir, "not-found")})
+		assert.Error(t, err)
+		assert.Equal(t, 1, exitCode)
+	})
+}
+
+func TestRecordExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerset")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	exitCode, err := newTestRunner(dir, "a").Run(context.Background(), []string{"sh", "-c", "exit 2"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, exitCode)
+	// the exit code recorded by the container is kept
+	recorded, err := RecordExitCode(dir, "a", 137)
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+
+	// a killed container never records its exit code, so its dependents would wait forever
+	recorded, err = RecordExitCode(dir, "b", 137)
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+	exitCode, err = newTestRunner(dir, "c", "b").Run(context.Background(), []string{"sh", "-c", "exit 0"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+	message, err := ioutil.ReadFile(filepath.Join(dir, "c-termination-log"))
+	assert.NoError(t, err)
+	assert.Equal(t, "omitted: dependency 'b' did not succeed", string(message))
+}
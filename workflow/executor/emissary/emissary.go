@@ -0,0 +1,272 @@
+package emissary
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/workflow/common"
+	execcommon "github.com/argoproj/argo/workflow/executor/common"
+)
+
+// EmissaryExecutor collects the outputs of the containers from the directory they share with the wait container,
+// where the emissary running their commands records them, so it needs neither privileges nor access to the container
+// runtime. Signals are requested by writing them to a file in the same directory.
+type EmissaryExecutor struct {
+	clientset kubernetes.Interface
+	podName   string
+	namespace string
+	// dir is the directory shared with the containers
+	dir string
+	// pollInterval is how often the exit code of the main container is checked
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// ctrIDToName maps a containerID to the name of the container
+	ctrIDToName map[string]string
+}
+
+func NewEmissaryExecutor(clientset kubernetes.Interface, podName, namespace string) (*EmissaryExecutor, error) {
+	log.Infof("Creating an emissary executor (namespace: %s, pod: %s)", namespace, podName)
+	return &EmissaryExecutor{
+		clientset:    clientset,
+		podName:      podName,
+		namespace:    namespace,
+		dir:          common.VarRunArgoPath,
+		pollInterval: time.Second,
+		ctrIDToName:  make(map[string]string),
+	}, nil
+}
+
+func (e *EmissaryExecutor) GetFileContents(containerID string, sourcePath string) (string, error) {
+	data, err := ioutil.ReadFile(parameterPath(e.dir, sourcePath))
+	if os.IsNotExist(err) {
+		return "", errors.Errorf(errors.CodeNotFound, "output parameter file %s was not found", sourcePath)
+	}
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	return string(data), nil
+}
+
+// CopyFile copies the archive the emissary saved of the source path, which is already tarred and gzipped with the
+// compression level of the artifact
+func (e *EmissaryExecutor) CopyFile(containerID string, sourcePath string, destPath string, compressionLevel int) error {
+	log.Infof("Copying %s to %s", sourcePath, destPath)
+	in, err := os.Open(artifactPath(e.dir, sourcePath))
+	if os.IsNotExist(err) {
+		return errors.Errorf(errors.CodeNotFound, "output artifact path %s was not found", sourcePath)
+	}
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(destPath)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
+func (e *EmissaryExecutor) GetOutputStream(containerID string, combinedOutput bool) (io.ReadCloser, error) {
+	name, err := e.containerName(containerID)
+	if err != nil {
+		return nil, err
+	}
+	path := stdoutPath(e.dir, name)
+	if combinedOutput {
+		path = combinedPath(e.dir, name)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.InternalWrapError(err)
+	}
+	return f, nil
+}
+
+func (e *EmissaryExecutor) GetExitCode(containerID string) (string, error) {
+	name, err := e.containerName(containerID)
+	if err != nil {
+		return "", err
+	}
+	data, err := ioutil.ReadFile(exitCodePath(e.dir, name))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (e *EmissaryExecutor) WaitInit() error {
+	return nil
+}
+
+// Wait waits until the emissary has recorded the exit code of the container. The pod is also checked every so often,
+// in case the container terminated without the emissary having the chance to record it, e.g. when it was OOM killed.
+func (e *EmissaryExecutor) Wait(containerID string) error {
+	log.Infof("Waiting for container %s to complete", containerID)
+	name, err := e.containerName(containerID)
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		_, err := os.Stat(exitCodePath(e.dir, name))
+		if err == nil {
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return errors.InternalWrapError(err)
+		}
+		if i%10 == 9 {
+			terminated, err := e.isTerminated(name)
+			if err != nil {
+				return err
+			}
+			if terminated {
+				return nil
+			}
+		}
+		<-ticker.C
+	}
+}
+
+// Kill requests the emissary of each container to send it a SIGTERM, then a SIGKILL after a grace period
+func (e *EmissaryExecutor) Kill(containerIDs []string) error {
+	log.Infof("Killing containers %s", containerIDs)
+	var names []string
+	for _, containerID := range containerIDs {
+		name, err := e.containerName(containerID)
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+	if err := e.signal(names, syscall.SIGTERM); err != nil {
+		return err
+	}
+	deadline := time.Now().Add(execcommon.KillGracePeriod * time.Second)
+	for time.Now().Before(deadline) {
+		if e.allExited(names) {
+			return nil
+		}
+		time.Sleep(e.pollInterval)
+	}
+	log.Infof("Containers %s did not terminate within the grace period, sending SIGKILL", names)
+	return e.signal(names, syscall.SIGKILL)
+}
+
+// signal requests the emissary of each named container, which has not yet exited, to send it the signal
+func (e *EmissaryExecutor) signal(names []string, sig syscall.Signal) error {
+	for _, name := range names {
+		if e.hasExited(name) {
+			continue
+		}
+		if err := writeAtomically(signalPath(e.dir, name), []byte(strconv.Itoa(int(sig)))); err != nil {
+			return errors.InternalWrapError(err)
+		}
+	}
+	return nil
+}
+
+func (e *EmissaryExecutor) allExited(names []string) bool {
+	for _, name := range names {
+		if !e.hasExited(name) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *EmissaryExecutor) hasExited(name string) bool {
+	_, err := os.Stat(exitCodePath(e.dir, name))
+	return err == nil
+}
+
+// isTerminated checks the pod to see if the named container has terminated
+func (e *EmissaryExecutor) isTerminated(name string) (bool, error) {
+	pod, err := e.clientset.CoreV1().Pods(e.namespace).Get(e.podName, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.InternalWrapError(err)
+	}
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if ctrStatus.Name == name {
+			return ctrStatus.State.Terminated != nil, nil
+		}
+	}
+	return false, nil
+}
+
+// containerName returns the name of the container with the given ID, which is how the containers are known in the
+// shared directory
+func (e *EmissaryExecutor) containerName(containerID string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if name, ok := e.ctrIDToName[containerID]; ok {
+		return name, nil
+	}
+	pod, err := e.clientset.CoreV1().Pods(e.namespace).Get(e.podName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if id := execcommon.GetContainerID(&ctrStatus); id != "" {
+			e.ctrIDToName[id] = ctrStatus.Name
+		}
+	}
+	name, ok := e.ctrIDToName[containerID]
+	if !ok {
+		return "", errors.Errorf(errors.CodeNotFound, "container %s was not found in pod %s", containerID, e.podName)
+	}
+	return name, nil
+}
+
+func ctrDir(dir, name string) string {
+	return filepath.Join(dir, "ctr", name)
+}
+
+func exitCodePath(dir, name string) string {
+	return filepath.Join(ctrDir(dir, name), "exitcode")
+}
+
+func stdoutPath(dir, name string) string {
+	return filepath.Join(ctrDir(dir, name), "stdout")
+}
+
+func combinedPath(dir, name string) string {
+	return filepath.Join(ctrDir(dir, name), "combined")
+}
+
+func signalPath(dir, name string) string {
+	return filepath.Join(ctrDir(dir, name), "signal")
+}
+
+func parameterPath(dir, path string) string {
+	return filepath.Join(dir, "outputs", "parameters", path)
+}
+
+func artifactPath(dir, path string) string {
+	return filepath.Join(dir, "outputs", "artifacts", fmt.Sprintf("%s.tgz", path))
+}
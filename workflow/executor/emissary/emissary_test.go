@@ -0,0 +1,172 @@
+package emissary
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+const (
+	podName   = "my-pod"
+	namespace = "my-ns"
+)
+
+// writeTemplate writes the template to an annotations file, in the format of the downward API
+func writeTemplate(t *testing.T, dir string, tmpl wfv1.Template) string {
+	tmplBytes, err := json.Marshal(tmpl)
+	assert.NoError(t, err)
+	value, err := json.Marshal(string(tmplBytes))
+	assert.NoError(t, err)
+	path := filepath.Join(dir, "annotations")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(fmt.Sprintf("%s=%s\n", common.AnnotationKeyTemplate, value)), 0644))
+	return path
+}
+
+func newTestExecutor(dir string) *EmissaryExecutor {
+	clientset := fake.NewSimpleClientset(&apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace},
+		Status: apiv1.PodStatus{
+			ContainerStatuses: []apiv1.ContainerStatus{
+				{Name: common.WaitContainerName, ContainerID: "containerd://wait-id"},
+				{Name: common.MainContainerName, ContainerID: "containerd://main-id"},
+				{Name: "side", ContainerID: "containerd://side-id"},
+			},
+		},
+	})
+	return &EmissaryExecutor{
+		clientset:    clientset,
+		podName:      podName,
+		namespace:    namespace,
+		dir:          dir,
+		pollInterval: 10 * time.Millisecond,
+		ctrIDToName:  make(map[string]string),
+	}
+}
+
+func TestEmissary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "emissary")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	outputDir := filepath.Join(dir, "output")
+	assert.NoError(t, os.MkdirAll(outputDir, 0755))
+	paramPath := filepath.Join(outputDir, "param")
+	artPath := filepath.Join(outputDir, "art")
+	tmpl := wfv1.Template{
+		Outputs: wfv1.Outputs{
+			Parameters: []wfv1.Parameter{
+				{Name: "param", ValueFrom: &wfv1.ValueFrom{Path: paramPath}},
+				{Name: "missing", ValueFrom: &wfv1.ValueFrom{Path: filepath.Join(outputDir, "missing")}},
+			},
+			Artifacts: []wfv1.Artifact{{Name: "art", Path: artPath}},
+		},
+	}
+	runner := &Runner{
+		Dir:          filepath.Join(dir, "var-run-argo"),
+		Name:         common.MainContainerName,
+		TemplatePath: writeTemplate(t, dir, tmpl),
+		PollInterval: 10 * time.Millisecond,
+	}
+	script := fmt.Sprintf("echo hello; echo oops >&2; echo -n value > %s; echo art > %s; exit 3", paramPath, artPath)
+	exitCode, err := runner.Run([]string{"sh", "-c", script})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, exitCode)
+
+	e := newTestExecutor(runner.Dir)
+	t.Run("GetExitCode", func(t *testing.T) {
+		exitCode, err := e.GetExitCode("main-id")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", exitCode)
+		exitCode, err = e.GetExitCode("side-id")
+		assert.NoError(t, err)
+		assert.Empty(t, exitCode)
+	})
+	t.Run("GetOutputStream", func(t *testing.T) {
+		stdout, err := e.GetOutputStream("main-id", false)
+		if assert.NoError(t, err) {
+			defer func() { _ = stdout.Close() }()
+			data, err := ioutil.ReadAll(stdout)
+			assert.NoError(t, err)
+			assert.Equal(t, "hello\n", string(data))
+		}
+		combined, err := e.GetOutputStream("main-id", true)
+		if assert.NoError(t, err) {
+			defer func() { _ = combined.Close() }()
+			data, err := ioutil.ReadAll(combined)
+			assert.NoError(t, err)
+			// stdout and stderr are copied concurrently, so their lines may be in either order
+			assert.ElementsMatch(t, []string{"hello", "oops"}, strings.Split(strings.TrimSpace(string(data)), "\n"))
+		}
+	})
+	t.Run("GetFileContents", func(t *testing.T) {
+		contents, err := e.GetFileContents("main-id", paramPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "value", contents)
+		_, err = e.GetFileContents("main-id", filepath.Join(outputDir, "missing"))
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+	t.Run("CopyFile", func(t *testing.T) {
+		destPath := filepath.Join(dir, "art.tgz")
+		assert.NoError(t, e.CopyFile("main-id", artPath, destPath, 0))
+		info, err := os.Stat(destPath)
+		if assert.NoError(t, err) {
+			assert.NotZero(t, info.Size())
+		}
+		err = e.CopyFile("main-id", filepath.Join(outputDir, "missing"), destPath, 0)
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+	t.Run("Wait", func(t *testing.T) {
+		assert.NoError(t, e.Wait("main-id"))
+	})
+	t.Run("UnknownContainer", func(t *testing.T) {
+		_, err := e.GetExitCode("unknown-id")
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+}
+
+func TestKill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "emissary")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	runner := &Runner{Dir: dir, Name: "side", PollInterval: 10 * time.Millisecond}
+	done := make(chan int)
+	go func() {
+		exitCode, _ := runner.Run([]string{"sleep", "60"})
+		done <- exitCode
+	}()
+	// wait for the emissary to start the command
+	for {
+		if _, err := os.Stat(stdoutPath(dir, "side")); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	e := newTestExecutor(dir)
+	assert.NoError(t, e.Kill([]string{"side-id"}))
+	select {
+	case exitCode := <-done:
+		// 128 + SIGTERM
+		assert.Equal(t, 143, exitCode)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the command to be killed")
+	}
+	exitCode, err := e.GetExitCode("side-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "143", exitCode)
+}
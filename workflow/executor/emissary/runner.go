@@ -0,0 +1,236 @@
+package emissary
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/util/archive"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/executor"
+)
+
+// Runner runs the command of a container on behalf of the emissary executor. It records the stdout, the combined
+// output and the exit code of the command in the directory shared with the wait container, delivers the signals the
+// wait container requests, and saves the outputs of the main container found in its base image layer, so that the
+// wait container can collect them without access to the filesystem of the main container.
+type Runner struct {
+	// Dir is the directory shared with the wait container
+	Dir string
+	// Name is the name of the container
+	Name string
+	// TemplatePath is the pod annotations file the template is loaded from, to find the outputs to save
+	TemplatePath string
+	// PollInterval is how often the signal file is checked
+	PollInterval time.Duration
+}
+
+// NewRunner returns a runner for the named container, using the directory mounted into the containers of the pod
+func NewRunner(name string) *Runner {
+	return &Runner{
+		Dir:          common.VarRunArgoPath,
+		Name:         name,
+		TemplatePath: common.PodMetadataAnnotationsPath,
+		PollInterval: time.Second,
+	}
+}
+
+// Run runs the command and returns its exit code. The exit code is recorded last, once the outputs have been saved,
+// as it is how the wait container learns that the container has completed.
+func (r *Runner) Run(command []string) (int, error) {
+	if err := os.MkdirAll(ctrDir(r.Dir, r.Name), 0755); err != nil {
+		return 1, err
+	}
+	exitCode, runErr := r.runCommand(command)
+	if r.Name == common.MainContainerName {
+		if err := r.saveOutputs(); err != nil {
+			log.WithError(err).Error("Failed to save outputs")
+			if runErr == nil {
+				runErr = err
+			}
+		}
+	}
+	if err := writeAtomically(exitCodePath(r.Dir, r.Name), []byte(strconv.Itoa(exitCode))); err != nil {
+		return 1, err
+	}
+	return exitCode, runErr
+}
+
+// runCommand runs the command, forwarding signals to it, and returns its exit code, which is 128 + the signal for a
+// command killed by a signal
+func (r *Runner) runCommand(command []string) (int, error) {
+	if len(command) == 0 {
+		return 1, fmt.Errorf("container %s has no command", r.Name)
+	}
+	stdout, err := os.Create(stdoutPath(r.Dir, r.Name))
+	if err != nil {
+		return 1, err
+	}
+	defer func() { _ = stdout.Close() }()
+	combined, err := os.Create(combinedPath(r.Dir, r.Name))
+	if err != nil {
+		return 1, err
+	}
+	defer func() { _ = combined.Close() }()
+	combinedWriter := &syncWriter{w: combined}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = io.MultiWriter(os.Stdout, stdout, combinedWriter)
+	cmd.Stderr = io.MultiWriter(os.Stderr, combinedWriter)
+	if err := cmd.Start(); err != nil {
+		return 1, err
+	}
+
+	signals := make(chan os.Signal, 1)
+	defer close(signals)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		for s := range signals {
+			log.Infof("Forwarding signal %s to %s", s, r.Name)
+			_ = cmd.Process.Signal(s)
+		}
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go r.deliverRequestedSignals(cmd.Process, done)
+
+	err = cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		// like a shell, report a command killed by a signal as 128 + the signal, rather than as -1
+		if ws, ok := exitErr.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+			return 128 + int(ws.Signal()), nil
+		}
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// deliverRequestedSignals sends the process the signals the wait container writes to the signal file, until done
+func (r *Runner) deliverRequestedSignals(process *os.Process, done <-chan struct{}) {
+	ticker := time.NewTicker(r.PollInterval)
+	defer ticker.Stop()
+	path := signalPath(r.Dir, r.Name)
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		_ = os.Remove(path)
+		s, err := strconv.Atoi(strings.TrimSpace(string(data)))
+		if err != nil {
+			log.WithError(err).Warnf("Ignoring invalid signal %q", string(data))
+			continue
+		}
+		log.Infof("Delivering signal %d to %s", s, r.Name)
+		_ = process.Signal(syscall.Signal(s))
+	}
+}
+
+// saveOutputs copies the output parameters and artifacts of the template which reside in the base image layer into
+// the shared directory. Outputs which do not exist are skipped, the wait container decides whether that is an error.
+func (r *Runner) saveOutputs() error {
+	tmpl, err := executor.LoadTemplate(r.TemplatePath)
+	if err != nil {
+		return err
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.Path == "" || !executor.IsBaseImagePath(tmpl, param.ValueFrom.Path) {
+			continue
+		}
+		data, err := ioutil.ReadFile(param.ValueFrom.Path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		log.Infof("Saving output parameter %s from %s", param.Name, param.ValueFrom.Path)
+		if err := writeAtomically(parameterPath(r.Dir, param.ValueFrom.Path), data); err != nil {
+			return err
+		}
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		if art.Path == "" || !executor.IsBaseImagePath(tmpl, art.Path) {
+			continue
+		}
+		if _, err := os.Stat(art.Path); os.IsNotExist(err) {
+			continue
+		}
+		log.Infof("Saving output artifact %s from %s", art.Name, art.Path)
+		if err := saveArtifact(artifactPath(r.Dir, art.Path), art); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveArtifact tars and gzips the path of the artifact, with the compression level of its archive strategy
+func saveArtifact(path string, art wfv1.Artifact) error {
+	compressionLevel := gzip.DefaultCompression
+	if art.Archive != nil && art.Archive.Tar != nil && art.Archive.Tar.CompressionLevel != nil {
+		compressionLevel = int(*art.Archive.Tar.CompressionLevel)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	err = archive.TarGzToWriter(art.Path, compressionLevel, bufio.NewWriter(f))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// writeAtomically writes the file by renaming a temporary file, so that it is never read partially
+func writeAtomically(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// syncWriter serializes the writes of the stdout and stderr of the command to the combined output
+type syncWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
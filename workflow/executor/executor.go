@@ -16,6 +16,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/argoproj/argo/util/retry"
 	artifact "github.com/argoproj/argo/workflow/artifacts"
 	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/executor/containerset"
 	os_specific "github.com/argoproj/argo/workflow/executor/os-specific"
 )
 
@@ -218,11 +220,14 @@ func (we *WorkflowExecutor) LoadArtifacts() error {
 	return nil
 }
 
-// StageFiles will create any files required by script/resource templates
+// StageFiles will create any files required by script/resource/container set templates
 func (we *WorkflowExecutor) StageFiles() error {
 	var filePath string
 	var body []byte
 	switch we.Template.GetType() {
+	case wfv1.TemplateTypeContainerSet:
+		log.Infof("Copying executor binary to %s", common.VarRunArgoExecutorPath)
+		return copyExecutor(common.VarRunArgoExecutorPath)
 	case wfv1.TemplateTypeScript:
 		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
@@ -241,12 +246,53 @@ func (we *WorkflowExecutor) StageFiles() error {
 	return nil
 }
 
+// StageExecutor copies the executor binary to the volume shared with the containers, so that the emissary executor
+// can run their commands
+func (we *WorkflowExecutor) StageExecutor() error {
+	log.Infof("Copying executor binary to %s", common.VarRunArgoExecutorPath)
+	return copyExecutor(common.VarRunArgoExecutorPath)
+}
+
+// copyExecutor copies the binary of the running executor to the given path, so that it can run the containers of a
+// container set, or the containers of any template with the emissary executor, which share a volume with the init
+// container
+func copyExecutor(path string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	in, err := os.Open(executable)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	_, err = io.Copy(out, in)
+	if err != nil {
+		_ = out.Close()
+		return errors.InternalWrapError(err)
+	}
+	err = out.Close()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
 // SaveArtifacts uploads artifacts to the archive location
 func (we *WorkflowExecutor) SaveArtifacts() error {
 	if len(we.Template.Outputs.Artifacts) == 0 {
 		log.Infof("No output artifacts")
 		return nil
 	}
+	return we.saveArtifacts(we.Template.Outputs.Artifacts)
+}
+
+// saveArtifacts uploads the given artifacts, updating their locations
+func (we *WorkflowExecutor) saveArtifacts(artifacts []wfv1.Artifact) error {
 	log.Infof("Saving output artifacts")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
@@ -258,12 +304,12 @@ func (we *WorkflowExecutor) SaveArtifacts() error {
 		return errors.InternalWrapError(err)
 	}
 
-	for i, art := range we.Template.Outputs.Artifacts {
+	for i, art := range artifacts {
 		err := we.saveArtifact(mainCtrID, &art)
 		if err != nil {
 			return err
 		}
-		we.Template.Outputs.Artifacts[i] = art
+		artifacts[i] = art
 	}
 	return nil
 }
@@ -312,6 +358,10 @@ func (we *WorkflowExecutor) saveArtifact(mainCtrID string, art *wfv1.Artifact) e
 			shallowCopy := *we.Template.ArchiveLocation.GCS
 			art.GCS = &shallowCopy
 			art.GCS.Key = path.Join(art.GCS.Key, fileName)
+		} else if we.Template.ArchiveLocation.Azure != nil {
+			shallowCopy := *we.Template.ArchiveLocation.Azure
+			art.Azure = &shallowCopy
+			art.Azure.Blob = path.Join(art.Azure.Blob, fileName)
 		} else {
 			return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
 		}
@@ -433,12 +483,18 @@ func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifac
 // isBaseImagePath checks if the given artifact path resides in the base image layer of the container
 // versus a shared volume mount between the wait and main container
 func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
+	return IsBaseImagePath(&we.Template, path)
+}
+
+// IsBaseImagePath checks if the given path resides in the base image layer of the main container of the template
+// versus a shared volume mount between the wait and main container
+func IsBaseImagePath(tmpl *wfv1.Template, path string) bool {
 	// first check if path overlaps with a user-specified volumeMount
-	if common.FindOverlappingVolume(&we.Template, path) != nil {
+	if common.FindOverlappingVolume(tmpl, path) != nil {
 		return false
 	}
 	// next check if path overlaps with a shared input-artifact emptyDir mounted by argo
-	for _, inArt := range we.Template.Inputs.Artifacts {
+	for _, inArt := range tmpl.Inputs.Artifacts {
 		if path == inArt.Path {
 			// The input artifact may have been optional and not supplied. If this is the case, the file won't exist on
 			// the input artifact volume. Since this function was called, we know that we want to use this path as an
@@ -461,13 +517,18 @@ func (we *WorkflowExecutor) SaveParameters() error {
 		log.Infof("No output parameters")
 		return nil
 	}
+	return we.saveParameters(we.Template.Outputs.Parameters)
+}
+
+// saveParameters sets the values of the given parameters from the content of their paths
+func (we *WorkflowExecutor) saveParameters(parameters []wfv1.Parameter) error {
 	log.Infof("Saving output parameters")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
 		return err
 	}
 
-	for i, param := range we.Template.Outputs.Parameters {
+	for i, param := range parameters {
 		log.Infof("Saving path output parameter: %s", param.Name)
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -508,7 +569,7 @@ func (we *WorkflowExecutor) SaveParameters() error {
 		if output.Type == intstr.String {
 			output = intstrutil.ParsePtr(strings.TrimSuffix(output.String(), "\n"))
 		}
-		we.Template.Outputs.Parameters[i].Value = output
+		parameters[i].Value = output
 		log.Infof("Successfully saved output parameter: %s", param.Name)
 	}
 	return nil
@@ -519,6 +580,10 @@ func (we *WorkflowExecutor) SaveLogs() (*wfv1.Artifact, error) {
 	if we.Template.ArchiveLocation == nil || we.Template.ArchiveLocation.ArchiveLogs == nil || !*we.Template.ArchiveLocation.ArchiveLogs {
 		return nil, nil
 	}
+	if we.Template.GetType() == wfv1.TemplateTypeContainerSet {
+		log.Warn("Archiving logs is not supported for container sets")
+		return nil, nil
+	}
 	log.Infof("Saving logs")
 	mainCtrID, err := we.GetMainContainerID()
 	if err != nil {
@@ -564,6 +629,10 @@ func (we *WorkflowExecutor) SaveLogs() (*wfv1.Artifact, error) {
 		shallowCopy := *we.Template.ArchiveLocation.OSS
 		art.OSS = &shallowCopy
 		art.OSS.Key = path.Join(art.OSS.Key, fileName)
+	} else if we.Template.ArchiveLocation.Azure != nil {
+		shallowCopy := *we.Template.ArchiveLocation.Azure
+		art.Azure = &shallowCopy
+		art.Azure.Blob = path.Join(art.Azure.Blob, fileName)
 	} else {
 		return nil, errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
 	}
@@ -804,6 +873,46 @@ func (we *WorkflowExecutor) CaptureScriptExitCode() error {
 	return nil
 }
 
+// SaveContainerOutputs saves the outputs of each container of a container set which succeeded, and annotates the pod
+// with them, so that the controller reports them on the nodes of the containers
+func (we *WorkflowExecutor) SaveContainerOutputs() error {
+	if we.Template.ContainerSet == nil {
+		return nil
+	}
+	pod, err := we.getPod()
+	if err != nil {
+		return err
+	}
+	succeeded := make(map[string]bool)
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		terminated := ctrStatus.State.Terminated
+		succeeded[ctrStatus.Name] = terminated != nil && terminated.ExitCode == 0 && !strings.HasPrefix(terminated.Message, common.ContainerOmittedMessagePrefix)
+	}
+	outputs := make(map[string]wfv1.Outputs)
+	for _, ctr := range we.Template.ContainerSet.Containers {
+		if !ctr.Outputs.HasOutputs() || !succeeded[ctr.Name] {
+			continue
+		}
+		log.Infof("Saving the outputs of container %s", ctr.Name)
+		ctrOutputs := ctr.Outputs.DeepCopy()
+		if err := we.saveParameters(ctrOutputs.Parameters); err != nil {
+			return err
+		}
+		if err := we.saveArtifacts(ctrOutputs.Artifacts); err != nil {
+			return err
+		}
+		outputs[ctr.Name] = *ctrOutputs
+	}
+	if len(outputs) == 0 {
+		return nil
+	}
+	outputBytes, err := json.Marshal(outputs)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return we.AddAnnotation(common.AnnotationKeyContainerOutputs, string(outputBytes))
+}
+
 // AnnotateOutputs annotation to the pod indicating all the outputs.
 func (we *WorkflowExecutor) AnnotateOutputs(logArt *wfv1.Artifact) error {
 	outputs := we.Template.Outputs.DeepCopy()
@@ -910,6 +1019,9 @@ func (we *WorkflowExecutor) Wait() error {
 	if err != nil {
 		return err
 	}
+	if we.Template.GetType() == wfv1.TemplateTypeContainerSet {
+		return we.waitContainerSet()
+	}
 	log.Infof("Waiting on main container")
 	mainContainerID, err := we.waitMainContainerStart()
 	if err != nil {
@@ -937,6 +1049,105 @@ func (we *WorkflowExecutor) Wait() error {
 	return nil
 }
 
+// waitContainerSet waits for all of the containers of a container set to complete. The containers are watched through
+// the pod, as they complete in the order of their dependencies.
+func (we *WorkflowExecutor) waitContainerSet() error {
+	log.Infof("Waiting on the containers of the container set")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	annotationUpdatesCh := we.monitorAnnotations(ctx)
+	go we.monitorDeadline(ctx, annotationUpdatesCh)
+
+	podsIf := we.ClientSet.CoreV1().Pods(we.Namespace)
+	opts := metav1.ListOptions{
+		FieldSelector: fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", we.PodName)).String(),
+	}
+	for {
+		var err error
+		var watchIf watch.Interface
+		err = wait.ExponentialBackoff(ExecutorRetry, func() (bool, error) {
+			watchIf, err = podsIf.Watch(opts)
+			if err != nil {
+				log.Debugf("Failed to establish watch, retrying: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			return errors.InternalWrapErrorf(err, "Failed to establish pod watch: %v", err)
+		}
+		for watchEv := range watchIf.ResultChan() {
+			if watchEv.Type == watch.Error {
+				return errors.InternalErrorf("Pod watch error waiting for the container set to complete: %v", watchEv.Object)
+			}
+			pod, ok := watchEv.Object.(*apiv1.Pod)
+			if !ok {
+				log.Warnf("Pod watch returned non pod object: %v", watchEv.Object)
+				continue
+			}
+			we.recordContainerSetExitCodes(pod)
+			if we.containerSetCompleted(pod) {
+				watchIf.Stop()
+				log.Infof("Container set completed")
+				return nil
+			}
+		}
+		log.Warnf("Pod watch closed unexpectedly")
+	}
+}
+
+// recordContainerSetExitCodes records the exit codes of the containers of the container set which terminated without
+// recording them, so that the containers which depend on them are omitted rather than waiting for them forever
+func (we *WorkflowExecutor) recordContainerSetExitCodes(pod *apiv1.Pod) {
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if we.Template.ContainerSet.GetContainer(ctrStatus.Name) == nil || ctrStatus.State.Terminated == nil {
+			continue
+		}
+		recorded, err := containerset.RecordExitCode(common.VarRunArgoPath, ctrStatus.Name, ctrStatus.State.Terminated.ExitCode)
+		if err != nil {
+			log.Warnf("Failed to record the exit code of container %s: %v", ctrStatus.Name, err)
+		} else if recorded {
+			log.Infof("Recorded exit code %d of container %s, which terminated without recording it", ctrStatus.State.Terminated.ExitCode, ctrStatus.Name)
+		}
+	}
+}
+
+// containerSetCompleted returns whether all of the containers of the container set have terminated
+func (we *WorkflowExecutor) containerSetCompleted(pod *apiv1.Pod) bool {
+	terminated := 0
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if we.Template.ContainerSet.GetContainer(ctrStatus.Name) != nil && ctrStatus.State.Terminated != nil {
+			terminated++
+		}
+	}
+	return terminated == len(we.Template.ContainerSet.Containers)
+}
+
+// getMainContainerIDs returns the container id of the main container, or the container ids of the containers of a
+// container set which have not terminated
+func (we *WorkflowExecutor) getMainContainerIDs() ([]string, error) {
+	if we.Template.GetType() != wfv1.TemplateTypeContainerSet {
+		mainContainerID, err := we.GetMainContainerID()
+		if err != nil {
+			return nil, err
+		}
+		return []string{mainContainerID}, nil
+	}
+	pod, err := we.getPod()
+	if err != nil {
+		return nil, err
+	}
+	var containerIDs []string
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		if we.Template.ContainerSet.GetContainer(ctrStatus.Name) == nil || ctrStatus.State.Terminated != nil || ctrStatus.ContainerID == "" {
+			continue
+		}
+		containerIDs = append(containerIDs, containerID(ctrStatus.ContainerID))
+	}
+	return containerIDs, nil
+}
+
 // waitMainContainerStart waits for the main container to start and returns its container ID.
 func (we *WorkflowExecutor) waitMainContainerStart() (string, error) {
 	for {
@@ -1114,8 +1325,8 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 					log.Info(message)
 					_ = we.AddAnnotation(common.AnnotationKeyNodeMessage, message)
 					log.Infof("Killing main container")
-					mainContainerID, _ := we.GetMainContainerID()
-					err := we.RuntimeExecutor.Kill([]string{mainContainerID})
+					mainContainerIDs, _ := we.getMainContainerIDs()
+					err := we.RuntimeExecutor.Kill(mainContainerIDs)
 					if err != nil {
 						log.Warnf("Failed to kill main container: %v", err)
 					}
@@ -1139,6 +1350,9 @@ func (we *WorkflowExecutor) KillSidecars() error {
 		if ctrStatus.Name == common.MainContainerName || ctrStatus.Name == common.WaitContainerName {
 			continue
 		}
+		if we.Template.ContainerSet != nil && we.Template.ContainerSet.GetContainer(ctrStatus.Name) != nil {
+			continue
+		}
 		if ctrStatus.State.Terminated != nil {
 			continue
 		}
@@ -1174,6 +1388,26 @@ func LoadTemplate(path string) (*wfv1.Template, error) {
 	return &tmpl, nil
 }
 
+// LoadTraceParent returns the W3C traceparent the controller passed to the pod in an annotation, so that the spans of
+// the executor are part of the trace of the workflow, or "" if there is none
+func LoadTraceParent(path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	// the downward api writes the annotations as key="quoted value" lines
+	prefix := common.AnnotationKeyTraceParent + "="
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, prefix) {
+			traceParent, err := strconv.Unquote(strings.TrimPrefix(line, prefix))
+			if err == nil {
+				return traceParent
+			}
+		}
+	}
+	return ""
+}
+
 // unmarshalAnnotationField unmarshals the value of an annotation key into the supplied interface
 // from the downward api annotation volume file
 func unmarshalAnnotationField(filePath string, key string, into interface{}) error {
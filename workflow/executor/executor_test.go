@@ -2,6 +2,8 @@ package executor
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -194,3 +196,37 @@ func TestIsTarball(t *testing.T) {
 		assert.Equal(t, test.isTarball, ok, test.path)
 	}
 }
+
+func TestContainerSetCompleted(t *testing.T) {
+	we := WorkflowExecutor{
+		Template: wfv1.Template{
+			ContainerSet: &wfv1.ContainerSetTemplate{
+				Containers: []wfv1.ContainerNode{{Container: corev1.Container{Name: "a"}}, {Container: corev1.Container{Name: "b"}}},
+			},
+		},
+	}
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+		{Name: "wait", State: running},
+		{Name: "a", State: terminated},
+		{Name: "b", State: running},
+	}}}
+	assert.False(t, we.containerSetCompleted(pod))
+	pod.Status.ContainerStatuses[2].State = terminated
+	assert.True(t, we.containerSetCompleted(pod))
+}
+
+func TestLoadTraceParent(t *testing.T) {
+	file, err := ioutil.TempFile("", "annotations")
+	if assert.NoError(t, err) {
+		defer func() { _ = os.Remove(file.Name()) }()
+		_, err = file.WriteString(`workflows.argoproj.io/node-name="my-wf"
+workflows.argoproj.io/traceparent="00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+`)
+		assert.NoError(t, err)
+		assert.NoError(t, file.Close())
+		assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", LoadTraceParent(file.Name()))
+	}
+	assert.Empty(t, LoadTraceParent("/tmp/missing-annotations"))
+}
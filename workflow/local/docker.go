@@ -0,0 +1,106 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/errors"
+)
+
+// Container is a container for a ContainerRuntime to run
+type Container struct {
+	Name       string
+	Image      string
+	Command    []string
+	Args       []string
+	Env        []string // KEY=VALUE
+	WorkingDir string
+	// Mounts maps absolute host paths to paths in the container
+	Mounts map[string]string
+}
+
+// ContainerRuntime runs containers on the local machine
+type ContainerRuntime interface {
+	// Run runs the container until it exits, and returns its exit code
+	Run(ctx context.Context, c Container, stdout, stderr io.Writer) (int, error)
+	// CopyFrom writes the file or directory at path within the exited container to w as a tar stream.
+	// It returns a CodeNotFound error if the path does not exist.
+	CopyFrom(ctx context.Context, name, path string, w io.Writer) error
+	// Remove removes the container
+	Remove(ctx context.Context, name string) error
+}
+
+// DockerRuntime runs containers using the Docker CLI
+type DockerRuntime struct {
+	// Executable is the path to the docker binary, defaults to "docker"
+	Executable string
+}
+
+var _ ContainerRuntime = &DockerRuntime{}
+
+func (d *DockerRuntime) executable() string {
+	if d.Executable != "" {
+		return d.Executable
+	}
+	return "docker"
+}
+
+func (d *DockerRuntime) Run(ctx context.Context, c Container, stdout, stderr io.Writer) (int, error) {
+	args := []string{"run", "--name", c.Name}
+	if c.WorkingDir != "" {
+		args = append(args, "--workdir", c.WorkingDir)
+	}
+	for _, env := range c.Env {
+		args = append(args, "--env", env)
+	}
+	for hostPath, path := range c.Mounts {
+		args = append(args, "--volume", hostPath+":"+path)
+	}
+	cmdArgs := c.Args
+	if len(c.Command) > 0 {
+		args = append(args, "--entrypoint", c.Command[0])
+		cmdArgs = append(append([]string{}, c.Command[1:]...), c.Args...)
+	}
+	args = append(args, c.Image)
+	args = append(args, cmdArgs...)
+	log.WithField("container", c.Name).Debugf("%s %s", d.executable(), strings.Join(args, " "))
+	cmd := exec.CommandContext(ctx, d.executable(), args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if exitErr, ok := err.(*exec.ExitError); ok && ctx.Err() == nil {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return -1, errors.InternalWrapError(err)
+	}
+	return 0, nil
+}
+
+func (d *DockerRuntime) CopyFrom(ctx context.Context, name, path string, w io.Writer) error {
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, d.executable(), "cp", name+":"+path, "-")
+	cmd.Stdout = w
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		if strings.Contains(stderr.String(), "No such container:path") || strings.Contains(stderr.String(), "Could not find the file") {
+			return errors.Errorf(errors.CodeNotFound, "%s not found in container %s", path, name)
+		}
+		return errors.InternalErrorf("failed to copy %s from container %s: %v: %s", path, name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func (d *DockerRuntime) Remove(ctx context.Context, name string) error {
+	output, err := exec.CommandContext(ctx, d.executable(), "rm", "--force", name).CombinedOutput()
+	if err != nil {
+		return errors.InternalErrorf("failed to remove container %s: %v: %s", name, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
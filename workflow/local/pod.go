@@ -0,0 +1,452 @@
+package local
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo/util/intstr"
+	"github.com/argoproj/argo/workflow/common"
+)
+
+// podRun is a single run of a workflow pod
+type podRun struct {
+	*Runner
+	pod     *apiv1.Pod
+	tmpl    wfv1.Template
+	execCtl common.ExecutionControl
+	// workDir is the host directory used to stage files for the container
+	workDir string
+	// containerName is the name of the local container
+	containerName string
+}
+
+// runPod runs the main container of the pod, and then updates the pod's status and outputs as the kubelet and
+// the wait container would
+func (r *Runner) runPod(ctx context.Context, pod *apiv1.Pod) error {
+	p := &podRun{Runner: r, pod: pod, containerName: containerName(pod)}
+	err := json.Unmarshal([]byte(pod.Annotations[common.AnnotationKeyTemplate]), &p.tmpl)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if execCtlStr, ok := pod.Annotations[common.AnnotationKeyExecutionControl]; ok {
+		err = json.Unmarshal([]byte(execCtlStr), &p.execCtl)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+	}
+	p.workDir, err = ioutil.TempDir("", pod.Name)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = os.RemoveAll(p.workDir) }()
+	defer p.removeContainer()
+
+	startedAt := metav1.Now()
+	exitCode, stdout, err := p.runMainContainer(ctx)
+	if ctx.Err() != nil {
+		// the runner is shutting down, e.g. because the workflow completed while a daemon was still running
+		return nil
+	}
+	var outputs *wfv1.Outputs
+	if err == nil {
+		outputs, err = p.captureOutputs(ctx, exitCode, stdout)
+	}
+
+	pod.Status.Phase = apiv1.PodSucceeded
+	if err != nil {
+		log.WithField("pod", pod.Name).WithError(err).Warn("failed to run pod")
+		pod.Status.Phase = apiv1.PodFailed
+		pod.Status.Message = err.Error()
+	} else if exitCode != 0 {
+		pod.Status.Phase = apiv1.PodFailed
+	}
+	pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{
+		Name: common.MainContainerName,
+		State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{
+			ExitCode:   int32(exitCode),
+			StartedAt:  startedAt,
+			FinishedAt: metav1.Now(),
+		}},
+	}}
+	if outputs != nil && outputs.HasOutputs() {
+		outputsBytes, err := json.Marshal(outputs)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		pod.Annotations[common.AnnotationKeyOutputs] = string(outputsBytes)
+	}
+	_, err = r.kubeclientset.CoreV1().Pods(pod.Namespace).Update(pod)
+	return err
+}
+
+func containerName(pod *apiv1.Pod) string {
+	return "argo-" + pod.Name
+}
+
+func (p *podRun) runMainContainer(ctx context.Context) (int, string, error) {
+	var mainCtr apiv1.Container
+	switch p.tmpl.GetType() {
+	case wfv1.TemplateTypeContainer:
+		mainCtr = *p.tmpl.Container
+	case wfv1.TemplateTypeScript:
+		mainCtr = p.tmpl.Script.Container
+		scriptPath := filepath.Join(p.workDir, "script")
+		err := ioutil.WriteFile(scriptPath, []byte(p.tmpl.Script.Source), 0755)
+		if err != nil {
+			return -1, "", errors.InternalWrapError(err)
+		}
+		mainCtr.Args = append(append([]string{}, mainCtr.Args...), common.ExecutorScriptSourcePath)
+	default:
+		return -1, "", errors.Errorf(errors.CodeBadRequest, "%s templates cannot be run locally", p.tmpl.GetType())
+	}
+	if len(p.tmpl.Sidecars) > 0 || len(p.tmpl.InitContainers) > 0 {
+		log.WithField("pod", p.pod.Name).Warn("init containers and sidecars are not run locally")
+	}
+
+	c := Container{
+		Name:       p.containerName,
+		Image:      mainCtr.Image,
+		Command:    mainCtr.Command,
+		Args:       mainCtr.Args,
+		WorkingDir: mainCtr.WorkingDir,
+		Mounts:     map[string]string{},
+	}
+	if p.tmpl.GetType() == wfv1.TemplateTypeScript {
+		c.Mounts[filepath.Join(p.workDir, "script")] = common.ExecutorScriptSourcePath
+	}
+	for _, env := range mainCtr.Env {
+		if env.ValueFrom != nil {
+			log.WithField("pod", p.pod.Name).Warnf("environment variable %s uses valueFrom, which is not supported locally", env.Name)
+			continue
+		}
+		c.Env = append(c.Env, env.Name+"="+env.Value)
+	}
+	// volumes are always local empty directories
+	for _, mnt := range mainCtr.VolumeMounts {
+		hostPath := filepath.Join(p.workDir, "volumes", mnt.Name)
+		err := os.MkdirAll(hostPath, 0777)
+		if err != nil {
+			return -1, "", errors.InternalWrapError(err)
+		}
+		c.Mounts[hostPath] = mnt.MountPath
+	}
+	for _, art := range p.tmpl.Inputs.Artifacts {
+		hostPath, err := p.loadArtifact(art)
+		if err != nil {
+			return -1, "", err
+		}
+		if hostPath != "" {
+			c.Mounts[hostPath] = art.Path
+		}
+	}
+
+	if p.execCtl.Deadline != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, *p.execCtl.Deadline)
+		defer cancel()
+	}
+	stdout := &bytes.Buffer{}
+	out := p.logWriter(p.pod.Name)
+	defer func() { _ = out.Close() }()
+	exitCode, err := p.containerRuntime.Run(ctx, c, io.MultiWriter(stdout, out), out)
+	if ctx.Err() == context.DeadlineExceeded {
+		return exitCode, "", errors.Errorf(errors.CodeTimeout, "Step exceeded its deadline")
+	}
+	return exitCode, stdout.String(), err
+}
+
+func (p *podRun) removeContainer() {
+	err := p.containerRuntime.Remove(context.Background(), p.containerName)
+	if err != nil {
+		log.WithField("pod", p.pod.Name).WithError(err).Warn("failed to remove container")
+	}
+}
+
+// loadArtifact loads the input artifact into the work dir, and returns the host path to mount into the container
+func (p *podRun) loadArtifact(art wfv1.Artifact) (string, error) {
+	if !art.HasLocation() {
+		if art.Optional {
+			return "", nil
+		}
+		return "", errors.Errorf(errors.CodeBadRequest, "required artifact %s not supplied", art.Name)
+	}
+	driver, err := p.artifactDriver(p.pod.Namespace, &art)
+	if err != nil {
+		return "", err
+	}
+	tempArtPath := filepath.Join(p.workDir, "inputs", art.Name+".tmp")
+	err = os.MkdirAll(filepath.Dir(tempArtPath), 0777)
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	err = driver.Load(&art, tempArtPath)
+	if err != nil {
+		if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
+			return "", nil
+		}
+		return "", err
+	}
+	isTar := art.GetArchive().Tar != nil
+	if art.GetArchive().None == nil && !isTar {
+		isTar = isTarball(tempArtPath)
+	}
+	if !isTar {
+		return tempArtPath, nil
+	}
+	dir := filepath.Join(p.workDir, "inputs", art.Name)
+	f, err := os.Open(tempArtPath)
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	defer func() { _ = f.Close() }()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	err = untar(gzr, dir)
+	if err != nil {
+		return "", err
+	}
+	// a tarball of a single file or directory is mounted as that file or directory
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	if len(files) == 1 {
+		return filepath.Join(dir, files[0].Name()), nil
+	}
+	return dir, nil
+}
+
+// captureOutputs does the work of the wait container: saving output parameters and artifacts, and capturing
+// the script result and exit code
+func (p *podRun) captureOutputs(ctx context.Context, exitCode int, stdout string) (*wfv1.Outputs, error) {
+	outputs := p.tmpl.Outputs.DeepCopy()
+	for i, param := range outputs.Parameters {
+		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
+			continue
+		}
+		value, err := p.readFile(ctx, param.ValueFrom.Path)
+		if err != nil {
+			if param.ValueFrom.Default != nil {
+				outputs.Parameters[i].Value = param.ValueFrom.Default
+				continue
+			}
+			return nil, err
+		}
+		// Trims off a single newline for user convenience
+		outputs.Parameters[i].Value = intstrutil.ParsePtr(strings.TrimSuffix(value, "\n"))
+	}
+	for i, art := range outputs.Artifacts {
+		err := p.saveArtifact(ctx, &outputs.Artifacts[i])
+		if err != nil {
+			if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
+				continue
+			}
+			return nil, err
+		}
+	}
+	if p.execCtl.IncludeScriptOutput {
+		// Trims off a single newline for user convenience
+		result := strings.TrimSuffix(stdout, "\n")
+		outputs.Result = &result
+	}
+	code := fmt.Sprintf("%d", exitCode)
+	outputs.ExitCode = &code
+	return outputs, nil
+}
+
+// readFile returns the contents of the file at path in the exited container
+func (p *podRun) readFile(ctx context.Context, path string) (string, error) {
+	buf := &bytes.Buffer{}
+	err := p.containerRuntime.CopyFrom(ctx, p.containerName, path, buf)
+	if err != nil {
+		return "", err
+	}
+	tr := tar.NewReader(buf)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return "", errors.Errorf(errors.CodeNotFound, "%s is not a file", path)
+		}
+		if err != nil {
+			return "", errors.InternalWrapError(err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			data, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return "", errors.InternalWrapError(err)
+			}
+			return string(data), nil
+		}
+	}
+}
+
+// saveArtifact copies the artifact out of the exited container and saves it, defaulting its location to the
+// template's archive location like the executor does
+func (p *podRun) saveArtifact(ctx context.Context, art *wfv1.Artifact) error {
+	if art.Path == "" {
+		return errors.InternalErrorf("Artifact %s did not specify a path", art.Name)
+	}
+	tarPath := filepath.Join(p.workDir, "outputs", art.Name+".tar")
+	err := os.MkdirAll(filepath.Dir(tarPath), 0777)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	f, err := os.Create(tarPath)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	err = p.containerRuntime.CopyFrom(ctx, p.containerName, art.Path, f)
+	_ = f.Close()
+	if err != nil {
+		return err
+	}
+
+	var fileName, localArtPath string
+	if art.GetArchive().None != nil {
+		dir := filepath.Join(p.workDir, "outputs", art.Name)
+		err = untarFile(tarPath, dir)
+		if err != nil {
+			return err
+		}
+		fileName = filepath.Base(art.Path)
+		localArtPath = filepath.Join(dir, fileName)
+	} else {
+		fileName = art.Name + ".tgz"
+		localArtPath = filepath.Join(p.workDir, "outputs", fileName)
+		level := gzip.DefaultCompression
+		if art.GetArchive().Tar != nil && art.GetArchive().Tar.CompressionLevel != nil {
+			level = int(*art.GetArchive().Tar.CompressionLevel)
+		}
+		err = gzipFile(tarPath, localArtPath, level)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !art.HasLocation() {
+		if p.tmpl.ArchiveLocation == nil || p.tmpl.ArchiveLocation.S3 == nil {
+			return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. No archive location", art.Name)
+		}
+		shallowCopy := *p.tmpl.ArchiveLocation.S3
+		art.S3 = &shallowCopy
+		art.S3.Key = path.Join(art.S3.Key, fileName)
+	}
+	driver, err := p.artifactDriver(p.pod.Namespace, art)
+	if err != nil {
+		return err
+	}
+	return driver.Save(localArtPath, art)
+}
+
+func isTarball(filePath string) bool {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return false
+	}
+	_, err = tar.NewReader(gzr).Next()
+	return err == nil
+}
+
+func gzipFile(src, dest string, level int) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(dest)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = out.Close() }()
+	w, err := gzip.NewWriterLevel(out, level)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	_, err = io.Copy(w, in)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	err = w.Close()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return out.Close()
+}
+
+func untarFile(tarPath, dest string) error {
+	f, err := os.Open(tarPath)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = f.Close() }()
+	return untar(f, dest)
+}
+
+// untar extracts the directories and regular files of the tar stream into dest
+func untar(r io.Reader, dest string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		target := filepath.Join(dest, filepath.FromSlash(hdr.Name))
+		if target != dest && !strings.HasPrefix(target, dest+string(os.PathSeparator)) {
+			return errors.InternalErrorf("illegal file path in tar: %s", hdr.Name)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, os.FileMode(hdr.Mode)|0700)
+		case tar.TypeReg:
+			err = writeFile(tr, target, os.FileMode(hdr.Mode))
+		default:
+			log.Debugf("Skipping %s in tar", hdr.Name)
+		}
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+	}
+}
+
+func writeFile(r io.Reader, target string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0777)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = io.Copy(f, r)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,255 @@
+package local
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo/config"
+	"github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	wfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned"
+	fakewfclientset "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	executor "github.com/argoproj/argo/workflow/artifacts"
+	localartifact "github.com/argoproj/argo/workflow/artifacts/local"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/argoproj/argo/workflow/controller"
+	"github.com/argoproj/argo/workflow/util"
+)
+
+const (
+	// Namespace is the namespace workflows are run in when they do not specify one
+	Namespace = "default"
+	// ArtifactBucket is the bucket of the S3 locations which the local artifact driver maps to files
+	ArtifactBucket = "local"
+	// artifactEndpoint is the endpoint of those locations, which is never connected to, but S3 locations need one
+	artifactEndpoint = "localhost"
+)
+
+// Opts are the options for running workflows locally
+type Opts struct {
+	// ArtifactDir is the directory output artifacts are kept in
+	ArtifactDir string
+	// ContainerRuntime runs the containers, defaults to the Docker CLI
+	ContainerRuntime ContainerRuntime
+	// Out receives the output of the containers, each line prefixed with the pod name. Defaults to stderr.
+	Out io.Writer
+	// PollInterval is how often the workflow is reconciled, defaults to one second
+	PollInterval time.Duration
+	// Objects are additional objects, such as workflow templates, config maps and secrets, that the workflow uses
+	Objects []runtime.Object
+}
+
+// Runner runs workflows without a Kubernetes cluster. It uses the workflow controller's operator against fake
+// clientsets, and runs each pod the operator creates as a local container. Output artifacts are kept on the local
+// file system.
+type Runner struct {
+	Opts
+	kubeclientset    kubernetes.Interface
+	wfclientset      wfclientset.Interface
+	containerRuntime ContainerRuntime
+	outMutex         sync.Mutex
+	// stopped are the names of the pods whose containers have been stopped
+	stopped sync.Map
+}
+
+// NewRunner returns a runner
+func NewRunner(opts Opts) *Runner {
+	r := &Runner{Opts: opts, containerRuntime: opts.ContainerRuntime}
+	if r.containerRuntime == nil {
+		r.containerRuntime = &DockerRuntime{}
+	}
+	if r.Out == nil {
+		r.Out = os.Stderr
+	}
+	if r.PollInterval == 0 {
+		r.PollInterval = time.Second
+	}
+	return r
+}
+
+// Run validates the workflow and runs it to completion, returning the completed workflow
+func (r *Runner) Run(ctx context.Context, wf *wfv1.Workflow) (*wfv1.Workflow, error) {
+	var kubeObjects, wfObjects []runtime.Object
+	for _, obj := range r.Objects {
+		switch obj.(type) {
+		case *wfv1.WorkflowTemplate, *wfv1.ClusterWorkflowTemplate:
+			wfObjects = append(wfObjects, obj)
+		default:
+			kubeObjects = append(kubeObjects, obj)
+		}
+	}
+	r.kubeclientset = fake.NewSimpleClientset(kubeObjects...)
+	r.wfclientset = fakewfclientset.NewSimpleClientset(wfObjects...)
+
+	if wf.Namespace == "" {
+		wf.Namespace = Namespace
+	}
+	// the fake clientset does not generate names or UIDs
+	if wf.Name == "" {
+		wf.Name = wf.GenerateName + rand.String(5)
+	}
+	wf.UID = types.UID(uuid.NewUUID())
+	// child workflows are not run, as there is no workflow informer
+	for _, tmpl := range wf.Spec.Templates {
+		if tmpl.GetType() == wfv1.TemplateTypeWorkflow {
+			return nil, errors.Errorf(errors.CodeBadRequest, "template %s creates a child workflow, which is not supported when running locally", tmpl.Name)
+		}
+	}
+	wf, err := util.SubmitWorkflow(r.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace), r.wfclientset, wf.Namespace, wf, &wfv1.SubmitOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	wfc, err := controller.NewLocalWorkflowController(ctx, r.kubeclientset, r.wfclientset, wf.Namespace, config.Config{
+		ArtifactRepository: config.ArtifactRepository{
+			S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Endpoint: artifactEndpoint, Bucket: ArtifactBucket}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wg := &sync.WaitGroup{}
+	// stop any containers which are still running, e.g. daemons, before returning
+	defer wg.Wait()
+	defer cancel()
+	for {
+		wf, err = wfc.OperateWorkflow(wf)
+		if err != nil {
+			return nil, err
+		}
+		if wf.Status.Fulfilled() {
+			return wf, nil
+		}
+		err = r.startPendingPods(ctx, wg, wf)
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return wf, ctx.Err()
+		case <-time.After(r.PollInterval):
+		}
+	}
+}
+
+// startPendingPods starts running the pods which have been created by the operator, but not yet started
+func (r *Runner) startPendingPods(ctx context.Context, wg *sync.WaitGroup, wf *wfv1.Workflow) error {
+	pods := r.kubeclientset.CoreV1().Pods(wf.Namespace)
+	list, err := pods.List(metav1.ListOptions{LabelSelector: common.LabelKeyWorkflow + "=" + wf.Name})
+	if err != nil {
+		return err
+	}
+	for _, pod := range list.Items {
+		if pod.Status.Phase == apiv1.PodRunning {
+			r.stopIfPastDeadline(&pod)
+			continue
+		}
+		if pod.Status.Phase != "" {
+			continue
+		}
+		pod.Status.Phase = apiv1.PodRunning
+		// the main container is ready as soon as it is started, so that daemons work
+		pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{Name: common.MainContainerName, Ready: true}}
+		running, err := pods.Update(&pod)
+		if err != nil {
+			return err
+		}
+		wg.Add(1)
+		go func(pod *apiv1.Pod) {
+			defer wg.Done()
+			err := r.runPod(ctx, pod)
+			if err != nil {
+				log.WithField("pod", pod.Name).WithError(err).Error("failed to run pod")
+			}
+		}(running)
+	}
+	return nil
+}
+
+// stopIfPastDeadline stops the pod's container if the operator has set its deadline to the past, which is how it
+// stops daemons and terminates workflows
+func (r *Runner) stopIfPastDeadline(pod *apiv1.Pod) {
+	execCtlStr, ok := pod.Annotations[common.AnnotationKeyExecutionControl]
+	if !ok {
+		return
+	}
+	var execCtl common.ExecutionControl
+	err := json.Unmarshal([]byte(execCtlStr), &execCtl)
+	if err != nil || execCtl.Deadline == nil || execCtl.Deadline.After(time.Now()) {
+		return
+	}
+	if _, stopped := r.stopped.LoadOrStore(pod.Name, true); stopped {
+		return
+	}
+	log.WithField("pod", pod.Name).Info("Stopping container which is past its deadline")
+	err = r.containerRuntime.Remove(context.Background(), containerName(pod))
+	if err != nil {
+		log.WithField("pod", pod.Name).WithError(err).Warn("failed to stop container")
+	}
+}
+
+func (r *Runner) artifactDriver(namespace string, art *wfv1.Artifact) (executor.ArtifactDriver, error) {
+	if art.S3 != nil {
+		if art.S3.Bucket != ArtifactBucket {
+			return nil, errors.Errorf(errors.CodeBadRequest, "artifact %s is in S3 bucket %s, only local artifacts are supported", art.Name, art.S3.Bucket)
+		}
+		return &localartifact.ArtifactDriver{RootDir: r.ArtifactDir}, nil
+	}
+	return executor.NewDriver(art, &resources{r.kubeclientset, namespace})
+}
+
+// resources gets secrets and config maps for artifact drivers from the objects the runner was given
+type resources struct {
+	kubeclientset kubernetes.Interface
+	namespace     string
+}
+
+func (r *resources) GetSecret(name, key string) (string, error) {
+	secret, err := r.kubeclientset.CoreV1().Secrets(r.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r *resources) GetConfigMapKey(name, key string) (string, error) {
+	cm, err := r.kubeclientset.CoreV1().ConfigMaps(r.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return cm.Data[key], nil
+}
+
+// logWriter returns a writer which writes each line to Out, prefixed with the name
+func (r *Runner) logWriter(name string) io.WriteCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		scanner := bufio.NewScanner(pr)
+		for scanner.Scan() {
+			r.outMutex.Lock()
+			_, _ = fmt.Fprintf(r.Out, "%s: %s\n", name, scanner.Text())
+			r.outMutex.Unlock()
+		}
+		_ = pr.CloseWithError(scanner.Err())
+	}()
+	return pw
+}
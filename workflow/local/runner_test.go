@@ -0,0 +1,132 @@
+package local
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/test"
+)
+
+// fakeRuntime runs every container, failing those with the argument "fail", and pretends every file contains the
+// container's name
+type fakeRuntime struct{}
+
+func (f *fakeRuntime) Run(_ context.Context, c Container, stdout, _ io.Writer) (int, error) {
+	for _, arg := range c.Args {
+		if arg == "fail" {
+			return 1, nil
+		}
+	}
+	_, err := io.WriteString(stdout, c.Name+"\n")
+	return 0, err
+}
+
+func (f *fakeRuntime) CopyFrom(_ context.Context, name, _ string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := tw.WriteHeader(&tar.Header{Name: "file", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(name))})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write([]byte(name))
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func (f *fakeRuntime) Remove(context.Context, string) error {
+	return nil
+}
+
+var stepsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: steps
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: produce
+        template: produce
+    - - name: consume
+        template: consume
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.produce.outputs.parameters.message}}"
+          artifacts:
+          - name: file
+            from: "{{steps.produce.outputs.artifacts.file}}"
+  - name: produce
+    container:
+      image: alpine
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /message
+      artifacts:
+      - name: file
+        path: /file
+  - name: consume
+    inputs:
+      parameters:
+      - name: message
+      artifacts:
+      - name: file
+        path: /file
+    container:
+      image: alpine
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestRunner_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	if assert.NoError(t, err) {
+		defer func() { _ = os.RemoveAll(dir) }()
+	}
+	r := NewRunner(Opts{ArtifactDir: dir, ContainerRuntime: &fakeRuntime{}, Out: ioutil.Discard, PollInterval: 10 * time.Millisecond})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		wf, err := r.Run(context.Background(), test.LoadWorkflowFromBytes([]byte(stepsWf)))
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Phase)
+			produce := wf.Status.Nodes.FindByDisplayName("produce")
+			if assert.NotNil(t, produce) && assert.NotNil(t, produce.Outputs) {
+				assert.Equal(t, "argo-"+produce.ID, produce.Outputs.Parameters[0].Value.String())
+				art := produce.Outputs.Artifacts[0]
+				if assert.NotNil(t, art.S3) {
+					assert.Equal(t, ArtifactBucket, art.S3.Bucket)
+					assert.FileExists(t, dir+"/local/"+art.S3.Key)
+				}
+			}
+			consume := wf.Status.Nodes.FindByDisplayName("consume")
+			if assert.NotNil(t, consume) {
+				assert.Equal(t, wfv1.NodeSucceeded, consume.Phase)
+			}
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		wf := test.LoadWorkflowFromBytes([]byte(stepsWf))
+		wf.Name = "failed"
+		wf.Spec.Templates[2].Container.Args = []string{"fail"}
+		wf, err := r.Run(context.Background(), wf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NodeFailed, wf.Status.Phase)
+			consume := wf.Status.Nodes.FindByDisplayName("consume")
+			if assert.NotNil(t, consume) {
+				assert.Equal(t, "failed with exit code 1", consume.Message)
+			}
+		}
+	})
+}
@@ -49,6 +49,7 @@ type Metrics struct {
 	errors             map[ErrorCause]prometheus.Counter
 	customMetrics      map[string]metric
 	workqueueMetrics   map[string]prometheus.Metric
+	namespaceMetrics   map[string]prometheus.Gauge
 
 	// Used to quickly check if a metric desc is already used by the system
 	defaultMetricDescs map[string]bool
@@ -78,6 +79,7 @@ func New(metricsConfig, telemetryConfig ServerConfig) *Metrics {
 		errors:             getErrorCounters(),
 		customMetrics:      make(map[string]metric),
 		workqueueMetrics:   make(map[string]prometheus.Metric),
+		namespaceMetrics:   make(map[string]prometheus.Gauge),
 		defaultMetricDescs: make(map[string]bool),
 		metricNameHelps:    make(map[string]string),
 		logMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
@@ -116,6 +118,9 @@ func (m *Metrics) allMetrics() []prometheus.Metric {
 	for _, metric := range m.workqueueMetrics {
 		allMetrics = append(allMetrics, metric)
 	}
+	for _, metric := range m.namespaceMetrics {
+		allMetrics = append(allMetrics, metric)
+	}
 	for _, metric := range m.customMetrics {
 		allMetrics = append(allMetrics, metric.metric)
 	}
@@ -217,6 +222,26 @@ func (m *Metrics) CronWorkflowSubmissionError() {
 	m.errors[ErrorCauseCronWorkflowSubmissionError].Inc()
 }
 
+// NamespaceWorkflows records the number of workflows of the namespace queued by the controller, because of its
+// parallelism limits, and the number of workflows it is running. The metrics of a namespace with no queued or running
+// workflows are removed, so that the namespaces which no longer have workflows are not reported forever.
+func (m *Metrics) NamespaceWorkflows(namespace string, queued, running int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for state, count := range map[string]int{"queued": queued, "running": running} {
+		key := fmt.Sprintf("%s-%s", namespace, state)
+		if queued == 0 && running == 0 {
+			delete(m.namespaceMetrics, key)
+			continue
+		}
+		if _, ok := m.namespaceMetrics[key]; !ok {
+			m.namespaceMetrics[key] = newGauge("namespace_workflows_count", "Number of workflows of the namespace queued or running", map[string]string{"namespace": namespace, "state": state})
+		}
+		m.namespaceMetrics[key].Set(float64(count))
+	}
+}
+
 // Act as a metrics provider for a workflow queue
 var _ workqueue.MetricsProvider = &Metrics{}
 
@@ -181,3 +181,26 @@ func TestWorkflowQueueMetrics(t *testing.T) {
 
 	}
 }
+
+func TestNamespaceWorkflows(t *testing.T) {
+	config := ServerConfig{
+		Enabled: true,
+		Path:    DefaultMetricsServerPath,
+		Port:    DefaultMetricsServerPort,
+	}
+	m := New(config, config)
+
+	m.NamespaceWorkflows("team-a", 3, 1)
+	m.NamespaceWorkflows("team-a", 2, 2)
+	var metric dto.Metric
+	if assert.NoError(t, m.namespaceMetrics["team-a-queued"].Write(&metric)) {
+		assert.Equal(t, float64(2), *metric.Gauge.Value)
+	}
+	if assert.NoError(t, m.namespaceMetrics["team-a-running"].Write(&metric)) {
+		assert.Equal(t, float64(2), *metric.Gauge.Value)
+	}
+	assert.Len(t, m.namespaceMetrics, 2)
+
+	m.NamespaceWorkflows("team-a", 0, 0)
+	assert.Empty(t, m.namespaceMetrics)
+}
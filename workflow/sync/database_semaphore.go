@@ -0,0 +1,146 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo/persist/sqldb"
+)
+
+// LockKindDatabase is the kind of the locks stored in the database
+const LockKindDatabase = "database"
+
+type SyncLockRepoFunc func() sqldb.SyncLockRepo
+
+// databaseSemaphore is a semaphore, or a mutex, whose holders and queue are stored in the database, so that it is
+// shared by the controllers of the database. The controllers cannot notify each other when they release the lock, so
+// the workflows waiting for it are polled.
+type databaseSemaphore struct {
+	name              string
+	dbName            string
+	limit             int
+	repo              SyncLockRepoFunc
+	releaseNotifyFunc ReleaseNotifyCallbackFunc
+	pollFunc          ReleaseNotifyCallbackFunc
+	log               *log.Entry
+}
+
+func newDatabaseSemaphore(name string, limit int, repo SyncLockRepoFunc, callbackFunc, pollFunc ReleaseNotifyCallbackFunc) (*databaseSemaphore, error) {
+	lockName, err := DecodeLockName(name)
+	if err != nil {
+		return nil, err
+	}
+	dbName := lockName.getDatabaseName()
+	// the limits of semaphores are inserted by the users, whereas the limit of a mutex is always one
+	if lockName.ResourceName == string(LockTypeMutex) {
+		err = repo().CreateMutex(dbName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &databaseSemaphore{
+		name:              name,
+		dbName:            dbName,
+		limit:             limit,
+		repo:              repo,
+		releaseNotifyFunc: callbackFunc,
+		pollFunc:          pollFunc,
+		log:               log.WithField(lockName.ResourceName, name),
+	}, nil
+}
+
+func (s *databaseSemaphore) getName() string {
+	return s.name
+}
+
+func (s *databaseSemaphore) getLimit() int {
+	return s.limit
+}
+
+func (s *databaseSemaphore) getCurrentHolders() []string {
+	holders, err := s.repo().ListHolders(s.dbName)
+	if err != nil {
+		s.log.WithError(err).Warn("Failed to list the holders")
+	}
+	return holders
+}
+
+func (s *databaseSemaphore) resize(n int) bool {
+	s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+	s.limit = n
+	return true
+}
+
+func (s *databaseSemaphore) release(key string) bool {
+	err := s.repo().Release(s.dbName, key)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to release the lock held by %s", key)
+		return false
+	}
+	s.log.Infof("Lock has been released by %s", key)
+	next, err := s.repo().NextHolder(s.dbName)
+	if err != nil {
+		s.log.WithError(err).Warn("Failed to get the next holder")
+	} else if next != "" {
+		// the next holder may be a workflow of another controller, which polls the lock
+		s.log.Debugf("Enqueue the workflow %s", getWorkflowKey(next))
+		s.releaseNotifyFunc(getWorkflowKey(next))
+	}
+	return true
+}
+
+func (s *databaseSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+	err := s.repo().AddToQueue(s.dbName, holderKey, priority, creationTime)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to add %s into the queue", holderKey)
+	}
+}
+
+func (s *databaseSemaphore) acquire(holderKey string) bool {
+	acquired, err := s.repo().Acquire(s.dbName, holderKey, s.limit)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to acquire the lock for %s", holderKey)
+	}
+	return acquired
+}
+
+func (s *databaseSemaphore) checkAcquire(holderKey string) (bool, string) {
+	ok, err := s.repo().CheckAcquire(s.dbName, holderKey, s.limit)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to check the lock for %s", holderKey)
+		s.pollFunc(getWorkflowKey(holderKey))
+		return false, fmt.Sprintf("Waiting for %s lock. Failed to check the lock: %v", s.name, err)
+	}
+	if ok {
+		return true, ""
+	}
+	s.pollFunc(getWorkflowKey(holderKey))
+	return false, fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.getCurrentHolders()), s.limit)
+}
+
+func (s *databaseSemaphore) tryAcquire(holderKey string) (bool, string) {
+	acquired, err := s.repo().TryAcquire(s.dbName, holderKey, s.limit)
+	if err != nil {
+		s.log.WithError(err).Warnf("Failed to acquire the lock for %s", holderKey)
+		s.pollFunc(getWorkflowKey(holderKey))
+		return false, fmt.Sprintf("Waiting for %s lock. Failed to acquire the lock: %v", s.name, err)
+	}
+	if acquired {
+		return true, ""
+	}
+	// another controller may release the lock, without notifying this one
+	s.pollFunc(getWorkflowKey(holderKey))
+	return false, fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.getCurrentHolders()), s.limit)
+}
+
+// getWorkflowKey returns the key of the workflow of the holder, which is either a workflow or a node of one
+func getWorkflowKey(holderKey string) string {
+	items := strings.Split(holderKey, "/")
+	if len(items) == 3 {
+		return fmt.Sprintf("%s/%s", items[0], items[1])
+	}
+	return holderKey
+}
@@ -0,0 +1,310 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// fakeSyncDatabase is the database shared by the controllers in the tests
+type fakeSyncDatabase struct {
+	limits map[string]int
+	states []*fakeSyncState
+}
+
+type fakeSyncState struct {
+	name, controller, holderKey string
+	held                        bool
+	priority                    int32
+	creationTime                time.Time
+}
+
+type fakeSyncLockRepo struct {
+	db         *fakeSyncDatabase
+	controller string
+}
+
+func (r *fakeSyncLockRepo) IsEnabled() bool { return true }
+
+func (r *fakeSyncLockRepo) Heartbeat() (bool, error) { return false, nil }
+
+func (r *fakeSyncLockRepo) GetLimit(name string) (int, error) {
+	limit, ok := r.db.limits[name]
+	if !ok {
+		return 0, fmt.Errorf("database semaphore %s has no limit", name)
+	}
+	return limit, nil
+}
+
+func (r *fakeSyncLockRepo) CreateMutex(name string) error {
+	if _, ok := r.db.limits[name]; !ok {
+		r.db.limits[name] = 1
+	}
+	return nil
+}
+
+func (r *fakeSyncLockRepo) find(name, holderKey string) *fakeSyncState {
+	for _, state := range r.db.states {
+		if state.name == name && state.controller == r.controller && state.holderKey == holderKey {
+			return state
+		}
+	}
+	return nil
+}
+
+func (r *fakeSyncLockRepo) AddToQueue(name, holderKey string, priority int32, creationTime time.Time) error {
+	if r.find(name, holderKey) == nil {
+		r.db.states = append(r.db.states, &fakeSyncState{name: name, controller: r.controller, holderKey: holderKey, priority: priority, creationTime: creationTime})
+	}
+	return nil
+}
+
+func (r *fakeSyncLockRepo) queue(name string) (int, []*fakeSyncState) {
+	held := 0
+	var waiting []*fakeSyncState
+	for _, state := range r.db.states {
+		if state.name != name {
+			continue
+		}
+		if state.held {
+			held++
+		} else {
+			waiting = append(waiting, state)
+		}
+	}
+	sort.SliceStable(waiting, func(i, j int) bool {
+		if waiting[i].priority == waiting[j].priority {
+			if waiting[i].creationTime.Equal(waiting[j].creationTime) {
+				return waiting[i].holderKey < waiting[j].holderKey
+			}
+			return waiting[i].creationTime.Before(waiting[j].creationTime)
+		}
+		return waiting[i].priority > waiting[j].priority
+	})
+	return held, waiting
+}
+
+func (r *fakeSyncLockRepo) TryAcquire(name, holderKey string, limit int) (bool, error) {
+	own := r.find(name, holderKey)
+	if own != nil && own.held {
+		return true, nil
+	}
+	held, waiting := r.queue(name)
+	if held >= limit || own == nil || waiting[0] != own {
+		return false, nil
+	}
+	own.held = true
+	return true, nil
+}
+
+func (r *fakeSyncLockRepo) CheckAcquire(name, holderKey string, limit int) (bool, error) {
+	own := r.find(name, holderKey)
+	if own != nil && own.held {
+		return true, nil
+	}
+	held, waiting := r.queue(name)
+	return held < limit && own != nil && waiting[0] == own, nil
+}
+
+func (r *fakeSyncLockRepo) Acquire(name, holderKey string, limit int) (bool, error) {
+	err := r.AddToQueue(name, holderKey, 0, time.Now())
+	if err != nil {
+		return false, err
+	}
+	own := r.find(name, holderKey)
+	if held, _ := r.queue(name); held >= limit && !own.held {
+		return false, nil
+	}
+	own.held = true
+	return true, nil
+}
+
+func (r *fakeSyncLockRepo) Release(name, holderKey string) error {
+	own := r.find(name, holderKey)
+	for i, state := range r.db.states {
+		if state == own {
+			r.db.states = append(r.db.states[:i], r.db.states[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *fakeSyncLockRepo) ListHolders(name string) ([]string, error) {
+	var holders []string
+	for _, state := range r.db.states {
+		if state.name == name && state.held {
+			holders = append(holders, state.holderKey)
+		}
+	}
+	return holders, nil
+}
+
+func (r *fakeSyncLockRepo) NextHolder(name string) (string, error) {
+	_, waiting := r.queue(name)
+	if len(waiting) == 0 {
+		return "", nil
+	}
+	return waiting[0].holderKey, nil
+}
+
+func newDatabaseLockManager(db *fakeSyncDatabase, controller string, polled *[]string) *SyncManager {
+	cm := NewLockManager(func(string) (int, error) {
+		return 0, fmt.Errorf("unexpected configmap semaphore")
+	}, func(string) {})
+	cm.SetDatabase(func() sqldb.SyncLockRepo {
+		return &fakeSyncLockRepo{db: db, controller: controller}
+	}, func(key string) {
+		*polled = append(*polled, key)
+	})
+	return cm
+}
+
+func newDatabaseLockWorkflow(name string, synchronization *wfv1.Synchronization) *wfv1.Workflow {
+	wf := unmarshalWF(wfWithSemaphore)
+	wf.Name = name
+	wf.Spec.Synchronization = synchronization
+	return wf
+}
+
+func TestDatabaseSemaphore(t *testing.T) {
+	synchronization := &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "warehouse"}}}
+
+	t.Run("SharedByControllers", func(t *testing.T) {
+		db := &fakeSyncDatabase{limits: map[string]int{"default/semaphore/warehouse": 1}}
+		var polledA, polledB []string
+		controllerA := newDatabaseLockManager(db, "cluster-a", &polledA)
+		controllerB := newDatabaseLockManager(db, "cluster-b", &polledB)
+		one := newDatabaseLockWorkflow("one", synchronization)
+		two := newDatabaseLockWorkflow("two", synchronization)
+
+		acquired, updated, msg, err := controllerA.TryAcquire(one, "", 0, time.Now(), one.Spec.Synchronization)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+			assert.True(t, updated)
+			assert.Empty(t, msg)
+			assert.Equal(t, "default/database/semaphore/warehouse", one.Status.Synchronization.Semaphore.Holding[0].Semaphore)
+		}
+
+		acquired, updated, msg, err = controllerB.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+		if assert.NoError(t, err) {
+			assert.False(t, acquired)
+			assert.True(t, updated)
+			assert.Equal(t, "Waiting for default/database/semaphore/warehouse lock. Lock status: 0/1 ", msg)
+			assert.Equal(t, []string{"default/one"}, two.Status.Synchronization.Semaphore.Waiting[0].Holders)
+			assert.Equal(t, []string{"default/two"}, polledB)
+		}
+
+		controllerA.Release(one, "", one.Namespace, one.Spec.Synchronization)
+		acquired, _, _, err = controllerB.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+		if assert.NoError(t, err) {
+			assert.True(t, acquired)
+		}
+		assert.Empty(t, polledA)
+	})
+
+	t.Run("Resize", func(t *testing.T) {
+		db := &fakeSyncDatabase{limits: map[string]int{"default/semaphore/warehouse": 1}}
+		var polled []string
+		cm := newDatabaseLockManager(db, "cluster-a", &polled)
+		one := newDatabaseLockWorkflow("one", synchronization)
+		two := newDatabaseLockWorkflow("two", synchronization)
+		acquired, _, _, err := cm.TryAcquire(one, "", 0, time.Now(), one.Spec.Synchronization)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		acquired, _, _, err = cm.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+
+		db.limits["default/semaphore/warehouse"] = 2
+		acquired, _, _, err = cm.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("NoLimit", func(t *testing.T) {
+		var polled []string
+		cm := newDatabaseLockManager(&fakeSyncDatabase{limits: map[string]int{}}, "cluster-a", &polled)
+		wf := newDatabaseLockWorkflow("one", synchronization)
+		_, _, _, err := cm.TryAcquire(wf, "", 0, time.Now(), wf.Spec.Synchronization)
+		assert.EqualError(t, err, "database semaphore default/semaphore/warehouse has no limit")
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		cm := NewLockManager(func(string) (int, error) { return 0, nil }, func(string) {})
+		wf := newDatabaseLockWorkflow("one", synchronization)
+		_, _, _, err := cm.TryAcquire(wf, "", 0, time.Now(), wf.Spec.Synchronization)
+		assert.Equal(t, sqldb.SyncLockNotSupportedError, err)
+	})
+}
+
+func TestDatabaseMutex(t *testing.T) {
+	synchronization := &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy", Database: true}}
+	db := &fakeSyncDatabase{limits: map[string]int{}}
+	var polledA, polledB []string
+	controllerA := newDatabaseLockManager(db, "cluster-a", &polledA)
+	controllerB := newDatabaseLockManager(db, "cluster-b", &polledB)
+	one := newDatabaseLockWorkflow("one", synchronization)
+	two := newDatabaseLockWorkflow("two", synchronization)
+
+	acquired, _, _, err := controllerA.TryAcquire(one, "", 0, time.Now(), one.Spec.Synchronization)
+	if assert.NoError(t, err) {
+		assert.True(t, acquired)
+		assert.Equal(t, wfv1.MutexHolding{Mutex: "default/database/mutex/deploy", Holder: "one"}, one.Status.Synchronization.Mutex.Holding[0])
+	}
+	acquired, _, _, err = controllerB.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+	if assert.NoError(t, err) {
+		assert.False(t, acquired)
+		assert.Equal(t, wfv1.MutexHolding{Mutex: "default/database/mutex/deploy", Holder: "default/one"}, two.Status.Synchronization.Mutex.Waiting[0])
+	}
+
+	controllerA.ReleaseAll(one)
+	acquired, _, _, err = controllerB.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+	if assert.NoError(t, err) {
+		assert.True(t, acquired)
+	}
+}
+
+func TestDatabaseMutexAndSemaphoreWithSameKey(t *testing.T) {
+	mutex := &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "deploy", Database: true}}
+	semaphore := &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "deploy"}}}
+	db := &fakeSyncDatabase{limits: map[string]int{}}
+	var polled []string
+	cm := newDatabaseLockManager(db, "cluster-a", &polled)
+	one := newDatabaseLockWorkflow("one", mutex)
+	acquired, _, _, err := cm.TryAcquire(one, "", 0, time.Now(), one.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, map[string]int{"default/mutex/deploy": 1}, db.limits)
+
+	// the limit of the mutex is not the limit of the semaphore
+	two := newDatabaseLockWorkflow("two", semaphore)
+	_, _, _, err = cm.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+	assert.EqualError(t, err, "database semaphore default/semaphore/deploy has no limit")
+
+	// nor do the holders of the mutex hold the semaphore
+	db.limits["default/semaphore/deploy"] = 1
+	acquired, _, _, err = cm.TryAcquire(two, "", 0, time.Now(), two.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
+
+func TestInitializeDatabaseLocks(t *testing.T) {
+	db := &fakeSyncDatabase{limits: map[string]int{"default/semaphore/warehouse": 1}}
+	var polled []string
+	cm := newDatabaseLockManager(db, "cluster-a", &polled)
+	wf := newDatabaseLockWorkflow("one", &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "warehouse"}}})
+	wf.Status.Synchronization = &wfv1.SynchronizationStatus{Semaphore: &wfv1.SemaphoreStatus{
+		Holding: []wfv1.SemaphoreHolding{{Semaphore: "default/database/semaphore/warehouse", Holders: []string{"one"}}},
+	}}
+	cm.Initialize(&wfv1.WorkflowList{Items: []wfv1.Workflow{*wf}})
+	if assert.Contains(t, cm.syncLockMap, "default/database/semaphore/warehouse") {
+		assert.Equal(t, []string{"default/one"}, cm.getCurrentLockHolders("default/database/semaphore/warehouse"))
+	}
+}
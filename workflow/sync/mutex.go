@@ -61,3 +61,9 @@ func (m *Mutex) tryAcquire(holderKey string) (bool, string) {
 	defer m.lock.Unlock()
 	return m.mutex.tryAcquire(holderKey)
 }
+
+func (m *Mutex) checkAcquire(holderKey string) (bool, string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.mutex.checkAcquire(holderKey)
+}
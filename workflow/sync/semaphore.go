@@ -2,7 +2,6 @@ package sync
 
 import (
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -90,12 +89,7 @@ func (s *Semaphore) release(key string) bool {
 		s.log.Infof("Lock has been released by %s. Available locks: %d", key, s.limit-len(s.lockHolder))
 		if s.pending.Len() > 0 {
 			item := s.pending.peek()
-			keyStr := fmt.Sprintf("%v", item.key)
-			items := strings.Split(keyStr, "/")
-			workflowKey := keyStr
-			if len(items) == 3 {
-				workflowKey = fmt.Sprintf("%s/%s", items[0], items[1])
-			}
+			workflowKey := getWorkflowKey(fmt.Sprintf("%v", item.key))
 			s.log.Debugf("Enqueue the workflow %s", workflowKey)
 			s.releaseNotifyFunc(workflowKey)
 		}
@@ -125,6 +119,25 @@ func (s *Semaphore) acquire(holderKey string) bool {
 	return false
 }
 
+// checkAcquire returns whether the holder holds the lock already, or tryAcquire would acquire it now, without
+// acquiring it
+func (s *Semaphore) checkAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		return true, ""
+	}
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.lockHolder), s.limit)
+	if s.pending.Len() > 0 && fmt.Sprintf("%v", s.pending.peek().key) != holderKey {
+		return false, waitingMsg
+	}
+	if len(s.lockHolder) >= s.limit {
+		return false, waitingMsg
+	}
+	return true, ""
+}
+
 func (s *Semaphore) tryAcquire(holderKey string) (bool, string) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -9,6 +9,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo/errors"
+	"github.com/argoproj/argo/persist/sqldb"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo/util/slice"
 )
@@ -21,6 +22,8 @@ type SyncManager struct {
 	lock                *sync.Mutex
 	releaseNotifyFunc   ReleaseNotifyCallbackFunc
 	syncLimitConfigFunc SyncLimitConfigFunc
+	syncLockRepo        SyncLockRepoFunc
+	pollFunc            ReleaseNotifyCallbackFunc
 }
 
 type LockName struct {
@@ -34,6 +37,7 @@ type LockName struct {
 type Synchronization interface {
 	acquire(holderKey string) bool
 	tryAcquire(holderKey string) (bool, string)
+	checkAcquire(holderKey string) (bool, string)
 	release(key string) bool
 	addToQueue(holderKey string, priority int32, creationTime time.Time)
 	getCurrentHolders() []string
@@ -63,9 +67,20 @@ func NewLockManager(getSyncLimitConfigFunc func(string) (int, error), callbackFu
 		lock:                &sync.Mutex{},
 		releaseNotifyFunc:   callbackFunc,
 		syncLimitConfigFunc: getSyncLimitConfigFunc,
+		syncLockRepo:        func() sqldb.SyncLockRepo { return sqldb.NullSyncLockRepo },
+		pollFunc:            callbackFunc,
 	}
 }
 
+// SetDatabase stores the database semaphores and mutexes in the repository. pollFunc is called with the workflows
+// waiting for a database lock, as the other controllers of the database cannot notify them when they release it.
+func (cm *SyncManager) SetDatabase(syncLockRepo SyncLockRepoFunc, pollFunc ReleaseNotifyCallbackFunc) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	cm.syncLockRepo = syncLockRepo
+	cm.pollFunc = pollFunc
+}
+
 func (cm *SyncManager) Initialize(wfList *wfv1.WorkflowList) {
 
 	for _, wf := range wfList.Items {
@@ -76,7 +91,8 @@ func (cm *SyncManager) Initialize(wfList *wfv1.WorkflowList) {
 			for _, holding := range wf.Status.Synchronization.Semaphore.Holding {
 				semaphore := cm.syncLockMap[holding.Semaphore]
 				if semaphore == nil {
-					semaphore, err := cm.initializeSemaphore(holding.Semaphore)
+					var err error
+					semaphore, err = cm.initializeSemaphore(holding.Semaphore)
 					if err != nil {
 						log.Warnf("Synchronization configmap %s is not found. %v", holding.Semaphore, err)
 						continue
@@ -120,20 +136,41 @@ func (cm *SyncManager) getCurrentLockHolders(lockName string) []string {
 	return nil
 }
 
+// getSemaphoreLimit returns the limit of the semaphore, from its configmap or the database
+func (cm *SyncManager) getSemaphoreLimit(semaphoreName string) (int, error) {
+	if isDatabaseLock(semaphoreName) {
+		lockName, err := DecodeLockName(semaphoreName)
+		if err != nil {
+			return 0, err
+		}
+		return cm.syncLockRepo().GetLimit(lockName.getDatabaseName())
+	}
+	return cm.syncLimitConfigFunc(semaphoreName)
+}
+
 func (cm *SyncManager) initializeSemaphore(semaphoreName string) (Synchronization, error) {
-	limit, err := cm.syncLimitConfigFunc(semaphoreName)
+	limit, err := cm.getSemaphoreLimit(semaphoreName)
 	if err != nil {
 		return nil, err
 	}
+	if isDatabaseLock(semaphoreName) {
+		return newDatabaseSemaphore(semaphoreName, limit, cm.syncLockRepo, cm.releaseNotifyFunc, cm.pollFunc)
+	}
 	return NewSemaphore(semaphoreName, limit, cm.releaseNotifyFunc, LockTypeSemaphore), nil
 }
 
 func (cm *SyncManager) initializeMutex(mutexName string) (Synchronization, error) {
+	if isDatabaseLock(mutexName) {
+		if !cm.syncLockRepo().IsEnabled() {
+			return nil, sqldb.SyncLockNotSupportedError
+		}
+		return newDatabaseSemaphore(mutexName, 1, cm.syncLockRepo, cm.releaseNotifyFunc, cm.pollFunc)
+	}
 	return NewMutex(mutexName, cm.releaseNotifyFunc), nil
 }
 
 func (cm *SyncManager) isSemaphoreSizeChanged(semaphore Synchronization) (bool, int, error) {
-	limit, err := cm.syncLimitConfigFunc(semaphore.getName())
+	limit, err := cm.getSemaphoreLimit(semaphore.getName())
 	if err != nil {
 		return false, semaphore.getLimit(), err
 	}
@@ -151,75 +188,134 @@ func (cm *SyncManager) checkAndUpdateSemaphoreSize(semaphore Synchronization) er
 	return nil
 }
 
-// TryAcquire tries to acquire the lock from semaphore.
-// It returns status of acquiring a lock , status of Workflow status updated, waiting message if lock is not available and any error encountered
-func (cm *SyncManager) TryAcquire(wf *wfv1.Workflow, nodeName string, priority int32, creationTime time.Time, syncLockRef *wfv1.Synchronization) (bool, bool, string, error) {
-	cm.lock.Lock()
-	defer cm.lock.Unlock()
-
-	if syncLockRef == nil {
-		return true, false, "", nil
-	}
-
-	var lockType LockType
-	var err error
-
-	var syncLockName *LockName
+type syncLock struct {
+	key      string
+	lockType LockType
+	lock     Synchronization
+}
 
-	if syncLockRef.Semaphore != nil {
-		syncLockName = getSemaphoreLockName(wf.Namespace, syncLockRef.Semaphore)
+// getSyncLocks returns the semaphores and mutexes of the synchronization, initializing the ones which are not yet
+func (cm *SyncManager) getSyncLocks(namespace string, syncLockRef *wfv1.Synchronization) ([]syncLock, error) {
+	var locks []syncLock
+	for _, semaphoreRef := range syncLockRef.GetSemaphores() {
+		syncLockName := getSemaphoreLockName(namespace, &semaphoreRef)
+		if syncLockName == nil {
+			return nil, errors.New(errors.CodeBadRequest, "Requested Synchronization is invalid")
+		}
 		semaphoreLockKey := syncLockName.getLockKey()
-
 		semaphoreLock, found := cm.syncLockMap[semaphoreLockKey]
 		if !found {
+			var err error
 			semaphoreLock, err = cm.initializeSemaphore(semaphoreLockKey)
 			if err != nil {
-				return false, false, "", err
+				return nil, err
 			}
 			cm.syncLockMap[semaphoreLockKey] = semaphoreLock
 		}
-
 		// Check syncLock configmap changes
 		err := cm.checkAndUpdateSemaphoreSize(semaphoreLock)
 		if err != nil {
-			return false, false, "", err
+			return nil, err
 		}
-		lockType = LockTypeSemaphore
-	} else if syncLockRef.Mutex != nil {
-		syncLockName = getMutexLockName(wf.Namespace, syncLockRef.Mutex)
-		mutexLockKey := syncLockName.getLockKey()
-		_, found := cm.syncLockMap[mutexLockKey]
+		locks = append(locks, syncLock{key: semaphoreLockKey, lockType: LockTypeSemaphore, lock: semaphoreLock})
+	}
+	for _, mutex := range syncLockRef.GetMutexes() {
+		mutexLockKey := getMutexLockName(namespace, &mutex).getLockKey()
+		mutexLock, found := cm.syncLockMap[mutexLockKey]
 		if !found {
-			mutexLock, err := cm.initializeMutex(mutexLockKey)
+			var err error
+			mutexLock, err = cm.initializeMutex(mutexLockKey)
 			if err != nil {
-				return false, false, "", err
+				return nil, err
 			}
 			cm.syncLockMap[mutexLockKey] = mutexLock
 		}
-		lockType = LockTypeMutex
+		locks = append(locks, syncLock{key: mutexLockKey, lockType: LockTypeMutex, lock: mutexLock})
 	}
+	if len(locks) == 0 {
+		return nil, errors.New(errors.CodeBadRequest, "Requested Synchronization is invalid")
+	}
+	return locks, nil
+}
 
-	if syncLockName == nil {
-		return false, false, "", errors.New(errors.CodeBadRequest, "Requested Synchronization is invalid")
+// TryAcquire tries to acquire the semaphores and mutexes of the synchronization.
+// The locks are acquired all together, or not at all, so that holders never wait for a lock while holding another.
+// Since the queues of all the locks are in the same order, the holder first in them acquires all its locks once they
+// are released, which prevents deadlocks.
+// It returns status of acquiring a lock , status of Workflow status updated, waiting message if lock is not available and any error encountered
+func (cm *SyncManager) TryAcquire(wf *wfv1.Workflow, nodeName string, priority int32, creationTime time.Time, syncLockRef *wfv1.Synchronization) (bool, bool, string, error) {
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+
+	if syncLockRef == nil {
+		return true, false, "", nil
 	}
 
-	syncLockKey := syncLockName.getLockKey()
-	syncLock, found := cm.syncLockMap[syncLockKey]
-	if !found {
-		return false, false, "", errors.New(errors.CodeBadRequest, "Requested Synchronized syncLock is invalid")
+	locks, err := cm.getSyncLocks(wf.Namespace, syncLockRef)
+	if err != nil {
+		return false, false, "", err
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
-	syncLock.addToQueue(holderKey, priority, creationTime)
+	for _, l := range locks {
+		l.lock.addToQueue(holderKey, priority, creationTime)
+	}
+
+	var msgs []string
+	for _, l := range locks {
+		if ok, msg := l.lock.checkAcquire(holderKey); !ok {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(msgs) == 0 {
+		var acquired []syncLock
+		for _, l := range locks {
+			held := slice.ContainsString(l.lock.getCurrentHolders(), holderKey)
+			status, msg := l.lock.tryAcquire(holderKey)
+			if !status {
+				// another controller acquired a database lock in the meantime
+				for _, a := range acquired {
+					a.lock.release(holderKey)
+					a.lock.addToQueue(holderKey, priority, creationTime)
+				}
+				msgs = append(msgs, msg)
+				break
+			}
+			if !held {
+				acquired = append(acquired, l)
+			}
+		}
+	}
 
-	status, msg := syncLock.tryAcquire(holderKey)
-	if status {
-		updated := cm.updateConcurrencyStatus(holderKey, syncLockKey, lockType, LockActionAcquired, wf)
+	if len(msgs) == 0 {
+		updated := false
+		for _, l := range locks {
+			if cm.updateConcurrencyStatus(holderKey, l.key, l.lockType, LockActionAcquired, wf) {
+				updated = true
+			}
+		}
 		return true, updated, "", nil
 	}
 
-	updated := cm.updateConcurrencyStatus(holderKey, syncLockKey, lockType, LockActionWaiting, wf)
-	return false, updated, msg, nil
+	updated := false
+	for _, l := range locks {
+		if cm.updateConcurrencyStatus(holderKey, l.key, l.lockType, LockActionWaiting, wf) {
+			updated = true
+		}
+	}
+	return false, updated, getWaitingMessage(msgs), nil
+}
+
+// getWaitingMessage returns the message of the locks the holder is waiting for
+func getWaitingMessage(msgs []string) string {
+	if len(msgs) == 1 {
+		return msgs[0]
+	}
+	for i, msg := range msgs {
+		msgs[i] = strings.TrimSpace(msg)
+	}
+	return strings.Join(msgs, "; ")
 }
 
 func (cm *SyncManager) Release(wf *wfv1.Workflow, nodeName, namespace string, syncRef *wfv1.Synchronization) {
@@ -231,21 +327,22 @@ func (cm *SyncManager) Release(wf *wfv1.Workflow, nodeName, namespace string, sy
 	}
 	holderKey := getHolderKey(wf, nodeName)
 
-	if syncRef.Semaphore != nil {
-		lockName := getSemaphoreLockName(namespace, syncRef.Semaphore)
-		if syncLockHolder, ok := cm.syncLockMap[lockName.getLockKey()]; ok {
-			syncLockHolder.release(holderKey)
-			log.Debugf("%s sync lock is released by %s", lockName.getLockKey(), holderKey)
-			cm.updateConcurrencyStatus(holderKey, lockName.getLockKey(), LockTypeSemaphore, LockActionReleased, wf)
+	for _, semaphoreRef := range syncRef.GetSemaphores() {
+		lockName := getSemaphoreLockName(namespace, &semaphoreRef)
+		if lockName != nil {
+			cm.release(holderKey, lockName.getLockKey(), LockTypeSemaphore, wf)
 		}
 	}
-	if syncRef.Mutex != nil {
-		lockName := getMutexLockName(namespace, syncRef.Mutex)
-		if syncLockHolder, ok := cm.syncLockMap[lockName.getLockKey()]; ok {
-			syncLockHolder.release(holderKey)
-			log.Debugf("%s sync lock is released by %s", lockName.getLockKey(), holderKey)
-			cm.updateConcurrencyStatus(holderKey, lockName.getLockKey(), LockTypeMutex, LockActionReleased, wf)
-		}
+	for _, mutex := range syncRef.GetMutexes() {
+		cm.release(holderKey, getMutexLockName(namespace, &mutex).getLockKey(), LockTypeMutex, wf)
+	}
+}
+
+func (cm *SyncManager) release(holderKey, lockKey string, lockType LockType, wf *wfv1.Workflow) {
+	if syncLockHolder, ok := cm.syncLockMap[lockKey]; ok {
+		syncLockHolder.release(holderKey)
+		log.Debugf("%s sync lock is released by %s", lockKey, holderKey)
+		cm.updateConcurrencyStatus(holderKey, lockKey, lockType, LockActionReleased, wf)
 	}
 }
 
@@ -291,8 +388,11 @@ func (cm *SyncManager) ReleaseAll(wf *wfv1.Workflow) bool {
 
 // updateSemaphoreStatus updates the semaphore holding and waiting details
 func (cm *SyncManager) updateSemaphoreStatus(holderKey, lockKey string, lockAction LockAction, wf *wfv1.Workflow) bool {
-	if wf.Status.Synchronization == nil || wf.Status.Synchronization.Semaphore == nil {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Semaphore: &wfv1.SemaphoreStatus{}}
+	if wf.Status.Synchronization == nil {
+		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
+	}
+	if wf.Status.Synchronization.Semaphore == nil {
+		wf.Status.Synchronization.Semaphore = &wfv1.SemaphoreStatus{}
 	}
 	// Update the semaphore which the workflow is waiting for
 	if lockAction == LockActionWaiting {
@@ -308,6 +408,12 @@ func (cm *SyncManager) updateSemaphoreStatus(holderKey, lockKey string, lockActi
 	}
 	// Update the semaphore which is acquired by the workflow
 	if lockAction == LockActionAcquired {
+		// the workflow no longer waits for the lock
+		removed := false
+		if index, _ := getSemaphoreHolding(wf.Status.Synchronization.Semaphore.Waiting, lockKey); index != -1 {
+			wf.Status.Synchronization.Semaphore.Waiting = append(wf.Status.Synchronization.Semaphore.Waiting[:index], wf.Status.Synchronization.Semaphore.Waiting[index+1:]...)
+			removed = true
+		}
 		index, semaphoreHolding := getSemaphoreHolding(wf.Status.Synchronization.Semaphore.Holding, lockKey)
 		items := strings.Split(holderKey, "/")
 		holdingName := items[len(items)-1]
@@ -321,7 +427,7 @@ func (cm *SyncManager) updateSemaphoreStatus(holderKey, lockKey string, lockActi
 				return true
 			}
 		}
-		return false
+		return removed
 	}
 	// Clear the semaphore which is released by the workflow
 	if lockAction == LockActionReleased {
@@ -339,8 +445,11 @@ func (cm *SyncManager) updateSemaphoreStatus(holderKey, lockKey string, lockActi
 
 // updateMutexStatus updates the mutex holding and waiting details
 func (cm *SyncManager) updateMutexStatus(holderKey, lockKey string, lockAction LockAction, wf *wfv1.Workflow) bool {
-	if wf.Status.Synchronization == nil || wf.Status.Synchronization.Mutex == nil {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Mutex: &wfv1.MutexStatus{}}
+	if wf.Status.Synchronization == nil {
+		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
+	}
+	if wf.Status.Synchronization.Mutex == nil {
+		wf.Status.Synchronization.Mutex = &wfv1.MutexStatus{}
 	}
 	// Update mutex which the workflow is waiting for
 	if lockAction == LockActionWaiting {
@@ -361,6 +470,12 @@ func (cm *SyncManager) updateMutexStatus(holderKey, lockKey string, lockAction L
 	}
 	// Update mutex which is acquired by the workflow
 	if lockAction == LockActionAcquired {
+		// the workflow no longer waits for the lock
+		removed := false
+		if index, _ := getMutexHolding(wf.Status.Synchronization.Mutex.Waiting, lockKey); index != -1 {
+			wf.Status.Synchronization.Mutex.Waiting = append(wf.Status.Synchronization.Mutex.Waiting[:index], wf.Status.Synchronization.Mutex.Waiting[index+1:]...)
+			removed = true
+		}
 		index, mutexHolding := getMutexHolding(wf.Status.Synchronization.Mutex.Holding, lockKey)
 		items := strings.Split(holderKey, "/")
 		holdingName := items[len(items)-1]
@@ -374,7 +489,7 @@ func (cm *SyncManager) updateMutexStatus(holderKey, lockKey string, lockAction L
 				return true
 			}
 		}
-		return false
+		return removed
 	}
 	// Clear the mutex which is released by the workflow
 	if lockAction == LockActionReleased {
@@ -463,13 +578,30 @@ func getSemaphoreLockName(namespace string, semaphoreRef *wfv1.SemaphoreRef) *Lo
 	if semaphoreRef.ConfigMapKeyRef != nil {
 		return NewLockName(namespace, "configmap", semaphoreRef.ConfigMapKeyRef.Name, semaphoreRef.ConfigMapKeyRef.Key)
 	}
+	if semaphoreRef.Database != nil {
+		return NewLockName(namespace, LockKindDatabase, string(LockTypeSemaphore), semaphoreRef.Database.Key)
+	}
 	return nil
 }
 
 func getMutexLockName(namespace string, mutex *wfv1.Mutex) *LockName {
+	if mutex.Database {
+		return NewLockName(namespace, LockKindDatabase, string(LockTypeMutex), mutex.Name)
+	}
 	return NewLockName(namespace, string(LockTypeMutex), mutex.Name, "")
 }
 
+// getDatabaseName returns the name of the database lock in the database, which is qualified by its type so that a
+// semaphore and a mutex with the same key are different locks
+func (ln *LockName) getDatabaseName() string {
+	return fmt.Sprintf("%s/%s/%s", ln.Namespace, ln.ResourceName, ln.Key)
+}
+
+func isDatabaseLock(lockKey string) bool {
+	items := strings.Split(lockKey, "/")
+	return len(items) == 4 && items[1] == LockKindDatabase
+}
+
 func getResourceKey(namespace, wfName, resourceName string) string {
 	resourceKey := fmt.Sprintf("%s/%s", namespace, wfName)
 	// Template level semaphore
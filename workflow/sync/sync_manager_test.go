@@ -542,3 +542,78 @@ func TestSemaphoreTmplLevel(t *testing.T) {
 
 	})
 }
+
+func TestMultipleLocks(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	err := yaml.Unmarshal([]byte(configMap), &cm)
+	assert.NoError(t, err)
+	_, err = kube.CoreV1().ConfigMaps("default").Create(&cm)
+	assert.NoError(t, err)
+	concurrenyMgr := NewLockManager(GetSyncLimitFunc(kube), func(key string) {})
+	semaphore := wfv1.SemaphoreRef{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "my-config"}, Key: "workflow"}}
+	createTime := time.Now()
+
+	one := unmarshalWF(wfWithSemaphore)
+	one.Name = "one"
+	one.Spec.Synchronization = &wfv1.Synchronization{Mutexes: []wfv1.Mutex{{Name: "deploy"}}}
+	two := one.DeepCopy()
+	two.Name = "two"
+	two.Spec.Synchronization = &wfv1.Synchronization{Semaphores: []wfv1.SemaphoreRef{semaphore}, Mutexes: []wfv1.Mutex{{Name: "deploy"}}}
+	three := one.DeepCopy()
+	three.Name = "three"
+	three.Spec.Synchronization = &wfv1.Synchronization{Semaphore: &semaphore}
+
+	status, _, _, err := concurrenyMgr.TryAcquire(one, "", 0, createTime, one.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, status)
+
+	// two does not hold the semaphore while it waits for the mutex
+	status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(two, "", 0, createTime.Add(time.Second), two.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, status)
+	assert.True(t, wfUpdate)
+	assert.Equal(t, "Waiting for default/mutex/deploy lock. Lock status: 0/1 ", msg)
+	assert.Empty(t, concurrenyMgr.getCurrentLockHolders("default/configmap/my-config/workflow"))
+	assert.Equal(t, []wfv1.MutexHolding{{Mutex: "default/mutex/deploy", Holder: "default/one"}}, two.Status.Synchronization.Mutex.Waiting)
+	assert.Equal(t, "default/configmap/my-config/workflow", two.Status.Synchronization.Semaphore.Waiting[0].Semaphore)
+
+	// three queues behind two, which is first in the queue of the semaphore
+	status, _, msg, err = concurrenyMgr.TryAcquire(three, "", 0, createTime.Add(2*time.Second), three.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, status)
+	assert.Equal(t, "Waiting for default/configmap/my-config/workflow lock. Lock status: 1/1 ", msg)
+
+	concurrenyMgr.Release(one, "", one.Namespace, one.Spec.Synchronization)
+	status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(two, "", 0, createTime.Add(time.Second), two.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, status)
+	assert.True(t, wfUpdate)
+	assert.Empty(t, msg)
+	assert.Equal(t, []wfv1.SemaphoreHolding{{Semaphore: "default/configmap/my-config/workflow", Holders: []string{"two"}}}, two.Status.Synchronization.Semaphore.Holding)
+	assert.Empty(t, two.Status.Synchronization.Semaphore.Waiting)
+	assert.Equal(t, []wfv1.MutexHolding{{Mutex: "default/mutex/deploy", Holder: "two"}}, two.Status.Synchronization.Mutex.Holding)
+	assert.Empty(t, two.Status.Synchronization.Mutex.Waiting)
+
+	// one waits for both the locks held by two
+	status, _, msg, err = concurrenyMgr.TryAcquire(one, "", 0, createTime.Add(3*time.Second), two.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, status)
+	assert.Equal(t, "Waiting for default/configmap/my-config/workflow lock. Lock status: 0/1; Waiting for default/mutex/deploy lock. Lock status: 0/1", msg)
+
+	concurrenyMgr.Release(two, "", two.Namespace, two.Spec.Synchronization)
+	assert.Empty(t, two.Status.Synchronization.Semaphore.Holding[0].Holders)
+	assert.Empty(t, two.Status.Synchronization.Mutex.Holding)
+	status, _, _, err = concurrenyMgr.TryAcquire(three, "", 0, createTime.Add(2*time.Second), three.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, status)
+}
+
+func TestInvalidSynchronization(t *testing.T) {
+	concurrenyMgr := NewLockManager(func(string) (int, error) { return 1, nil }, func(key string) {})
+	wf := unmarshalWF(wfWithSemaphore)
+	_, _, _, err := concurrenyMgr.TryAcquire(wf, "", 0, time.Now(), &wfv1.Synchronization{Semaphores: []wfv1.SemaphoreRef{{}}})
+	assert.EqualError(t, err, "Requested Synchronization is invalid")
+	_, _, _, err = concurrenyMgr.TryAcquire(wf, "", 0, time.Now(), &wfv1.Synchronization{})
+	assert.EqualError(t, err, "Requested Synchronization is invalid")
+}
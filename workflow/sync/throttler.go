@@ -2,9 +2,11 @@ package sync
 
 import (
 	"container/heap"
+	"fmt"
 	"sync"
 	"time"
 
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 )
 
@@ -17,23 +19,50 @@ type Throttler interface {
 	Remove(key interface{})
 	// SetParallelism update throttler parallelism limit.
 	SetParallelism(parallelism int)
+	// ParallelismChanged notifies throttler that the parallelism limit of a namespace changed. In responses the throttler triggers processing of the items no longer throttled.
+	ParallelismChanged()
 }
 
+// NamespaceParallelismFunc returns the parallelism limit of the namespace, or 0 if it has none
+type NamespaceParallelismFunc func(namespace string) int
+
+// NamespaceStatsFunc is called with the number of queued and in progress items of the namespace when they change
+type NamespaceStatsFunc func(namespace string, queued, inProgress int)
+
 type throttler struct {
-	queue       workqueue.RateLimitingInterface
-	inProgress  map[interface{}]bool
-	pending     *priorityQueue
-	lock        *sync.Mutex
-	parallelism int
+	queue                workqueue.RateLimitingInterface
+	inProgress           map[interface{}]bool
+	pending              map[string]*priorityQueue
+	inProgressByNs       map[string]int
+	lock                 *sync.Mutex
+	parallelism          int
+	namespaceParallelism NamespaceParallelismFunc
+	statsFunc            NamespaceStatsFunc
 }
 
 func NewThrottler(parallelism int, queue workqueue.RateLimitingInterface) Throttler {
+	return NewNamespaceThrottler(parallelism, nil, nil, queue)
+}
+
+// NewNamespaceThrottler returns a throttler which also limits the number of items of each namespace processed in
+// parallel. It shares the parallelism fairly between the namespaces: the next item processed is the first item of the
+// namespace with the fewest items in progress.
+func NewNamespaceThrottler(parallelism int, namespaceParallelism NamespaceParallelismFunc, statsFunc NamespaceStatsFunc, queue workqueue.RateLimitingInterface) Throttler {
+	if namespaceParallelism == nil {
+		namespaceParallelism = func(string) int { return 0 }
+	}
+	if statsFunc == nil {
+		statsFunc = func(string, int, int) {}
+	}
 	return &throttler{
-		queue:       queue,
-		inProgress:  make(map[interface{}]bool),
-		lock:        &sync.Mutex{},
-		parallelism: parallelism,
-		pending:     &priorityQueue{itemByKey: make(map[interface{}]*item)},
+		queue:                queue,
+		inProgress:           make(map[interface{}]bool),
+		pending:              make(map[string]*priorityQueue),
+		inProgressByNs:       make(map[string]int),
+		lock:                 &sync.Mutex{},
+		parallelism:          parallelism,
+		namespaceParallelism: namespaceParallelism,
+		statsFunc:            statsFunc,
 	}
 }
 
@@ -46,22 +75,36 @@ func (t *throttler) SetParallelism(parallelism int) {
 	}
 }
 
+func (t *throttler) ParallelismChanged() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.queueThrottled()
+}
+
 func (t *throttler) Add(key interface{}, priority int32, creationTime time.Time) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	t.pending.add(key, priority, creationTime)
+	if t.inProgress[key] {
+		return
+	}
+	namespace := getNamespace(key)
+	pending, ok := t.pending[namespace]
+	if !ok {
+		pending = &priorityQueue{itemByKey: make(map[interface{}]*item)}
+		t.pending[namespace] = pending
+	}
+	pending.add(key, priority, creationTime)
+	t.updateStats(namespace)
 }
 
 func (t *throttler) Next(key interface{}) (interface{}, bool) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
-	if _, isInProgress := t.inProgress[key]; isInProgress || t.pending.Len() == 0 {
+	if _, isInProgress := t.inProgress[key]; isInProgress || len(t.pending) == 0 {
 		return key, true
 	}
-	if t.parallelism < 1 || t.parallelism > len(t.inProgress) {
-		next := t.pending.pop()
-		t.inProgress[next.key] = true
+	if next := t.next(); next != nil {
 		return next.key, true
 	}
 	return key, false
@@ -71,20 +114,80 @@ func (t *throttler) Next(key interface{}) (interface{}, bool) {
 func (t *throttler) Remove(key interface{}) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	delete(t.inProgress, key)
-	t.pending.remove(key)
+	namespace := getNamespace(key)
+	if t.inProgress[key] {
+		delete(t.inProgress, key)
+		t.inProgressByNs[namespace]--
+		if t.inProgressByNs[namespace] == 0 {
+			delete(t.inProgressByNs, namespace)
+		}
+	}
+	if pending, ok := t.pending[namespace]; ok {
+		pending.remove(key)
+		if pending.Len() == 0 {
+			delete(t.pending, namespace)
+		}
+	}
+	t.updateStats(namespace)
 
 	t.queueThrottled()
 }
 
 func (t *throttler) queueThrottled() {
-	for t.pending.Len() > 0 && (t.parallelism < 1 || t.parallelism > len(t.inProgress)) {
-		next := t.pending.pop()
-		t.inProgress[next.key] = true
+	for next := t.next(); next != nil; next = t.next() {
 		t.queue.Add(next.key)
 	}
 }
 
+// next pops the next item to process, and marks it in progress, unless all the items are throttled
+func (t *throttler) next() *item {
+	if t.parallelism > 0 && t.parallelism <= len(t.inProgress) {
+		return nil
+	}
+	var next *item
+	var nextNamespace string
+	for namespace, pending := range t.pending {
+		if limit := t.namespaceParallelism(namespace); limit > 0 && limit <= t.inProgressByNs[namespace] {
+			continue
+		}
+		first := pending.peek()
+		if next == nil || t.inProgressByNs[namespace] < t.inProgressByNs[nextNamespace] ||
+			(t.inProgressByNs[namespace] == t.inProgressByNs[nextNamespace] && first.before(next)) {
+			next = first
+			nextNamespace = namespace
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	pending := t.pending[nextNamespace]
+	pending.pop()
+	if pending.Len() == 0 {
+		delete(t.pending, nextNamespace)
+	}
+	t.inProgress[next.key] = true
+	t.inProgressByNs[nextNamespace]++
+	t.updateStats(nextNamespace)
+	return next
+}
+
+func (t *throttler) updateStats(namespace string) {
+	queued := 0
+	if pending, ok := t.pending[namespace]; ok {
+		queued = pending.Len()
+	}
+	t.statsFunc(namespace, queued, t.inProgressByNs[namespace])
+}
+
+// getNamespace returns the namespace of the key of a namespaced item
+func getNamespace(key interface{}) string {
+	namespace, _, err := cache.SplitMetaNamespaceKey(fmt.Sprint(key))
+	if err != nil {
+		return ""
+	}
+	return namespace
+}
+
 type item struct {
 	key          interface{}
 	creationTime time.Time
@@ -126,10 +229,19 @@ func (pq *priorityQueue) remove(key interface{}) {
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
-	if pq.items[i].priority == pq.items[j].priority {
-		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
+	return pq.items[i].before(pq.items[j])
+}
+
+// before returns whether the item comes before the other item, by priority, then creation time
+func (i *item) before(other *item) bool {
+	if i.priority == other.priority {
+		// ties are broken by key, so that holders waiting for several locks are in the same order in all their queues
+		if i.creationTime.Equal(other.creationTime) {
+			return fmt.Sprint(i.key) < fmt.Sprint(other.key)
+		}
+		return i.creationTime.Before(other.creationTime)
 	}
-	return pq.items[i].priority > pq.items[j].priority
+	return i.priority > other.priority
 }
 
 func (pq priorityQueue) Swap(i, j int) {
@@ -84,3 +84,61 @@ func TestChangeParallelism(t *testing.T) {
 	queued, _ = queue.Get()
 	assert.Equal(t, "b", queued)
 }
+
+func TestNamespaceParallelism(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	limits := map[string]int{"team-a": 1}
+	stats := make(map[string][2]int)
+	throttler := NewNamespaceThrottler(0, func(namespace string) int { return limits[namespace] }, func(namespace string, queued, inProgress int) {
+		stats[namespace] = [2]int{queued, inProgress}
+	}, queue)
+
+	throttler.Add("team-a/a", 0, time.Now())
+	throttler.Add("team-a/b", 0, time.Now().Add(time.Hour))
+
+	next, ok := throttler.Next("team-a/a")
+	assert.True(t, ok)
+	assert.Equal(t, "team-a/a", next)
+	assert.Equal(t, [2]int{1, 1}, stats["team-a"])
+
+	_, ok = throttler.Next("team-a/b")
+	assert.False(t, ok)
+
+	limits["team-a"] = 2
+	throttler.ParallelismChanged()
+	assert.Equal(t, 1, queue.Len())
+	queued, _ := queue.Get()
+	assert.Equal(t, "team-a/b", queued)
+	assert.Equal(t, [2]int{0, 2}, stats["team-a"])
+
+	throttler.Remove("team-a/a")
+	assert.Equal(t, [2]int{0, 1}, stats["team-a"])
+}
+
+func TestNamespaceFairShare(t *testing.T) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	throttler := NewNamespaceThrottler(2, nil, nil, queue)
+
+	// team-a queues its workflows first, and with a higher priority
+	throttler.Add("team-a/a", 1, time.Now())
+	throttler.Add("team-a/b", 1, time.Now())
+	throttler.Add("team-a/c", 1, time.Now())
+	throttler.Add("team-b/d", 0, time.Now().Add(time.Hour))
+
+	next, ok := throttler.Next("team-a/a")
+	assert.True(t, ok)
+	assert.Equal(t, "team-a/a", next)
+
+	// team-b has no workflow in progress, so it goes next
+	next, ok = throttler.Next("team-a/b")
+	assert.True(t, ok)
+	assert.Equal(t, "team-b/d", next)
+
+	_, ok = throttler.Next("team-a/b")
+	assert.False(t, ok)
+
+	throttler.Remove("team-b/d")
+	assert.Equal(t, 1, queue.Len())
+	queued, _ := queue.Get()
+	assert.Equal(t, "team-a/b", queued)
+}
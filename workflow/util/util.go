@@ -57,7 +57,7 @@ import (
 // objects. We no longer return WorkflowInformer due to:
 // https://github.com/kubernetes/kubernetes/issues/57705
 // https://github.com/argoproj/argo/issues/632
-func NewWorkflowInformer(cfg *rest.Config, ns string, resyncPeriod time.Duration, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+func NewWorkflowInformer(cfg *rest.Config, ns string, resyncPeriod time.Duration, tweakListOptions internalinterfaces.TweakListOptionsFunc, indexers cache.Indexers) cache.SharedIndexInformer {
 	dclient, err := dynamic.NewForConfig(cfg)
 	if err != nil {
 		panic(err)
@@ -73,7 +73,7 @@ func NewWorkflowInformer(cfg *rest.Config, ns string, resyncPeriod time.Duration
 		dclient,
 		ns,
 		resyncPeriod,
-		cache.Indexers{},
+		indexers,
 		tweakListOptions,
 	)
 	return informer
@@ -667,8 +667,49 @@ func RetryWorkflow(kubeClient kubernetes.Interface, hydrator hydrator.Interface,
 		return nil, err
 	}
 
+	newWF, podsToDelete, err := FormulateRetryWorkflow(wf, restartSuccessful, nodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	err = DeletePods(kubeClient, wf.ObjectMeta.Namespace, podsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hydrator.Dehydrate(newWF)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+	}
+
+	return wfClient.Update(newWF)
+}
+
+// DeletePods deletes the pods of the nodes a retry resets, ignoring the pods which no longer exist
+func DeletePods(kubeClient kubernetes.Interface, namespace string, podNames []string) error {
+	podIf := kubeClient.CoreV1().Pods(namespace)
+	for _, podName := range podNames {
+		log.Infof("Deleting pod: %s", podName)
+		err := podIf.Delete(podName, &metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return errors.InternalWrapError(err)
+		}
+	}
+	return nil
+}
+
+// FormulateRetryWorkflow formulates a retry of a Failed/Error workflow, whose nodes must be hydrated. Its failed
+// steps and onExit node (and children) are reset. The pods of the reset nodes, which must be deleted before the
+// workflow is retried, are returned too.
+func FormulateRetryWorkflow(wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, []string, error) {
+	switch wf.Status.Phase {
+	case wfv1.NodeFailed, wfv1.NodeError:
+	default:
+		return nil, nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
+	}
+
 	newWF := wf.DeepCopy()
-	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
+	var podsToDelete []string
 
 	// Delete/reset fields which indicate workflow completed
 	delete(newWF.Labels, common.LabelKeyCompleted)
@@ -688,7 +729,7 @@ func RetryWorkflow(kubeClient kubernetes.Interface, hydrator hydrator.Interface,
 	// Get all children of nodes that match filter
 	nodeIDsToReset, err := getNodeIDsToReset(restartSuccessful, nodeFieldSelector, wf.Status.Nodes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Iterate the previous nodes. If it was successful Pod carry it forward
@@ -716,14 +757,10 @@ func RetryWorkflow(kubeClient kubernetes.Interface, hydrator hydrator.Interface,
 			// do not add this status to the node. pretend as if this node never existed.
 		default:
 			// Do not allow retry of workflows with pods in Running/Pending phase
-			return nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
+			return nil, nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
 		}
 		if node.Type == wfv1.NodeTypePod {
-			log.Infof("Deleting pod: %s", node.ID)
-			err := podIf.Delete(node.ID, &metav1.DeleteOptions{})
-			if err != nil && !apierr.IsNotFound(err) {
-				return nil, errors.InternalWrapError(err)
-			}
+			podsToDelete = append(podsToDelete, node.ID)
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -734,17 +771,12 @@ func RetryWorkflow(kubeClient kubernetes.Interface, hydrator hydrator.Interface,
 		}
 	}
 
-	err = hydrator.Dehydrate(newWF)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
-	}
-
 	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
 	for id, tmpl := range wf.Status.StoredTemplates {
 		newWF.Status.StoredTemplates[id] = tmpl
 	}
 
-	return wfClient.Update(newWF)
+	return newWF, podsToDelete, nil
 }
 
 func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes wfv1.Nodes) (map[string]bool, error) {
@@ -979,7 +1011,7 @@ func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 		return wfv1.NodeTypeRetry
 	}
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource, wfv1.TemplateTypeContainerSet:
 		return wfv1.NodeTypePod
 	case wfv1.TemplateTypeDAG:
 		return wfv1.NodeTypeDAG
@@ -987,6 +1019,10 @@ func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 		return wfv1.NodeTypeSteps
 	case wfv1.TemplateTypeSuspend:
 		return wfv1.NodeTypeSuspend
+	case wfv1.TemplateTypeHTTP:
+		return wfv1.NodeTypeHTTP
+	case wfv1.TemplateTypeWorkflow:
+		return wfv1.NodeTypeWorkflow
 	}
 	return ""
 }
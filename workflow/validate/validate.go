@@ -4,13 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/antonmedv/expr"
 	"github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -177,6 +178,9 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if wf.Spec.Priority != nil {
 		ctx.globalParams[common.GlobalVarWorkflowPriority] = strconv.Itoa(int(*wf.Spec.Priority))
 	}
+	if _, ok := wf.ObjectMeta.Annotations[common.AnnotationKeyCronWfScheduledTime]; ok {
+		ctx.globalParams[common.GlobalVarWorkflowScheduledTime] = placeholderGenerator.NextPlaceholder()
+	}
 
 	if !opts.IgnoreEntrypoint && entrypoint == "" {
 		return nil, errors.New(errors.CodeBadRequest, "spec.entrypoint is required")
@@ -234,6 +238,10 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 			return nil, err
 		}
 	}
+	err = ctx.validateLifecycleHooks(wf.Spec.Hooks, tmplCtx)
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "spec.%s", err.Error())
+	}
 
 	if wf.Spec.PodGC != nil {
 		switch wf.Spec.PodGC.Strategy {
@@ -243,6 +251,16 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		}
 	}
 
+	err = validateDependsOn(wf.Spec.DependsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateSynchronization("spec.synchronization", wf.Spec.Synchronization)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
 		_, err := ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: template.Name}, tmplCtx, &FakeArguments{}, map[string]interface{}{})
@@ -278,7 +296,7 @@ func ValidateClusterWorkflowTemplate(wftmplGetter templateresolution.WorkflowTem
 
 // ValidateCronWorkflow validates a CronWorkflow
 func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, cronWf *wfv1.CronWorkflow) error {
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
+	if _, err := cronWf.GetSchedule(); err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
 	}
 
@@ -293,7 +311,7 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return errors.Errorf(errors.CodeBadRequest, "startingDeadlineSeconds must be positive")
 	}
 
-	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
+	wf := common.ConvertCronWorkflowToWorkflowWithScheduledTime(cronWf, time.Now())
 
 	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
 	if err != nil {
@@ -427,6 +445,16 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 			return err
 		}
 	}
+	err = validateSynchronization(fmt.Sprintf("templates.%s.synchronization", newTmpl.Name), newTmpl.Synchronization)
+	if err != nil {
+		return err
+	}
+	if newTmpl.Memoize != nil {
+		err = validateMemoize(newTmpl)
+		if err != nil {
+			return err
+		}
+	}
 	if newTmpl.Metrics != nil {
 		for _, metric := range newTmpl.Metrics.Prometheus {
 			if !metrics.IsValidMetricName(metric.Name) {
@@ -494,6 +522,14 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if resolvedTmpl.RetryStrategy.Expression != "" {
+			if _, err := expr.Compile(resolvedTmpl.RetryStrategy.Expression); err != nil {
+				return nil, fmt.Errorf("templates.%s.retryStrategy.expression is invalid: %s", resolvedTmpl.Name, err)
+			}
+		}
+		if affinity := resolvedTmpl.RetryStrategy.Affinity; affinity != nil && affinity.NodeAntiAffinity != nil && !resolvedTmpl.IsPodType() {
+			return nil, fmt.Errorf("templates.%s.retryStrategy.affinity.nodeAntiAffinity is only supported by templates which run pods", resolvedTmpl.Name)
+		}
 	}
 
 	return resolvedTmpl, ctx.validateTemplate(resolvedTmpl, tmplCtx, args, extraScope)
@@ -502,7 +538,7 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend} {
+	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.HTTP, tmpl.ContainerSet, tmpl.Workflow} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -512,11 +548,11 @@ func validateTemplateType(tmpl *wfv1.Template) error {
 	}
 	switch numTypes {
 	case 0:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, http, containerSet, workflow, template, template ref", tmpl.Name)
 	case 1:
 		// Do nothing
 	default:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, http, containerSet, workflow, template, template ref", tmpl.Name)
 	}
 	return nil
 }
@@ -557,6 +593,9 @@ func validateInputs(tmpl *wfv1.Template, extraScope map[string]interface{}) (map
 		if art.From != "" {
 			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.from not valid in inputs", tmpl.Name, artRef)
 		}
+		if art.FromExpression != "" {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.fromExpression not valid in inputs", tmpl.Name, artRef)
+		}
 		errPrefix := fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef)
 		err = validateArtifactLocation(errPrefix, art.ArtifactLocation)
 		if err != nil {
@@ -595,6 +634,14 @@ func resolveAllVariables(scope map[string]interface{}, tmplStr string) error {
 
 	fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
 
+		// Expressions are only evaluated at runtime, so we just check they compile
+		if common.IsExpressionTag(tag) {
+			if unresolvedErr == nil {
+				unresolvedErr = validateExpressionTag(tag)
+			}
+			return 0, nil
+		}
+
 		// Skip the custom variable references
 		if !checkValidWorkflowVariablePrefix(tag) {
 			return 0, nil
@@ -620,6 +667,18 @@ func resolveAllVariables(scope map[string]interface{}, tmplStr string) error {
 	return unresolvedErr
 }
 
+// validateExpressionTag checks that the expression of a {{=expression}} tag compiles
+func validateExpressionTag(tag string) error {
+	expression, err := common.UnmarshalExpressionTag(tag)
+	if err != nil {
+		return err
+	}
+	if _, err := expr.Compile(expression); err != nil {
+		return fmt.Errorf("invalid expression {{%s}}: %v", tag, err)
+	}
+	return nil
+}
+
 // checkValidWorkflowVariablePrefix is a helper methood check variable starts workflow root elements
 func checkValidWorkflowVariablePrefix(tag string) bool {
 	for _, rootTag := range common.GlobalVarValidWorkflowVariablePrefix {
@@ -688,6 +747,21 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	if tmpl.HTTP != nil {
+		if err := validateHTTP(tmpl); err != nil {
+			return err
+		}
+	}
+	if tmpl.ContainerSet != nil {
+		if err := validateContainerSet(tmpl); err != nil {
+			return err
+		}
+	}
+	if tmpl.Workflow != nil {
+		if err := validateChildWorkflow(tmpl); err != nil {
+			return err
+		}
+	}
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
@@ -717,6 +791,220 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+// validateLifecycleHooks validates that the expressions of the hooks compile and that their templates can be resolved
+func (ctx *templateValidationCtx) validateLifecycleHooks(hooks wfv1.LifecycleHooks, tmplCtx *templateresolution.Context) error {
+	for name, hook := range hooks {
+		if errs := isValidWorkflowFieldName(name); len(errs) != 0 {
+			return fmt.Errorf("hooks.%s name is invalid: %s", name, strings.Join(errs, ";"))
+		}
+		if hook.Expression == "" {
+			return fmt.Errorf("hooks.%s.expression is required", name)
+		}
+		if _, err := expr.Compile(hook.Expression); err != nil {
+			return fmt.Errorf("hooks.%s.expression '%s' is invalid: %s", name, hook.Expression, err)
+		}
+		if hook.Template == "" && hook.TemplateRef == nil {
+			return fmt.Errorf("hooks.%s.template or hooks.%s.templateRef is required", name, name)
+		}
+		_, err := ctx.validateTemplateHolder(&hook, tmplCtx, &FakeArguments{}, map[string]interface{}{})
+		if err != nil {
+			return fmt.Errorf("hooks.%s %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateChildWorkflow(tmpl *wfv1.Template) error {
+	if tmpl.Workflow.WorkflowTemplateRef == nil || tmpl.Workflow.WorkflowTemplateRef.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.workflow.workflowTemplateRef.name is required", tmpl.Name)
+	}
+	return validateArgumentsValues(fmt.Sprintf("templates.%s.workflow.arguments.", tmpl.Name), tmpl.Workflow.Arguments)
+}
+
+func validateHTTP(tmpl *wfv1.Template) error {
+	if tmpl.HTTP.URL == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.url may not be empty", tmpl.Name)
+	}
+	if !placeholderGenerator.IsPlaceholder(tmpl.HTTP.Method) {
+		switch tmpl.HTTP.Method {
+		case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+			// OK
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS", tmpl.Name)
+		}
+	}
+	for i, header := range tmpl.HTTP.Headers {
+		if header.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].name may not be empty", tmpl.Name, i)
+		}
+		if header.ValueFrom != nil {
+			if header.Value != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d] only one of value or valueFrom may be specified", tmpl.Name, i)
+			}
+			if header.ValueFrom.SecretKeyRef == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].valueFrom.secretKeyRef must be specified", tmpl.Name, i)
+			}
+		}
+	}
+	if tmpl.HTTP.TimeoutSeconds != nil && *tmpl.HTTP.TimeoutSeconds <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.timeoutSeconds must be a positive integer > 0", tmpl.Name)
+	}
+	if tmpl.HTTP.SuccessCondition != "" {
+		if _, err := expr.Compile(tmpl.HTTP.SuccessCondition); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.successCondition is invalid: %s", tmpl.Name, err)
+		}
+	}
+	if len(tmpl.Inputs.Artifacts) > 0 || len(tmpl.Outputs.Artifacts) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s artifacts are not supported by http templates", tmpl.Name)
+	}
+	return nil
+}
+
+// validateMemoize validates that the memoization cache is of exactly one type and that its max age is a duration
+func validateMemoize(tmpl *wfv1.Template) error {
+	cache := tmpl.Memoize.Cache
+	if cache == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must be specified", tmpl.Name)
+	}
+	n := 0
+	if cache.ConfigMap != nil {
+		n++
+	}
+	if cache.SQL != nil {
+		n++
+	}
+	if cache.Artifact != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must specify exactly one of configMap, sql or artifact", tmpl.Name)
+	}
+	if !placeholderGenerator.IsPlaceholder(tmpl.Memoize.MaxAge) {
+		if _, err := tmpl.Memoize.GetMaxAge(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.maxAge is invalid: %s", tmpl.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateContainerSet validates the containers of a container set, their dependencies, and that its artifacts and
+// output parameters are in the volumes shared by the containers, as there is no main container to copy them from
+func validateContainerSet(tmpl *wfv1.Template) error {
+	if len(tmpl.ContainerSet.Containers) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have at least one container", tmpl.Name)
+	}
+	names := make(map[string]bool)
+	for i, ctr := range tmpl.ContainerSet.Containers {
+		prefix := fmt.Sprintf("templates.%s.containerSet.containers[%d]", tmpl.Name, i)
+		if errs := isValidWorkflowFieldName(ctr.Name); len(errs) != 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name '%s' is invalid: %s", prefix, ctr.Name, strings.Join(errs, ";"))
+		}
+		switch ctr.Name {
+		case common.InitContainerName, common.WaitContainerName:
+			return errors.Errorf(errors.CodeBadRequest, "%s.name '%s' is reserved", prefix, ctr.Name)
+		}
+		if names[ctr.Name] {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name '%s' is not unique", prefix, ctr.Name)
+		}
+		names[ctr.Name] = true
+		if ctr.Image == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.image may not be empty", prefix)
+		}
+		if len(ctr.Command) == 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%s.command may not be empty", prefix)
+		}
+	}
+	for i, ctr := range tmpl.ContainerSet.Containers {
+		for _, dep := range ctr.Dependencies {
+			if !names[dep] {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].dependencies '%s' is not a container of the set", tmpl.Name, i, dep)
+			}
+		}
+	}
+	if err := verifyNoContainerSetCycles(tmpl); err != nil {
+		return err
+	}
+	for _, art := range tmpl.Inputs.Artifacts {
+		if common.FindOverlappingVolume(tmpl, art.Path) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.artifacts.%s.path must be in one of containerSet.volumeMounts", tmpl.Name, art.Name)
+		}
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		if common.FindOverlappingVolume(tmpl, art.Path) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts.%s.path must be in one of containerSet.volumeMounts", tmpl.Name, art.Name)
+		}
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.Path != "" && common.FindOverlappingVolume(tmpl, param.ValueFrom.Path) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters.%s.valueFrom.path must be in one of containerSet.volumeMounts", tmpl.Name, param.Name)
+		}
+	}
+	// the artifacts of the containers are archived alongside the artifacts of the template, under their names
+	artNames := make(map[string]bool)
+	for _, art := range tmpl.Outputs.Artifacts {
+		artNames[art.Name] = true
+	}
+	for i, ctr := range tmpl.ContainerSet.Containers {
+		prefix := fmt.Sprintf("templates.%s.containerSet.containers[%d].outputs", tmpl.Name, i)
+		if err := validateWorkflowFieldNames(ctr.Outputs.Parameters); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.parameters %s", prefix, err.Error())
+		}
+		if err := validateWorkflowFieldNames(ctr.Outputs.Artifacts); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.artifacts %s", prefix, err.Error())
+		}
+		for _, art := range ctr.Outputs.Artifacts {
+			if art.Path == "" || common.FindOverlappingVolume(tmpl, art.Path) == nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s.artifacts.%s.path must be in one of containerSet.volumeMounts", prefix, art.Name)
+			}
+			if artNames[art.Name] {
+				return errors.Errorf(errors.CodeBadRequest, "%s.artifacts.%s is not unique amongst the artifacts of the template and its containers", prefix, art.Name)
+			}
+			artNames[art.Name] = true
+		}
+		for _, param := range ctr.Outputs.Parameters {
+			if param.ValueFrom == nil || param.ValueFrom.Path == "" || common.FindOverlappingVolume(tmpl, param.ValueFrom.Path) == nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s.parameters.%s.valueFrom.path must be in one of containerSet.volumeMounts", prefix, param.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// verifyNoContainerSetCycles verifies there are no cycles in the dependencies of the containers of a container set
+func verifyNoContainerSetCycles(tmpl *wfv1.Template) error {
+	visited := make(map[string]bool)
+	var noCyclesHelper func(ctrName string, cycle []string) error
+	noCyclesHelper = func(ctrName string, cycle []string) error {
+		if visited[ctrName] {
+			return nil
+		}
+		for _, depName := range tmpl.ContainerSet.GetContainer(ctrName).Dependencies {
+			for _, name := range cycle {
+				if name == depName {
+					return errors.Errorf(errors.CodeBadRequest,
+						"templates.%s.containerSet.containers dependency cycle detected: %s->%s",
+						tmpl.Name, strings.Join(cycle, "->"), name)
+				}
+			}
+			cycle = append(cycle, depName)
+			err := noCyclesHelper(depName, cycle)
+			if err != nil {
+				return err
+			}
+			cycle = cycle[0 : len(cycle)-1]
+		}
+		visited[ctrName] = true
+		return nil
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		err := noCyclesHelper(ctr.Name, []string{ctr.Name})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -788,6 +1076,10 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			err = ctx.validateLifecycleHooks(step.Hooks, tmplCtx)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s.%s", tmpl.Name, i, step.Name, err.Error())
+			}
 			resolvedTemplates[step.Name] = resolvedTmpl
 		}
 
@@ -869,6 +1161,9 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		scope[fmt.Sprintf("%s.exitCode", prefix)] = true
 	}
+	if tmpl.HTTP != nil {
+		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
+	}
 	for _, param := range tmpl.Outputs.Parameters {
 		scope[fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)] = true
 		if param.GlobalName != "" {
@@ -902,6 +1197,8 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 		case wfv1.TemplateTypeScript:
 			scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 			scope[fmt.Sprintf("%s.exitCode", prefix)] = true
+		case wfv1.TemplateTypeHTTP:
+			scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		default:
 			scope[fmt.Sprintf("%s.outputs.parameters", prefix)] = true
 		}
@@ -940,6 +1237,19 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path only valid in container/script templates", tmpl.Name, artRef)
 			}
 		}
+		if art.FromExpression != "" {
+			switch tmpl.GetType() {
+			case wfv1.TemplateTypeDAG, wfv1.TemplateTypeSteps:
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.fromExpression only valid in steps/dag templates", tmpl.Name, artRef)
+			}
+			if art.From != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s has both from and fromExpression specified. Choose one.", tmpl.Name, artRef)
+			}
+			if _, err := expr.Compile(art.FromExpression); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.fromExpression is invalid: %v", tmpl.Name, artRef, err)
+			}
+		}
 		if art.GlobalName != "" && !isParameter(art.GlobalName) {
 			errs := isValidParamOrArtifactName(art.GlobalName)
 			if len(errs) > 0 {
@@ -949,6 +1259,13 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
+		// the outputs of a workflow template are the outputs of its child workflow, so they only need to be named
+		if tmpl.GetType() == wfv1.TemplateTypeWorkflow {
+			if param.ValueFrom != nil || param.Value != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s must not have valueFrom or value specified for %s templates", paramRef, wfv1.TemplateTypeWorkflow)
+			}
+			continue
+		}
 		err = validateOutputParameter(paramRef, &param)
 		if err != nil {
 			return err
@@ -956,7 +1273,7 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 		if param.ValueFrom != nil {
 			tmplType := tmpl.GetType()
 			switch tmplType {
-			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 				if param.ValueFrom.Path == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
 				}
@@ -965,9 +1282,14 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 					return errors.Errorf(errors.CodeBadRequest, "%s .jqFilter or jsonPath must be specified for %s templates", paramRef, tmplType)
 				}
 			case wfv1.TemplateTypeDAG, wfv1.TemplateTypeSteps:
-				if param.ValueFrom.Parameter == "" {
+				if param.ValueFrom.Parameter == "" && param.ValueFrom.Expression == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.parameter must be specified for %s templates", paramRef, tmplType)
 				}
+				if param.ValueFrom.Expression != "" {
+					if _, err := expr.Compile(param.ValueFrom.Expression); err != nil {
+						return errors.Errorf(errors.CodeBadRequest, "%s.expression is invalid: %v", paramRef, err)
+					}
+				}
 			}
 		}
 		if param.GlobalName != "" && !isParameter(param.GlobalName) {
@@ -987,8 +1309,8 @@ func (ctx *templateValidationCtx) validateBaseImageOutputs(tmpl *wfv1.Template)
 		return nil
 	}
 	switch ctx.ContainerRuntimeExecutor {
-	case "", common.ContainerRuntimeExecutorDocker:
-		// docker executor supports all modes of artifact outputs
+	case "", common.ContainerRuntimeExecutorDocker, common.ContainerRuntimeExecutorEmissary:
+		// docker and emissary executors support all modes of artifact outputs
 	case common.ContainerRuntimeExecutorPNS:
 		// pns supports copying from the base image, but only if there is no volume mount underneath it
 		errMsg := "pns executor does not support outputs from base image layer with volume mounts. must use emptyDir"
@@ -1046,7 +1368,7 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 		return errors.Errorf(errors.CodeBadRequest, "%s does not have valueFrom or value specified", paramRef)
 	}
 	paramTypes := 0
-	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter} {
+	for _, value := range []string{param.ValueFrom.Path, param.ValueFrom.JQFilter, param.ValueFrom.JSONPath, param.ValueFrom.Parameter, param.ValueFrom.Expression} {
 		if value != "" {
 			paramTypes++
 		}
@@ -1056,10 +1378,10 @@ func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	}
 	switch paramTypes {
 	case 0:
-		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, raw")
+		return errors.New(errors.CodeBadRequest, "valueFrom type unspecified. choose one of: path, jqFilter, jsonPath, parameter, expression, raw")
 	case 1:
 	default:
-		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, raw")
+		return errors.New(errors.CodeBadRequest, "multiple valueFrom types specified. choose one of: path, jqFilter, jsonPath, parameter, expression, raw")
 	}
 	return nil
 }
@@ -1184,6 +1506,10 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		err = ctx.validateLifecycleHooks(task.Hooks, tmplCtx)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s.%s", tmpl.Name, task.Name, err.Error())
+		}
 
 		resolvedTemplates[task.Name] = resolvedTmpl
 
@@ -1352,3 +1678,96 @@ func isValidWorkflowFieldName(name string) []string {
 func getTemplateID(tmpl *wfv1.Template) string {
 	return fmt.Sprintf("%s %v", tmpl.Name, tmpl.TemplateRef)
 }
+
+// validateDependsOn validates the workflows a workflow depends on
+func validateDependsOn(dependsOn *wfv1.WorkflowDependencies) error {
+	if dependsOn == nil {
+		return nil
+	}
+	if len(dependsOn.Workflows) == 0 {
+		return errors.New(errors.CodeBadRequest, "spec.dependsOn.workflows must not be empty")
+	}
+	if dependsOn.TimeoutSeconds != nil && *dependsOn.TimeoutSeconds <= 0 {
+		return errors.New(errors.CodeBadRequest, "spec.dependsOn.timeoutSeconds must be greater than zero")
+	}
+	for i, dependency := range dependsOn.Workflows {
+		if (dependency.Name == "") == (dependency.Selector == nil) {
+			return errors.Errorf(errors.CodeBadRequest, "spec.dependsOn.workflows[%d] must have exactly one of name or selector", i)
+		}
+		if dependency.Selector != nil {
+			if _, err := v1.LabelSelectorAsSelector(dependency.Selector); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "spec.dependsOn.workflows[%d].selector is invalid: %s", i, err)
+			}
+		}
+		switch dependency.GetPhase() {
+		case wfv1.NodeRunning, wfv1.NodeSucceeded, wfv1.NodeFailed, wfv1.NodeError:
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "spec.dependsOn.workflows[%d].phase must be one of Running, Succeeded, Failed or Error", i)
+		}
+	}
+	return nil
+}
+
+// validateSynchronization validates the semaphores and mutexes of a workflow or template
+func validateSynchronization(errPrefix string, synchronization *wfv1.Synchronization) error {
+	if synchronization == nil {
+		return nil
+	}
+	semaphorePaths := make([]string, len(synchronization.Semaphores))
+	for i := range synchronization.Semaphores {
+		semaphorePaths[i] = fmt.Sprintf("%s.semaphores[%d]", errPrefix, i)
+	}
+	if synchronization.Semaphore != nil {
+		semaphorePaths = append([]string{errPrefix + ".semaphore"}, semaphorePaths...)
+	}
+	mutexPaths := make([]string, len(synchronization.Mutexes))
+	for i := range synchronization.Mutexes {
+		mutexPaths[i] = fmt.Sprintf("%s.mutexes[%d]", errPrefix, i)
+	}
+	if synchronization.Mutex != nil {
+		mutexPaths = append([]string{errPrefix + ".mutex"}, mutexPaths...)
+	}
+	semaphores := synchronization.GetSemaphores()
+	mutexes := synchronization.GetMutexes()
+	if len(semaphores) == 0 && len(mutexes) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s must have at least one semaphore or mutex", errPrefix)
+	}
+	locks := make(map[string]bool)
+	for i, semaphore := range semaphores {
+		path := semaphorePaths[i]
+		var lock string
+		if (semaphore.ConfigMapKeyRef == nil) == (semaphore.Database == nil) {
+			return errors.Errorf(errors.CodeBadRequest, "%s must have exactly one of configMapKeyRef or database", path)
+		}
+		if semaphore.ConfigMapKeyRef != nil {
+			if semaphore.ConfigMapKeyRef.Name == "" || semaphore.ConfigMapKeyRef.Key == "" {
+				return errors.Errorf(errors.CodeBadRequest, "%s.configMapKeyRef must have a name and a key", path)
+			}
+			lock = fmt.Sprintf("semaphore %s/%s", semaphore.ConfigMapKeyRef.Name, semaphore.ConfigMapKeyRef.Key)
+		} else {
+			if semaphore.Database.Key == "" || strings.Contains(semaphore.Database.Key, "/") {
+				return errors.Errorf(errors.CodeBadRequest, "%s.database.key must be non-empty and must not contain '/'", path)
+			}
+			lock = fmt.Sprintf("database semaphore %s", semaphore.Database.Key)
+		}
+		if locks[lock] {
+			return errors.Errorf(errors.CodeBadRequest, "%s: %s is already used", path, lock)
+		}
+		locks[lock] = true
+	}
+	for i, mutex := range mutexes {
+		path := mutexPaths[i]
+		if mutex.Name == "" || strings.Contains(mutex.Name, "/") {
+			return errors.Errorf(errors.CodeBadRequest, "%s.name must be non-empty and must not contain '/'", path)
+		}
+		lock := fmt.Sprintf("mutex %s", mutex.Name)
+		if mutex.Database {
+			lock = fmt.Sprintf("database mutex %s", mutex.Name)
+		}
+		if locks[lock] {
+			return errors.Errorf(errors.CodeBadRequest, "%s: %s is already used", path, lock)
+		}
+		locks[lock] = true
+	}
+	return nil
+}
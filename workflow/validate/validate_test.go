@@ -1,11 +1,13 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -885,6 +887,61 @@ func TestInvalidOutputParam(t *testing.T) {
 	}
 }
 
+var expressions = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: expressions-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: n
+      value: "2"
+  templates:
+  - name: main
+    steps:
+    - - name: double
+        template: whalesay
+        arguments:
+          parameters:
+          - name: message
+            value: "{{=asInt(workflow.parameters.n) * 2}}"
+    outputs:
+      parameters:
+      - name: result
+        valueFrom:
+          expression: "upper(steps.double.outputs.result)"
+      artifacts:
+      - name: out
+        fromExpression: "steps.double.status == 'Succeeded' ? steps.double.outputs.artifacts.out : steps.double.outputs.artifacts.other"
+  - name: whalesay
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      args: ["{{=\"cowsay \" + inputs.parameters.message}}"]
+`
+
+func TestExpressions(t *testing.T) {
+	_, err := validate(expressions)
+	assert.NoError(t, err)
+
+	_, err = validate(strings.Replace(expressions, "{{=asInt(workflow.parameters.n) * 2}}", "{{=asInt(workflow.parameters.n) *}}", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid expression")
+	}
+	_, err = validate(strings.Replace(expressions, "upper(steps.double.outputs.result)", "upper(", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.outputs.parameters.result.expression is invalid")
+	}
+	_, err = validate(strings.Replace(expressions, "steps.double.status == 'Succeeded' ?", "steps.double.status ==", 1))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.outputs.artifacts.out.fromExpression is invalid")
+	}
+}
+
 var multipleTemplateTypes = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -914,6 +971,334 @@ func TestMultipleTemplateTypes(t *testing.T) {
 	}
 }
 
+var childWorkflowTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.child.outputs.parameters.result}}"
+  - name: child
+    workflow:
+      workflowTemplateRef:
+        name: my-wftmpl
+      arguments:
+        parameters:
+        - name: message
+          value: hello
+    outputs:
+      parameters:
+      - name: result
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay:latest
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestChildWorkflowTemplate(t *testing.T) {
+	_, err := validate(childWorkflowTemplate)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(childWorkflowTemplate)
+	wf.Spec.Templates[1].Workflow.WorkflowTemplateRef = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "workflow.workflowTemplateRef.name is required")
+	}
+
+	wf = unmarshalWf(childWorkflowTemplate)
+	wf.Spec.Templates[1].Workflow.Arguments.Parameters[0].Value = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.child.workflow.arguments.message.value is required")
+	}
+
+	wf = unmarshalWf(childWorkflowTemplate)
+	wf.Spec.Templates[1].Outputs.Parameters[0].ValueFrom = &wfv1.ValueFrom{Path: "/tmp/result"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "must not have valueFrom or value specified for Workflow templates")
+	}
+}
+
+var httpTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-template-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: get
+        template: http
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.get.outputs.result}}"
+  - name: http
+    http:
+      url: https://example.com
+      successCondition: response.statusCode == 200
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:latest
+      args: ["echo", "{{inputs.parameters.message}}"]
+`
+
+func TestHTTPTemplate(t *testing.T) {
+	_, err := validate(httpTemplate)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(httpTemplate)
+	wf.Spec.Templates[1].HTTP.Method = "FETCH"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "http.method must be one of")
+	}
+
+	wf = unmarshalWf(httpTemplate)
+	wf.Spec.Templates[1].HTTP.URL = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "http.url may not be empty")
+	}
+
+	wf = unmarshalWf(httpTemplate)
+	wf.Spec.Templates[1].HTTP.SuccessCondition = "response.statusCode =="
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "http.successCondition is invalid")
+	}
+
+	wf = unmarshalWf(httpTemplate)
+	wf.Spec.Templates[1].HTTP.Headers = []wfv1.HTTPHeader{{Name: "Authorization", ValueFrom: &wfv1.HTTPHeaderSource{}}}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "valueFrom.secretKeyRef must be specified")
+	}
+}
+
+var memoizedTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    memoize:
+      key: hello
+      maxAge: 1h
+      cache:
+        sql:
+          name: whalesay-cache
+    container:
+      image: docker/whalesay:latest
+      command: [cowsay, hello]
+`
+
+func TestMemoize(t *testing.T) {
+	_, err := validate(memoizedTemplate)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(memoizedTemplate)
+	wf.Spec.Templates[0].Memoize.MaxAge = "1 hour"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "memoize.maxAge is invalid")
+	}
+
+	wf = unmarshalWf(memoizedTemplate)
+	wf.Spec.Templates[0].Memoize.Cache.Artifact = &wfv1.ArtifactCache{Name: "whalesay-cache"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "must specify exactly one of configMap, sql or artifact")
+	}
+
+	wf = unmarshalWf(memoizedTemplate)
+	wf.Spec.Templates[0].Memoize.Cache = &wfv1.Cache{}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "must specify exactly one of configMap, sql or artifact")
+	}
+}
+
+var lifecycleHooks = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: lifecycle-hooks-
+spec:
+  entrypoint: main
+  hooks:
+    failed:
+      expression: workflow.status == "Failed"
+      template: notify
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        template: whalesay
+        hooks:
+          running:
+            expression: steps.hello.status == "Running"
+            template: notify
+  - name: whalesay
+    container:
+      image: docker/whalesay
+  - name: notify
+    container:
+      image: docker/whalesay
+`
+
+func TestLifecycleHooks(t *testing.T) {
+	_, err := validate(lifecycleHooks)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(lifecycleHooks)
+	hook := wf.Spec.Hooks["failed"]
+	hook.Expression = `workflow.status ==`
+	wf.Spec.Hooks["failed"] = hook
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "spec.hooks.failed.expression")
+	}
+
+	wf = unmarshalWf(lifecycleHooks)
+	hook = wf.Spec.Templates[0].Steps[0].Steps[0].Hooks["running"]
+	hook.Template = "missing"
+	wf.Spec.Templates[0].Steps[0].Steps[0].Hooks["running"] = hook
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "template name 'missing' undefined")
+	}
+}
+
+var containerSet = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: container-set-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    volumes:
+    - name: workspace
+      emptyDir: {}
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: a
+        image: argoproj/argosay:v2
+        command: [sh, -c]
+        args: ["echo hello > /workspace/message"]
+      - name: b
+        image: argoproj/argosay:v2
+        command: [cat, /workspace/message]
+        dependencies: [a]
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /workspace/message
+`
+
+func TestContainerSet(t *testing.T) {
+	_, err := validate(containerSet)
+	assert.NoError(t, err)
+
+	t.Run("NoContainers", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers must have at least one container")
+	})
+	t.Run("ReservedName", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[0].Name = "wait"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[0].name 'wait' is reserved")
+	})
+	t.Run("DuplicateName", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[1].Name = "a"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[1].name 'a' is not unique")
+	})
+	t.Run("NoCommand", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[1].Command = nil
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[1].command may not be empty")
+	})
+	t.Run("MissingDependency", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[1].Dependencies = []string{"c"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[1].dependencies 'c' is not a container of the set")
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[0].Dependencies = []string{"b"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers dependency cycle detected: a->b->a")
+	})
+	t.Run("OutputNotInVolume", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].Outputs.Parameters[0].ValueFrom.Path = "/tmp/message"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.outputs.parameters.message.valueFrom.path must be in one of containerSet.volumeMounts")
+	})
+	t.Run("ContainerOutputs", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[0].Outputs = wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{Name: "message", ValueFrom: &wfv1.ValueFrom{Path: "/workspace/message"}}},
+			Artifacts:  []wfv1.Artifact{{Name: "message", Path: "/workspace/message"}},
+		}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+	t.Run("ContainerOutputNotInVolume", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		wf.Spec.Templates[0].ContainerSet.Containers[0].Outputs.Parameters = []wfv1.Parameter{{Name: "message", ValueFrom: &wfv1.ValueFrom{Path: "/tmp/message"}}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[0].outputs.parameters.message.valueFrom.path must be in one of containerSet.volumeMounts")
+	})
+	t.Run("DuplicateContainerArtifact", func(t *testing.T) {
+		wf := unmarshalWf(containerSet)
+		for i := range wf.Spec.Templates[0].ContainerSet.Containers {
+			wf.Spec.Templates[0].ContainerSet.Containers[i].Outputs.Artifacts = []wfv1.Artifact{{Name: "message", Path: "/workspace/message"}}
+		}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.containerSet.containers[1].outputs.artifacts.message is not unique amongst the artifacts of the template and its containers")
+	})
+}
+
 var exitHandlerWorkflowStatusOnExit = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2615,3 +3000,161 @@ func TestDagAndStepLevelOutputArtifactsForDiffExecutor(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+var dependsOnWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: depends-on-
+spec:
+  entrypoint: main
+  dependsOn:
+    timeoutSeconds: 3600
+    workflows:
+    - name: upstream
+    - selector:
+        matchLabels:
+          team: data
+      phase: Running
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestValidateDependsOn(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(dependsOnWf)
+		assert.NoError(t, err)
+	})
+	t.Run("NameAndSelector", func(t *testing.T) {
+		wf := unmarshalWf(dependsOnWf)
+		wf.Spec.DependsOn.Workflows[1].Name = "upstream"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.dependsOn.workflows[1] must have exactly one of name or selector")
+	})
+	t.Run("InvalidPhase", func(t *testing.T) {
+		wf := unmarshalWf(dependsOnWf)
+		wf.Spec.DependsOn.Workflows[0].Phase = wfv1.NodeSkipped
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.dependsOn.workflows[0].phase must be one of Running, Succeeded, Failed or Error")
+	})
+	t.Run("InvalidTimeout", func(t *testing.T) {
+		wf := unmarshalWf(dependsOnWf)
+		wf.Spec.DependsOn.TimeoutSeconds = pointer.Int64Ptr(0)
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.dependsOn.timeoutSeconds must be greater than zero")
+	})
+}
+
+var synchronizationWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: synchronization-
+spec:
+  entrypoint: main
+  synchronization:
+    semaphores:
+    - configMapKeyRef:
+        name: my-config
+        key: workflow
+    - database:
+        key: warehouse
+    mutexes:
+    - name: deploy
+    - name: deploy
+      database: true
+  templates:
+  - name: main
+    synchronization:
+      mutex:
+        name: main
+      mutexes:
+      - name: other
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestValidateSynchronization(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(synchronizationWf)
+		assert.NoError(t, err)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Synchronization = &wfv1.Synchronization{}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.synchronization must have at least one semaphore or mutex")
+	})
+	t.Run("ConfigMapAndDatabase", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Synchronization.Semaphores[1].ConfigMapKeyRef = wf.Spec.Synchronization.Semaphores[0].ConfigMapKeyRef
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.synchronization.semaphores[1] must have exactly one of configMapKeyRef or database")
+	})
+	t.Run("InvalidDatabaseKey", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Synchronization.Semaphores[1].Database.Key = "default/warehouse"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.synchronization.semaphores[1].database.key must be non-empty and must not contain '/'")
+	})
+	t.Run("DuplicateSemaphore", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Synchronization.Semaphore = wf.Spec.Synchronization.Semaphores[1].DeepCopy()
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.synchronization.semaphores[1]: database semaphore warehouse is already used")
+	})
+	t.Run("DuplicateMutex", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Templates[0].Synchronization.Mutexes[0].Name = "main"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.synchronization.mutexes[0]: mutex main is already used")
+	})
+	t.Run("MissingMutexName", func(t *testing.T) {
+		wf := unmarshalWf(synchronizationWf)
+		wf.Spec.Templates[0].Synchronization.Mutex.Name = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.synchronization.mutex.name must be non-empty and must not contain '/'")
+	})
+}
+
+var retryStrategyWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-strategy-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      expression: 'lastRetry.exitCode == "137" || lastRetry.message matches "OOM"'
+      affinity:
+        nodeAntiAffinity: {}
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestValidateRetryStrategy(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(retryStrategyWf)
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		wf := unmarshalWf(retryStrategyWf)
+		wf.Spec.Templates[0].RetryStrategy.Expression = "lastRetry.exitCode =="
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "templates.main.retryStrategy.expression is invalid")
+		}
+	})
+	t.Run("NodeAntiAffinityWithoutPod", func(t *testing.T) {
+		wf := unmarshalWf(retryStrategyWf)
+		wf.Spec.Templates[0].Container = nil
+		wf.Spec.Templates[0].Suspend = &wfv1.SuspendTemplate{}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.retryStrategy.affinity.nodeAntiAffinity is only supported by templates which run pods")
+	})
+}